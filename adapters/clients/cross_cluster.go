@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// CrossClusterPrimary reads from the cluster API of a primary cluster on
+// behalf of a follower cluster
+type CrossClusterPrimary struct {
+	client *http.Client
+}
+
+func NewCrossClusterPrimary(httpClient *http.Client) *CrossClusterPrimary {
+	return &CrossClusterPrimary{client: httpClient}
+}
+
+// SchemaLog returns the schema changes following the raft log index from
+func (c *CrossClusterPrimary) SchemaLog(ctx context.Context, host string, from uint64, limit int) (*api.CrossClusterSchemaLog, error) {
+	query := url.Values{}
+	query.Set("from", strconv.FormatUint(from, 10))
+	query.Set("limit", strconv.Itoa(limit))
+	u := url.URL{Scheme: "http", Host: host, Path: "/cluster/cross_cluster/schema", RawQuery: query.Encode()}
+
+	var log api.CrossClusterSchemaLog
+	if err := c.get(ctx, u, &log); err != nil {
+		return nil, err
+	}
+	return &log, nil
+}
+
+// ShardHosts returns the hosts of the nodes holding each shard of the class
+func (c *CrossClusterPrimary) ShardHosts(ctx context.Context, host, class string) (map[string][]string, error) {
+	query := url.Values{}
+	query.Set("class", class)
+	u := url.URL{Scheme: "http", Host: host, Path: "/cluster/cross_cluster/shards", RawQuery: query.Encode()}
+
+	var hosts map[string][]string
+	if err := c.get(ctx, u, &hosts); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+func (c *CrossClusterPrimary) get(ctx context.Context, u url.URL, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return enterrors.NewErrUnmarshalBody(err)
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -618,6 +619,40 @@ func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	return status, c.retry(ctx, MAX_RETRIES, try)
 }
 
+func (c *RemoteIndex) ReadChanges(ctx context.Context,
+	hostName, indexName, shardName string, after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	query := url.Values{
+		"after":      []string{strconv.FormatUint(after, 10)},
+		"limit":      []string{strconv.Itoa(limit)},
+		"withObject": []string{strconv.FormatBool(withObject)},
+	}
+	req, err := setupRequest(ctx, http.MethodGet, hostName,
+		fmt.Sprintf("/indices/%s/shards/%s/changes", indexName, shardName),
+		query.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	var changes []changelog.Change
+	try := func(ctx context.Context) (bool, error) {
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		if err := json.NewDecoder(res.Body).Decode(&changes); err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+	return changes, c.retry(ctx, MAX_RETRIES, try)
+}
+
 func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
@@ -33,8 +33,10 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 )
 
@@ -184,6 +186,42 @@ func TestRemoteIndexShardStatus(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexReadChanges(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		path = "/indices/C1/shards/S1/changes"
+		fs   = newFakeRemoteIndexServer(t, http.MethodGet, path)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	n := 0
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		switch n {
+		case 0:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			assert.Equal(t, "5", r.URL.Query().Get("after"))
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			assert.Equal(t, "true", r.URL.Query().Get("withObject"))
+			w.Write([]byte(`[{"offset":6,"id":"00000000-0000-0000-0000-000000000001","operation":"DELETE","timestamp":7}]`))
+		}
+		n++
+	}
+
+	changes, err := client.ReadChanges(ctx, fs.host, "C1", "S1", 5, 10, true)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, changelog.Change{
+		Offset:    6,
+		ID:        "00000000-0000-0000-0000-000000000001",
+		Operation: changelog.OperationDelete,
+		Timestamp: 7,
+	}, changes[0])
+}
+
 func TestRemoteIndexPutFile(t *testing.T) {
 	t.Parallel()
 	var (
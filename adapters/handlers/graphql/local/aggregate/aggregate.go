@@ -247,7 +247,7 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 		return makePropertyField(class, property, booleanPropertyFields)
 	case schema.DataTypeDateArray:
 		return makePropertyField(class, property, datePropertyFields)
-	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
+	case schema.DataTypeUUID, schema.DataTypeUUIDArray, schema.DataTypeSparseVector:
 		// not aggregatable
 		return nil, nil
 	case schema.DataTypeObject, schema.DataTypeObjectArray:
@@ -133,6 +133,14 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		args.Query = query.(string)
 	}
 
+	if nearSparse, ok := source["nearSparseVector"]; ok {
+		params, err := ExtractNearSparseVector(nearSparse.(map[string]interface{}))
+		if err != nil {
+			return nil, nil, err
+		}
+		args.NearSparseVectorParams = params
+	}
+
 	fusionType, ok := source["fusionType"]
 	if ok {
 		args.FusionAlgorithm = fusionType.(int)
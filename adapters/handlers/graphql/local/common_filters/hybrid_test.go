@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 func TestHybrid(t *testing.T) {
@@ -49,6 +50,15 @@ func TestHybrid(t *testing.T) {
 			output:            &searchparams.HybridSearch{NearVectorParams: &searchparams.NearVector{Vectors: []models.Vector{[]float32{1, 2, 3}, []float32{1, 2}}}, TargetVectors: []string{"target1", "target2"}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
 			outputCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: nilweights},
 		},
+		{
+			input:             map[string]interface{}{"query": "foo", "nearSparseVector": map[string]interface{}{"property": "splade", "indices": []interface{}{7, 3}, "values": []interface{}{0.5, 1.5}}},
+			output:            &searchparams.HybridSearch{Query: "foo", NearSparseVectorParams: &searchparams.NearSparseVector{Property: "splade", Vector: sparsevector.Vector{Indices: []uint32{3, 7}, Values: []float32{1.5, 0.5}}}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
+			outputCombination: nil,
+		},
+		{
+			input: map[string]interface{}{"nearSparseVector": map[string]interface{}{"property": "splade", "indices": []interface{}{3, 3}, "values": []interface{}{0.5, 1.5}}},
+			error: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-platform/graphql"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/sparsevector"
+)
+
+// NearSparseVectorFields returns the input fields shared by the top-level
+// nearSparseVector argument and the hybrid nearSparseVector leg.
+func NearSparseVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: "The sparseVector property to search in",
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"indices": &graphql.InputObjectFieldConfig{
+			Description: "The dimension indices of the query vector",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Int)),
+		},
+		"values": &graphql.InputObjectFieldConfig{
+			Description: "The weights of the query vector, one per index",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+	}
+}
+
+func ExtractNearSparseVector(source map[string]interface{}) (*searchparams.NearSparseVector, error) {
+	var args searchparams.NearSparseVector
+
+	if property, ok := source["property"]; ok {
+		args.Property = property.(string)
+	}
+
+	var indices []uint32
+	if raw, ok := source["indices"]; ok {
+		rawSlice := raw.([]interface{})
+		indices = make([]uint32, len(rawSlice))
+		for i, idx := range rawSlice {
+			asInt := idx.(int)
+			if asInt < 0 {
+				return nil, fmt.Errorf("nearSparseVector: index %d must not be negative", asInt)
+			}
+			indices[i] = uint32(asInt)
+		}
+	}
+
+	var values []float32
+	if raw, ok := source["values"]; ok {
+		rawSlice := raw.([]interface{})
+		values = make([]float32, len(rawSlice))
+		for i, val := range rawSlice {
+			values[i] = float32(val.(float64))
+		}
+	}
+
+	vec, err := sparsevector.New(indices, values)
+	if err != nil {
+		return nil, fmt.Errorf("nearSparseVector: %w", err)
+	}
+	args.Vector = *vec
+
+	return &args, nil
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
@@ -94,6 +95,15 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String,
 		}
+	case schema.DataTypeSparseVector:
+		obj := newSparseVectorObject(className, property.Name)
+
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        obj,
+			Resolve:     resolveSparseVector,
+		}
 	case schema.DataTypeTextArray:
 		return &graphql.Field{
 			Description: property.Description,
@@ -161,6 +171,25 @@ func newGeoCoordinatesObject(className string, propertyName string) *graphql.Obj
 	})
 }
 
+func newSparseVectorObject(className string, propertyName string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Description: "SparseVector as parallel lists of dimension indices and weights",
+		Name:        fmt.Sprintf("%s%sSparseVectorObj", className, propertyName),
+		Fields: graphql.Fields{
+			"indices": &graphql.Field{
+				Name:        "Indices",
+				Description: "The dimension indices of the non-zero weights, in ascending order.",
+				Type:        graphql.NewList(graphql.Int),
+			},
+			"values": &graphql.Field{
+				Name:        "Values",
+				Description: "The weights for each of the dimension indices.",
+				Type:        graphql.NewList(graphql.Float),
+			},
+		},
+	})
+}
+
 func newPhoneNumberObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "PhoneNumber in various parsed formats",
@@ -240,6 +269,7 @@ func buildGetClassField(classObject *graphql.Object,
 	}
 
 	field.Args["bm25"] = bm25Argument(class.Class)
+	field.Args["nearSparseVector"] = nearSparseVectorArgument(class.Class)
 	field.Args["hybrid"] = hybridArgument(classObject, class, modulesProvider, fusionEnum)
 
 	if modulesProvider != nil {
@@ -276,6 +306,32 @@ func resolveGeoCoordinates(p graphql.ResolveParams) (interface{}, error) {
 	}, nil
 }
 
+func resolveSparseVector(p graphql.ResolveParams) (interface{}, error) {
+	field := p.Source.(map[string]interface{})[p.Info.FieldName]
+	if field == nil {
+		return nil, nil
+	}
+
+	vec, err := sparsevector.Parse(field)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, len(vec.Indices))
+	for i := range vec.Indices {
+		indices[i] = int(vec.Indices[i])
+	}
+	values := make([]float64, len(vec.Values))
+	for i := range vec.Values {
+		values[i] = float64(vec.Values[i])
+	}
+
+	return map[string]interface{}{
+		"indices": indices,
+		"values":  values,
+	}, nil
+}
+
 func resolvePhoneNumber(p graphql.ResolveParams) (interface{}, error) {
 	field := p.Source.(map[string]interface{})[p.Info.FieldName]
 	if field == nil {
@@ -440,6 +496,25 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		keywordRankingParams = &p
 	}
 
+	if nearSparse, ok := p.Args["nearSparseVector"]; ok {
+		if len(sort) > 0 {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with sort")
+		}
+		if keywordRankingParams != nil {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with bm25")
+		}
+		params, err := common_filters.ExtractNearSparseVector(nearSparse.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		keywordRankingParams = &searchparams.KeywordRanking{
+			Type:                   "sparseVector",
+			Properties:             []string{params.Property},
+			SparseVector:           params,
+			AdditionalExplanations: addlProps.ExplainScore,
+		}
+	}
+
 	// Extract hybrid search params from the processed query
 	// Everything hybrid can go in another namespace AFTER modulesprovider is
 	// refactored
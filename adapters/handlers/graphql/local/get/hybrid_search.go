@@ -76,6 +76,13 @@ func hybridOperands(classObject *graphql.Object,
 			Type:        graphql.NewList(graphql.String),
 		},
 		"bm25SearchOperator": common_filters.GenerateBM25SearchOperatorFields(prefixName),
+		"nearSparseVector": &graphql.InputObjectFieldConfig{
+			Description: "Use a sparseVector property as the keyword leg instead of bm25",
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sHybridNearSparseVectorInpObj", prefixName),
+				Fields: common_filters.NearSparseVectorFields(),
+			}),
+		},
 
 		"searches": &graphql.InputObjectFieldConfig{
 			Description: "Subsearch list",
@@ -43,3 +43,15 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 		"searchOperator": common_filters.GenerateBM25SearchOperatorFields(prefix),
 	}
 }
+
+func nearSparseVectorArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearSparseVectorInpObj", prefix),
+				Fields: common_filters.NearSparseVectorFields(),
+			},
+		),
+	}
+}
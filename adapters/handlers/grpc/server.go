@@ -32,6 +32,7 @@ import (
 	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -94,7 +95,10 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) (*grpc.S
 
 	interceptors = append(interceptors, makeIPInterceptor())
 	interceptors = append(interceptors, makeOperationalModeInterceptor(state))
+	interceptors = append(interceptors, makeCrossClusterFollowerInterceptor(state.CrossClusterFollower.CheckWritable))
 	interceptors = append(interceptors, makeMaintenanceModeUnaryInterceptor(state.Cluster.MaintenanceModeEnabledForLocalhost))
+	interceptors = append(interceptors, makeSessionTokenInterceptor())
+	interceptors = append(interceptors, makeWriteConcernInterceptor())
 
 	// Add OpenTelemetry tracing interceptors
 	interceptors = append(interceptors, monitoring.GRPCTracingInterceptor())
@@ -112,6 +116,9 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) (*grpc.S
 
 	o = append(o, grpc.ChainStreamInterceptor(makeAuthStreamInterceptor(auth.NewHandler(allowAnonymous, authComposer))))
 	o = append(o, grpc.ChainStreamInterceptor(makeMaintenanceModeStreamInterceptor(state.Cluster.MaintenanceModeEnabledForLocalhost)))
+	o = append(o, grpc.ChainStreamInterceptor(makeCrossClusterFollowerStreamInterceptor(state.CrossClusterFollower.CheckWritable)))
+	o = append(o, grpc.ChainStreamInterceptor(makeSessionTokenStreamInterceptor()))
+	o = append(o, grpc.ChainStreamInterceptor(makeWriteConcernStreamInterceptor()))
 
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
@@ -223,6 +230,47 @@ func makeOperationalModeInterceptor(state *state.State) grpc.UnaryServerIntercep
 	}
 }
 
+// crossClusterFollowerMethods are the methods rejected while the cluster
+// follows a primary cluster, their data is replicated from the primary.
+var crossClusterFollowerMethods = map[string]struct{}{
+	pbv1.Weaviate_BatchObjects_FullMethodName:    {},
+	pbv1.Weaviate_BatchReferences_FullMethodName: {},
+	pbv1.Weaviate_BatchDelete_FullMethodName:     {},
+	pbv1.Weaviate_BatchStream_FullMethodName:     {},
+}
+
+// makeCrossClusterFollowerInterceptor rejects client writes while
+// checkWritable returns an error, see crosscluster.Follower
+func makeCrossClusterFollowerInterceptor(checkWritable func() error) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkCrossClusterFollower(info.FullMethod, checkWritable); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func makeCrossClusterFollowerStreamInterceptor(checkWritable func() error) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkCrossClusterFollower(info.FullMethod, checkWritable); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkCrossClusterFollower(method string, checkWritable func() error) error {
+	if _, ok := crossClusterFollowerMethods[method]; !ok {
+		return nil
+	}
+	if err := checkWritable(); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
 func basicAuthUnaryInterceptor(servicePrefix, expectedUsername, expectedPassword string) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
@@ -265,6 +313,132 @@ func makeMaintenanceModeUnaryInterceptor(maintenanceModeEnabledForLocalhost func
 	}
 }
 
+// makeSessionTokenInterceptor attaches the replica session of the request's
+// token to its context and returns the session's updated token in the
+// response header, see replica.SessionTokenHeader
+func makeSessionTokenInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		session, err := sessionFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(replica.ContextWithSession(ctx, session), req)
+		if token := session.Token(); token != "" {
+			grpc.SetHeader(ctx, metadata.Pairs(replica.SessionTokenHeader, token))
+		}
+		return resp, err
+	}
+}
+
+// makeSessionTokenStreamInterceptor attaches the replica session of the
+// stream's token to its context. The headers of a stream are sent with its
+// first message, before any write completed, so the session's updated token
+// is returned in the trailer instead.
+func makeSessionTokenStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		session, err := sessionFromMetadata(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		err = handler(srv, &contextServerStream{
+			ServerStream: ss,
+			ctx:          replica.ContextWithSession(ss.Context(), session),
+		})
+		if token := session.Token(); token != "" {
+			ss.SetTrailer(metadata.Pairs(replica.SessionTokenHeader, token))
+		}
+		return err
+	}
+}
+
+// sessionFromMetadata parses the session token set by the incoming metadata
+// of ctx. It returns an empty session if none is set.
+func sessionFromMetadata(ctx context.Context) (*replica.Session, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(replica.SessionTokenHeader); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	session, err := replica.ParseSessionToken(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return session, nil
+}
+
+// makeWriteConcernInterceptor attaches the write concern set by the request's
+// metadata to its context and returns the replicas which acknowledged the
+// writes in the response header, see replica.WriteAcksHeader. Batch replies
+// list them per object as well.
+func makeWriteConcernInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		wc, err := writeConcernFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if wc == nil {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(replica.ContextWithWriteConcern(ctx, wc), req)
+		if acks := wc.Header(); acks != "" {
+			grpc.SetHeader(ctx, metadata.Pairs(replica.WriteAcknowledgementsHeader, acks))
+		}
+		return resp, err
+	}
+}
+
+// makeWriteConcernStreamInterceptor attaches the write concern set by the
+// stream's metadata to its context. Streaming batch returns the replicas which
+// acknowledged each object in its results instead of a header.
+func makeWriteConcernStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wc, err := writeConcernFromMetadata(ss.Context())
+		if err != nil {
+			return err
+		}
+		if wc == nil {
+			return handler(srv, ss)
+		}
+		return handler(srv, &contextServerStream{
+			ServerStream: ss,
+			ctx:          replica.ContextWithWriteConcern(ss.Context(), wc),
+		})
+	}
+}
+
+// contextServerStream wraps a grpc.ServerStream to replace its context
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// writeConcernFromMetadata parses the write concern set by the incoming
+// metadata of ctx. It returns nil if none is set.
+func writeConcernFromMetadata(ctx context.Context) (*replica.WriteConcern, error) {
+	var acks, timeout string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(replica.WriteAcksHeader); len(values) > 0 {
+			acks = values[0]
+		}
+		if values := md.Get(replica.WriteTimeoutHeader); len(values) > 0 {
+			timeout = values[0]
+		}
+	}
+	wc, err := replica.ParseWriteConcern(acks, timeout)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return wc, nil
+}
+
 func makeMaintenanceModeStreamInterceptor(maintenanceModeEnabledForLocalhost func() bool) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		if maintenanceModeEnabledForLocalhost() {
@@ -26,6 +26,7 @@ import (
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -115,15 +116,24 @@ func (h *Handler) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 		return nil, err
 	}
 
+	wc := replica.WriteConcernFromContext(ctx)
+	var objAcks []*pb.BatchObjectsReply_Acknowledgement
 	for i, obj := range response {
 		if obj.Err != nil {
 			objErrors = append(objErrors, &pb.BatchObjectsReply_BatchError{Index: int32(objOriginalIndex[i]), Error: obj.Err.Error()})
 		}
+		if wc == nil || obj.Object == nil {
+			continue
+		}
+		if replicas := wc.ObjectAcknowledgements(obj.Object.Class, obj.Object.Tenant, obj.UUID); replicas != nil {
+			objAcks = append(objAcks, &pb.BatchObjectsReply_Acknowledgement{Index: int32(objOriginalIndex[i]), Replicas: replicas})
+		}
 	}
 
 	result := &pb.BatchObjectsReply{
-		Took:   float32(time.Since(before).Seconds()),
-		Errors: objErrors,
+		Took:             float32(time.Since(before).Seconds()),
+		Errors:           objErrors,
+		Acknowledgements: objAcks,
 	}
 	return result, nil
 }
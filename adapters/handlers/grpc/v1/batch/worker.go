@@ -136,7 +136,13 @@ func (w *worker) fanoutObjects(
 		wg.Add(1)
 		enterrors.GoWrapper(func() {
 			defer wg.Done()
-			reply, err := w.batcher.BatchObjects(ctx, &pb.BatchObjectsRequest{
+			subCtx := ctx
+			// each sub-batch collects its acknowledgements in a write concern of
+			// its own so that they do not pile up over the lifetime of the stream
+			if wc := replica.WriteConcernFromContext(ctx); wc != nil {
+				subCtx = replica.ContextWithWriteConcern(ctx, replica.NewWriteConcern(wc.Acks, wc.Timeout))
+			}
+			reply, err := w.batcher.BatchObjects(subCtx, &pb.BatchObjectsRequest{
 				Objects:          subBatch,
 				ConsistencyLevel: cl,
 			})
@@ -180,6 +186,8 @@ func (w *worker) sendObjects(
 	errored := make(map[int32]struct{})
 	// Keep track of retriable errors to send again
 	retriable := make([]*pb.BatchObject, 0)
+	// Nodes which acknowledged the write of each object under a write concern
+	acked := make(map[*pb.BatchObject][]string)
 
 	objsByCollection := make(map[string][]*pb.BatchObject)
 	for _, obj := range objs {
@@ -207,6 +215,9 @@ func (w *worker) sendObjects(
 					}
 					return
 				}
+				for _, ack := range resp.reply.GetAcknowledgements() {
+					acked[objs[ack.Index+lastIndex]] = ack.Replicas
+				}
 				if len(resp.reply.GetErrors()) > 0 {
 					for _, err := range resp.reply.GetErrors() {
 						index := err.Index + lastIndex
@@ -220,8 +231,9 @@ func (w *worker) sendObjects(
 							continue
 						}
 						errors = append(errors, &pb.BatchStreamReply_Results_Error{
-							Error:  err.Error,
-							Detail: &pb.BatchStreamReply_Results_Error_Uuid{Uuid: objs[index].Uuid},
+							Error:                err.Error,
+							Detail:               &pb.BatchStreamReply_Results_Error_Uuid{Uuid: objs[index].Uuid},
+							AcknowledgedReplicas: acked[objs[index]],
 						})
 					}
 				}
@@ -245,7 +257,8 @@ func (w *worker) sendObjects(
 			continue
 		}
 		successes = append(successes, &pb.BatchStreamReply_Results_Success{
-			Detail: &pb.BatchStreamReply_Results_Success_Uuid{Uuid: obj.Uuid},
+			Detail:               &pb.BatchStreamReply_Results_Success_Uuid{Uuid: obj.Uuid},
+			AcknowledgedReplicas: acked[obj],
 		})
 	}
 	return successes, errors
@@ -335,4 +335,64 @@ func TestWorkerLoop(t *testing.T) {
 		wg.Wait()
 		require.Empty(t, processingQueue, "Expected processing queue to be empty after processing")
 	})
+
+	t.Run("should return the replicas which acknowledged each object under a write concern", func(t *testing.T) {
+		mockBatcher := mocks.NewMockbatcher(t)
+
+		reportingQueues := NewReportingQueues()
+		reportingQueues.Make(StreamId)
+		processingQueue := NewProcessingQueue()
+
+		streamWC := replica.NewWriteConcern(2, time.Second)
+		mockBatcher.EXPECT().BatchObjects(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
+			wc := replica.WriteConcernFromContext(ctx)
+			require.NotNil(t, wc, "Expected the write concern of the stream to be passed on")
+			require.NotSame(t, streamWC, wc, "Expected each sub-batch to use a write concern of its own")
+			require.Equal(t, 2, wc.Acks)
+			require.Equal(t, time.Second, wc.Timeout)
+			return &pb.BatchObjectsReply{
+				Took:   float32(1),
+				Errors: []*pb.BatchObjectsReply_BatchError{{Error: "objs error", Index: 1}},
+				Acknowledgements: []*pb.BatchObjectsReply_Acknowledgement{
+					{Index: 0, Replicas: []string{"node-0", "node-1"}},
+					{Index: 1, Replicas: []string{"node-0"}},
+				},
+			}, nil
+		}).Times(1)
+
+		var wg sync.WaitGroup
+		StartBatchWorkers(&wg, 1, processingQueue, reportingQueues, mockBatcher, logger)
+
+		collection := "TestCollection"
+		objs := []*pb.BatchObject{
+			{Collection: collection, Uuid: uuid.New().String()},
+			{Collection: collection, Uuid: uuid.New().String()},
+		}
+
+		// Send data
+		wg.Add(1)
+		go func() {
+			processingQueue <- &processRequest{
+				objects:                       objs,
+				streamId:                      StreamId,
+				streamCtx:                     replica.ContextWithWriteConcern(ctx, streamWC),
+				usesVectorisationByCollection: map[string]bool{collection: false},
+				onComplete:                    func() { wg.Done() },
+				onStart:                       func() {},
+			}
+		}()
+
+		rq, ok := reportingQueues.Get(StreamId)
+		require.True(t, ok, "Expected reporting queue to exist and to contain message")
+
+		report := <-rq
+		require.Len(t, report.Successes, 1, "Expected one success to be returned")
+		require.Equal(t, objs[0].GetUuid(), report.Successes[0].GetUuid())
+		require.Equal(t, []string{"node-0", "node-1"}, report.Successes[0].GetAcknowledgedReplicas())
+		require.Len(t, report.Errors, 1, "Expected one error to be returned")
+		require.Equal(t, objs[1].GetUuid(), report.Errors[0].GetUuid())
+		require.Equal(t, []string{"node-0"}, report.Errors[0].GetAcknowledgedReplicas())
+		close(processingQueue) // Allow the draining logic to exit naturally
+		wg.Wait()
+	})
 }
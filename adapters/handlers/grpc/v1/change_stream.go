@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/changelog"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+const (
+	changeStreamBatchSize    = 100
+	changeStreamPollInterval = 500 * time.Millisecond
+	// when a shard is read from another replica than before, the stream
+	// resumes this far before the last offset. Offsets follow the wall clock
+	// of the replica, so this covers the replication lag and clock skew
+	// between the replicas, at the price of repeated changes.
+	changeStreamReplicaSwitchMargin = uint64(time.Minute)
+)
+
+type changeReader interface {
+	ChangeSources(ctx context.Context, className, tenant string) ([]changelog.Source, error)
+	ReadChanges(ctx context.Context, className string, source changelog.Source,
+		after uint64, limit int, withObject bool) ([]changelog.Change, error)
+}
+
+// ChangeStream sends the mutations of the objects of a collection, or of a
+// tenant, to the client until the client cancels the stream. Changes are
+// delivered at least once and in order per shard.
+func (s *Service) ChangeStream(req *pb.ChangeStreamRequest, stream pb.Weaviate_ChangeStreamServer) error {
+	ctx := stream.Context()
+
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	principal, err := s.authenticator.PrincipalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	cs, err := newChangeStream(req, s.traverser, func() error {
+		_, err := s.classGetterWithAuthzFunc(ctx, principal, req.GetTenant())(req.Collection)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return cs.run(ctx, stream.Send)
+}
+
+type changeStream struct {
+	req       *pb.ChangeStreamRequest
+	reader    changeReader
+	authorize func() error
+
+	sources []changelog.Source
+	// offsets of the last delivered change per source
+	offsets map[changelog.Source]uint64
+	// offsets by shard, to resume a shard on another replica
+	shardOffsets map[string]uint64
+	start        uint64
+
+	pollInterval time.Duration
+}
+
+func newChangeStream(req *pb.ChangeStreamRequest, reader changeReader, authorize func() error) (*changeStream, error) {
+	if req.Collection == "" {
+		return nil, fmt.Errorf("collection is required")
+	}
+
+	cs := &changeStream{
+		req:          req,
+		reader:       reader,
+		authorize:    authorize,
+		offsets:      map[changelog.Source]uint64{},
+		shardOffsets: map[string]uint64{},
+		pollInterval: changeStreamPollInterval,
+	}
+	if req.SinceUnixMs != nil {
+		if req.GetSinceUnixMs() <= 0 {
+			return nil, fmt.Errorf("since_unix_ms must be positive")
+		}
+		cs.start = uint64(time.UnixMilli(req.GetSinceUnixMs()).UnixNano()) - 1
+	}
+	for _, o := range req.Offsets {
+		cs.offsets[changelog.Source{Shard: o.Shard, Node: o.Node}] = o.Offset
+		cs.shardOffsets[o.Shard] = max(cs.shardOffsets[o.Shard], o.Offset)
+	}
+	return cs, nil
+}
+
+func (cs *changeStream) run(ctx context.Context, send func(*pb.ChangeEvent) error) error {
+	for {
+		// permissions and shards may change during the lifetime of the stream
+		if err := cs.authorize(); err != nil {
+			return err
+		}
+		if err := cs.refreshSources(ctx); err != nil {
+			return err
+		}
+
+		sent := 0
+		for _, source := range cs.sources {
+			n, err := cs.drain(ctx, source, send)
+			if err != nil {
+				return err
+			}
+			sent += n
+		}
+
+		if sent > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cs.pollInterval):
+		}
+	}
+}
+
+func (cs *changeStream) refreshSources(ctx context.Context) error {
+	sources, err := cs.reader.ChangeSources(ctx, cs.req.Collection, cs.req.GetTenant())
+	if err != nil {
+		return fmt.Errorf("resolve shards: %w", err)
+	}
+
+	for _, source := range sources {
+		if _, ok := cs.offsets[source]; ok {
+			continue
+		}
+		if offset, ok := cs.shardOffsets[source.Shard]; ok {
+			cs.offsets[source] = offset - min(offset, changeStreamReplicaSwitchMargin)
+		} else {
+			cs.offsets[source] = cs.start
+		}
+	}
+	cs.sources = sources
+	return nil
+}
+
+// drain sends the changes of the source until it is caught up
+func (cs *changeStream) drain(ctx context.Context, source changelog.Source, send func(*pb.ChangeEvent) error) (int, error) {
+	sent := 0
+	for {
+		changes, err := cs.reader.ReadChanges(ctx, cs.req.Collection, source,
+			cs.offsets[source], changeStreamBatchSize, cs.req.IncludeObject)
+		if err != nil {
+			return sent, fmt.Errorf("read changes of shard %s on node %s: %w", source.Shard, source.Node, err)
+		}
+
+		for _, change := range changes {
+			event, err := changeEventFromChange(source, change, cs.req.Tenant)
+			if err != nil {
+				return sent, err
+			}
+			if err := send(event); err != nil {
+				return sent, err
+			}
+			cs.offsets[source] = change.Offset
+			cs.shardOffsets[source.Shard] = change.Offset
+			sent++
+		}
+
+		if len(changes) < changeStreamBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+var changeEventOperations = map[changelog.Operation]pb.ChangeEvent_Operation{
+	changelog.OperationInsert: pb.ChangeEvent_OPERATION_INSERT,
+	changelog.OperationUpdate: pb.ChangeEvent_OPERATION_UPDATE,
+	changelog.OperationDelete: pb.ChangeEvent_OPERATION_DELETE,
+}
+
+func changeEventFromChange(source changelog.Source, change changelog.Change, tenant *string) (*pb.ChangeEvent, error) {
+	event := &pb.ChangeEvent{
+		Shard:           source.Shard,
+		Node:            source.Node,
+		Offset:          change.Offset,
+		Uuid:            change.ID.String(),
+		Operation:       changeEventOperations[change.Operation],
+		Tenant:          tenant,
+		TimestampUnixMs: change.Timestamp,
+	}
+
+	if len(change.Properties) > 0 {
+		var props map[string]interface{}
+		if err := json.Unmarshal(change.Properties, &props); err != nil {
+			return nil, fmt.Errorf("unmarshal properties of object %s: %w", change.ID, err)
+		}
+		properties, err := structpb.NewStruct(props)
+		if err != nil {
+			return nil, fmt.Errorf("convert properties of object %s: %w", change.ID, err)
+		}
+		event.Properties = properties
+	}
+	return event, nil
+}
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changelog"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+type fakeChangeReader struct {
+	sources []changelog.Source
+	changes map[changelog.Source][]changelog.Change
+	reads   []uint64
+}
+
+func (f *fakeChangeReader) ChangeSources(ctx context.Context, className, tenant string) ([]changelog.Source, error) {
+	return f.sources, nil
+}
+
+func (f *fakeChangeReader) ReadChanges(ctx context.Context, className string, source changelog.Source,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	f.reads = append(f.reads, after)
+	var out []changelog.Change
+	for _, change := range f.changes[source] {
+		if change.Offset > after && len(out) < limit {
+			if !withObject {
+				change.Properties = nil
+			}
+			out = append(out, change)
+		}
+	}
+	return out, nil
+}
+
+// collect runs the stream until n events got sent
+func collect(t *testing.T, cs *changeStream, n int) []*pb.ChangeEvent {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []*pb.ChangeEvent
+	err := cs.run(ctx, func(event *pb.ChangeEvent) error {
+		events = append(events, event)
+		if len(events) == n {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	return events
+}
+
+func TestChangeStream(t *testing.T) {
+	id1 := strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	id2 := strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	source := changelog.Source{Shard: "tenant1", Node: "node1"}
+	allowed := func() error { return nil }
+
+	newReader := func() *fakeChangeReader {
+		return &fakeChangeReader{
+			sources: []changelog.Source{source},
+			changes: map[changelog.Source][]changelog.Change{source: {
+				{Offset: 10, ID: id1, Operation: changelog.OperationInsert, Timestamp: 1000, Properties: []byte(`{"name":"a"}`)},
+				{Offset: 20, ID: id1, Operation: changelog.OperationUpdate, Timestamp: 2000, Properties: []byte(`{"name":"b","count":2}`)},
+				{Offset: 30, ID: id2, Operation: changelog.OperationDelete, Timestamp: 3000},
+			}},
+		}
+	}
+
+	t.Run("deliver all changes", func(t *testing.T) {
+		tenant := "tenant1"
+		cs, err := newChangeStream(&pb.ChangeStreamRequest{Collection: "C", Tenant: &tenant, IncludeObject: true}, newReader(), allowed)
+		require.NoError(t, err)
+
+		events := collect(t, cs, 3)
+		require.Len(t, events, 3)
+		assert.Equal(t, []pb.ChangeEvent_Operation{
+			pb.ChangeEvent_OPERATION_INSERT, pb.ChangeEvent_OPERATION_UPDATE, pb.ChangeEvent_OPERATION_DELETE,
+		}, []pb.ChangeEvent_Operation{events[0].Operation, events[1].Operation, events[2].Operation})
+
+		assert.Equal(t, "tenant1", events[1].Shard)
+		assert.Equal(t, "node1", events[1].Node)
+		assert.Equal(t, uint64(20), events[1].Offset)
+		assert.Equal(t, id1.String(), events[1].Uuid)
+		assert.Equal(t, "tenant1", events[1].GetTenant())
+		assert.Equal(t, int64(2000), events[1].TimestampUnixMs)
+		assert.Equal(t, "b", events[1].Properties.Fields["name"].GetStringValue())
+		assert.Equal(t, float64(2), events[1].Properties.Fields["count"].GetNumberValue())
+		assert.Nil(t, events[2].Properties)
+	})
+
+	t.Run("resume from offset", func(t *testing.T) {
+		cs, err := newChangeStream(&pb.ChangeStreamRequest{
+			Collection: "C",
+			Offsets:    []*pb.ChangeStreamOffset{{Shard: "tenant1", Node: "node1", Offset: 20}},
+		}, newReader(), allowed)
+		require.NoError(t, err)
+
+		events := collect(t, cs, 1)
+		assert.Equal(t, uint64(30), events[0].Offset)
+		assert.Nil(t, events[0].Tenant)
+	})
+
+	t.Run("resume on another replica", func(t *testing.T) {
+		reader := newReader()
+		offset := uint64(time.Hour)
+		cs, err := newChangeStream(&pb.ChangeStreamRequest{
+			Collection: "C",
+			Offsets:    []*pb.ChangeStreamOffset{{Shard: "tenant1", Node: "node2", Offset: offset}},
+		}, reader, allowed)
+		require.NoError(t, err)
+
+		require.NoError(t, cs.refreshSources(context.Background()))
+		assert.Equal(t, offset-changeStreamReplicaSwitchMargin, cs.offsets[source])
+	})
+
+	t.Run("start at a point in time", func(t *testing.T) {
+		since := int64(1700000000000)
+		cs, err := newChangeStream(&pb.ChangeStreamRequest{Collection: "C", SinceUnixMs: &since}, newReader(), allowed)
+		require.NoError(t, err)
+
+		require.NoError(t, cs.refreshSources(context.Background()))
+		assert.Equal(t, uint64(since)*uint64(time.Millisecond)-1, cs.offsets[source])
+	})
+
+	t.Run("stop when permissions are revoked", func(t *testing.T) {
+		denied := errors.New("forbidden")
+		calls := 0
+		cs, err := newChangeStream(&pb.ChangeStreamRequest{Collection: "C"}, newReader(), func() error {
+			calls++
+			if calls > 1 {
+				return denied
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		cs.pollInterval = time.Millisecond
+
+		sent := 0
+		err = cs.run(context.Background(), func(*pb.ChangeEvent) error {
+			sent++
+			return nil
+		})
+		require.ErrorIs(t, err, denied)
+		assert.Equal(t, 3, sent)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := newChangeStream(&pb.ChangeStreamRequest{}, newReader(), allowed)
+		require.Error(t, err)
+
+		since := int64(-1)
+		_, err = newChangeStream(&pb.ChangeStreamRequest{Collection: "C", SinceUnixMs: &since}, newReader(), allowed)
+		require.Error(t, err)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/byteops"
 	"google.golang.org/protobuf/runtime/protoimpl"
@@ -96,6 +97,12 @@ func (m *Mapper) NewPrimitiveValue(v interface{}, dt schema.DataType) (*pb.Value
 				return nil, protoimpl.X.NewError("invalid type: %T expected *models.PhoneNumber when serializing phone number property", v)
 			}
 			return newPhoneNumberValue(val), nil
+		case schema.DataTypeSparseVector:
+			val, err := sparsevector.Parse(v)
+			if err != nil {
+				return nil, protoimpl.X.NewError("invalid type: %T expected *sparsevector.Vector when serializing sparse vector property: %v", v, err)
+			}
+			return newSparseVectorValue(val), nil
 		default:
 			return nil, protoimpl.X.NewError("invalid type: %T", v)
 		}
@@ -381,6 +388,23 @@ func newPhoneNumberValue(v *models.PhoneNumber) *pb.Value {
 	}}
 }
 
+// newSparseVectorValue constructs an object Value holding the indices and
+// weights of a sparse vector as int and number lists.
+func newSparseVectorValue(v *sparsevector.Vector) *pb.Value {
+	indices := make([]float64, len(v.Indices))
+	for i := range v.Indices {
+		indices[i] = float64(v.Indices[i])
+	}
+	values := make([]float64, len(v.Values))
+	for i := range v.Values {
+		values[i] = float64(v.Values[i])
+	}
+	return NewObjectValue(&pb.Properties{Fields: map[string]*pb.Value{
+		"indices": newListValue(&pb.ListValue{Kind: &pb.ListValue_IntValues{IntValues: &pb.IntValues{Values: byteops.IntsToByteVector(indices)}}}),
+		"values":  newListValue(&pb.ListValue{Kind: &pb.ListValue_NumberValues{NumberValues: &pb.NumberValues{Values: byteops.Fp64SliceToBytes(values)}}}),
+	}})
+}
+
 // NewNilValue constructs a new nil Value.
 func (m *Mapper) NewNilValue() *pb.Value {
 	return &pb.Value{Kind: &pb.Value_NullValue{}}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// SearchBatch runs many nearVector queries which share the collection,
+// filters and limit. The reply holds one result list per query, in request
+// order.
+func (s *Service) SearchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	var result *pb.SearchBatchReply
+	var errInner error
+
+	if class := s.schemaManager.ResolveAlias(req.Collection); class != "" {
+		req.Collection = class
+	}
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.searchBatch(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) searchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	before := time.Now()
+
+	principal, err := s.authenticator.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	params, err := searchBatchParamsFromProto(req,
+		s.classGetterWithAuthzFunc(ctx, principal, req.GetTenant()), s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.traverser.SearchBatch(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchBatchReplyFromResults(results, before), nil
+}
+
+func searchBatchParamsFromProto(req *pb.SearchBatchRequest,
+	authorizedGetClass classGetterWithAuthzFunc, config *config.Config,
+) (dto.BatchVectorSearchParams, error) {
+	// authorizes reading the collection, even if there are no filters
+	if _, err := authorizedGetClass(req.Collection); err != nil {
+		return dto.BatchVectorSearchParams{}, err
+	}
+
+	if len(req.Queries) == 0 {
+		return dto.BatchVectorSearchParams{}, fmt.Errorf("batch search needs at least one query")
+	}
+
+	params := dto.BatchVectorSearchParams{
+		ClassName:             req.Collection,
+		TargetVector:          req.GetTargetVector(),
+		Limit:                 int(config.QueryDefaults.Limit),
+		ReplicationProperties: extractReplicationProperties(req.ConsistencyLevel),
+		Tenant:                req.GetTenant(),
+		// only ids and distances are returned
+		Properties: []string{},
+	}
+	if req.Limit > 0 {
+		params.Limit = int(req.Limit)
+	}
+
+	params.Vectors = make([][]float32, len(req.Queries))
+	for i, query := range req.Queries {
+		if len(query.VectorBytes) == 0 || len(query.VectorBytes)%4 != 0 {
+			return dto.BatchVectorSearchParams{}, fmt.Errorf("query %d: invalid vector of %d bytes", i, len(query.VectorBytes))
+		}
+		params.Vectors[i] = byteops.Fp32SliceFromBytes(query.VectorBytes)
+		if len(params.Vectors[i]) != len(params.Vectors[0]) {
+			return dto.BatchVectorSearchParams{}, fmt.Errorf("query %d: vector has %d dimensions, expected %d",
+				i, len(params.Vectors[i]), len(params.Vectors[0]))
+		}
+	}
+
+	if req.Filters != nil {
+		clause, err := ExtractFilters(req.Filters, authorizedGetClass, req.Collection, req.GetTenant())
+		if err != nil {
+			return dto.BatchVectorSearchParams{}, fmt.Errorf("extract filters: %w", err)
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(authorizedGetClass, filter); err != nil {
+			return dto.BatchVectorSearchParams{}, fmt.Errorf("validate filters: %w", err)
+		}
+		params.Filters = filter
+	}
+
+	return params, nil
+}
+
+func searchBatchReplyFromResults(results []search.Results, before time.Time) *pb.SearchBatchReply {
+	reply := &pb.SearchBatchReply{
+		Results: make([]*pb.SearchBatchResult, len(results)),
+	}
+	for i, res := range results {
+		hits := make([]*pb.SearchBatchHit, len(res))
+		for j := range res {
+			hits[j] = &pb.SearchBatchHit{
+				Id:       res[j].ID.String(),
+				Distance: res[j].Dist,
+			}
+		}
+		reply.Results[i] = &pb.SearchBatchResult{Hits: hits}
+	}
+	reply.Took = float32(time.Since(before).Seconds())
+	return reply
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestSearchBatchRequest(t *testing.T) {
+	collection := "TestClass"
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: collection,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+
+	getClass := func(name string) (*models.Class, error) {
+		class := scheme.GetClass(name)
+		if class == nil {
+			return nil, errors.New("could not find class " + name + " in schema")
+		}
+		return class, nil
+	}
+
+	cfg := &config.Config{QueryDefaults: config.QueryDefaults{Limit: 10}}
+	tenant := "tenant1"
+
+	tests := []struct {
+		name  string
+		req   *pb.SearchBatchRequest
+		check func(t *testing.T, vectors [][]float32, limit int, filter *filters.LocalFilter)
+		error string
+	}{
+		{
+			name: "default limit",
+			req: &pb.SearchBatchRequest{
+				Collection: collection,
+				Queries: []*pb.SearchBatchQuery{
+					{VectorBytes: byteVector([]float32{1, 2, 3})},
+					{VectorBytes: byteVector([]float32{4, 5, 6})},
+				},
+			},
+			check: func(t *testing.T, vectors [][]float32, limit int, filter *filters.LocalFilter) {
+				require.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, vectors)
+				require.Equal(t, 10, limit)
+				require.Nil(t, filter)
+			},
+		},
+		{
+			name: "explicit limit, tenant and filter",
+			req: &pb.SearchBatchRequest{
+				Collection: collection,
+				Tenant:     &tenant,
+				Limit:      3,
+				Queries:    []*pb.SearchBatchQuery{{VectorBytes: byteVector([]float32{1, 2, 3})}},
+				Filters: &pb.Filters{
+					Operator:  pb.Filters_OPERATOR_EQUAL,
+					TestValue: &pb.Filters_ValueText{ValueText: "test"},
+					Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+				},
+			},
+			check: func(t *testing.T, vectors [][]float32, limit int, filter *filters.LocalFilter) {
+				require.Equal(t, 3, limit)
+				require.NotNil(t, filter)
+				require.Equal(t, filters.OperatorEqual, filter.Root.Operator)
+			},
+		},
+		{
+			name:  "collection does not exist",
+			req:   &pb.SearchBatchRequest{Collection: "does not exist"},
+			error: "could not find class",
+		},
+		{
+			name:  "no queries",
+			req:   &pb.SearchBatchRequest{Collection: collection},
+			error: "at least one query",
+		},
+		{
+			name: "invalid vector bytes",
+			req: &pb.SearchBatchRequest{
+				Collection: collection,
+				Queries:    []*pb.SearchBatchQuery{{VectorBytes: []byte{1, 2, 3}}},
+			},
+			error: "invalid vector",
+		},
+		{
+			name: "dimension mismatch",
+			req: &pb.SearchBatchRequest{
+				Collection: collection,
+				Queries: []*pb.SearchBatchQuery{
+					{VectorBytes: byteVector([]float32{1, 2, 3})},
+					{VectorBytes: byteVector([]float32{1, 2})},
+				},
+			},
+			error: "expected 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := searchBatchParamsFromProto(tt.req, getClass, cfg)
+			if tt.error != "" {
+				require.ErrorContains(t, err, tt.error)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, collection, params.ClassName)
+			require.Equal(t, tt.req.GetTenant(), params.Tenant)
+			tt.check(t, params.Vectors, params.Limit, params.Filters)
+		})
+	}
+}
+
+func TestSearchBatchReply(t *testing.T) {
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	results := []search.Results{
+		{{ID: id, Dist: 0.25}},
+		{},
+	}
+
+	reply := searchBatchReplyFromResults(results, time.Now())
+	require.Len(t, reply.Results, 2)
+	require.Len(t, reply.Results[0].Hits, 1)
+	require.Equal(t, string(id), reply.Results[0].Hits[0].Id)
+	require.Equal(t, float32(0.25), reply.Results[0].Hits[0].Distance)
+	require.Empty(t, reply.Results[1].Hits)
+}
@@ -62,6 +62,20 @@ type ControllerAndGetUsers interface {
 	GetUsers(userIds ...string) (map[string]*apikey.User, error)
 }
 
+// principalController is implemented by controllers that can attribute changes
+// to the principal issuing them, e.g. for the schema audit log
+type principalController interface {
+	WithPrincipal(principal *models.Principal) authorization.Controller
+}
+
+// controllerFor returns the controller to apply changes requested by principal
+func (h *authZHandlers) controllerFor(principal *models.Principal) authorization.Controller {
+	if pc, ok := h.controller.(principalController); ok {
+		return pc.WithPrincipal(principal)
+	}
+	return h.controller
+}
+
 func SetupHandlers(api *operations.WeaviateAPI, controller ControllerAndGetUsers, schemaReader schemaUC.SchemaGetter,
 	apiKeysConfigs config.StaticAPIKey, oidcConfigs config.OIDC, rconfig rbacconf.Config, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger,
 ) {
@@ -162,7 +176,7 @@ func (h *authZHandlers) createRole(params authz.CreateRoleParams, principal *mod
 		return authz.NewCreateRoleConflict().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("role with name %s already exists", *params.Body.Name)))
 	}
 
-	if err = h.controller.CreateRolesPermissions(policies); err != nil {
+	if err = h.controllerFor(principal).CreateRolesPermissions(policies); err != nil {
 		return authz.NewCreateRoleInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
 	}
 
@@ -209,7 +223,7 @@ func (h *authZHandlers) addPermissions(params authz.AddPermissionsParams, princi
 		return authz.NewAddPermissionsNotFound()
 	}
 
-	if err := h.controller.UpdateRolesPermissions(policies); err != nil {
+	if err := h.controllerFor(principal).UpdateRolesPermissions(policies); err != nil {
 		return authz.NewAddPermissionsInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
 	}
 
@@ -263,7 +277,7 @@ func (h *authZHandlers) removePermissions(params authz.RemovePermissionsParams,
 		return authz.NewRemovePermissionsNotFound()
 	}
 
-	if err := h.controller.RemovePermissions(params.ID, permissions); err != nil {
+	if err := h.controllerFor(principal).RemovePermissions(params.ID, permissions); err != nil {
 		return authz.NewRemovePermissionsInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("RemovePermissions: %w", err)))
 	}
 
@@ -433,7 +447,7 @@ func (h *authZHandlers) deleteRole(params authz.DeleteRoleParams, principal *mod
 		return authz.NewDeleteRoleForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
 	}
 
-	if err := h.controller.DeleteRoles(params.ID); err != nil {
+	if err := h.controllerFor(principal).DeleteRoles(params.ID); err != nil {
 		return authz.NewDeleteRoleInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("DeleteRoles: %w", err)))
 	}
 
@@ -485,7 +499,7 @@ func (h *authZHandlers) assignRoleToUser(params authz.AssignRoleToUserParams, pr
 		return authz.NewAssignRoleToUserNotFound().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("username to assign role to doesn't exist")))
 	}
 	for _, userType := range userTypes {
-		if err := h.controller.AddRolesForUser(conv.UserNameWithTypeFromId(params.ID, userType), params.Body.Roles); err != nil {
+		if err := h.controllerFor(principal).AddRolesForUser(conv.UserNameWithTypeFromId(params.ID, userType), params.Body.Roles); err != nil {
 			return authz.NewAssignRoleToUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("AddRolesForUser: %w", err)))
 		}
 	}
@@ -540,7 +554,7 @@ func (h *authZHandlers) assignRoleToGroup(params authz.AssignRoleToGroupParams,
 		return authz.NewAssignRoleToGroupNotFound()
 	}
 
-	if err := h.controller.AddRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles); err != nil {
+	if err := h.controllerFor(principal).AddRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles); err != nil {
 		return authz.NewAssignRoleToGroupInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("AddRolesForUser: %w", err)))
 	}
 
@@ -895,7 +909,7 @@ func (h *authZHandlers) revokeRoleFromUser(params authz.RevokeRoleFromUserParams
 		return authz.NewRevokeRoleFromUserNotFound().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("username to revoke role from doesn't exist")))
 	}
 	for _, userType := range userTypes {
-		if err := h.controller.RevokeRolesForUser(conv.UserNameWithTypeFromId(params.ID, userType), params.Body.Roles...); err != nil {
+		if err := h.controllerFor(principal).RevokeRolesForUser(conv.UserNameWithTypeFromId(params.ID, userType), params.Body.Roles...); err != nil {
 			return authz.NewRevokeRoleFromUserInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("AddRolesForUser: %w", err)))
 		}
 	}
@@ -949,7 +963,7 @@ func (h *authZHandlers) revokeRoleFromGroup(params authz.RevokeRoleFromGroupPara
 		return authz.NewRevokeRoleFromGroupNotFound()
 	}
 
-	if err := h.controller.RevokeRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles...); err != nil {
+	if err := h.controllerFor(principal).RevokeRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles...); err != nil {
 		return authz.NewRevokeRoleFromGroupInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("RevokeRolesForGroup: %w", err)))
 	}
 
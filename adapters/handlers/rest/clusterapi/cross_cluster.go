@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+)
+
+const defaultCrossClusterSchemaLimit = 100
+
+type crossClusterSource interface {
+	CrossClusterSchemaLog(from uint64, limit int) (*api.CrossClusterSchemaLog, error)
+	CrossClusterShardNodes(class string) (map[string][]string, error)
+}
+
+type nodeResolver interface {
+	NodeHostname(nodeName string) (string, bool)
+}
+
+// CrossCluster serves the schema changes and the shard replicas of this
+// cluster to follower clusters
+type CrossCluster struct {
+	source   crossClusterSource
+	resolver nodeResolver
+	auth     auth
+}
+
+func NewCrossCluster(source crossClusterSource, resolver nodeResolver, auth auth) *CrossCluster {
+	return &CrossCluster{source: source, resolver: resolver, auth: auth}
+}
+
+func (c *CrossCluster) Primary() http.Handler {
+	return c.auth.handleFunc(c.primaryHandler())
+}
+
+func (c *CrossCluster) primaryHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if r.Method != http.MethodGet {
+			msg := fmt.Sprintf("/cross cluster api path %q with method %v not found", path, r.Method)
+			http.Error(w, msg, http.StatusMethodNotAllowed)
+			return
+		}
+
+		switch path {
+		case "/cluster/cross_cluster/schema":
+			c.incomingSchemaLog().ServeHTTP(w, r)
+		case "/cluster/cross_cluster/shards":
+			c.incomingShardHosts().ServeHTTP(w, r)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}
+}
+
+func (c *CrossCluster) incomingSchemaLog() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		var from uint64
+		if v := r.URL.Query().Get("from"); v != "" {
+			var err error
+			if from, err = strconv.ParseUint(v, 10, 64); err != nil {
+				http.Error(w, "invalid from: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		limit := defaultCrossClusterSchemaLimit
+		if v := r.URL.Query().Get("limit"); v != "" {
+			var err error
+			if limit, err = strconv.Atoi(v); err != nil || limit < 1 {
+				http.Error(w, fmt.Sprintf("invalid limit %q", v), http.StatusBadRequest)
+				return
+			}
+		}
+
+		log, err := c.source.CrossClusterSchemaLog(from, limit)
+		if err != nil {
+			http.Error(w, "read schema log: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.respond(w, log)
+	})
+}
+
+func (c *CrossCluster) incomingShardHosts() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		class := r.URL.Query().Get("class")
+		if class == "" {
+			http.Error(w, "missing class", http.StatusBadRequest)
+			return
+		}
+
+		nodes, err := c.source.CrossClusterShardNodes(class)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, clusterSchema.ErrClassNotFound) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, "read shards: "+err.Error(), status)
+			return
+		}
+
+		hosts := make(map[string][]string, len(nodes))
+		for shard, names := range nodes {
+			for _, name := range names {
+				if host, ok := c.resolver.NodeHostname(name); ok {
+					hosts[shard] = append(hosts[shard], host)
+				}
+			}
+		}
+		c.respond(w, hosts)
+	})
+}
+
+func (c *CrossCluster) respond(w http.ResponseWriter, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, "/cross cluster marshal response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/clients"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+type fakeCrossClusterSource struct {
+	from  uint64
+	limit int
+}
+
+func (f *fakeCrossClusterSource) CrossClusterSchemaLog(from uint64, limit int) (*api.CrossClusterSchemaLog, error) {
+	f.from, f.limit = from, limit
+	return &api.CrossClusterSchemaLog{
+		Index:     from + 2,
+		LastIndex: 10,
+		Entries:   []api.CrossClusterSchemaEntry{{Index: from + 1, Request: []byte{1, 2}}},
+	}, nil
+}
+
+func (f *fakeCrossClusterSource) CrossClusterShardNodes(class string) (map[string][]string, error) {
+	return map[string][]string{"shard1": {"node1", "unknown"}}, nil
+}
+
+type hostResolver map[string]string
+
+func (r hostResolver) NodeHostname(name string) (string, bool) {
+	host, ok := r[name]
+	return host, ok
+}
+
+func TestInternalCrossClusterAPI(t *testing.T) {
+	source := &fakeCrossClusterSource{}
+	handler := clusterapi.NewCrossCluster(source, hostResolver{"node1": "10.0.0.1:7001"}, clusterapi.NewNoopAuthHandler())
+
+	mux := http.NewServeMux()
+	mux.Handle("/cluster/cross_cluster/", handler.Primary())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	require.Nil(t, err)
+	primary := clients.NewCrossClusterPrimary(&http.Client{})
+
+	t.Run("schema log", func(t *testing.T) {
+		log, err := primary.SchemaLog(context.Background(), parsedURL.Host, 5, 20)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), source.from)
+		assert.Equal(t, 20, source.limit)
+		assert.Equal(t, uint64(7), log.Index)
+		assert.Equal(t, uint64(10), log.LastIndex)
+		assert.Equal(t, []api.CrossClusterSchemaEntry{{Index: 6, Request: []byte{1, 2}}}, log.Entries)
+	})
+
+	t.Run("shard hosts", func(t *testing.T) {
+		hosts, err := primary.ShardHosts(context.Background(), parsedURL.Host, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]string{"shard1": {"10.0.0.1:7001"}}, hosts)
+	})
+}
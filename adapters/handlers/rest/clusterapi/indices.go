@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/go-openapi/strfmt"
@@ -65,6 +66,7 @@ type indices struct {
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
+	regexpShardChanges        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShardFileMetadata   *regexp.Regexp
 	regexpShard               *regexp.Regexp
@@ -109,6 +111,8 @@ const (
 		`\/shards\/(` + sh + `)\/queuesize`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
+	urlPatternShardChanges = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/changes`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/files/(.*)`
 	urlPatternShardFileMetadata = `\/indices\/(` + cl + `)` +
@@ -161,6 +165,8 @@ type shards interface {
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
+	ReadChanges(ctx context.Context, indexName, shardName string,
+		after uint64, limit int, withObject bool) ([]changelog.Change, error)
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -216,6 +222,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpReferences:                 regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:            regexp.MustCompile(urlPatternShardsQueueSize),
 		regexpShardsStatus:               regexp.MustCompile(urlPatternShardsStatus),
+		regexpShardChanges:               regexp.MustCompile(urlPatternShardChanges),
 		regexpShardFiles:                 regexp.MustCompile(urlPatternShardFiles),
 		regexpShardFileMetadata:          regexp.MustCompile(urlPatternShardFileMetadata),
 		regexpShard:                      regexp.MustCompile(urlPatternShard),
@@ -355,6 +362,13 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpShardChanges.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getShardChanges().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
@@ -1323,6 +1337,52 @@ func (i *indices) getGetShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) getShardChanges() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardChanges.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		query := r.URL.Query()
+		after, err := strconv.ParseUint(query.Get("after"), 10, 64)
+		if err != nil {
+			http.Error(w, "parse after: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		withObject := query.Get("withObject") == "true"
+
+		changes, err := i.shards.ReadChanges(r.Context(), index, shard, after, limit, withObject)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if err != nil {
+			http.Error(w, "read changes: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(changes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postUpdateShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
@@ -51,6 +51,7 @@ func TestMaintenanceModeIndices(t *testing.T) {
 		{"GET", "/queuesize"},
 		{"GET", "/status"},
 		{"POST", "/status"},
+		{"GET", "/changes"},
 		{"POST", "/files/myfile"},
 		{"POST", ""},
 		{"PUT", ":reinit"},
@@ -69,6 +69,7 @@ func NewServer(appState *state.State) *Server {
 	backups := NewBackups(appState.BackupManager, auth)
 	dbUsers := NewDbUsers(appState.APIKeyRemote, auth)
 	objectTTL := NewObjectTTL(appState.RemoteIndexIncoming, auth, appState.Logger, appState.ServerConfig.Config)
+	crossCluster := NewCrossCluster(appState.ClusterService.Raft, appState.Cluster, auth)
 
 	mux := http.NewServeMux()
 	mux.Handle("/classifications/transactions/",
@@ -77,6 +78,7 @@ func NewServer(appState *state.State) *Server {
 
 	mux.Handle("/cluster/users/db/", dbUsers.Users())
 	mux.Handle("/cluster/object_ttl/", objectTTL.Expired())
+	mux.Handle("/cluster/cross_cluster/", crossCluster.Primary())
 	mux.Handle("/nodes/", monitoring.AddTracingToHTTPMiddleware(nodes.Nodes(), appState.Logger))
 	mux.Handle("/indices/", monitoring.AddTracingToHTTPMiddleware(indices.Indices(), appState.Logger))
 	mux.Handle("/replicas/indices/", monitoring.AddTracingToHTTPMiddleware(replicatedIndices.Indices(), appState.Logger))
@@ -65,8 +65,11 @@ import (
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	rCluster "github.com/weaviate/weaviate/cluster"
+	"github.com/weaviate/weaviate/cluster/audit"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/replication/copier"
+	"github.com/weaviate/weaviate/cluster/replication/crosscluster"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
 	"github.com/weaviate/weaviate/cluster/usage"
 	"github.com/weaviate/weaviate/entities/concurrency"
 	entconfig "github.com/weaviate/weaviate/entities/config"
@@ -436,6 +439,7 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		HNSWSnapshotMinDeltaCommitlogsNumber:         appState.ServerConfig.Config.Persistence.HNSWSnapshotMinDeltaCommitlogsNumber,
 		HNSWSnapshotMinDeltaCommitlogsSizePercentage: appState.ServerConfig.Config.Persistence.HNSWSnapshotMinDeltaCommitlogsSizePercentage,
 		HNSWWaitForCachePrefill:                      appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
+		HNSWVectorCacheAdmission:                     appState.ServerConfig.Config.HNSWVectorCacheAdmission,
 		HNSWFlatSearchConcurrency:                    appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
 		HNSWAcornFilterRatio:                         appState.ServerConfig.Config.HNSWAcornFilterRatio,
 		HNSWGeoIndexEF:                               appState.ServerConfig.Config.HNSWGeoIndexEF,
@@ -448,6 +452,9 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		MaintenanceModeEnabled:                       appState.Cluster.MaintenanceModeEnabledForLocalhost,
 		AsyncIndexingEnabled:                         appState.ServerConfig.Config.AsyncIndexingEnabled,
 		HFreshEnabled:                                appState.ServerConfig.Config.HFreshEnabled,
+		ChangeDataCapture:                            appState.ServerConfig.Config.ChangeDataCapture,
+		HintedHandoff:                                appState.ServerConfig.Config.HintedHandoff,
+		RecallSampling:                               appState.ServerConfig.Config.RecallSampling,
 		OperationalMode:                              appState.ServerConfig.Config.OperationalMode,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch, nil, nil, nil) // TODO client
 	if err != nil {
@@ -609,6 +616,10 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		ReplicaMovementEnabled:          appState.ServerConfig.Config.ReplicaMovementEnabled,
 		ReplicaMovementMinimumAsyncWait: appState.ServerConfig.Config.ReplicaMovementMinimumAsyncWait,
 		DrainSleep:                      appState.ServerConfig.Config.Raft.DrainSleep.Get(),
+		AuditLog: audit.Config{
+			MaxEntries: appState.ServerConfig.Config.Raft.AuditLogMaxEntries,
+			MaxAge:     appState.ServerConfig.Config.Raft.AuditLogMaxAge,
+		},
 	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
@@ -616,6 +627,11 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 			break
 		}
 	}
+	// query nodes join as non-voters, they must never take part in schema votes
+	if appState.ServerConfig.Config.Cluster.QueryOnly {
+		rConfig.Voter = false
+	}
+	rConfig.QueryOnly = appState.ServerConfig.Config.Cluster.QueryOnly
 
 	appState.ClusterService = rCluster.New(rConfig, appState.AuthzController, appState.AuthzSnapshotter, appState.GRPCServerMetrics)
 	migrator.SetCluster(appState.ClusterService.Raft)
@@ -658,6 +674,7 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 	repo.SetReplicationFSM(appState.ClusterService.ReplicationFsm())
 	repo.SetSchemaGetter(appState.SchemaManager)
 	repo.SetTenantsActivityManager(appState.SchemaManager)
+	repo.SetShardSplitReporter(appState.ClusterService.Raft)
 
 	// initialize needed services after all components are ready
 	postInitModules(appState)
@@ -806,6 +823,44 @@ func MakeAppState(ctx, serverShutdownCtx context.Context, options *swag.CommandL
 		}, appState.Logger)
 	}
 
+	rebalancerCfg := appState.ServerConfig.Config.Rebalancer
+	appState.Rebalancer = rebalancer.New(rebalancer.Config{
+		Interval:           rebalancerCfg.Interval,
+		ImbalanceThreshold: rebalancerCfg.ImbalanceThreshold,
+		MaxConcurrentMoves: rebalancerCfg.MaxConcurrentMoves,
+		Metric:             rebalancerCfg.Metric,
+		Paused:             rebalancerCfg.Paused,
+	}, repo, rebalancerReplicator{appState.ClusterService}, appState.Cluster, appState.Logger)
+	if rebalancerCfg.Enabled {
+		if !appState.ServerConfig.Config.ReplicaMovementEnabled {
+			appState.Logger.WithField("action", "startup").
+				Warn("rebalancer not started, it requires replica movement to be enabled")
+		} else {
+			enterrors.GoWrapper(func() {
+				if metaStoreReady.waitForMetaStore() != nil {
+					return
+				}
+				appState.Rebalancer.Run(serverShutdownCtx)
+			}, appState.Logger)
+		}
+	}
+
+	crossClusterCfg := appState.ServerConfig.Config.CrossClusterReplication
+	if crossClusterCfg.Enabled() {
+		appState.CrossClusterFollower = crosscluster.New(crosscluster.Config{
+			PrimaryHost:     crossClusterCfg.PrimaryHost,
+			Interval:        crossClusterCfg.Interval,
+			SchemaBatchSize: crossClusterCfg.SchemaBatchSize,
+		}, appState.Cluster.LocalName(), clients.NewCrossClusterPrimary(appState.ClusterHttpClient),
+			appState.ClusterService.Raft, repo, prometheus.DefaultRegisterer, appState.Logger)
+		enterrors.GoWrapper(func() {
+			if metaStoreReady.waitForMetaStore() != nil {
+				return
+			}
+			appState.CrossClusterFollower.Run(serverShutdownCtx)
+		}, appState.Logger)
+	}
+
 	return appState
 }
 
@@ -965,7 +1020,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
 	backupScheduler := startBackupScheduler(appState)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
+	enterrors.GoWrapper(func() {
+		backupScheduler.RunSchedules(serverShutdownCtx, time.Minute)
+	}, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
+	setupNodeDrainHandlers(api, appState)
+	setupCrossClusterHandlers(api, appState)
+	setupSchemaAuditHandlers(api, appState)
+	setupReplicationVerifyHandlers(api, appState)
+	setupRebalancerHandlers(api, appState)
 	if appState.ServerConfig.Config.DistributedTasks.Enabled {
 		setupDistributedTasksHandlers(api, appState.Authorizer, appState.ClusterService.Raft)
 	}
@@ -1098,6 +1161,7 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 		membership{appState.Cluster, appState.ClusterService},
 		appState.SchemaManager,
 		appState.Logger)
+	backupScheduler.SetScheduleStore(appState.ClusterService)
 	return backupScheduler
 }
 
@@ -2063,6 +2127,14 @@ func (m membership) LeaderID() string {
 	return id
 }
 
+type rebalancerReplicator struct {
+	*rCluster.Service
+}
+
+func (r rebalancerReplicator) ShardReplicas(class, shard string) ([]string, error) {
+	return r.SchemaReader().ShardReplicas(class, shard)
+}
+
 // initRuntimeOverrides assumes, Configs from envs are loaded before
 // initializing runtime overrides.
 func initRuntimeOverrides(appState *state.State) *configRuntime.ConfigManager[config.WeaviateRuntimeConfig] {
@@ -2092,6 +2164,7 @@ func initRuntimeOverrides(appState *state.State) *configRuntime.ConfigManager[co
 		registered.ObjectsTTLPauseEveryNoBatches = appState.ServerConfig.Config.ObjectsTTLPauseEveryNoBatches
 		registered.ObjectsTTLPauseDuration = appState.ServerConfig.Config.ObjectsTTLPauseDuration
 		registered.ObjectsTTLConcurrencyFactor = appState.ServerConfig.Config.ObjectsTTLConcurrencyFactor
+		registered.RebalancerPaused = appState.ServerConfig.Config.Rebalancer.Paused
 
 		if appState.ServerConfig.Config.Authentication.OIDC.Enabled {
 			registered.OIDCIssuer = appState.ServerConfig.Config.Authentication.OIDC.Issuer
@@ -1597,6 +1597,148 @@ func init() {
         ]
       }
     },
+    "/backups/schedules": {
+      "get": {
+        "description": "Lists all backup schedules together with the state of their last run and the backups they retain.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the backup schedules.",
+            "schema": {
+              "$ref": "#/definitions/BackupScheduleListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while listing the backup schedules. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/schedules/{id}": {
+      "delete": {
+        "description": "Deletes the schedule. The backups it created are kept.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted the backup schedule."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The backup schedule does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while deleting the backup schedule. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "put": {
+        "description": "Creates a schedule which starts a backup whenever its cron expression fires and deletes the backups it created once its retention rules no longer select them. Schedules are run by the cluster leader. Updating a schedule keeps the backups it created so far.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Create or update a backup schedule",
+        "operationId": "backups.schedules.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The definition of the schedule.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The schedule has been created or updated.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid schedule, e.g. a malformed cron expression or an unknown backend.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while storing the backup schedule. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/backups/{backend}": {
       "get": {
         "description": "List all created backups IDs, Status",
@@ -2394,19 +2536,20 @@ func init() {
         ]
       }
     },
-    "/cluster/statistics": {
+    "/cluster/cross-cluster-replication": {
       "get": {
-        "description": "Provides statistics about the internal Raft consensus protocol state for the Weaviate cluster.",
+        "description": "Returns the role of this cluster in cross-cluster replication and, for a follower cluster, how far it lags behind its primary cluster.",
         "tags": [
           "cluster"
         ],
-        "summary": "Get cluster statistics",
-        "operationId": "cluster.get.statistics",
+        "summary": "Get the cross-cluster replication status",
+        "operationId": "cluster.get.cross.cluster.replication",
+        "parameters": [],
         "responses": {
           "200": {
-            "description": "Successfully retrieved Raft cluster statistics.",
+            "description": "Successfully retrieved the cross-cluster replication status.",
             "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
+              "$ref": "#/definitions/CrossClusterReplicationStatus"
             }
           },
           "401": {
@@ -2418,48 +2561,32 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid request for cluster statistics.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
-            "description": "An internal server error occurred while retrieving cluster statistics. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
+          "weaviate.cluster.crossClusterReplication.get"
         ]
       }
     },
-    "/graphql": {
+    "/cluster/cross-cluster-replication/promote": {
       "post": {
-        "description": "Executes a single GraphQL query provided in the request body. Use this endpoint for all Weaviate data queries and exploration.",
+        "description": "Stops following the primary cluster for good. Schema changes and objects are no longer pulled from the primary cluster and the cluster can be used on its own, e.g. after the primary cluster failed. Promoting cannot be undone.",
         "tags": [
-          "graphql"
-        ],
-        "summary": "Perform a GraphQL query",
-        "operationId": "graphql.post",
-        "parameters": [
-          {
-            "description": "The GraphQL query to execute, including the query string and optional variables.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
-          }
+          "cluster"
         ],
+        "summary": "Promote a follower cluster",
+        "operationId": "cluster.promote.cross.cluster.replication",
+        "parameters": [],
         "responses": {
           "200": {
-            "description": "Query executed successfully. The response body contains the query result.",
+            "description": "The cluster has been promoted.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/CrossClusterReplicationStatus"
             }
           },
           "401": {
@@ -2472,53 +2599,43 @@ func init() {
             }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "The cluster is not a follower cluster or has already been promoted.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred during query execution. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while promoting the cluster. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.cluster.crossClusterReplication.promote"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Executes multiple GraphQL queries provided in the request body as an array. Allows performing several queries in a single network request for efficiency.",
+    "/cluster/nodes/{nodeName}/drain": {
+      "delete": {
+        "description": "Stops draining the node, which accepts new shards and tenants again. Replicas which have already been moved stay on their new nodes and movements in progress are not cancelled. For a node which has already been removed, this forgets its drain status.",
         "tags": [
-          "graphql"
+          "cluster"
         ],
-        "summary": "Perform batched GraphQL queries",
-        "operationId": "graphql.batch",
+        "summary": "Cancel the drain of a node",
+        "operationId": "cluster.cancel.node.drain",
         "parameters": [
           {
-            "description": "An array containing multiple GraphQL query objects to execute in batch.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "The name of the drained node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Batch request processed successfully. The response body contains an array of results corresponding to the input queries.",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Successfully cancelled the drain."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2529,42 +2646,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+          "404": {
+            "description": "The node is not being drained.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred during batch query execution. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while cancelling the drain. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.cluster.nodes.drain.cancel"
         ]
-      }
-    },
-    "/meta": {
+      },
       "get": {
-        "description": "Provides meta-information about the running Weaviate instance, including its version, loaded modules, and network hostname. This information can be useful for monitoring, compatibility checks, or inter-instance communication.",
+        "description": "Returns the state of the drain of the node, the number of shard replicas it still holds and the number of replica movements in progress.",
         "tags": [
-          "meta"
+          "cluster"
+        ],
+        "summary": "Get the drain status of a node",
+        "operationId": "cluster.get.node.drain",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the drained node.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Get instance metadata",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successfully retrieved meta information.",
+            "description": "Successfully retrieved the drain status.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/NodeDrainStatus"
             }
           },
           "401": {
@@ -2576,38 +2695,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The node is not being drained.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
-            "description": "An internal server error occurred while retrieving meta information. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the drain status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.cluster.nodes.drain.get"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Retrieves status information about all nodes in the cluster. Use the ` + "`" + `output` + "`" + ` query parameter to control the level of detail.",
+      },
+      "post": {
+        "description": "Marks the node as draining so that no new shards or tenants are placed on it, moves all of its shard replicas to the other nodes using replication ` + "`" + `MOVE` + "`" + ` operations and finally removes it from the Raft cluster. Requires replica movement to be enabled. The progress can be followed with the corresponding ` + "`" + `GET` + "`" + ` request.",
         "tags": [
-          "nodes"
+          "cluster"
         ],
-        "summary": "Get node status",
-        "operationId": "nodes.get",
+        "summary": "Drain a node",
+        "operationId": "cluster.drain.node",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "type": "string",
+            "description": "The name of the node to drain.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the status for all nodes.",
+            "description": "The node is being drained.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/NodeDrainStatus"
             }
           },
           "401": {
@@ -2620,59 +2745,95 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found.",
+            "description": "The node is not a storage node of the cluster.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid request for node status.",
+            "description": "The node cannot be drained, e.g. because the remaining nodes cannot hold the configured replication factor.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred while retrieving node status. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while starting the drain. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.cluster.nodes.drain"
         ]
       }
     },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Retrieves status information only for the nodes that host shards for the specified collection (` + "`" + `className` + "`" + `). Use the ` + "`" + `output` + "`" + ` query parameter to control the level of detail.",
+    "/cluster/rebalancer/pause": {
+      "post": {
+        "description": "Stops the rebalancer from scheduling replica moves until it is resumed. Moves which have already been scheduled are not cancelled. The pause is stored in Raft, so it applies to the whole cluster, including a newly elected leader.",
         "tags": [
-          "nodes"
+          "cluster"
         ],
-        "summary": "Get node status by collection",
-        "operationId": "nodes.get.class",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The name of the collection (class) for which to retrieve node status.",
-            "name": "className",
-            "in": "path",
-            "required": true
+        "summary": "Pause the rebalancer",
+        "operationId": "cluster.pause.rebalancer",
+        "parameters": [],
+        "responses": {
+          "200": {
+            "description": "The rebalancer has been paused.",
+            "schema": {
+              "$ref": "#/definitions/RebalancerStatus"
+            }
           },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "query"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An internal server error occurred while pausing the rebalancer. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.rebalancer.pause"
+        ]
+      }
+    },
+    "/cluster/rebalancer/plan": {
+      "get": {
+        "description": "Computes the replica moves which would balance the load of the storage nodes, without scheduling them. Without ` + "`" + `limit` + "`" + `, the plan only contains the moves the next rebalancing run would schedule within the maximum of concurrent moves. Moves are only scheduled by the leader, and not while the rebalancer is paused.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Get the rebalancing plan",
+        "operationId": "cluster.get.rebalancer.plan",
+        "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of planned moves. Defaults to the number of moves which may still be started without exceeding the maximum of concurrent moves.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the status for nodes relevant to the specified collection.",
+            "description": "Successfully computed the rebalancing plan.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/RebalancerPlan"
             }
           },
           "401": {
@@ -2684,76 +2845,205 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found.",
+          "500": {
+            "description": "An internal server error occurred while computing the plan, e.g. because a storage node is not healthy. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.rebalancer.plan"
+        ]
+      }
+    },
+    "/cluster/rebalancer/resume": {
+      "post": {
+        "description": "Lets the rebalancer schedule replica moves again after it has been paused. The rebalancer stays paused while ` + "`" + `REBALANCER_PAUSED` + "`" + ` or the ` + "`" + `rebalancer_paused` + "`" + ` runtime override is set.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Resume the rebalancer",
+        "operationId": "cluster.resume.rebalancer",
+        "parameters": [],
+        "responses": {
+          "200": {
+            "description": "The rebalancer has been resumed.",
+            "schema": {
+              "$ref": "#/definitions/RebalancerStatus"
+            }
           },
-          "422": {
-            "description": "Invalid request for node status.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred while retrieving node status for the collection. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while resuming the rebalancer. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.cluster.rebalancer.resume"
         ]
       }
     },
-    "/objects": {
+    "/cluster/replication-verify": {
       "get": {
-        "description": "Retrieves a list of data objects. By default, objects are returned in reverse order of creation. Requires a collection name (` + "`" + `class` + "`" + `) parameter to specify which collection's objects to list, otherwise, returns an empty list.",
+        "description": "Compares the replicas of the shards of a collection by their hashtrees and reports the objects which differ between them, without repairing anything. Every node holding a replica reads its hashtree and the digests of the objects in differing leaves, so this may be expensive on large collections. Requires async replication to be enabled for the collection. Replicas are compared by the update time of their objects, as async replication does, so replicas whose objects differ in content but share an update time are reported as consistent.",
         "tags": [
-          "objects"
+          "cluster"
         ],
-        "summary": "List objects",
-        "operationId": "objects.list",
+        "summary": "Verify the replicas of a collection",
+        "operationId": "cluster.verify.replication",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
+            "type": "string",
+            "description": "The collection whose replicas are compared.",
+            "name": "collection",
+            "in": "query",
+            "required": true
           },
           {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of differing objects reported per pair of replicas. Defaults to 100.",
+            "name": "limit",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
+            "type": "string",
+            "description": "Only compare the replicas of this shard or tenant. All shards with more than one replica are compared if absent.",
+            "name": "shard",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully compared the replicas.",
+            "schema": {
+              "$ref": "#/definitions/ReplicationVerificationReport"
+            }
           },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The collection or shard does not exist, or async replication is not enabled for the collection.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while comparing the replicas. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.replication.verify"
+        ]
+      }
+    },
+    "/cluster/schema-audit": {
+      "get": {
+        "description": "Returns the schema, alias and RBAC commands applied through raft, who issued them, when and what they changed. Entries are returned newest first, and retention is controlled with ` + "`" + `RAFT_AUDIT_LOG_MAX_ENTRIES` + "`" + ` and ` + "`" + `RAFT_AUDIT_LOG_MAX_AGE` + "`" + `.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Get the schema audit log",
+        "operationId": "cluster.get.schema.audit",
+        "parameters": [
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "description": "Only return entries for this collection.",
+            "name": "class",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonSortParameterQuery"
+            "type": "string",
+            "description": "Only return entries for this command type, e.g. ` + "`" + `add_class` + "`" + ` or ` + "`" + `delete_class` + "`" + `.",
+            "name": "command",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of entries to return. Zero or absent returns all matching entries.",
+            "name": "limit",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonClassParameterQuery"
+            "type": "string",
+            "description": "Only return entries issued by this principal.",
+            "name": "principal",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "type": "integer",
+            "format": "int64",
+            "description": "Only return entries applied at or after this time, in milliseconds since the Unix epoch.",
+            "name": "sinceUnixMs",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response containing the list of objects. If the collection name (` + "`" + `class` + "`" + `) is not provided, the response will not include any objects.",
+            "description": "Successfully retrieved the schema audit log.",
             "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
+              "$ref": "#/definitions/SchemaAuditLogResponse"
             }
           },
-          "400": {
-            "description": "Malformed request.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while retrieving the schema audit log. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.schemaAudit.get"
+        ]
+      }
+    },
+    "/cluster/statistics": {
+      "get": {
+        "description": "Provides statistics about the internal Raft consensus protocol state for the Weaviate cluster.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Get cluster statistics",
+        "operationId": "cluster.get.statistics",
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved Raft cluster statistics.",
+            "schema": {
+              "$ref": "#/definitions/ClusterStatisticsResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2764,60 +3054,48 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no matching objects were found."
-          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the specified collection exists.",
+            "description": "Invalid request for cluster statistics.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving cluster statistics. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.cluster.statistics.get"
         ]
-      },
+      }
+    },
+    "/graphql": {
       "post": {
-        "description": "Creates a new data object. The object's metadata and schema values are validated before creation.\u003cbr/\u003e\u003cbr/\u003e**Note (batch import)**:\u003cbr/\u003eIf you plan on importing a large number of objects, using the ` + "`" + `/batch/objects` + "`" + ` endpoint is significantly more efficient than sending multiple single requests.\u003cbr/\u003e\u003cbr/\u003e**Note (idempotence)**:\u003cbr/\u003eThis operation (POST) fails if an object with the provided ID already exists. To update an existing object, use the PUT or PATCH methods.",
+        "description": "Executes a single GraphQL query provided in the request body. Use this endpoint for all Weaviate data queries and exploration.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Create an object",
-        "operationId": "objects.create",
+        "summary": "Perform a GraphQL query",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "description": "The object to be created.",
+            "description": "The GraphQL query to execute, including the query string and optional variables.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQuery"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created successfully.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Query executed successfully. The response body contains the query result.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -2830,13 +3108,13 @@ func init() {
             }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred during query execution. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2845,32 +3123,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/objects/validate": {
+    "/graphql/batch": {
       "post": {
-        "description": "Checks if a data object's structure conforms to the specified collection schema and metadata rules without actually storing the object.\u003cbr/\u003e\u003cbr/\u003eA successful validation returns a 200 OK status code with no body. If validation fails, an error response with details is returned.",
+        "description": "Executes multiple GraphQL queries provided in the request body as an array. Allows performing several queries in a single network request for efficiency.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Validate an object",
-        "operationId": "objects.validate",
+        "summary": "Perform batched GraphQL queries",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "description": "The object definition to validate.",
+            "description": "An array containing multiple GraphQL query objects to execute in batch.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Object is valid according to the schema."
+            "description": "Batch request processed successfully. The response body contains an array of results corresponding to the input queries.",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2882,13 +3166,13 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed but the object is invalid according to the schema.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred during batch query execution. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2897,58 +3181,69 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/meta": {
       "get": {
-        "description": "Get a data object based on its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `).",
+        "description": "Provides meta-information about the running Weaviate instance, including its version, loaded modules, and network hostname. This information can be useful for monitoring, compatibility checks, or inter-instance communication.",
         "tags": [
-          "objects"
+          "meta"
         ],
-        "summary": "Get an object",
-        "operationId": "objects.class.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Name of the collection (class) the object belongs to.",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique UUID of the object to be retrieved.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+        "summary": "Get instance metadata",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved meta information.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An internal server error occurred while retrieving meta information. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Retrieves status information about all nodes in the cluster. Use the ` + "`" + `output` + "`" + ` query parameter to control the level of detail.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Get node status",
+        "operationId": "nodes.get",
+        "parameters": [
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response containing the object.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully retrieved the status for all nodes.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2961,68 +3256,59 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Not Found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid request for node status.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving node status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.nodes.status.get"
         ]
-      },
-      "put": {
-        "description": "Replaces properties of an existing data object. The object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The request body must contain the complete object definition with the new property values.",
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Retrieves status information only for the nodes that host shards for the specified collection (` + "`" + `className` + "`" + `). Use the ` + "`" + `output` + "`" + ` query parameter to control the level of detail.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Replace an object",
-        "operationId": "objects.class.put",
+        "summary": "Get node status by collection",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
-            "description": "Name of the collection (class) the object belongs to.",
+            "description": "The name of the collection (class) for which to retrieve node status.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique UUID of the object to be replaced.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The object definition to replace the existing object with.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "name": "shardName",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object replaced successfully.",
+            "description": "Successfully retrieved the status for nodes relevant to the specified collection.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -3035,60 +3321,69 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Not Found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
+            "description": "Invalid request for node status.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving node status for the collection. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "delete": {
-        "description": "Removes a data object from a specific collection, identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `).\u003cbr/\u003e\u003cbr/\u003e**Note on deleting references (legacy format):**\u003cbr/\u003eFor backward compatibility with older beacon formats (lacking a collection name), deleting a reference requires the beacon in the request to exactly match the stored format. Beacons always use ` + "`" + `localhost` + "`" + ` as the host, indicating the target is within the same Weaviate instance.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Retrieves a list of data objects. By default, objects are returned in reverse order of creation. Requires a collection name (` + "`" + `class` + "`" + `) parameter to specify which collection's objects to list, otherwise, returns an empty list.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an object",
-        "operationId": "objects.class.delete",
+        "summary": "List objects",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "description": "Name of the collection (class) the object belongs to.",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique UUID of the object to be deleted.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object deleted successfully."
+          "200": {
+            "description": "Successful response containing the list of objects. If the collection name (` + "`" + `class` + "`" + `) is not provided, the response will not include any objects.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -3106,10 +3401,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Successful query result but no matching objects were found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the specified collection exists.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3121,45 +3416,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "head": {
-        "description": "Verifies the existence of a specific data object within a collection (class), identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `), without returning the object itself.\u003cbr/\u003e\u003cbr/\u003eThis is faster than a GET request as it avoids retrieving and processing object data. Existence is confirmed by a 204 No Content status code, while non-existence results in a 404 Not Found.",
+      "post": {
+        "description": "Creates a new data object. The object's metadata and schema values are validated before creation.\u003cbr/\u003e\u003cbr/\u003e**Note (batch import)**:\u003cbr/\u003eIf you plan on importing a large number of objects, using the ` + "`" + `/batch/objects` + "`" + ` endpoint is significantly more efficient than sending multiple single requests.\u003cbr/\u003e\u003cbr/\u003e**Note (idempotence)**:\u003cbr/\u003eThis operation (POST) fails if an object with the provided ID already exists. To update an existing object, use the PUT or PATCH methods.",
         "tags": [
           "objects"
         ],
-        "summary": "Check if an object exists",
-        "operationId": "objects.class.head",
+        "summary": "Create an object",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Name of the collection (class) the object belongs to.",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique UUID of the object to check.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The object to be created.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Object created successfully.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3170,11 +3465,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object does not exist."
-          },
           "422": {
-            "description": "Invalid data provided. Please check the values in your request (e.g., invalid UUID format).",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3186,56 +3478,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
-      },
-      "patch": {
-        "description": "Updates specific properties of an existing data object using JSON merge patch semantics (RFC 7396). The object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). Only the fields provided in the request body are modified. Metadata and schema values are validated, and the object's ` + "`" + `lastUpdateTimeUnix` + "`" + ` is updated.",
+      }
+    },
+    "/objects/validate": {
+      "post": {
+        "description": "Checks if a data object's structure conforms to the specified collection schema and metadata rules without actually storing the object.\u003cbr/\u003e\u003cbr/\u003eA successful validation returns a 200 OK status code with no body. If validation fails, an error response with details is returned.",
         "tags": [
           "objects"
         ],
-        "summary": "Patch an object",
-        "operationId": "objects.class.patch",
+        "summary": "Validate an object",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "type": "string",
-            "description": "Name of the collection (class) the object belongs to.",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique UUID of the object to be patched.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style JSON merge patch object containing the fields to update.",
+            "description": "The object definition to validate.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object patched successfully."
-          },
-          "400": {
-            "description": "Malformed patch request body.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Object is valid according to the schema."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3246,11 +3517,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object not found."
-          },
           "422": {
-            "description": "The patch object is valid JSON but is unprocessable for other reasons (e.g., invalid schema).",
+            "description": "Request body is well-formed but the object is invalid according to the schema.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3265,22 +3533,22 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replaces all existing references for a specific reference property (` + "`" + `propertyName` + "`" + `) on a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The new set of references is provided in the request body.",
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a data object based on its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `).",
         "tags": [
           "objects"
         ],
-        "summary": "Replace object references",
-        "operationId": "objects.class.references.put",
+        "summary": "Get an object",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Name of the collection (class) the source object belongs to.",
+            "description": "Name of the collection (class) the object belongs to.",
             "name": "className",
             "in": "path",
             "required": true
@@ -3288,29 +3556,19 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be retrieved.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "description": "The new list of references.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -3318,7 +3576,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "References replaced successfully."
+            "description": "Successful response containing the object.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -3336,10 +3597,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object not found."
+            "description": "Object not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3354,20 +3615,20 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Adds a new reference to a reference property (` + "`" + `propertyName` + "`" + `) on a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The reference to add is specified in the request body.",
+      "put": {
+        "description": "Replaces properties of an existing data object. The object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The request body must contain the complete object definition with the new property values.",
         "tags": [
           "objects"
         ],
-        "summary": "Add an object reference",
-        "operationId": "objects.class.references.create",
+        "summary": "Replace an object",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
-            "description": "Name of the collection (class) the source object belongs to.",
+            "description": "Name of the collection (class) the object belongs to.",
             "name": "className",
             "in": "path",
             "required": true
@@ -3375,42 +3636,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be replaced.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The reference to add.",
+            "description": "The object definition to replace the existing object with.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Reference added successfully."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Object replaced successfully.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -3423,10 +3671,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object not found."
+            "description": "Object not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3445,16 +3693,16 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Removes a specific reference from a reference property (` + "`" + `propertyName` + "`" + `) of a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The reference to remove is specified in the request body.",
+        "description": "Removes a data object from a specific collection, identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `).\u003cbr/\u003e\u003cbr/\u003e**Note on deleting references (legacy format):**\u003cbr/\u003eFor backward compatibility with older beacon formats (lacking a collection name), deleting a reference requires the beacon in the request to exactly match the stored format. Beacons always use ` + "`" + `localhost` + "`" + ` as the host, indicating the target is within the same Weaviate instance.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an object reference",
-        "operationId": "objects.class.references.delete",
+        "summary": "Delete an object",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "Name of the collection (class) the source object belongs to.",
+            "description": "Name of the collection (class) the object belongs to.",
             "name": "className",
             "in": "path",
             "required": true
@@ -3462,27 +3710,11 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be deleted.",
             "name": "id",
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The reference to remove.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -3492,7 +3724,7 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "Reference deleted successfully."
+            "description": "Object deleted successfully."
           },
           "400": {
             "description": "Malformed request.",
@@ -3510,13 +3742,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Object or reference not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3528,47 +3757,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
+      },
+      "head": {
+        "description": "Verifies the existence of a specific data object within a collection (class), identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `), without returning the object itself.\u003cbr/\u003e\u003cbr/\u003eThis is faster than a GET request as it avoids retrieving and processing object data. Existence is confirmed by a 204 No Content status code, while non-existence results in a 404 Not Found.",
         "tags": [
           "objects"
         ],
-        "summary": "Get an object",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Check if an object exists",
+        "operationId": "objects.class.head",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the collection (class) the object belongs to.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the object to be retrieved.",
+            "description": "Unique UUID of the object to check.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response containing the object.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3580,7 +3807,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Object does not exist."
+          },
+          "422": {
+            "description": "Invalid data provided. Please check the values in your request (e.g., invalid UUID format).",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
@@ -3589,34 +3822,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. ` + "`" + `lastUpdateTimeUnix` + "`" + ` is set to the time this function is called. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
+      "patch": {
+        "description": "Updates specific properties of an existing data object using JSON merge patch semantics (RFC 7396). The object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). Only the fields provided in the request body are modified. Metadata and schema values are validated, and the object's ` + "`" + `lastUpdateTimeUnix` + "`" + ` is updated.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an object",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "Patch an object",
+        "operationId": "objects.class.patch",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the collection (class) the object belongs to.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the object to be replaced.",
+            "description": "Unique UUID of the object to be patched.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The object definition to replace the existing object with.",
+            "description": "RFC 7396-style JSON merge patch object containing the fields to update.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
@@ -3626,10 +3864,13 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Object replaced successfully.",
+          "204": {
+            "description": "Object patched successfully."
+          },
+          "400": {
+            "description": "Malformed patch request body.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3645,7 +3886,7 @@ func init() {
             "description": "Object not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
+            "description": "The patch object is valid JSON but is unprocessable for other reasons (e.g., invalid schema).",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3662,24 +3903,48 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
-        "tags": [
-          "objects"
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replaces all existing references for a specific reference property (` + "`" + `propertyName` + "`" + `) on a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The new set of references is provided in the request body.",
+        "tags": [
+          "objects"
         ],
-        "summary": "Delete an object",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Replace object references",
+        "operationId": "objects.class.references.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the collection (class) the source object belongs to.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the object to be deleted.",
+            "description": "Unique UUID of the source object.",
             "name": "id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new list of references.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -3688,8 +3953,14 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object deleted successfully."
+          "200": {
+            "description": "References replaced successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3701,7 +3972,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Source object not found."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
@@ -3710,33 +3987,67 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
+      "post": {
+        "description": "Adds a new reference to a reference property (` + "`" + `propertyName` + "`" + `) on a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The reference to add is specified in the request body.",
         "tags": [
           "objects"
         ],
-        "summary": "Check if an object exists",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Add an object reference",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the collection (class) the source object belongs to.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the object to check.",
+            "description": "Unique UUID of the source object.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The reference to add.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Reference added successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3748,7 +4059,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object does not exist."
+            "description": "Source object not found."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
@@ -3757,47 +4074,67 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.local.manipulate"
         ]
       },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. ` + "`" + `lastUpdateTimeUnix` + "`" + ` is set to the time this function is called. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
+      "delete": {
+        "description": "Removes a specific reference from a reference property (` + "`" + `propertyName` + "`" + `) of a source data object. The source object is identified by its collection name (` + "`" + `className` + "`" + `) and UUID (` + "`" + `id` + "`" + `). The reference to remove is specified in the request body.",
         "tags": [
           "objects"
         ],
-        "summary": "Patch an object",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "summary": "Delete an object reference",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the collection (class) the source object belongs to.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the object to be patched.",
+            "description": "Unique UUID of the source object.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style JSON merge patch object containing the fields to update.",
+            "type": "string",
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The reference to remove.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Object patched successfully."
+            "description": "Reference deleted successfully."
           },
           "400": {
-            "description": "Malformed patch request body."
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3809,10 +4146,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object not found."
+            "description": "Object or reference not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch object is valid JSON but is unprocessable for other reasons (e.g., invalid schema).",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3831,47 +4171,40 @@ func init() {
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
+    "/objects/{id}": {
+      "get": {
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace object references",
-        "operationId": "objects.references.update",
+        "summary": "Get an object",
+        "operationId": "objects.get",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be retrieved.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The new list of references.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "References replaced successfully."
+            "description": "Successful response containing the object.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3882,11 +4215,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Object not found."
           },
           "500": {
             "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
@@ -3898,49 +4228,45 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Add a reference to a specific property of a data object. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. ` + "`" + `lastUpdateTimeUnix` + "`" + ` is set to the time this function is called. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
         "tags": [
           "objects"
         ],
-        "summary": "Add an object reference",
-        "operationId": "objects.references.create",
+        "summary": "Update an object",
+        "operationId": "objects.update",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be replaced.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The reference to add.",
+            "description": "The object definition to replace the existing object with.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Reference added successfully."
+            "description": "Object replaced successfully.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3951,8 +4277,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Object not found."
+          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3971,37 +4300,24 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
+        "description": "Deletes an object from the database based on its UUID. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an object reference",
-        "operationId": "objects.references.delete",
+        "summary": "Delete an object",
+        "operationId": "objects.delete",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique UUID of the source object.",
+            "description": "Unique UUID of the object to be deleted.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the reference property of the source object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The reference to remove.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -4009,7 +4325,7 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "Reference deleted successfully."
+            "description": "Object deleted successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4021,10 +4337,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Object or reference not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object not found."
           },
           "500": {
             "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
@@ -4033,43 +4346,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/replication/replicate": {
-      "post": {
-        "description": "Begins an asynchronous operation to move or copy a specific shard replica from its current node to a designated target node. The operation involves copying data, synchronizing, and potentially decommissioning the source replica.",
+      },
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
         "tags": [
-          "replication"
+          "objects"
         ],
-        "summary": "Initiate a replica movement",
-        "operationId": "replicate",
+        "summary": "Check if an object exists",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique UUID of the object to check.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Replication operation registered successfully. ID of the operation is returned.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateReplicaResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4080,45 +4383,57 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Object does not exist."
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "501": {
-            "description": "Replica movement operations are disabled.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.replication.replicate"
+          "weaviate.objects.check"
         ]
       },
-      "delete": {
-        "description": "Schedules all replication operations for deletion across all collections, shards, and nodes.",
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. ` + "`" + `lastUpdateTimeUnix` + "`" + ` is set to the time this function is called. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}` + "`" + ` endpoint instead.",
         "tags": [
-          "replication"
+          "objects"
+        ],
+        "summary": "Patch an object",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique UUID of the object to be patched.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style JSON merge patch object containing the fields to update.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
         ],
-        "summary": "Delete all replication operations",
-        "operationId": "deleteAllReplications",
         "responses": {
           "204": {
-            "description": "Replication operation registered successfully"
+            "description": "Object patched successfully."
           },
           "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Malformed patch request body."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4129,59 +4444,70 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Object not found."
+          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "The patch object is valid JSON but is unprocessable for other reasons (e.g., invalid schema).",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "501": {
-            "description": "Replica movement operations are disabled.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.replication.deleteAllReplications"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/replication/replicate/force-delete": {
-      "post": {
-        "description": "USE AT OWN RISK! Synchronously force delete operations from the FSM. This will not perform any checks on which state the operation is in so may lead to data corruption or loss. It is recommended to first scale the number of replication engine workers to 0 before calling this endpoint to ensure no operations are in-flight.",
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
         "tags": [
-          "replication"
+          "objects"
         ],
-        "summary": "Force delete replication operations",
-        "operationId": "forceDeleteReplications",
+        "summary": "Replace object references",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
           {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique UUID of the source object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new list of references.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ReplicationReplicateForceDeleteRequest"
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Replication operations force deleted successfully.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateForceDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "References replaced successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4193,73 +4519,64 @@ func init() {
             }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.replication.forceDeleteReplications"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/replication/replicate/list": {
-      "get": {
-        "description": "Retrieves a list of currently registered replication operations, optionally filtered by collection, shard, or node ID.",
+      },
+      "post": {
+        "description": "Add a reference to a specific property of a data object. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
         "tags": [
-          "replication"
+          "objects"
         ],
-        "summary": "List replication operations",
-        "operationId": "listReplication",
+        "summary": "Add an object reference",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the target node to get details for.",
-            "name": "targetNode",
-            "in": "query"
+            "format": "uuid",
+            "description": "Unique UUID of the source object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "The name of the collection to get details for.",
-            "name": "collection",
-            "in": "query"
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "The shard to get details for.",
-            "name": "shard",
-            "in": "query"
+            "description": "The reference to add.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
           },
           {
-            "type": "boolean",
-            "description": "Whether to include the history of the replication operation.",
-            "name": "includeHistory",
-            "in": "query"
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "The details of the replication operations.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "object",
-                "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Reference added successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4270,189 +4587,131 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the property exists and is a reference type.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "501": {
-            "description": "Replica movement operations are disabled.",
+          "500": {
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.replication.replicate.details"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/replication/replicate/{id}": {
-      "get": {
-        "description": "Fetches the current status and detailed information for a specific replication operation, identified by its unique ID. Optionally includes historical data of the operation's progress if requested.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has. \u003cbr/\u003e\u003cbr/\u003e**Note**: This endpoint is deprecated and will be removed in a future version. Use the ` + "`" + `/objects/{className}/{id}/references/{propertyName}` + "`" + ` endpoint instead.",
         "tags": [
-          "replication"
+          "objects"
         ],
-        "summary": "Retrieve a replication operation",
-        "operationId": "replicationDetails",
+        "summary": "Delete an object reference",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the replication operation to get details for.",
+            "description": "Unique UUID of the source object.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "description": "Whether to include the history of the replication operation.",
-            "name": "includeHistory",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The details of the replication operation.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Shard replica operation not found."
-          },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "type": "string",
+            "description": "Unique name of the reference property of the source object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          {
+            "description": "The reference to remove.",
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/SingleRef"
             }
           },
-          "501": {
-            "description": "Replica movement operations are disabled.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.replication.replicate.details"
-        ]
-      },
-      "delete": {
-        "description": "Removes a specific replication operation. If the operation is currently active, it will be cancelled and its resources cleaned up before the operation is deleted.",
-        "tags": [
-          "replication"
-        ],
-        "summary": "Delete a replication operation",
-        "operationId": "deleteReplication",
-        "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the replication operation to delete.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Reference deleted successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden.",
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Shard replica operation not found."
-          },
-          "409": {
-            "description": "The operation is not in a deletable state, e.g. it is a MOVE op in the DEHYDRATING state.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Object or reference not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "501": {
-            "description": "Replica movement operations are disabled.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.replication.replicate.delete"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/replication/replicate/{id}/cancel": {
+    "/replication/replicate": {
       "post": {
-        "description": "Requests the cancellation of an active replication operation identified by its ID. The operation will be stopped, but its record will remain in the ` + "`" + `CANCELLED` + "`" + ` state (can't be resumed) and will not be automatically deleted.",
+        "description": "Begins an asynchronous operation to move or copy a specific shard replica from its current node to a designated target node. The operation involves copying data, synchronizing, and potentially decommissioning the source replica.",
         "tags": [
           "replication"
         ],
-        "summary": "Cancel a replication operation",
-        "operationId": "cancelReplication",
+        "summary": "Initiate a replica movement",
+        "operationId": "replicate",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the replication operation to cancel.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully cancelled."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          "200": {
+            "description": "Replication operation registered successfully. ID of the operation is returned.",
+            "schema": {
+              "$ref": "#/definitions/ReplicationReplicateReplicaResponse"
+            }
           },
-          "403": {
-            "description": "Forbidden",
+          "400": {
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard replica operation not found."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "409": {
-            "description": "The operation is not in a cancellable state, e.g. it is READY or is a MOVE op in the DEHYDRATING state.",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4477,47 +4736,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.replicate.cancel"
+          "weaviate.replication.replicate"
         ]
-      }
-    },
-    "/replication/scale": {
-      "get": {
-        "description": "Computes and returns a replication scale plan for a given collection and desired replication factor. The plan includes, for each shard, a list of nodes to be added and a list of nodes to be removed.",
-        "produces": [
-          "application/json"
-        ],
+      },
+      "delete": {
+        "description": "Schedules all replication operations for deletion across all collections, shards, and nodes.",
         "tags": [
           "replication"
         ],
-        "summary": "Get replication scale plan",
-        "operationId": "getReplicationScalePlan",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The collection name to get the scaling plan for.",
-            "name": "collection",
-            "in": "query",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "description": "The desired replication factor to scale to. Must be a positive integer greater than zero.",
-            "name": "replicationFactor",
-            "in": "query",
-            "required": true
-          }
-        ],
+        "summary": "Delete all replication operations",
+        "operationId": "deleteAllReplications",
         "responses": {
-          "200": {
-            "description": "Replication scale plan showing node additions and removals per shard.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationScalePlan"
-            }
+          "204": {
+            "description": "Replication operation registered successfully"
           },
           "400": {
-            "description": "Bad request.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4531,8 +4765,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Collection not found.",
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4551,42 +4785,36 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.scale.get"
+          "weaviate.replication.deleteAllReplications"
         ]
-      },
+      }
+    },
+    "/replication/replicate/force-delete": {
       "post": {
-        "description": "Apply a replication scaling plan that specifies nodes to add or remove per shard for a given collection.",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
+        "description": "USE AT OWN RISK! Synchronously force delete operations from the FSM. This will not perform any checks on which state the operation is in so may lead to data corruption or loss. It is recommended to first scale the number of replication engine workers to 0 before calling this endpoint to ensure no operations are in-flight.",
         "tags": [
           "replication"
         ],
-        "summary": "Apply replication scaling plan",
-        "operationId": "applyReplicationScalePlan",
+        "summary": "Force delete replication operations",
+        "operationId": "forceDeleteReplications",
         "parameters": [
           {
-            "description": "The replication scaling plan specifying the collection and its shard-level replica adjustments.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/ReplicationScalePlan"
+              "$ref": "#/definitions/ReplicationReplicateForceDeleteRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "List of replication shard copy operation IDs initiated for the scale operation",
+            "description": "Replication operations force deleted successfully.",
             "schema": {
-              "$ref": "#/definitions/ReplicationScaleApplyResponse"
+              "$ref": "#/definitions/ReplicationReplicateForceDeleteResponse"
             }
           },
           "400": {
-            "description": "Bad request.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4600,8 +4828,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Collection not found.",
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4611,46 +4839,56 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Replica movement operations are disabled.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.scale.post"
+          "weaviate.replication.forceDeleteReplications"
         ]
       }
     },
-    "/replication/sharding-state": {
+    "/replication/replicate/list": {
       "get": {
-        "description": "Fetches the current sharding state, including replica locations and statuses, for all collections or a specified collection. If a shard name is provided along with a collection, the state for that specific shard is returned.",
+        "description": "Retrieves a list of currently registered replication operations, optionally filtered by collection, shard, or node ID.",
         "tags": [
           "replication"
         ],
-        "summary": "Get sharding state",
-        "operationId": "getCollectionShardingState",
+        "summary": "List replication operations",
+        "operationId": "listReplication",
         "parameters": [
           {
             "type": "string",
-            "description": "The collection name to get the sharding state for.",
+            "description": "The name of the target node to get details for.",
+            "name": "targetNode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The name of the collection to get details for.",
             "name": "collection",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The shard to get the sharding state for.",
+            "description": "The shard to get details for.",
             "name": "shard",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Whether to include the history of the replication operation.",
+            "name": "includeHistory",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved sharding state.",
+            "description": "The details of the replication operations.",
             "schema": {
-              "$ref": "#/definitions/ReplicationShardingStateResponse"
+              "type": "array",
+              "items": {
+                "type": "object",
+                "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
+              }
             }
           },
           "400": {
@@ -4668,12 +4906,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Collection or shard not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4688,136 +4920,160 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.shardingstate.collection.get"
+          "weaviate.replication.replicate.details"
         ]
       }
     },
-    "/schema": {
+    "/replication/replicate/{id}": {
       "get": {
-        "description": "Retrieves the definitions of all collections (classes) currently in the database schema.",
+        "description": "Fetches the current status and detailed information for a specific replication operation, identified by its unique ID. Optionally includes historical data of the operation's progress if requested.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Get all collection definitions",
-        "operationId": "schema.dump",
+        "summary": "Retrieve a replication operation",
+        "operationId": "replicationDetails",
         "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the replication operation to get details for.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "boolean",
-            "default": true,
-            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
-            "name": "consistency",
-            "in": "header"
+            "description": "Whether to include the history of the replication operation.",
+            "name": "includeHistory",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the database schema.",
+            "description": "The details of the replication operation.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden",
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard replica operation not found."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while retrieving the schema. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.replication.replicate.details"
         ]
       },
-      "post": {
-        "description": "Defines and creates a new collection (class).\u003cbr/\u003e\u003cbr/\u003eIf [` + "`" + `AutoSchema` + "`" + `](https://docs.weaviate.io/weaviate/config-refs/collections#auto-schema) is enabled (not recommended for production), Weaviate might attempt to infer schema from data during import. Manual definition via this endpoint provides explicit control.",
+      "delete": {
+        "description": "Removes a specific replication operation. If the operation is currently active, it will be cancelled and its resources cleaned up before the operation is deleted.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Create a new collection",
-        "operationId": "schema.objects.create",
+        "summary": "Delete a replication operation",
+        "operationId": "deleteReplication",
         "parameters": [
           {
-            "description": "The definition of the collection (class) to create.",
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the replication operation to delete.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Collection created successfully and its definition returned.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
           "403": {
-            "description": "Forbidden",
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard replica operation not found."
+          },
+          "409": {
+            "description": "The operation is not in a deletable state, e.g. it is a MOVE op in the DEHYDRATING state.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid collection definition provided. Check the definition structure and properties.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred during collection creation. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.replication.replicate.delete"
         ]
       }
     },
-    "/schema/{className}": {
-      "get": {
-        "description": "Retrieve the definition of a specific collection (` + "`" + `className` + "`" + `), including its properties, configuration, and vectorizer settings.",
+    "/replication/replicate/{id}/cancel": {
+      "post": {
+        "description": "Requests the cancellation of an active replication operation identified by its ID. The operation will be stopped, but its record will remain in the ` + "`" + `CANCELLED` + "`" + ` state (can't be resumed) and will not be automatically deleted.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Get a single collection",
-        "operationId": "schema.objects.get",
+        "summary": "Cancel a replication operation",
+        "operationId": "cancelReplication",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) to retrieve.",
-            "name": "className",
+            "format": "uuid",
+            "description": "The ID of the replication operation to cancel.",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
-            "name": "consistency",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully retrieved the collection definition.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully cancelled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4829,49 +5085,77 @@ func init() {
             }
           },
           "404": {
-            "description": "Collection not found."
+            "description": "Shard replica operation not found."
+          },
+          "409": {
+            "description": "The operation is not in a cancellable state, e.g. it is READY or is a MOVE op in the DEHYDRATING state.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
-            "description": "An error occurred while retrieving the collection definition. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.replication.replicate.cancel"
         ]
-      },
-      "put": {
-        "description": "Updates the configuration settings of an existing collection (` + "`" + `className` + "`" + `) based on the provided definition. Note: This operation modifies mutable settings specified in the request body. It does not add properties (use ` + "`" + `POST /schema/{className}/properties` + "`" + ` for that) or change the collection name.",
+      }
+    },
+    "/replication/scale": {
+      "get": {
+        "description": "Computes and returns a replication scale plan for a given collection and desired replication factor. The plan includes, for each shard, a list of nodes to be added and a list of nodes to be removed.",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Update collection definition",
-        "operationId": "schema.objects.update",
+        "summary": "Get replication scale plan",
+        "operationId": "getReplicationScalePlan",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) to update.",
-            "name": "className",
-            "in": "path",
+            "description": "The collection name to get the scaling plan for.",
+            "name": "collection",
+            "in": "query",
             "required": true
           },
           {
-            "description": "The updated collection definition containing the settings to modify.",
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "minimum": 1,
+            "type": "integer",
+            "description": "The desired replication factor to scale to. Must be a positive integer greater than zero.",
+            "name": "replicationFactor",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Collection settings updated successfully.",
+            "description": "Replication scale plan showing node additions and removals per shard.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReplicationScalePlan"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4889,45 +5173,56 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid update attempt.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "500": {
-            "description": "An error occurred while updating the collection. Check the ErrorResponse for details.",
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.scale.get"
         ]
       },
-      "delete": {
-        "description": "Removes a collection definition from the schema. WARNING: This action permanently deletes all data objects stored within the collection.",
+      "post": {
+        "description": "Apply a replication scaling plan that specifies nodes to add or remove per shard for a given collection.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Delete a collection (and all associated data)",
-        "operationId": "schema.objects.delete",
+        "summary": "Apply replication scaling plan",
+        "operationId": "applyReplicationScalePlan",
         "parameters": [
           {
-            "type": "string",
-            "description": "The name of the collection (class) to delete.",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "description": "The replication scaling plan specifying the collection and its shard-level replica adjustments.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReplicationScalePlan"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Collection deleted successfully."
+            "description": "List of replication shard copy operation IDs initiated for the scale operation",
+            "schema": {
+              "$ref": "#/definitions/ReplicationScaleApplyResponse"
+            }
           },
           "400": {
-            "description": "Could not delete the collection. See the error response for details.",
+            "description": "Bad request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4941,49 +5236,63 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Collection not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
-            "description": "An error occurred during collection deletion. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.scale.post"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
-        "description": "Adds a new property definition to an existing collection (` + "`" + `className` + "`" + `) definition.",
+    "/replication/sharding-state": {
+      "get": {
+        "description": "Fetches the current sharding state, including replica locations and statuses, for all collections or a specified collection. If a shard name is provided along with a collection, the state for that specific shard is returned.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Add a property to a collection",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Get sharding state",
+        "operationId": "getCollectionShardingState",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) to add the property to.",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "description": "The collection name to get the sharding state for.",
+            "name": "collection",
+            "in": "query"
           },
           {
-            "description": "The definition of the property to add.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "type": "string",
+            "description": "The shard to get the sharding state for.",
+            "name": "shard",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Property added successfully and its definition returned.",
+            "description": "Successfully retrieved sharding state.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ReplicationShardingStateResponse"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4995,63 +5304,98 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid property definition provided.",
+          "404": {
+            "description": "Collection or shard not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while adding the property. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.shardingstate.collection.get"
         ]
       }
     },
-    "/schema/{className}/properties/{propertyName}/index/{indexName}": {
-      "delete": {
-        "description": "Deletes an inverted index of a specific property within a collection (` + "`" + `className` + "`" + `). The index to delete is identified by ` + "`" + `indexName` + "`" + ` and must be one of ` + "`" + `filterable` + "`" + `, ` + "`" + `searchable` + "`" + `, or ` + "`" + `rangeFilters` + "`" + `.",
+    "/schema": {
+      "get": {
+        "description": "Retrieves the definitions of all collections (classes) currently in the database schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Delete a property's inverted index",
-        "operationId": "schema.objects.properties.delete",
+        "summary": "Get all collection definitions",
+        "operationId": "schema.dump",
         "parameters": [
           {
-            "type": "string",
-            "description": "The name of the collection (class) containing the property.",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": true,
+            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           },
-          {
-            "type": "string",
-            "description": "The name of the property whose inverted index should be deleted.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error occurred while retrieving the schema. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Defines and creates a new collection (class).\u003cbr/\u003e\u003cbr/\u003eIf [` + "`" + `AutoSchema` + "`" + `](https://docs.weaviate.io/weaviate/config-refs/collections#auto-schema) is enabled (not recommended for production), Weaviate might attempt to infer schema from data during import. Manual definition via this endpoint provides explicit control.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new collection",
+        "operationId": "schema.objects.create",
+        "parameters": [
           {
-            "enum": [
-              "filterable",
-              "searchable",
-              "rangeFilters"
-            ],
-            "type": "string",
-            "description": "The name of the inverted index to delete from the property.",
-            "name": "indexName",
-            "in": "path",
-            "required": true
+            "description": "The definition of the collection (class) to create.",
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Index deleted successfully."
+            "description": "Collection created successfully and its definition returned.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5063,51 +5407,52 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid index, property or collection provided.",
+            "description": "Invalid collection definition provided. Check the definition structure and properties.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while deleting the index. Check the ErrorResponse for details.",
+            "description": "An error occurred during collection creation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/schema/{className}": {
       "get": {
-        "description": "Retrieves the status of all shards associated with the specified collection (` + "`" + `className` + "`" + `). For multi-tenant collections, use the ` + "`" + `tenant` + "`" + ` query parameter to retrieve status for a specific tenant's shards.",
+        "description": "Retrieve the definition of a specific collection (` + "`" + `className` + "`" + `), including its properties, configuration, and vectorizer settings.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of a collection",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Get a single collection",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) whose shards to query.",
+            "description": "The name of the collection (class) to retrieve.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The name of the tenant for which to retrieve shard statuses (only applicable for multi-tenant collections).",
-            "name": "tenant",
-            "in": "query"
+            "type": "boolean",
+            "default": true,
+            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard statuses retrieved successfully.",
+            "description": "Successfully retrieved the collection definition.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -5120,13 +5465,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Collection not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Collection not found."
           },
           "500": {
-            "description": "An error occurred while retrieving shard statuses. Check the ErrorResponse for details.",
+            "description": "An error occurred while retrieving the collection definition. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5135,46 +5477,37 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.get.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Updates the status of a specific shard within a collection (e.g., sets it to ` + "`" + `READY` + "`" + ` or ` + "`" + `READONLY` + "`" + `). This is typically used after resolving an underlying issue (like disk space) that caused a shard to become non-operational. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "description": "Updates the configuration settings of an existing collection (` + "`" + `className` + "`" + `) based on the provided definition. Note: This operation modifies mutable settings specified in the request body. It does not add properties (use ` + "`" + `POST /schema/{className}/properties` + "`" + ` for that) or change the collection name.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a shard status",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Update collection definition",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) containing the shard.",
+            "description": "The name of the collection (class) to update.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The name of the shard to update.",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The shard status object containing the desired new status.",
-            "name": "body",
+            "description": "The updated collection definition containing the settings to modify.",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status updated successfully.",
+            "description": "Collection settings updated successfully.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -5187,19 +5520,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Collection or shard not found.",
+            "description": "Collection not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid update attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while updating the shard status. Check the ErrorResponse for details.",
+            "description": "An error occurred while updating the collection. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5208,40 +5541,31 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema/{className}/tenants": {
-      "get": {
-        "description": "Retrieves a list of all tenants currently associated with the specified collection.",
+      },
+      "delete": {
+        "description": "Removes a collection definition from the schema. WARNING: This action permanently deletes all data objects stored within the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the list of tenants",
-        "operationId": "tenants.get",
+        "summary": "Delete a collection (and all associated data)",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) whose tenants to list.",
+            "description": "The name of the collection (class) to delete.",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
-            "name": "consistency",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved tenants.",
+            "description": "Collection deleted successfully."
+          },
+          "400": {
+            "description": "Could not delete the collection. See the error response for details.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5253,56 +5577,49 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
-            "description": "An error occurred while listing tenants. Check the ErrorResponse for details.",
+            "description": "An error occurred during collection deletion. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "put": {
-        "description": "Updates the activity status (e.g., ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + `, etc.) of one or more specified tenants within a collection (` + "`" + `className` + "`" + `).",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "description": "Adds a new property definition to an existing collection (` + "`" + `className` + "`" + `) definition.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a tenant",
-        "operationId": "tenants.update",
+        "summary": "Add a property to a collection",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) containing the tenants.",
+            "description": "The name of the collection (class) to add the property to.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "An array of tenant objects specifying the tenants to update and their desired new status.",
+            "description": "The definition of the property to add.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Tenant statuses updated successfully.",
+            "description": "Property added successfully and its definition returned.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -5315,56 +5632,62 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid update request.",
+            "description": "Invalid property definition provided.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while updating tenants. Check the ErrorResponse for details.",
+            "description": "An error occurred while adding the property. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "post": {
-        "description": "Creates one or more new tenants for a specified collection (` + "`" + `className` + "`" + `). Multi-tenancy must be enabled for the collection via its definition.",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties/{propertyName}/index/{indexName}": {
+      "delete": {
+        "description": "Deletes an inverted index of a specific property within a collection (` + "`" + `className` + "`" + `). The index to delete is identified by ` + "`" + `indexName` + "`" + ` and must be one of ` + "`" + `filterable` + "`" + `, ` + "`" + `searchable` + "`" + `, or ` + "`" + `rangeFilters` + "`" + `.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new tenant",
-        "operationId": "tenants.create",
+        "summary": "Delete a property's inverted index",
+        "operationId": "schema.objects.properties.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the multi-tenant enabled collection (class).",
+            "description": "The name of the collection (class) containing the property.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "An array of tenant objects to create.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+            "type": "string",
+            "description": "The name of the property whose inverted index should be deleted.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "filterable",
+              "searchable",
+              "rangeFilters"
+            ],
+            "type": "string",
+            "description": "The name of the inverted index to delete from the property.",
+            "name": "indexName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Tenants created successfully.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
+            "description": "Index deleted successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5376,51 +5699,52 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid request.",
+            "description": "Invalid index, property or collection provided.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while creating tenants. Check the ErrorResponse for details.",
+            "description": "An error occurred while deleting the index. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "Deletes one or more specified tenants from a collection (` + "`" + `className` + "`" + `). WARNING: This action permanently deletes all data associated with the specified tenants.",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
+        "description": "Retrieves the status of all shards associated with the specified collection (` + "`" + `className` + "`" + `). For multi-tenant collections, use the ` + "`" + `tenant` + "`" + ` query parameter to retrieve status for a specific tenant's shards.",
         "tags": [
           "schema"
         ],
-        "summary": "Delete tenants",
-        "operationId": "tenants.delete",
+        "summary": "Get the shards status of a collection",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) from which to delete tenants.",
+            "description": "The name of the collection (class) whose shards to query.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "An array of tenant names to delete.",
-            "name": "tenants",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "description": "Name of a tenant to delete.",
-                "type": "string"
-              }
-            }
+            "type": "string",
+            "description": "The name of the tenant for which to retrieve shard statuses (only applicable for multi-tenant collections).",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Tenants deleted successfully."
+            "description": "Shard statuses retrieved successfully.",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5431,57 +5755,62 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid request.",
+          "404": {
+            "description": "Collection not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while deleting tenants. Check the ErrorResponse for details.",
+            "description": "An error occurred while retrieving shard statuses. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "/schema/{className}/tenants/{tenantName}": {
-      "get": {
-        "description": "Retrieves details about a specific tenant within the given collection (` + "`" + `className` + "`" + `), such as its current activity status.",
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Updates the status of a specific shard within a collection (e.g., sets it to ` + "`" + `READY` + "`" + ` or ` + "`" + `READONLY` + "`" + `). This is typically used after resolving an underlying issue (like disk space) that caused a shard to become non-operational. There is also a convenience function in each client to set the status of all shards of a collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a specific tenant",
-        "operationId": "tenants.get.one",
+        "summary": "Update a shard status",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) containing the tenant.",
+            "description": "The name of the collection (class) containing the shard.",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the tenant to retrieve.",
-            "name": "tenantName",
+            "description": "The name of the shard to update.",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
-            "name": "consistency",
-            "in": "header"
+            "description": "The shard status object containing the desired new status.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved tenant details.",
+            "description": "Shard status updated successfully.",
             "schema": {
-              "$ref": "#/definitions/Tenant"
+              "$ref": "#/definitions/ShardStatus"
             }
           },
           "401": {
@@ -5494,44 +5823,45 @@ func init() {
             }
           },
           "404": {
-            "description": "Tenant or collection not found."
+            "description": "Collection or shard not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid request.",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while retrieving the tenant. Check the ErrorResponse for details.",
+            "description": "An error occurred while updating the shard status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "head": {
-        "description": "Checks for the existence of a specific tenant within the given collection (` + "`" + `className` + "`" + `).",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "Retrieves a list of all tenants currently associated with the specified collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Check if a tenant exists",
-        "operationId": "tenant.exists",
+        "summary": "Get the list of tenants",
+        "operationId": "tenants.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the collection (class) to check within.",
+            "description": "The name of the collection (class) whose tenants to list.",
             "name": "className",
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "The name of the tenant to check for.",
-            "name": "tenantName",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "boolean",
             "default": true,
@@ -5542,7 +5872,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "The tenant exists in the specified collection."
+            "description": "Successfully retrieved tenants.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5553,9 +5889,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Tenant or collection not found."
-          },
           "422": {
             "description": "Invalid request.",
             "schema": {
@@ -5563,70 +5896,109 @@ func init() {
             }
           },
           "500": {
-            "description": "An error occurred during the check. Check the ErrorResponse for details.",
+            "description": "An error occurred while listing tenants. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "/tasks": {
-      "get": {
+      },
+      "put": {
+        "description": "Updates the activity status (e.g., ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + `, etc.) of one or more specified tenants within a collection (` + "`" + `className` + "`" + `).",
         "tags": [
-          "distributedTasks"
+          "schema"
+        ],
+        "summary": "Update a tenant",
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the collection (class) containing the tenants.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "An array of tenant objects specifying the tenants to update and their desired new status.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
         ],
-        "summary": "Lists all distributed tasks in the cluster",
-        "operationId": "distributedTasks.get",
         "responses": {
           "200": {
-            "description": "Distributed tasks successfully returned.",
+            "description": "Tenant statuses updated successfully.",
             "schema": {
-              "$ref": "#/definitions/DistributedTasks"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
           "403": {
-            "description": "Unauthorized or invalid credentials.",
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred while retrieving distributed tasks. Check the ErrorResponse for details.",
+            "description": "An error occurred while updating tenants. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.distributedTasks.get"
-        ]
-      }
-    },
-    "/users/db": {
-      "get": {
-        "description": "Retrieves a list of all database (` + "`" + `db` + "`" + ` user type) users with their roles and status information.",
+        }
+      },
+      "post": {
+        "description": "Creates one or more new tenants for a specified collection (` + "`" + `className` + "`" + `). Multi-tenancy must be enabled for the collection via its definition.",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "List all users",
-        "operationId": "listAllUsers",
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to include the last time the users were utilized.",
-            "name": "includeLastUsedTime",
-            "in": "query"
+            "type": "string",
+            "description": "The name of the multi-tenant enabled collection (class).",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "An array of tenant objects to create.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Info about the users.",
+            "description": "Tenants created successfully.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/DBUserInfo"
+                "$ref": "#/definitions/Tenant"
               }
             }
           },
@@ -5639,48 +6011,52 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while creating tenants. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.list_all"
-        ]
-      }
-    },
-    "/users/db/{user_id}": {
-      "get": {
-        "description": "Retrieve detailed information about a specific database user (` + "`" + `db` + "`" + ` user type), including their roles, status, and type.",
+        }
+      },
+      "delete": {
+        "description": "Deletes one or more specified tenants from a collection (` + "`" + `className` + "`" + `). WARNING: This action permanently deletes all data associated with the specified tenants.",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "Get user info",
-        "operationId": "getUserInfo",
+        "summary": "Delete tenants",
+        "operationId": "tenants.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the user.",
-            "name": "user_id",
+            "description": "The name of the collection (class) from which to delete tenants.",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to include the last used time of the given user",
-            "name": "includeLastUsedTime",
-            "in": "query"
+            "description": "An array of tenant names to delete.",
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "description": "Name of a tenant to delete.",
+                "type": "string"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Info about the user.",
-            "schema": {
-              "$ref": "#/definitions/DBUserInfo"
-            }
+            "description": "Tenants deleted successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5691,72 +6067,57 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "User not found."
-          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while deleting tenants. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.get"
-        ]
-      },
-      "post": {
-        "description": "Create a new database (` + "`" + `db` + "`" + ` user type) user with the specified name. Returns an API key for the newly created user.",
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}": {
+      "get": {
+        "description": "Retrieves details about a specific tenant within the given collection (` + "`" + `className` + "`" + `), such as its current activity status.",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "Create a new user",
-        "operationId": "createUser",
+        "summary": "Get a specific tenant",
+        "operationId": "tenants.get.one",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the user.",
-            "name": "user_id",
+            "description": "The name of the collection (class) containing the tenant.",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "createTime": {
-                  "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - set the given time as creation time",
-                  "type": "string",
-                  "format": "date-time"
-                },
-                "import": {
-                  "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - import api key from static user",
-                  "type": "boolean",
-                  "default": false
-                }
-              }
-            }
+            "type": "string",
+            "description": "The name of the tenant to retrieve.",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "User created successfully and API key returned.",
-            "schema": {
-              "$ref": "#/definitions/UserApiKey"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Successfully retrieved tenant details.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Tenant"
             }
           },
           "401": {
@@ -5769,59 +6130,55 @@ func init() {
             }
           },
           "404": {
-            "description": "User not found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "409": {
-            "description": "A user with the specified name already exists.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Tenant or collection not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while retrieving the tenant. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.create"
-        ]
+        }
       },
-      "delete": {
-        "description": "Delete a database user. You can't delete your current user.",
+      "head": {
+        "description": "Checks for the existence of a specific tenant within the given collection (` + "`" + `className` + "`" + `).",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "Delete a user",
-        "operationId": "deleteUser",
+        "summary": "Check if a tenant exists",
+        "operationId": "tenant.exists",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the user.",
-            "name": "user_id",
+            "description": "The name of the collection (class) to check within.",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the tenant to check for.",
+            "name": "tenantName",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If true, the request is proxied to the cluster leader to ensure strong schema consistency. Default is true.",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "The tenant exists in the specified collection."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5833,10 +6190,87 @@ func init() {
             }
           },
           "404": {
-            "description": "User not found."
+            "description": "Tenant or collection not found."
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error occurred during the check. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/tasks": {
+      "get": {
+        "tags": [
+          "distributedTasks"
+        ],
+        "summary": "Lists all distributed tasks in the cluster",
+        "operationId": "distributedTasks.get",
+        "responses": {
+          "200": {
+            "description": "Distributed tasks successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/DistributedTasks"
+            }
+          },
+          "403": {
+            "description": "Unauthorized or invalid credentials.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An internal server error occurred while retrieving distributed tasks. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.distributedTasks.get"
+        ]
+      }
+    },
+    "/users/db": {
+      "get": {
+        "description": "Retrieves a list of all database (` + "`" + `db` + "`" + ` user type) users with their roles and status information.",
+        "tags": [
+          "users"
+        ],
+        "summary": "List all users",
+        "operationId": "listAllUsers",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the last time the users were utilized.",
+            "name": "includeLastUsedTime",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Info about the users.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DBUserInfo"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5849,18 +6283,18 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.delete"
+          "weaviate.users.db.list_all"
         ]
       }
     },
-    "/users/db/{user_id}/activate": {
-      "post": {
-        "description": "Activate a deactivated database user (` + "`" + `db` + "`" + ` user type).",
+    "/users/db/{user_id}": {
+      "get": {
+        "description": "Retrieve detailed information about a specific database user (` + "`" + `db` + "`" + ` user type), including their roles, status, and type.",
         "tags": [
           "users"
         ],
-        "summary": "Activate a user",
-        "operationId": "activateUser",
+        "summary": "Get user info",
+        "operationId": "getUserInfo",
         "parameters": [
           {
             "type": "string",
@@ -5868,16 +6302,20 @@ func init() {
             "name": "user_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the last used time of the given user",
+            "name": "includeLastUsedTime",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "User successfully activated."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Info about the user.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/DBUserInfo"
             }
           },
           "401": {
@@ -5892,9 +6330,6 @@ func init() {
           "404": {
             "description": "User not found."
           },
-          "409": {
-            "description": "User already activated."
-          },
           "422": {
             "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
@@ -5909,18 +6344,16 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.activateUser"
+          "weaviate.users.db.get"
         ]
-      }
-    },
-    "/users/db/{user_id}/deactivate": {
+      },
       "post": {
-        "description": "Deactivate a database user (` + "`" + `db` + "`" + ` user type).",
+        "description": "Create a new database (` + "`" + `db` + "`" + ` user type) user with the specified name. Returns an API key for the newly created user.",
         "tags": [
           "users"
         ],
-        "summary": "Deactivate a user",
-        "operationId": "deactivateUser",
+        "summary": "Create a new user",
+        "operationId": "createUser",
         "parameters": [
           {
             "type": "string",
@@ -5935,8 +6368,13 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "revoke_key": {
-                  "description": "Whether the API key should be revoked when deactivating the user.",
+                "createTime": {
+                  "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - set the given time as creation time",
+                  "type": "string",
+                  "format": "date-time"
+                },
+                "import": {
+                  "description": "EXPERIMENTAL, DONT USE. THIS WILL BE REMOVED AGAIN. - import api key from static user",
                   "type": "boolean",
                   "default": false
                 }
@@ -5945,8 +6383,11 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "User successfully deactivated."
+          "201": {
+            "description": "User created successfully and API key returned.",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -5964,10 +6405,16 @@ func init() {
             }
           },
           "404": {
-            "description": "User not found."
+            "description": "User not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "409": {
-            "description": "User already deactivated."
+            "description": "A user with the specified name already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
             "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
@@ -5983,18 +6430,16 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.deactivateUser"
+          "weaviate.users.db.create"
         ]
-      }
-    },
-    "/users/db/{user_id}/rotate-key": {
-      "post": {
-        "description": "Revoke the current API key for the specified database user (` + "`" + `db` + "`" + ` user type) and generate a new one.",
+      },
+      "delete": {
+        "description": "Delete a database user. You can't delete your current user.",
         "tags": [
           "users"
         ],
-        "summary": "Rotate API key of a user",
-        "operationId": "rotateUserApiKey",
+        "summary": "Delete a user",
+        "operationId": "deleteUser",
         "parameters": [
           {
             "type": "string",
@@ -6005,11 +6450,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "API key successfully updated.",
-            "schema": {
-              "$ref": "#/definitions/UserApiKey"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "400": {
             "description": "Malformed request.",
@@ -6043,43 +6485,237 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.rotateApiKey"
+          "weaviate.users.db.delete"
         ]
       }
     },
-    "/users/own-info": {
-      "get": {
-        "description": "Get information about the currently authenticated user, including username and assigned roles.",
+    "/users/db/{user_id}/activate": {
+      "post": {
+        "description": "Activate a deactivated database user (` + "`" + `db` + "`" + ` user type).",
         "tags": [
           "users"
         ],
-        "summary": "Get current user info",
-        "operationId": "getOwnInfo",
+        "summary": "Activate a user",
+        "operationId": "activateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the user.",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Info about the user.",
+            "description": "User successfully activated."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/UserOwnInfo"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "501": {
-            "description": "Replica movement operations are disabled.",
+          "404": {
+            "description": "User not found."
+          },
+          "409": {
+            "description": "User already activated."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.users.get.own-info"
+          "weaviate.users.db.activateUser"
+        ]
+      }
+    },
+    "/users/db/{user_id}/deactivate": {
+      "post": {
+        "description": "Deactivate a database user (` + "`" + `db` + "`" + ` user type).",
+        "tags": [
+          "users"
+        ],
+        "summary": "Deactivate a user",
+        "operationId": "deactivateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the user.",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "revoke_key": {
+                  "description": "Whether the API key should be revoked when deactivating the user.",
+                  "type": "boolean",
+                  "default": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully deactivated."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found."
+          },
+          "409": {
+            "description": "User already deactivated."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.deactivateUser"
+        ]
+      }
+    },
+    "/users/db/{user_id}/rotate-key": {
+      "post": {
+        "description": "Revoke the current API key for the specified database user (` + "`" + `db` + "`" + ` user type) and generate a new one.",
+        "tags": [
+          "users"
+        ],
+        "summary": "Rotate API key of a user",
+        "operationId": "rotateUserApiKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the user.",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "API key successfully updated.",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.rotateApiKey"
+        ]
+      }
+    },
+    "/users/own-info": {
+      "get": {
+        "description": "Get information about the currently authenticated user, including username and assigned roles.",
+        "tags": [
+          "users"
+        ],
+        "summary": "Get current user info",
+        "operationId": "getOwnInfo",
+        "responses": {
+          "200": {
+            "description": "Info about the user.",
+            "schema": {
+              "$ref": "#/definitions/UserOwnInfo"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement operations are disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.get.own-info"
         ]
       }
     }
@@ -6195,6 +6831,10 @@ func init() {
           "description": "Name of the endpoint, e.g. s3.amazonaws.com.",
           "type": "string"
         },
+        "IncrementalBaseBackupID": {
+          "description": "ID of an earlier backup on the same backend, bucket and path. When set, immutable LSM segments which are unchanged since the base backup are referenced instead of being uploaded again.",
+          "type": "string"
+        },
         "Path": {
           "description": "Path or key within the bucket.",
           "type": "string"
@@ -6379,6 +7019,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of collections (classes).",
       "properties": {
+        "classMapping": {
+          "description": "Allows restoring collections (classes) under a different name. Keys are collection names in the backup and values are the names they are restored as. Aliases of a renamed collection are not restored unless moveAliases is set.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process.",
           "type": "object",
@@ -6398,6 +7045,10 @@ func init() {
             "type": "string"
           }
         },
+        "moveAliases": {
+          "description": "When collections are renamed with classMapping, restore the aliases of the backup pointing to the restored collections. This moves aliases away from existing collections of the original name. By default aliases of renamed collections are skipped.",
+          "type": "boolean"
+        },
         "node_mapping": {
           "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
           "type": "object",
@@ -6408,6 +7059,13 @@ func init() {
         "overwriteAlias": {
           "description": "Allows ovewriting the collection alias if there is a conflict",
           "type": "boolean"
+        },
+        "tenantMapping": {
+          "description": "Allows restoring tenants under a different name. Keys are tenant names in the backup and values are the names they are restored as. Only the listed tenants are restored and exactly one multi-tenant collection must be restored.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
@@ -6490,6 +7148,103 @@ func init() {
         }
       }
     },
+    "BackupSchedule": {
+      "description": "A schedule creating backups periodically and deleting the backups it created according to its retention rules.",
+      "type": "object",
+      "properties": {
+        "backend": {
+          "description": "The backend storage system to store the backups on (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+          "type": "string"
+        },
+        "backups": {
+          "description": "The IDs of the backups created by the schedule which have not been deleted by its retention rules. Ignored in requests.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Custom configuration of the backups. ` + "`" + `IncrementalBaseBackupID` + "`" + ` is not supported for schedules.",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "createdAtUnixMs": {
+          "description": "The time the schedule was created, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "cron": {
+          "description": "A standard cron expression (minute, hour, day of month, month, day of week) or a descriptor such as ` + "`" + `@daily` + "`" + `. It is evaluated in UTC unless prefixed with a time zone, e.g. ` + "`" + `CRON_TZ=Europe/Berlin 0 3 * * *` + "`" + `.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule, taken from the path. The IDs of the backups created by the schedule consist of the schedule ID followed by the UTC time of the run, e.g. ` + "`" + `nightly-20260102030000` + "`" + `.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of collections to include in the backups. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastBackupId": {
+          "description": "The ID of the backup triggered by the last run. Empty if the last run failed to start a backup. Ignored in requests.",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "The error which prevented the last run from starting a backup, if any. Ignored in requests.",
+          "type": "string"
+        },
+        "lastRunAtUnixMs": {
+          "description": "The time the schedule last triggered a backup, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nextRunAtUnixMs": {
+          "description": "The time the schedule triggers the next backup, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        }
+      }
+    },
+    "BackupScheduleListResponse": {
+      "description": "The list of backup schedules.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/BackupSchedule"
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Rules deciding which backups of a schedule are kept. A backup is kept if any rule selects it, only successful backups are selected. Failed and canceled backups are deleted. Backups are never deleted if no rule is set.",
+      "type": "object",
+      "properties": {
+        "keepDaily": {
+          "description": "Keep the most recent backup of each of the last N days having one.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Keep the N most recent backups.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepWeekly": {
+          "description": "Keep the most recent backup of each of the last N weeks having one.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "BatchDelete": {
       "type": "object",
       "properties": {
@@ -7018,16 +7773,74 @@ func init() {
         }
       }
     },
-    "DBUserInfo": {
+    "CrossClusterReplicationStatus": {
+      "description": "The role of a cluster in cross-cluster replication and, for a follower cluster, its lag behind the primary cluster.",
       "type": "object",
-      "required": [
-        "userId",
-        "dbUserType",
-        "roles",
-        "active"
-      ],
       "properties": {
-        "active": {
+        "appliedIndex": {
+          "description": "The Raft log index of the primary cluster up to which its schema changes have been applied.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastObjectsInSyncUnixMs": {
+          "description": "The last time all shards of the node serving the request were found in sync with the primary cluster, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastSchemaSyncUnixMs": {
+          "description": "The last time the schema was synced with the primary cluster, in milliseconds since the Unix epoch. Only known to the leader of the cluster.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsLagSeconds": {
+          "description": "The number of seconds since all shards of the node serving the request were last found in sync with the primary cluster.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "primaryHost": {
+          "description": "The address of the primary cluster which is followed.",
+          "type": "string"
+        },
+        "primaryIndex": {
+          "description": "The last Raft log index applied by the primary cluster, as last seen by the node serving the request.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "promotedAtUnixMs": {
+          "description": "The time the cluster was promoted, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "role": {
+          "description": "The role of the cluster. A ` + "`" + `PRIMARY` + "`" + ` cluster does not follow another cluster. A ` + "`" + `FOLLOWER` + "`" + ` cluster pulls schema changes and objects from its primary cluster. A ` + "`" + `PROMOTED` + "`" + ` cluster followed a primary cluster until it was promoted.",
+          "type": "string",
+          "enum": [
+            "PRIMARY",
+            "FOLLOWER",
+            "PROMOTED"
+          ]
+        },
+        "schemaLag": {
+          "description": "The number of Raft log entries of the primary cluster which have not been applied yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "DBUserInfo": {
+      "type": "object",
+      "required": [
+        "userId",
+        "dbUserType",
+        "roles",
+        "active"
+      ],
+      "properties": {
+        "active": {
           "description": "Activity status of the returned user.",
           "type": "boolean"
         },
@@ -7475,6 +8288,45 @@ func init() {
         }
       }
     },
+    "NodeDrainStatus": {
+      "description": "The progress of draining a node before it is removed from the cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The last error which prevented the drain from progressing, if any.",
+          "type": "string"
+        },
+        "movesInFlight": {
+          "description": "The number of replica movements away from the node which are in progress.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "node": {
+          "description": "The name of the drained node.",
+          "type": "string"
+        },
+        "replicasRemaining": {
+          "description": "The number of shard replicas which are still hosted by the node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "startTimeUnixMs": {
+          "description": "The time the drain was started, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "The state of the drain. A ` + "`" + `DRAINING` + "`" + ` node does not get any new shards or tenants while its replicas are moved to other nodes. A ` + "`" + `REMOVED` + "`" + ` node holds no replicas anymore and has been removed from the cluster.",
+          "type": "string",
+          "enum": [
+            "DRAINING",
+            "REMOVED"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -7718,6 +8570,14 @@ func init() {
               "description": "Results for this specific object.",
               "format": "object",
               "properties": {
+                "acknowledgedReplicas": {
+                  "description": "Nodes which acknowledged the write of this object. Only set if the request carries a write concern, see the ` + "`" + `X-Weaviate-Write-Acks` + "`" + ` header.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                },
                 "errors": {
                   "$ref": "#/definitions/ErrorResponse"
                 },
@@ -8255,6 +9115,123 @@ func init() {
         }
       }
     },
+    "RebalancerMove": {
+      "description": "A shard replica which is moved from an overloaded to an underloaded storage node.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The collection of the shard.",
+          "type": "string"
+        },
+        "load": {
+          "description": "The load moved along with the replica.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node the replica is moved away from.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node the replica is moved to.",
+          "type": "string"
+        }
+      }
+    },
+    "RebalancerNodeLoad": {
+      "description": "The load of a storage node as seen by the rebalancer.",
+      "type": "object",
+      "properties": {
+        "diskUsed": {
+          "description": "The disk space used on the node, in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "load": {
+          "description": "The load of the node according to the configured metric.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "node": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects held by the node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "RebalancerPlan": {
+      "description": "The replica moves which would balance the load of the storage nodes.",
+      "type": "object",
+      "properties": {
+        "imbalance": {
+          "description": "The largest deviation of the load of a node from the mean load, relative to the mean load.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "imbalanceAfter": {
+          "description": "The imbalance once all planned moves are done.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "inFlight": {
+          "description": "The number of replication operations which are in progress.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "metric": {
+          "description": "The metric the load of the nodes is measured by, ` + "`" + `objects` + "`" + ` or ` + "`" + `disk` + "`" + `.",
+          "type": "string"
+        },
+        "moves": {
+          "description": "The planned moves.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebalancerMove"
+          }
+        },
+        "nodes": {
+          "description": "The load of each storage node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebalancerNodeLoad"
+          }
+        },
+        "paused": {
+          "description": "Whether the rebalancer is paused and does not schedule any moves.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "reason": {
+          "description": "Why no moves are planned, if so.",
+          "type": "string"
+        }
+      }
+    },
+    "RebalancerStatus": {
+      "description": "Whether the rebalancer schedules replica moves.",
+      "type": "object",
+      "properties": {
+        "paused": {
+          "description": "Whether the rebalancer is paused and does not schedule any moves.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -8828,6 +9805,89 @@ func init() {
         }
       }
     },
+    "ReplicationVerificationDifference": {
+      "description": "An object whose state differs between two replicas.",
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The id of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "updateTimes": {
+          "description": "The update time of the object on each replica, in the order of the nodes of the pair, 0 if it does not exist.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        }
+      }
+    },
+    "ReplicationVerificationPair": {
+      "description": "The outcome of comparing two replicas of a shard.",
+      "type": "object",
+      "properties": {
+        "consistent": {
+          "description": "Whether the hashtrees of both replicas match.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "differences": {
+          "description": "The objects whose update times differ between the replicas, up to the requested limit.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationVerificationDifference"
+          }
+        },
+        "differingLeaves": {
+          "description": "The number of hashtree leaves whose digests differ.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "The error which stopped the comparison of this pair, if any.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "The names of the two nodes holding the replicas.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "truncated": {
+          "description": "Whether there are more differing objects than reported.",
+          "type": "boolean"
+        }
+      }
+    },
+    "ReplicationVerificationReport": {
+      "description": "The outcome of comparing the replicas of the shards of a collection with each other.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "consistent": {
+          "description": "Whether all compared replicas are consistent with each other.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "pairs": {
+          "description": "One report per shard and pair of its replicas.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationVerificationPair"
+          }
+        }
+      }
+    },
     "RestoreConfig": {
       "description": "Backup custom configuration",
       "type": "object",
@@ -8922,6 +9982,70 @@ func init() {
         }
       }
     },
+    "SchemaAuditChange": {
+      "description": "A single field changed by a schema command.",
+      "type": "object",
+      "properties": {
+        "after": {
+          "description": "The value after the command was applied, absent if the field was removed."
+        },
+        "before": {
+          "description": "The value before the command was applied, absent if the field was added."
+        },
+        "path": {
+          "description": "The path of the changed field, e.g. ` + "`" + `properties[title].indexFilterable` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaAuditEntry": {
+      "description": "A schema, alias or RBAC command applied through raft.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The fields changed by the command.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaAuditChange"
+          }
+        },
+        "class": {
+          "description": "The name of the class the command applied to, if any.",
+          "type": "string"
+        },
+        "command": {
+          "description": "The type of the command, e.g. ` + "`" + `add_class` + "`" + ` or ` + "`" + `delete_class` + "`" + `.",
+          "type": "string"
+        },
+        "index": {
+          "description": "The raft log index of the command.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "principal": {
+          "description": "The principal which issued the command.",
+          "type": "string"
+        },
+        "timeUnixMs": {
+          "description": "The time the command was applied, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaAuditLogResponse": {
+      "description": "The schema audit log entries matching the query, newest first.",
+      "type": "object",
+      "properties": {
+        "entries": {
+          "description": "The matching audit log entries.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaAuditEntry"
+          }
+        }
+      }
+    },
     "SchemaClusterStatus": {
       "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
@@ -9689,10 +10813,709 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully retrieved the list of aliases",
+          "200": {
+            "description": "Successfully retrieved the list of aliases",
+            "schema": {
+              "$ref": "#/definitions/AliasResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid collection (class) parameter provided",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new alias mapping between an alias name and a collection (class). The alias acts as an alternative name for accessing the collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new alias",
+        "operationId": "aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully created a new alias for the specified collection (class)",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid create alias request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/aliases/{aliasName}": {
+      "get": {
+        "description": "Retrieve details about a specific alias by its name, including which collection (class) it points to.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get an alias",
+        "operationId": "aliases.get.alias",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the alias details.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias name provided.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update an existing alias to point to a different collection (class). This allows you to redirect an alias from one collection to another without changing the alias name.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update an alias",
+        "operationId": "aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "class": {
+                  "description": "The new collection (class) that the alias should point to.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully updated the alias to point to the new collection (class).",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update alias request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Remove an existing alias from the system. This will delete the alias mapping but will not affect the underlying collection (class).",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias",
+        "operationId": "aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid delete alias request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/authz/groups/{groupType}": {
+      "get": {
+        "description": "Retrieves a list of all available group names for a specified group type (` + "`" + `oidc` + "`" + ` or ` + "`" + `db` + "`" + `).",
+        "tags": [
+          "authz"
+        ],
+        "summary": "List all groups of a specific type",
+        "operationId": "getGroups",
+        "parameters": [
+          {
+            "enum": [
+              "oidc"
+            ],
+            "type": "string",
+            "description": "The type of group to retrieve.",
+            "name": "groupType",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of group names for the specified type.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.groups"
+        ]
+      }
+    },
+    "/authz/groups/{id}/assign": {
+      "post": {
+        "description": "Assign roles to the specified group.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Assign a role to a group",
+        "operationId": "assignRoleToGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the group.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "groupType": {
+                  "$ref": "#/definitions/GroupType"
+                },
+                "roles": {
+                  "description": "The roles to assign to the specified group.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Roles assigned successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Role or group not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assign.role"
+        ]
+      }
+    },
+    "/authz/groups/{id}/revoke": {
+      "post": {
+        "description": "Revoke roles from the specified group.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Revoke a role from a group",
+        "operationId": "revokeRoleFromGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the group.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "groupType": {
+                  "$ref": "#/definitions/GroupType"
+                },
+                "roles": {
+                  "description": "The roles to revoke from the specified group.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Roles revoked successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Role or group not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role.group"
+        ]
+      }
+    },
+    "/authz/groups/{id}/roles/{groupType}": {
+      "get": {
+        "description": "Retrieves a list of all roles assigned to a specific group. The group must be identified by both its name (` + "`" + `id` + "`" + `) and its type (` + "`" + `db` + "`" + ` or ` + "`" + `oidc` + "`" + `).",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get roles assigned to a specific group",
+        "operationId": "getRolesForGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The unique name of the group.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "oidc"
+            ],
+            "type": "string",
+            "description": "The type of the group.",
+            "name": "groupType",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "If true, the response will include the full role definitions with all associated permissions. If false, only role names are returned.",
+            "name": "includeFullRoles",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of roles assigned to the specified group.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The specified group was not found."
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.groups.roles"
+        ]
+      }
+    },
+    "/authz/roles": {
+      "get": {
+        "description": "Get all roles and their assigned permissions.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get all roles",
+        "operationId": "getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles"
+        ]
+      },
+      "post": {
+        "description": "Create a new role with the specified permissions.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Create new role",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Role already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.create.role"
+        ]
+      }
+    },
+    "/authz/roles/{id}": {
+      "get": {
+        "description": "Fetch a role by its name.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get a role",
+        "operationId": "getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "No role found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.role"
+        ]
+      },
+      "delete": {
+        "description": "Deleting a role will remove it from the system, and revoke the associated permissions from all users who had this role.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Delete a role",
+        "operationId": "deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/AliasResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9704,42 +11527,64 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid collection (class) parameter provided",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
+        },
+        "x-serviceIds": [
+          "weaviate.authz.delete.role"
+        ]
+      }
+    },
+    "/authz/roles/{id}/add-permissions": {
       "post": {
-        "description": "Create a new alias mapping between an alias name and a collection (class). The alias acts as an alternative name for accessing the collection.",
+        "description": "Add new permissions to an existing role without affecting current permissions.",
         "tags": [
-          "schema"
+          "authz"
         ],
-        "summary": "Create a new alias",
-        "operationId": "aliases.create",
+        "summary": "Add permissions to a role",
+        "operationId": "addPermissions",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The name (ID) of the role being modified.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Alias"
+              "type": "object",
+              "required": [
+                "name",
+                "permissions"
+              ],
+              "properties": {
+                "permissions": {
+                  "description": "Permissions to be added to the role.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully created a new alias for the specified collection (class)",
+            "description": "Permissions added successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Alias"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9751,8 +11596,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "No role found."
+          },
           "422": {
-            "description": "Invalid create alias request.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9763,30 +11611,43 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.add.role.permissions"
+        ]
       }
     },
-    "/aliases/{aliasName}": {
+    "/authz/roles/{id}/group-assignments": {
       "get": {
-        "description": "Retrieve details about a specific alias by its name, including which collection (class) it points to.",
+        "description": "Retrieves a list of all groups that have been assigned a specific role, identified by its name.",
         "tags": [
-          "schema"
+          "authz"
         ],
-        "summary": "Get an alias",
-        "operationId": "aliases.get.alias",
+        "summary": "Get groups that have a specific role assigned",
+        "operationId": "getGroupsForRole",
         "parameters": [
           {
             "type": "string",
-            "name": "aliasName",
+            "description": "The unique name of the role.",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the alias details.",
+            "description": "Successfully retrieved the list of groups that have the role assigned.",
             "schema": {
-              "$ref": "#/definitions/Alias"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GetGroupsForRoleOKBodyItems0"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9799,13 +11660,70 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Alias does not exist",
+            "description": "The specified role was not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles.groups"
+        ]
+      }
+    },
+    "/authz/roles/{id}/has-permission": {
+      "post": {
+        "description": "Check whether a role has the specified permissions.",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Check whether a role possesses a permission",
+        "operationId": "hasPermission",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The permissions to be checked.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Permission"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Permission check was successful.",
+            "schema": {
+              "type": "boolean"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid alias name provided.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9816,19 +11734,25 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "put": {
-        "description": "Update an existing alias to point to a different collection (class). This allows you to redirect an alias from one collection to another without changing the alias name.",
+        },
+        "x-serviceIds": [
+          "weaviate.authz.has.role.permission"
+        ]
+      }
+    },
+    "/authz/roles/{id}/remove-permissions": {
+      "post": {
+        "description": "Permissions can be revoked from a specified role. Removing all permissions from a role will delete the role itself.",
         "tags": [
-          "schema"
+          "authz"
         ],
-        "summary": "Update an alias",
-        "operationId": "aliases.update",
+        "summary": "Remove permissions from a role",
+        "operationId": "removePermissions",
         "parameters": [
           {
             "type": "string",
-            "name": "aliasName",
+            "description": "The name of the role being modified.",
+            "name": "id",
             "in": "path",
             "required": true
           },
@@ -9838,10 +11762,16 @@ func init() {
             "required": true,
             "schema": {
               "type": "object",
+              "required": [
+                "permissions"
+              ],
               "properties": {
-                "class": {
-                  "description": "The new collection (class) that the alias should point to.",
-                  "type": "string"
+                "permissions": {
+                  "description": "Permissions to remove from the role.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
                 }
               }
             }
@@ -9849,9 +11779,12 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully updated the alias to point to the new collection (class).",
+            "description": "Permissions removed successfully."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Alias"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9864,13 +11797,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Alias does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "No role found."
           },
           "422": {
-            "description": "Invalid update alias request.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9881,80 +11811,90 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "Remove an existing alias from the system. This will delete the alias mapping but will not affect the underlying collection (class).",
+        },
+        "x-serviceIds": [
+          "weaviate.authz.remove.role.permissions"
+        ]
+      }
+    },
+    "/authz/roles/{id}/user-assignments": {
+      "get": {
+        "description": "Fetch a list of users which have the specified role.",
         "tags": [
-          "schema"
+          "authz"
         ],
-        "summary": "Delete an alias",
-        "operationId": "aliases.delete",
+        "summary": "Get users assigned to a role",
+        "operationId": "getUsersForRole",
         "parameters": [
           {
             "type": "string",
-            "name": "aliasName",
+            "description": "The name (ID) of the role.",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted the alias."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "200": {
+            "description": "Users assigned to this role.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GetUsersForRoleOKBodyItems0"
+              }
             }
           },
-          "404": {
-            "description": "Not Found - Alias does not exist",
+          "400": {
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid delete alias request.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "No role found."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles.users"
+        ]
       }
     },
-    "/authz/groups/{groupType}": {
+    "/authz/roles/{id}/users": {
       "get": {
-        "description": "Retrieves a list of all available group names for a specified group type (` + "`" + `oidc` + "`" + ` or ` + "`" + `db` + "`" + `).",
+        "description": "Get all the users (` + "`" + `db` + "`" + ` + ` + "`" + `oidc` + "`" + `) who have been assigned a specific role. Deprecated, will be removed when v1.29 is not supported anymore.",
         "tags": [
           "authz"
         ],
-        "summary": "List all groups of a specific type",
-        "operationId": "getGroups",
+        "summary": "Get users assigned to a role",
+        "operationId": "getUsersForRoleDeprecated",
+        "deprecated": true,
         "parameters": [
           {
-            "enum": [
-              "oidc"
-            ],
             "type": "string",
-            "description": "The type of group to retrieve.",
-            "name": "groupType",
+            "description": "The name of the role.",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of group names for the specified type.",
+            "description": "Users assigned to this role.",
             "schema": {
               "type": "array",
               "items": {
@@ -9963,7 +11903,7 @@ func init() {
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9977,11 +11917,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "No role found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -9991,22 +11928,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.groups"
+          "weaviate.authz.get.roles.users"
         ]
       }
     },
-    "/authz/groups/{id}/assign": {
+    "/authz/users/{id}/assign": {
       "post": {
-        "description": "Assign roles to the specified group.",
+        "description": "Assign one or more roles to a user. Users can have multiple roles.",
         "tags": [
           "authz"
         ],
-        "summary": "Assign a role to a group",
-        "operationId": "assignRoleToGroup",
+        "summary": "Assign a role to a user",
+        "operationId": "assignRoleToUser",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the group.",
+            "description": "The name of the user.",
             "name": "id",
             "in": "path",
             "required": true
@@ -10018,15 +11955,15 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "groupType": {
-                  "$ref": "#/definitions/GroupType"
-                },
                 "roles": {
-                  "description": "The roles to assign to the specified group.",
+                  "description": "The roles that are assigned to the specified user.",
                   "type": "array",
                   "items": {
                     "type": "string"
                   }
+                },
+                "userType": {
+                  "$ref": "#/definitions/UserTypeInput"
                 }
               }
             }
@@ -10034,7 +11971,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Roles assigned successfully."
+            "description": "Role assigned successfully."
           },
           "400": {
             "description": "Malformed request.",
@@ -10052,7 +11989,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Role or group not found."
+            "description": "Specified role or user not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -10062,22 +12002,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.assign.role"
+          "weaviate.authz.assign.role.user"
         ]
       }
     },
-    "/authz/groups/{id}/revoke": {
+    "/authz/users/{id}/revoke": {
       "post": {
-        "description": "Revoke roles from the specified group.",
+        "description": "Remove one or more roles from a user.",
         "tags": [
           "authz"
         ],
-        "summary": "Revoke a role from a group",
-        "operationId": "revokeRoleFromGroup",
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRoleFromUser",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the group.",
+            "description": "The name of the user.",
             "name": "id",
             "in": "path",
             "required": true
@@ -10089,15 +12029,15 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "groupType": {
-                  "$ref": "#/definitions/GroupType"
-                },
                 "roles": {
-                  "description": "The roles to revoke from the specified group.",
+                  "description": "The roles to revoke from the specified user.",
                   "type": "array",
                   "items": {
                     "type": "string"
                   }
+                },
+                "userType": {
+                  "$ref": "#/definitions/UserTypeInput"
                 }
               }
             }
@@ -10123,7 +12063,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Role or group not found."
+            "description": "Specified role or user not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -10133,53 +12076,37 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role.group"
+          "weaviate.authz.revoke.role.user"
         ]
       }
     },
-    "/authz/groups/{id}/roles/{groupType}": {
+    "/authz/users/{id}/roles": {
       "get": {
-        "description": "Retrieves a list of all roles assigned to a specific group. The group must be identified by both its name (` + "`" + `id` + "`" + `) and its type (` + "`" + `db` + "`" + ` or ` + "`" + `oidc` + "`" + `).",
+        "description": "Retrieve the roles assigned to a specific user (` + "`" + `db` + "`" + ` + ` + "`" + `oidc` + "`" + `). Deprecated, will be removed when 1.29 is not supported anymore",
         "tags": [
           "authz"
         ],
-        "summary": "Get roles assigned to a specific group",
-        "operationId": "getRolesForGroup",
+        "summary": "Get roles assigned to a user",
+        "operationId": "getRolesForUserDeprecated",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "description": "The unique name of the group.",
+            "description": "The name of the user.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "oidc"
-            ],
-            "type": "string",
-            "description": "The type of the group.",
-            "name": "groupType",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "If true, the response will include the full role definitions with all associated permissions. If false, only role names are returned.",
-            "name": "includeFullRoles",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of roles assigned to the specified group.",
+            "description": "Roles assigned to the user.",
             "schema": {
               "$ref": "#/definitions/RolesListResponse"
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -10194,7 +12121,7 @@ func init() {
             }
           },
           "404": {
-            "description": "The specified group was not found."
+            "description": "No roles found for specified user."
           },
           "422": {
             "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
@@ -10210,71 +12137,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.groups.roles"
+          "weaviate.authz.get.users.roles"
         ]
       }
     },
-    "/authz/roles": {
+    "/authz/users/{id}/roles/{userType}": {
       "get": {
-        "description": "Get all roles and their assigned permissions.",
+        "description": "Get all the roles for a specific user (` + "`" + `db` + "`" + ` or ` + "`" + `oidc` + "`" + `).",
         "tags": [
           "authz"
         ],
-        "summary": "Get all roles",
-        "operationId": "getRoles",
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/RolesListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+        "summary": "Get roles assigned to a user",
+        "operationId": "getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the user.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "enum": [
+              "oidc",
+              "db"
+            ],
+            "type": "string",
+            "description": "The type of the user.",
+            "name": "userType",
+            "in": "path",
+            "required": true
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.authz.get.roles"
-        ]
-      },
-      "post": {
-        "description": "Create a new role with the specified permissions.",
-        "tags": [
-          "authz"
-        ],
-        "summary": "Create new role",
-        "operationId": "createRole",
-        "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Role"
-            }
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include detailed role information like its assigned permissions.",
+            "name": "includeFullRoles",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Role created successfully."
+          "200": {
+            "description": "Roles assigned to the user.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -10291,11 +12198,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "409": {
-            "description": "Role already exists.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "No roles found for specified user."
           },
           "422": {
             "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
@@ -10311,38 +12215,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.create.role"
+          "weaviate.authz.get.users.roles"
         ]
       }
     },
-    "/authz/roles/{id}": {
+    "/backups/schedules": {
       "get": {
-        "description": "Fetch a role by its name.",
+        "description": "Lists all backup schedules together with the state of their last run and the backups they retain.",
         "tags": [
-          "authz"
-        ],
-        "summary": "Get a role",
-        "operationId": "getRole",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The name of the role.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "backups"
         ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Role"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully retrieved the backup schedules.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupScheduleListResponse"
             }
           },
           "401": {
@@ -10354,31 +12243,30 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "No role found."
-          },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while listing the backup schedules. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.role"
+          "weaviate.local.backup"
         ]
-      },
+      }
+    },
+    "/backups/schedules/{id}": {
       "delete": {
-        "description": "Deleting a role will remove it from the system, and revoke the associated permissions from all users who had this role.",
+        "description": "Deletes the schedule. The backups it created are kept.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Delete a role",
-        "operationId": "deleteRole",
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the role.",
+            "description": "The ID of the schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
@@ -10386,13 +12274,7 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successfully deleted the backup schedule."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10403,64 +12285,53 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The backup schedule does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while deleting the backup schedule. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.delete.role"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}/add-permissions": {
-      "post": {
-        "description": "Add new permissions to an existing role without affecting current permissions.",
+      },
+      "put": {
+        "description": "Creates a schedule which starts a backup whenever its cron expression fires and deletes the backups it created once its retention rules no longer select them. Schedules are run by the cluster leader. Updating a schedule keeps the backups it created so far.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Add permissions to a role",
-        "operationId": "addPermissions",
+        "summary": "Create or update a backup schedule",
+        "operationId": "backups.schedules.put",
         "parameters": [
           {
             "type": "string",
-            "description": "The name (ID) of the role being modified.",
+            "description": "The ID of the schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
+            "description": "The definition of the schedule.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "name",
-                "permissions"
-              ],
-              "properties": {
-                "permissions": {
-                  "description": "Permissions to be added to the role.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BackupSchedule"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions added successfully."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The schedule has been created or updated.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupSchedule"
             }
           },
           "401": {
@@ -10472,58 +12343,57 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "No role found."
-          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid schedule, e.g. a malformed cron expression or an unknown backend.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while storing the backup schedule. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.add.role.permissions"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/group-assignments": {
+    "/backups/{backend}": {
       "get": {
-        "description": "Retrieves a list of all groups that have been assigned a specific role, identified by its name.",
+        "description": "List all created backups IDs, Status",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Get groups that have a specific role assigned",
-        "operationId": "getGroupsForRole",
+        "summary": "List all created backups",
+        "operationId": "backups.list",
         "parameters": [
           {
             "type": "string",
-            "description": "The unique name of the role.",
-            "name": "id",
+            "description": "Specifies the backend storage system to list backups from (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "desc",
+            "description": "Order of returned list of backups based on creation time. (asc or desc)",
+            "name": "order",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the list of groups that have the role assigned.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/GetGroupsForRoleOKBodyItems0"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Successfully retrieved the list of backups in progress.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupListResponse"
             }
           },
           "401": {
@@ -10535,58 +12405,53 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "The specified role was not found."
+          "422": {
+            "description": "Invalid request to list backups.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while listing backups. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.groups"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}/has-permission": {
+      },
       "post": {
-        "description": "Check whether a role has the specified permissions.",
+        "description": "Initiates the creation of a backup for specified collections on a designated backend storage.\u003cbr/\u003e\u003cbr/\u003eNotes:\u003cbr/\u003e- Backups are compressed using gzip by default.\u003cbr/\u003e- Weaviate remains operational during the backup process.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Check whether a role possesses a permission",
-        "operationId": "hasPermission",
+        "summary": "Create a backup",
+        "operationId": "backups.create",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the role.",
-            "name": "id",
+            "description": "Specifies the backend storage system where the backup will be stored (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
             "in": "path",
             "required": true
           },
           {
-            "description": "The permissions to be checked.",
+            "description": "Details of the backup request, including the backup ID and collections to include or exclude.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Permission"
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permission check was successful.",
-            "schema": {
-              "type": "boolean"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup creation process initiated successfully. Check the status endpoint for progress.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -10599,68 +12464,64 @@ func init() {
             }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid backup creation request. Check the request body and backend configuration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred during backup initiation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.has.role.permission"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/remove-permissions": {
-      "post": {
-        "description": "Permissions can be revoked from a specified role. Removing all permissions from a role will delete the role itself.",
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Checks the status of a specific backup creation process identified by its ID on the specified backend.\u003cbr/\u003e\u003cbr/\u003eClient libraries often provide a 'wait for completion' feature that polls this endpoint automatically. Use this endpoint for manual status checks or if 'wait for completion' is disabled.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Remove permissions from a role",
-        "operationId": "removePermissions",
+        "summary": "Get backup creation status",
+        "operationId": "backups.create.status",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the role being modified.",
+            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The unique identifier of the backup. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "permissions"
-              ],
-              "properties": {
-                "permissions": {
-                  "description": "Permissions to remove from the role.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions removed successfully."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully retrieved the status of the backup creation process.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateStatusResponse"
             }
           },
           "401": {
@@ -10673,58 +12534,66 @@ func init() {
             }
           },
           "404": {
-            "description": "No role found."
+            "description": "Backup not found on the specified backend with the given ID.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid request to check backup creation status.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while checking backup status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.remove.role.permissions"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}/user-assignments": {
-      "get": {
-        "description": "Fetch a list of users which have the specified role.",
+      },
+      "delete": {
+        "description": "Cancels an ongoing backup operation identified by its ID.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Get users assigned to a role",
-        "operationId": "getUsersForRole",
+        "summary": "Cancel a backup",
+        "operationId": "backups.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "The name (ID) of the role.",
+            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The unique identifier of the backup to cancel. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Users assigned to this role.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/GetUsersForRoleOKBodyItems0"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Backup canceled successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10735,53 +12604,65 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "No role found."
+          "422": {
+            "description": "Invalid backup cancellation request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred during backup cancellation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/users": {
+    "/backups/{backend}/{id}/restore": {
       "get": {
-        "description": "Get all the users (` + "`" + `db` + "`" + ` + ` + "`" + `oidc` + "`" + `) who have been assigned a specific role. Deprecated, will be removed when v1.29 is not supported anymore.",
+        "description": "Checks the status of a specific backup restoration process identified by the backup ID on the specified backend.\u003cbr/\u003e\u003cbr/\u003eClient libraries often provide a 'wait for completion' feature that polls this endpoint automatically. Use this endpoint for manual status checks or if 'wait for completion' is disabled.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Get users assigned to a role",
-        "operationId": "getUsersForRoleDeprecated",
-        "deprecated": true,
+        "summary": "Get backup restoration status",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the role.",
+            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The unique identifier of the backup being restored. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the path within the bucket.",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Users assigned to this role.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully retrieved the status of the backup restoration process.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -10794,65 +12675,59 @@ func init() {
             }
           },
           "404": {
-            "description": "No role found."
+            "description": "Backup not found on the specified backend with the given ID.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while checking restore status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/assign": {
+      },
       "post": {
-        "description": "Assign one or more roles to a user. Users can have multiple roles.",
+        "description": "Initiates the restoration of collections from a specified backup located on a designated backend.\u003cbr/\u003e\u003cbr/\u003eRequirements:\u003cbr/\u003e- Target cluster must have the same number of nodes as the source cluster where the backup was created.\u003cbr/\u003e- Collections included in the restore must not already exist on the target cluster.\u003cbr/\u003e- Node names must match between the backup and the target cluster.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Assign a role to a user",
-        "operationId": "assignRoleToUser",
+        "summary": "Restore from a backup",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the user.",
+            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The unique identifier of the backup to restore from. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
+            "description": "Details of the restore request, including collections to include or exclude and node mapping if necessary.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "roles": {
-                  "description": "The roles that are assigned to the specified user.",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "userType": {
-                  "$ref": "#/definitions/UserTypeInput"
-                }
-              }
+              "$ref": "#/definitions/BackupRestoreRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned successfully."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup restoration process initiated successfully. Check the status endpoint for progress.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -10865,69 +12740,66 @@ func init() {
             }
           },
           "404": {
-            "description": "Specified role or user not found.",
+            "description": "Backup not found on the specified backend with the given ID.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration request. Check requirements and request body.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred during restore initiation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.assign.role.user"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/revoke": {
-      "post": {
-        "description": "Remove one or more roles from a user.",
+      },
+      "delete": {
+        "description": "Cancels an ongoing backup restoration process identified by its ID on the specified backend storage.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRoleFromUser",
+        "summary": "Cancel a backup restoration",
+        "operationId": "backups.restore.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the user.",
+            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The unique identifier of the backup restoration to cancel. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "roles": {
-                  "description": "The roles to revoke from the specified user.",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "userType": {
-                  "$ref": "#/definitions/UserTypeInput"
-                }
-              }
-            }
+            "type": "string",
+            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Roles revoked successfully."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Backup restoration cancelled successfully."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10938,47 +12810,82 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Specified role or user not found.",
+          "422": {
+            "description": "Invalid backup restoration cancellation request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred during backup restoration cancellation. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role.user"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/users/{id}/roles": {
-      "get": {
-        "description": "Retrieve the roles assigned to a specific user (` + "`" + `db` + "`" + ` + ` + "`" + `oidc` + "`" + `). Deprecated, will be removed when 1.29 is not supported anymore",
+    "/batch/objects": {
+      "post": {
+        "description": "Registers multiple data objects in a single request for efficiency. Metadata and schema values for each object are validated.\u003cbr/\u003e\u003cbr/\u003e**Note (idempotence)**:\u003cbr/\u003eThis operation is idempotent based on the object UUIDs provided. If an object with a given UUID already exists, it will be overwritten (similar to a PUT operation for that specific object within the batch).",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "Get roles assigned to a user",
-        "operationId": "getRolesForUserDeprecated",
-        "deprecated": true,
+        "summary": "Create objects in batch",
+        "operationId": "batch.objects.create",
         "parameters": [
+          {
+            "description": "The request body containing the objects to be created.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Controls which fields are returned in the response for each object. Default is ` + "`" + `ALL` + "`" + `.",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "description": "Array of objects to be created.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
           {
             "type": "string",
-            "description": "The name of the user.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Roles assigned to the user.",
+            "description": "Request processed successfully. Individual object statuses are provided in the response body.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
             }
           },
           "400": {
@@ -10996,67 +12903,61 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "No roles found for specified user."
-          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.add"
         ]
-      }
-    },
-    "/authz/users/{id}/roles/{userType}": {
-      "get": {
-        "description": "Get all the roles for a specific user (` + "`" + `db` + "`" + ` or ` + "`" + `oidc` + "`" + `).",
+      },
+      "delete": {
+        "description": "Removes multiple data objects based on a filter specified in the request body.\u003cbr/\u003e\u003cbr/\u003eDeletion occurs based on the filter criteria provided in the ` + "`" + `where` + "`" + ` clause. There is a configurable limit (default 10,000, set via ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `) on how many objects can be deleted in a single batch request to prevent excessive resource usage. Objects are deleted in the order they match the filter. To delete more objects than the limit allows, repeat the request until no more matching objects are found.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "Get roles assigned to a user",
-        "operationId": "getRolesForUser",
+        "summary": "Delete objects in batch",
+        "operationId": "batch.objects.delete",
         "parameters": [
           {
-            "type": "string",
-            "description": "The name of the user.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The request body containing the match filter and output configuration.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
           },
           {
-            "enum": [
-              "oidc",
-              "db"
-            ],
             "type": "string",
-            "description": "The type of the user.",
-            "name": "userType",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
+            "name": "consistency_level",
+            "in": "query"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to include detailed role information like its assigned permissions.",
-            "name": "includeFullRoles",
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant collection (class).",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Roles assigned to the user.",
+            "description": "Request processed successfully. See response body for matching objects and deletion results.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "400": {
@@ -11074,60 +12975,69 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "No roles found for specified user."
-          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request.",
+            "description": "Invalid data provided. Please check the values in your request (e.g., invalid filter).",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/backups/{backend}": {
-      "get": {
-        "description": "List all created backups IDs, Status",
+    "/batch/references": {
+      "post": {
+        "description": "Batch create cross-references between collection items in bulk.",
         "tags": [
-          "backups"
+          "batch",
+          "references"
         ],
-        "summary": "List all created backups",
-        "operationId": "backups.list",
+        "summary": "Create cross-references in bulk",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Specifies the backend storage system to list backups from (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
-            "enum": [
-              "asc",
-              "desc"
-            ],
             "type": "string",
-            "default": "desc",
-            "description": "Order of returned list of backups based on creation time. (asc or desc)",
-            "name": "order",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
+            "name": "consistency_level",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the list of backups in progress.",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -11140,52 +13050,55 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid request to list backups.",
+            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred while listing backups. Check the ErrorResponse for details.",
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.add"
         ]
-      },
+      }
+    },
+    "/classifications/": {
       "post": {
-        "description": "Initiates the creation of a backup for specified collections on a designated backend storage.\u003cbr/\u003e\u003cbr/\u003eNotes:\u003cbr/\u003e- Backups are compressed using gzip by default.\u003cbr/\u003e- Weaviate remains operational during the backup process.",
+        "description": "Initiates a background classification task based on the provided parameters. Use the GET /classifications/{id} endpoint to monitor the status and retrieve results.",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "Create a backup",
-        "operationId": "backups.create",
+        "summary": "Start a classification",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Specifies the backend storage system where the backup will be stored (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Details of the backup request, including the backup ID and collections to include or exclude.",
-            "name": "body",
+            "description": "Configuration parameters for the classification task, including type, target properties, and training data references.",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/Classification"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup creation process initiated successfully. Check the status endpoint for progress.",
+          "201": {
+            "description": "Classification task successfully initiated. The response body contains the classification details including its ID.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Invalid request body or parameters.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -11197,65 +13110,40 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup creation request. Check the request body and backend configuration.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
-            "description": "An internal server error occurred during backup initiation. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while starting the classification task. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
+    "/classifications/{id}": {
       "get": {
-        "description": "Checks the status of a specific backup creation process identified by its ID on the specified backend.\u003cbr/\u003e\u003cbr/\u003eClient libraries often provide a 'wait for completion' feature that polls this endpoint automatically. Use this endpoint for manual status checks or if 'wait for completion' is disabled.",
+        "description": "Retrieves the status, metadata, and results (if completed) of a classification task identified by its unique ID.",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "Get backup creation status",
-        "operationId": "backups.create.status",
+        "summary": "Get classification status",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The unique identifier of the backup. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
+            "description": "The unique identifier (UUID) of the classification task.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
-            "name": "path",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the status of the backup creation process.",
+            "description": "Successfully retrieved the classification details.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -11268,66 +13156,35 @@ func init() {
             }
           },
           "404": {
-            "description": "Backup not found on the specified backend with the given ID.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid request to check backup creation status.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Classification with the given ID not found."
           },
           "500": {
-            "description": "An internal server error occurred while checking backup status. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the classification status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.get"
         ]
-      },
-      "delete": {
-        "description": "Cancels an ongoing backup operation identified by its ID.",
-        "tags": [
-          "backups"
-        ],
-        "summary": "Cancel a backup",
-        "operationId": "backups.cancel",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The unique identifier of the backup to cancel. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
-            "name": "path",
-            "in": "query"
-          }
+      }
+    },
+    "/cluster/cross-cluster-replication": {
+      "get": {
+        "description": "Returns the role of this cluster in cross-cluster replication and, for a follower cluster, how far it lags behind its primary cluster.",
+        "tags": [
+          "cluster"
         ],
+        "summary": "Get the cross-cluster replication status",
+        "operationId": "cluster.get.cross.cluster.replication",
+        "parameters": [],
         "responses": {
-          "204": {
-            "description": "Backup canceled successfully."
+          "200": {
+            "description": "Successfully retrieved the cross-cluster replication status.",
+            "schema": {
+              "$ref": "#/definitions/CrossClusterReplicationStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -11338,65 +13195,32 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup cancellation request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
-            "description": "An internal server error occurred during backup cancellation. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.crossClusterReplication.get"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Checks the status of a specific backup restoration process identified by the backup ID on the specified backend.\u003cbr/\u003e\u003cbr/\u003eClient libraries often provide a 'wait for completion' feature that polls this endpoint automatically. Use this endpoint for manual status checks or if 'wait for completion' is disabled.",
+    "/cluster/cross-cluster-replication/promote": {
+      "post": {
+        "description": "Stops following the primary cluster for good. Schema changes and objects are no longer pulled from the primary cluster and the cluster can be used on its own, e.g. after the primary cluster failed. Promoting cannot be undone.",
         "tags": [
-          "backups"
-        ],
-        "summary": "Get backup restoration status",
-        "operationId": "backups.restore.status",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The unique identifier of the backup being restored. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the path within the bucket.",
-            "name": "path",
-            "in": "query"
-          }
+          "cluster"
         ],
+        "summary": "Promote a follower cluster",
+        "operationId": "cluster.promote.cross.cluster.replication",
+        "parameters": [],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the status of the backup restoration process.",
+            "description": "The cluster has been promoted.",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/CrossClusterReplicationStatus"
             }
           },
           "401": {
@@ -11408,61 +13232,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Backup not found on the specified backend with the given ID.",
+          "422": {
+            "description": "The cluster is not a follower cluster or has already been promoted.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred while checking restore status. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while promoting the cluster. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.crossClusterReplication.promote"
         ]
-      },
-      "post": {
-        "description": "Initiates the restoration of collections from a specified backup located on a designated backend.\u003cbr/\u003e\u003cbr/\u003eRequirements:\u003cbr/\u003e- Target cluster must have the same number of nodes as the source cluster where the backup was created.\u003cbr/\u003e- Collections included in the restore must not already exist on the target cluster.\u003cbr/\u003e- Node names must match between the backup and the target cluster.",
+      }
+    },
+    "/cluster/nodes/{nodeName}/drain": {
+      "delete": {
+        "description": "Stops draining the node, which accepts new shards and tenants again. Replicas which have already been moved stay on their new nodes and movements in progress are not cancelled. For a node which has already been removed, this forgets its drain status.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Restore from a backup",
-        "operationId": "backups.restore",
+        "summary": "Cancel the drain of a node",
+        "operationId": "cluster.cancel.node.drain",
         "parameters": [
           {
             "type": "string",
-            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The unique identifier of the backup to restore from. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
-            "name": "id",
+            "description": "The name of the drained node.",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Details of the restore request, including collections to include or exclude and node mapping if necessary.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup restoration process initiated successfully. Check the status endpoint for progress.",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
-            }
+          "204": {
+            "description": "Successfully cancelled the drain."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -11474,66 +13281,44 @@ func init() {
             }
           },
           "404": {
-            "description": "Backup not found on the specified backend with the given ID.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration request. Check requirements and request body.",
+            "description": "The node is not being drained.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred during restore initiation. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while cancelling the drain. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.nodes.drain.cancel"
         ]
       },
-      "delete": {
-        "description": "Cancels an ongoing backup restoration process identified by its ID on the specified backend storage.",
+      "get": {
+        "description": "Returns the state of the drain of the node, the number of shard replicas it still holds and the number of replica movements in progress.",
         "tags": [
-          "backups"
+          "cluster"
         ],
-        "summary": "Cancel a backup restoration",
-        "operationId": "backups.restore.cancel",
+        "summary": "Get the drain status of a node",
+        "operationId": "cluster.get.node.drain",
         "parameters": [
           {
             "type": "string",
-            "description": "Specifies the backend storage system where the backup resides (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The unique identifier of the backup restoration to cancel. Must be URL-safe and compatible with filesystem paths (only lowercase, numbers, underscore, minus characters allowed).",
-            "name": "id",
+            "description": "The name of the drained node.",
+            "name": "nodeName",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the bucket, container, or volume name if required by the backend.",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Optional: Specifies the path within the bucket/container/volume if the backup is not at the root.",
-            "name": "path",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Backup restoration cancelled successfully."
+          "200": {
+            "description": "Successfully retrieved the drain status.",
+            "schema": {
+              "$ref": "#/definitions/NodeDrainStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -11544,88 +13329,44 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup restoration cancellation request.",
+          "404": {
+            "description": "The node is not being drained.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An internal server error occurred during backup restoration cancellation. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the drain status. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.nodes.drain.get"
         ]
-      }
-    },
-    "/batch/objects": {
+      },
       "post": {
-        "description": "Registers multiple data objects in a single request for efficiency. Metadata and schema values for each object are validated.\u003cbr/\u003e\u003cbr/\u003e**Note (idempotence)**:\u003cbr/\u003eThis operation is idempotent based on the object UUIDs provided. If an object with a given UUID already exists, it will be overwritten (similar to a PUT operation for that specific object within the batch).",
+        "description": "Marks the node as draining so that no new shards or tenants are placed on it, moves all of its shard replicas to the other nodes using replication ` + "`" + `MOVE` + "`" + ` operations and finally removes it from the Raft cluster. Requires replica movement to be enabled. The progress can be followed with the corresponding ` + "`" + `GET` + "`" + ` request.",
         "tags": [
-          "batch",
-          "objects"
+          "cluster"
         ],
-        "summary": "Create objects in batch",
-        "operationId": "batch.objects.create",
+        "summary": "Drain a node",
+        "operationId": "cluster.drain.node",
         "parameters": [
-          {
-            "description": "The request body containing the objects to be created.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Controls which fields are returned in the response for each object. Default is ` + "`" + `ALL` + "`" + `.",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "description": "Array of objects to be created.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
-            "name": "consistency_level",
-            "in": "query"
+            "description": "The name of the node to drain.",
+            "name": "nodeName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request processed successfully. Individual object statuses are provided in the response body.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The node is being drained.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodeDrainStatus"
             }
           },
           "401": {
@@ -11637,67 +13378,50 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The node is not a storage node of the cluster.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
+            "description": "The node cannot be drained, e.g. because the remaining nodes cannot hold the configured replication factor.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while starting the drain. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "501": {
+            "description": "Replica movement is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.cluster.nodes.drain"
         ]
-      },
-      "delete": {
-        "description": "Removes multiple data objects based on a filter specified in the request body.\u003cbr/\u003e\u003cbr/\u003eDeletion occurs based on the filter criteria provided in the ` + "`" + `where` + "`" + ` clause. There is a configurable limit (default 10,000, set via ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `) on how many objects can be deleted in a single batch request to prevent excessive resource usage. Objects are deleted in the order they match the filter. To delete more objects than the limit allows, repeat the request until no more matching objects are found.",
+      }
+    },
+    "/cluster/rebalancer/pause": {
+      "post": {
+        "description": "Stops the rebalancer from scheduling replica moves until it is resumed. Moves which have already been scheduled are not cancelled. The pause is stored in Raft, so it applies to the whole cluster, including a newly elected leader.",
         "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Delete objects in batch",
-        "operationId": "batch.objects.delete",
-        "parameters": [
-          {
-            "description": "The request body containing the match filter and output configuration.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant collection (class).",
-            "name": "tenant",
-            "in": "query"
-          }
+          "cluster"
         ],
+        "summary": "Pause the rebalancer",
+        "operationId": "cluster.pause.rebalancer",
+        "parameters": [],
         "responses": {
           "200": {
-            "description": "Request processed successfully. See response body for matching objects and deletion results.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The rebalancer has been paused.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/RebalancerStatus"
             }
           },
           "401": {
@@ -11709,69 +13433,41 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid data provided. Please check the values in your request (e.g., invalid filter).",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
-            "description": "An error occurred while trying to fulfill the request. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while pausing the rebalancer. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.cluster.rebalancer.pause"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Batch create cross-references between collection items in bulk.",
+    "/cluster/rebalancer/plan": {
+      "get": {
+        "description": "Computes the replica moves which would balance the load of the storage nodes, without scheduling them. Without ` + "`" + `limit` + "`" + `, the plan only contains the moves the next rebalancing run would schedule within the maximum of concurrent moves. Moves are only scheduled by the leader, and not while the rebalancer is paused.",
         "tags": [
-          "batch",
-          "references"
+          "cluster"
         ],
-        "summary": "Create cross-references in bulk",
-        "operationId": "batch.references.create",
+        "summary": "Get the rebalancing plan",
+        "operationId": "cluster.get.rebalancer.plan",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful.",
-            "name": "consistency_level",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of planned moves. Defaults to the number of moves which may still be started without exceeding the maximum of concurrent moves.",
+            "name": "limit",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully computed the rebalancing plan.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/RebalancerPlan"
             }
           },
           "401": {
@@ -11783,56 +13479,91 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "The request syntax is correct, but the server couldn't process it due to semantic issues. Please check the values in your request. Ensure the collection exists and the object properties are valid.",
+          "500": {
+            "description": "An internal server error occurred while computing the plan, e.g. because a storage node is not healthy. Check the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.rebalancer.plan"
+        ]
+      }
+    },
+    "/cluster/rebalancer/resume": {
+      "post": {
+        "description": "Lets the rebalancer schedule replica moves again after it has been paused. The rebalancer stays paused while ` + "`" + `REBALANCER_PAUSED` + "`" + ` or the ` + "`" + `rebalancer_paused` + "`" + ` runtime override is set.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Resume the rebalancer",
+        "operationId": "cluster.resume.rebalancer",
+        "parameters": [],
+        "responses": {
+          "200": {
+            "description": "The rebalancer has been resumed.",
+            "schema": {
+              "$ref": "#/definitions/RebalancerStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "An internal server error occurred while resuming the rebalancer. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.cluster.rebalancer.resume"
         ]
       }
     },
-    "/classifications/": {
-      "post": {
-        "description": "Initiates a background classification task based on the provided parameters. Use the GET /classifications/{id} endpoint to monitor the status and retrieve results.",
+    "/cluster/replication-verify": {
+      "get": {
+        "description": "Compares the replicas of the shards of a collection by their hashtrees and reports the objects which differ between them, without repairing anything. Every node holding a replica reads its hashtree and the digests of the objects in differing leaves, so this may be expensive on large collections. Requires async replication to be enabled for the collection. Replicas are compared by the update time of their objects, as async replication does, so replicas whose objects differ in content but share an update time are reported as consistent.",
         "tags": [
-          "classifications"
+          "cluster"
         ],
-        "summary": "Start a classification",
-        "operationId": "classifications.post",
+        "summary": "Verify the replicas of a collection",
+        "operationId": "cluster.verify.replication",
         "parameters": [
           {
-            "description": "Configuration parameters for the classification task, including type, target properties, and training data references.",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "description": "The collection whose replicas are compared.",
+            "name": "collection",
+            "in": "query",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of differing objects reported per pair of replicas. Defaults to 100.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only compare the replicas of this shard or tenant. All shards with more than one replica are compared if absent.",
+            "name": "shard",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Classification task successfully initiated. The response body contains the classification details including its ID.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Invalid request body or parameters.",
+          "200": {
+            "description": "Successfully compared the replicas.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ReplicationVerificationReport"
             }
           },
           "401": {
@@ -11844,40 +13575,72 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "The collection or shard does not exist, or async replication is not enabled for the collection.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
-            "description": "An internal server error occurred while starting the classification task. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while comparing the replicas. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.cluster.replication.verify"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/cluster/schema-audit": {
       "get": {
-        "description": "Retrieves the status, metadata, and results (if completed) of a classification task identified by its unique ID.",
+        "description": "Returns the schema, alias and RBAC commands applied through raft, who issued them, when and what they changed. Entries are returned newest first, and retention is controlled with ` + "`" + `RAFT_AUDIT_LOG_MAX_ENTRIES` + "`" + ` and ` + "`" + `RAFT_AUDIT_LOG_MAX_AGE` + "`" + `.",
         "tags": [
-          "classifications"
+          "cluster"
         ],
-        "summary": "Get classification status",
-        "operationId": "classifications.get",
+        "summary": "Get the schema audit log",
+        "operationId": "cluster.get.schema.audit",
         "parameters": [
           {
             "type": "string",
-            "description": "The unique identifier (UUID) of the classification task.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Only return entries for this collection.",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return entries for this command type, e.g. ` + "`" + `add_class` + "`" + ` or ` + "`" + `delete_class` + "`" + `.",
+            "name": "command",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of entries to return. Zero or absent returns all matching entries.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return entries issued by this principal.",
+            "name": "principal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only return entries applied at or after this time, in milliseconds since the Unix epoch.",
+            "name": "sinceUnixMs",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved the classification details.",
+            "description": "Successfully retrieved the schema audit log.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/SchemaAuditLogResponse"
             }
           },
           "401": {
@@ -11889,18 +13652,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Classification with the given ID not found."
-          },
           "500": {
-            "description": "An internal server error occurred while retrieving the classification status. Check the ErrorResponse for details.",
+            "description": "An internal server error occurred while retrieving the schema audit log. Check the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.cluster.schemaAudit.get"
         ]
       }
     },
@@ -15815,6 +17575,10 @@ func init() {
           "description": "Name of the endpoint, e.g. s3.amazonaws.com.",
           "type": "string"
         },
+        "IncrementalBaseBackupID": {
+          "description": "ID of an earlier backup on the same backend, bucket and path. When set, immutable LSM segments which are unchanged since the base backup are referenced instead of being uploaded again.",
+          "type": "string"
+        },
         "Path": {
           "description": "Path or key within the bucket.",
           "type": "string"
@@ -16002,6 +17766,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of collections (classes).",
       "properties": {
+        "classMapping": {
+          "description": "Allows restoring collections (classes) under a different name. Keys are collection names in the backup and values are the names they are restored as. Aliases of a renamed collection are not restored unless moveAliases is set.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process.",
           "type": "object",
@@ -16021,6 +17792,10 @@ func init() {
             "type": "string"
           }
         },
+        "moveAliases": {
+          "description": "When collections are renamed with classMapping, restore the aliases of the backup pointing to the restored collections. This moves aliases away from existing collections of the original name. By default aliases of renamed collections are skipped.",
+          "type": "boolean"
+        },
         "node_mapping": {
           "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
           "type": "object",
@@ -16031,6 +17806,13 @@ func init() {
         "overwriteAlias": {
           "description": "Allows ovewriting the collection alias if there is a conflict",
           "type": "boolean"
+        },
+        "tenantMapping": {
+          "description": "Allows restoring tenants under a different name. Keys are tenant names in the backup and values are the names they are restored as. Only the listed tenants are restored and exactly one multi-tenant collection must be restored.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
@@ -16096,20 +17878,117 @@ func init() {
           "description": "Destination path of backup files valid for the selected backup backend, contains bucket and path.",
           "type": "string"
         },
-        "status": {
-          "description": "Phase of backup restoration process.",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "FINALIZING",
-            "SUCCESS",
-            "FAILED",
-            "CANCELLING",
-            "CANCELED"
-          ]
+        "status": {
+          "description": "Phase of backup restoration process.",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "FINALIZING",
+            "SUCCESS",
+            "FAILED",
+            "CANCELLING",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule creating backups periodically and deleting the backups it created according to its retention rules.",
+      "type": "object",
+      "properties": {
+        "backend": {
+          "description": "The backend storage system to store the backups on (e.g., ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `).",
+          "type": "string"
+        },
+        "backups": {
+          "description": "The IDs of the backups created by the schedule which have not been deleted by its retention rules. Ignored in requests.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "config": {
+          "description": "Custom configuration of the backups. ` + "`" + `IncrementalBaseBackupID` + "`" + ` is not supported for schedules.",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "createdAtUnixMs": {
+          "description": "The time the schedule was created, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "cron": {
+          "description": "A standard cron expression (minute, hour, day of month, month, day of week) or a descriptor such as ` + "`" + `@daily` + "`" + `. It is evaluated in UTC unless prefixed with a time zone, e.g. ` + "`" + `CRON_TZ=Europe/Berlin 0 3 * * *` + "`" + `.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule, taken from the path. The IDs of the backups created by the schedule consist of the schedule ID followed by the UTC time of the run, e.g. ` + "`" + `nightly-20260102030000` + "`" + `.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of collections to include in the backups. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastBackupId": {
+          "description": "The ID of the backup triggered by the last run. Empty if the last run failed to start a backup. Ignored in requests.",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "The error which prevented the last run from starting a backup, if any. Ignored in requests.",
+          "type": "string"
+        },
+        "lastRunAtUnixMs": {
+          "description": "The time the schedule last triggered a backup, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nextRunAtUnixMs": {
+          "description": "The time the schedule triggers the next backup, in milliseconds since the Unix epoch. Ignored in requests.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        }
+      }
+    },
+    "BackupScheduleListResponse": {
+      "description": "The list of backup schedules.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/BackupSchedule"
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Rules deciding which backups of a schedule are kept. A backup is kept if any rule selects it, only successful backups are selected. Failed and canceled backups are deleted. Backups are never deleted if no rule is set.",
+      "type": "object",
+      "properties": {
+        "keepDaily": {
+          "description": "Keep the most recent backup of each of the last N days having one.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Keep the N most recent backups.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepWeekly": {
+          "description": "Keep the most recent backup of each of the last N weeks having one.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -16793,6 +18672,64 @@ func init() {
         }
       }
     },
+    "CrossClusterReplicationStatus": {
+      "description": "The role of a cluster in cross-cluster replication and, for a follower cluster, its lag behind the primary cluster.",
+      "type": "object",
+      "properties": {
+        "appliedIndex": {
+          "description": "The Raft log index of the primary cluster up to which its schema changes have been applied.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastObjectsInSyncUnixMs": {
+          "description": "The last time all shards of the node serving the request were found in sync with the primary cluster, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastSchemaSyncUnixMs": {
+          "description": "The last time the schema was synced with the primary cluster, in milliseconds since the Unix epoch. Only known to the leader of the cluster.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsLagSeconds": {
+          "description": "The number of seconds since all shards of the node serving the request were last found in sync with the primary cluster.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "primaryHost": {
+          "description": "The address of the primary cluster which is followed.",
+          "type": "string"
+        },
+        "primaryIndex": {
+          "description": "The last Raft log index applied by the primary cluster, as last seen by the node serving the request.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "promotedAtUnixMs": {
+          "description": "The time the cluster was promoted, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "role": {
+          "description": "The role of the cluster. A ` + "`" + `PRIMARY` + "`" + ` cluster does not follow another cluster. A ` + "`" + `FOLLOWER` + "`" + ` cluster pulls schema changes and objects from its primary cluster. A ` + "`" + `PROMOTED` + "`" + ` cluster followed a primary cluster until it was promoted.",
+          "type": "string",
+          "enum": [
+            "PRIMARY",
+            "FOLLOWER",
+            "PROMOTED"
+          ]
+        },
+        "schemaLag": {
+          "description": "The number of Raft log entries of the primary cluster which have not been applied yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "DBUserInfo": {
       "type": "object",
       "required": [
@@ -17286,6 +19223,45 @@ func init() {
         }
       }
     },
+    "NodeDrainStatus": {
+      "description": "The progress of draining a node before it is removed from the cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The last error which prevented the drain from progressing, if any.",
+          "type": "string"
+        },
+        "movesInFlight": {
+          "description": "The number of replica movements away from the node which are in progress.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "node": {
+          "description": "The name of the drained node.",
+          "type": "string"
+        },
+        "replicasRemaining": {
+          "description": "The number of shard replicas which are still hosted by the node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "startTimeUnixMs": {
+          "description": "The time the drain was started, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "The state of the drain. A ` + "`" + `DRAINING` + "`" + ` node does not get any new shards or tenants while its replicas are moved to other nodes. A ` + "`" + `REMOVED` + "`" + ` node holds no replicas anymore and has been removed from the cluster.",
+          "type": "string",
+          "enum": [
+            "DRAINING",
+            "REMOVED"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -17529,6 +19505,14 @@ func init() {
               "description": "Results for this specific object.",
               "format": "object",
               "properties": {
+                "acknowledgedReplicas": {
+                  "description": "Nodes which acknowledged the write of this object. Only set if the request carries a write concern, see the ` + "`" + `X-Weaviate-Write-Acks` + "`" + ` header.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                },
                 "errors": {
                   "$ref": "#/definitions/ErrorResponse"
                 },
@@ -18239,6 +20223,123 @@ func init() {
         }
       }
     },
+    "RebalancerMove": {
+      "description": "A shard replica which is moved from an overloaded to an underloaded storage node.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The collection of the shard.",
+          "type": "string"
+        },
+        "load": {
+          "description": "The load moved along with the replica.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node the replica is moved away from.",
+          "type": "string"
+        },
+        "targetNode": {
+          "description": "The node the replica is moved to.",
+          "type": "string"
+        }
+      }
+    },
+    "RebalancerNodeLoad": {
+      "description": "The load of a storage node as seen by the rebalancer.",
+      "type": "object",
+      "properties": {
+        "diskUsed": {
+          "description": "The disk space used on the node, in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "load": {
+          "description": "The load of the node according to the configured metric.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "node": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "The number of objects held by the node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "RebalancerPlan": {
+      "description": "The replica moves which would balance the load of the storage nodes.",
+      "type": "object",
+      "properties": {
+        "imbalance": {
+          "description": "The largest deviation of the load of a node from the mean load, relative to the mean load.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "imbalanceAfter": {
+          "description": "The imbalance once all planned moves are done.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "inFlight": {
+          "description": "The number of replication operations which are in progress.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "metric": {
+          "description": "The metric the load of the nodes is measured by, ` + "`" + `objects` + "`" + ` or ` + "`" + `disk` + "`" + `.",
+          "type": "string"
+        },
+        "moves": {
+          "description": "The planned moves.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebalancerMove"
+          }
+        },
+        "nodes": {
+          "description": "The load of each storage node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebalancerNodeLoad"
+          }
+        },
+        "paused": {
+          "description": "Whether the rebalancer is paused and does not schedule any moves.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "reason": {
+          "description": "Why no moves are planned, if so.",
+          "type": "string"
+        }
+      }
+    },
+    "RebalancerStatus": {
+      "description": "Whether the rebalancer schedules replica moves.",
+      "type": "object",
+      "properties": {
+        "paused": {
+          "description": "Whether the rebalancer is paused and does not schedule any moves.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -18815,6 +20916,89 @@ func init() {
         }
       }
     },
+    "ReplicationVerificationDifference": {
+      "description": "An object whose state differs between two replicas.",
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The id of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "updateTimes": {
+          "description": "The update time of the object on each replica, in the order of the nodes of the pair, 0 if it does not exist.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        }
+      }
+    },
+    "ReplicationVerificationPair": {
+      "description": "The outcome of comparing two replicas of a shard.",
+      "type": "object",
+      "properties": {
+        "consistent": {
+          "description": "Whether the hashtrees of both replicas match.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "differences": {
+          "description": "The objects whose update times differ between the replicas, up to the requested limit.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationVerificationDifference"
+          }
+        },
+        "differingLeaves": {
+          "description": "The number of hashtree leaves whose digests differ.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "The error which stopped the comparison of this pair, if any.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "The names of the two nodes holding the replicas.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "shard": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "truncated": {
+          "description": "Whether there are more differing objects than reported.",
+          "type": "boolean"
+        }
+      }
+    },
+    "ReplicationVerificationReport": {
+      "description": "The outcome of comparing the replicas of the shards of a collection with each other.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The name of the collection.",
+          "type": "string"
+        },
+        "consistent": {
+          "description": "Whether all compared replicas are consistent with each other.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "pairs": {
+          "description": "One report per shard and pair of its replicas.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationVerificationPair"
+          }
+        }
+      }
+    },
     "RestoreConfig": {
       "description": "Backup custom configuration",
       "type": "object",
@@ -18909,6 +21093,70 @@ func init() {
         }
       }
     },
+    "SchemaAuditChange": {
+      "description": "A single field changed by a schema command.",
+      "type": "object",
+      "properties": {
+        "after": {
+          "description": "The value after the command was applied, absent if the field was removed."
+        },
+        "before": {
+          "description": "The value before the command was applied, absent if the field was added."
+        },
+        "path": {
+          "description": "The path of the changed field, e.g. ` + "`" + `properties[title].indexFilterable` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaAuditEntry": {
+      "description": "A schema, alias or RBAC command applied through raft.",
+      "type": "object",
+      "properties": {
+        "changes": {
+          "description": "The fields changed by the command.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaAuditChange"
+          }
+        },
+        "class": {
+          "description": "The name of the class the command applied to, if any.",
+          "type": "string"
+        },
+        "command": {
+          "description": "The type of the command, e.g. ` + "`" + `add_class` + "`" + ` or ` + "`" + `delete_class` + "`" + `.",
+          "type": "string"
+        },
+        "index": {
+          "description": "The raft log index of the command.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "principal": {
+          "description": "The principal which issued the command.",
+          "type": "string"
+        },
+        "timeUnixMs": {
+          "description": "The time the command was applied, in milliseconds since the Unix epoch.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaAuditLogResponse": {
+      "description": "The schema audit log entries matching the query, newest first.",
+      "type": "object",
+      "properties": {
+        "entries": {
+          "description": "The matching audit log entries.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaAuditEntry"
+          }
+        }
+      }
+    },
     "SchemaClusterStatus": {
       "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
@@ -46,7 +46,7 @@ func compressionFromBCfg(cfg *models.BackupConfig) ubak.Compression {
 
 		return ubak.Compression{
 			CPUPercentage: int(cfg.CPUPercentage),
-			Level:         parseCompressionLevel(cfg.CompressionLevel),
+			Level:         ubak.ParseCompressionLevel(cfg.CompressionLevel),
 		}
 	}
 
@@ -74,42 +74,26 @@ func compressionFromRCfg(cfg *models.RestoreConfig) ubak.Compression {
 	}
 }
 
-func parseCompressionLevel(l string) ubak.CompressionLevel {
-	switch l {
-	case models.BackupConfigCompressionLevelBestSpeed:
-		return ubak.GzipBestSpeed
-	case models.BackupConfigCompressionLevelBestCompression:
-		return ubak.GzipBestCompression
-	case models.BackupConfigCompressionLevelZstdBestSpeed:
-		return ubak.ZstdBestSpeed
-	case models.BackupConfigCompressionLevelZstdDefaultCompression:
-		return ubak.ZstdDefaultCompression
-	case models.BackupConfigCompressionLevelZstdBestCompression:
-		return ubak.ZstdBestCompression
-	case models.BackupConfigCompressionLevelNoCompression:
-		return ubak.NoCompression
-	default:
-		return ubak.GzipDefaultCompression
-	}
-}
-
 func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	overrideBucket := ""
 	overridePath := ""
+	baseBackupID := ""
 	if params.Body.Config != nil {
 		overrideBucket = params.Body.Config.Bucket
 		overridePath = params.Body.Config.Path
+		baseBackupID = params.Body.Config.IncrementalBaseBackupID
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.Body.ID,
-		Backend:     params.Backend,
-		Bucket:      overrideBucket,
-		Path:        overridePath,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		Compression: compressionFromBCfg(params.Body.Config),
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Bucket:       overrideBucket,
+		Path:         overridePath,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		Compression:  compressionFromBCfg(params.Body.Config),
+		BaseBackupID: baseBackupID,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -198,6 +182,9 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		Include:           params.Body.Include,
 		Exclude:           params.Body.Exclude,
 		NodeMapping:       params.Body.NodeMapping,
+		ClassMapping:      params.Body.ClassMapping,
+		TenantMapping:     params.Body.TenantMapping,
+		MoveAliases:       params.Body.MoveAliases,
 		Compression:       compressionFromRCfg(params.Body.Config),
 		Bucket:            bucket,
 		Path:              path,
@@ -375,6 +362,9 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 	api.BackupsBackupsCancelHandler = backups.BackupsCancelHandlerFunc(h.cancel)
 	api.BackupsBackupsRestoreCancelHandler = backups.BackupsRestoreCancelHandlerFunc(h.cancelRestore)
 	api.BackupsBackupsListHandler = backups.BackupsListHandlerFunc(h.list)
+	api.BackupsBackupsSchedulesPutHandler = backups.BackupsSchedulesPutHandlerFunc(h.putSchedule)
+	api.BackupsBackupsSchedulesListHandler = backups.BackupsSchedulesListHandlerFunc(h.listSchedules)
+	api.BackupsBackupsSchedulesDeleteHandler = backups.BackupsSchedulesDeleteHandlerFunc(h.deleteSchedule)
 }
 
 type backupRequestsTotal struct {
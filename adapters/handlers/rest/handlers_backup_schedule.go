@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func (s *backupHandlers) putSchedule(params backups.BackupsSchedulesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	sched := scheduleFromModel(params.ID, params.Body)
+	if cfg := params.Body.Config; cfg != nil && cfg.IncrementalBaseBackupID != "" {
+		err := errors.New("incremental backups are not supported for schedules")
+		s.metricRequestsTotal.logUserError("")
+		return backups.NewBackupsSchedulesPutUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	if err := s.manager.PutSchedule(ctx, principal, sched); err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return backups.NewBackupsSchedulesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &backup.ErrUnprocessable{}):
+			return backups.NewBackupsSchedulesPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	// return the stored schedule, an update keeps the run state of the schedule
+	schedules, err := s.manager.Schedules(ctx, principal)
+	if err == nil {
+		for i := range schedules {
+			if schedules[i].ID == sched.ID {
+				sched = schedules[i]
+			}
+		}
+	}
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesPutOK().WithPayload(scheduleToModel(&sched))
+}
+
+func (s *backupHandlers) listSchedules(params backups.BackupsSchedulesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedules, err := s.manager.Schedules(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return backups.NewBackupsSchedulesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make(models.BackupScheduleListResponse, len(schedules))
+	for i := range schedules {
+		payload[i] = scheduleToModel(&schedules[i])
+	}
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesListOK().WithPayload(payload)
+}
+
+func (s *backupHandlers) deleteSchedule(params backups.BackupsSchedulesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := s.manager.DeleteSchedule(params.HTTPRequest.Context(), principal, params.ID); err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return backups.NewBackupsSchedulesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &backup.ErrNotFound{}):
+			return backups.NewBackupsSchedulesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesDeleteNoContent()
+}
+
+func scheduleFromModel(id string, m *models.BackupSchedule) backup.Schedule {
+	sched := backup.Schedule{
+		ID:      id,
+		Cron:    m.Cron,
+		Backend: m.Backend,
+		Include: m.Include,
+		Exclude: m.Exclude,
+	}
+	if cfg := m.Config; cfg != nil {
+		sched.Bucket = cfg.Bucket
+		sched.Path = cfg.Path
+		sched.CompressionLevel = cfg.CompressionLevel
+		sched.CPUPercentage = int(cfg.CPUPercentage)
+	}
+	if r := m.Retention; r != nil {
+		sched.Retention = backup.Retention{
+			KeepLast:   int(r.KeepLast),
+			KeepDaily:  int(r.KeepDaily),
+			KeepWeekly: int(r.KeepWeekly),
+		}
+	}
+	return sched
+}
+
+func scheduleToModel(sched *backup.Schedule) *models.BackupSchedule {
+	m := &models.BackupSchedule{
+		ID:      sched.ID,
+		Cron:    sched.Cron,
+		Backend: sched.Backend,
+		Include: sched.Include,
+		Exclude: sched.Exclude,
+		Config: &models.BackupConfig{
+			Bucket:           sched.Bucket,
+			Path:             sched.Path,
+			CompressionLevel: sched.CompressionLevel,
+			CPUPercentage:    int64(sched.CPUPercentage),
+		},
+		Retention: &models.BackupScheduleRetention{
+			KeepLast:   int64(sched.Retention.KeepLast),
+			KeepDaily:  int64(sched.Retention.KeepDaily),
+			KeepWeekly: int64(sched.Retention.KeepWeekly),
+		},
+		CreatedAtUnixMs: unixMilli(sched.CreatedAt),
+		LastRunAtUnixMs: unixMilli(sched.LastRunAt),
+		LastBackupID:    sched.LastBackupID,
+		LastError:       sched.LastError,
+		Backups:         sched.Backups,
+	}
+	if next, err := sched.Next(); err == nil {
+		m.NextRunAtUnixMs = next.UnixMilli()
+	}
+	return m
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -26,6 +26,7 @@ import (
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 type batchObjectHandlers struct {
@@ -66,10 +67,12 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 
 	h.metricRequestsTotal.logOk("")
 	return batch.NewBatchObjectsCreateOK().
-		WithPayload(h.objectsResponse(objs))
+		WithPayload(h.objectsResponse(objs, replica.WriteConcernFromContext(ctx)))
 }
 
-func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
+// objectsResponse converts the batch results. If the request carries a write
+// concern, each result lists the replicas which acknowledged the object.
+func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects, wc *replica.WriteConcern) []*models.ObjectsGetResponse {
 	response := make([]*models.ObjectsGetResponse, len(input))
 	for i, object := range input {
 		var errorResponse *models.ErrorResponse
@@ -83,8 +86,9 @@ func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*mod
 		response[i] = &models.ObjectsGetResponse{
 			Object: *object.Object,
 			Result: &models.ObjectsGetResponseAO2Result{
-				Errors: errorResponse,
-				Status: &status,
+				AcknowledgedReplicas: wc.ObjectAcknowledgements(object.Object.Class, object.Object.Tenant, object.UUID),
+				Errors:               errorResponse,
+				Status:               &status,
 			},
 		}
 	}
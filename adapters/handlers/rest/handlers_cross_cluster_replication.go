@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/crosscluster"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type crossClusterState interface {
+	GetCrossCluster(ctx context.Context) (api.ReplicationCrossClusterResponse, error)
+}
+
+type crossClusterHandlers struct {
+	// follower is nil unless this cluster follows a primary cluster
+	follower    *crosscluster.Follower
+	state       crossClusterState
+	primaryHost string
+	authorizer  authorization.Authorizer
+	logger      logrus.FieldLogger
+}
+
+func (h *crossClusterHandlers) getStatus(params cluster.ClusterGetCrossClusterReplicationParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Cluster()); err != nil {
+		return cluster.NewClusterGetCrossClusterReplicationForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	status, err := h.status(ctx)
+	if err != nil {
+		return cluster.NewClusterGetCrossClusterReplicationInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterGetCrossClusterReplicationOK().WithPayload(status)
+}
+
+// promote stops following the primary cluster. Since this ends the
+// replication of all collections, it requires the permission to update any
+// replication.
+func (h *crossClusterHandlers) promote(params cluster.ClusterPromoteCrossClusterReplicationParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.Replications("*", "*")); err != nil {
+		return cluster.NewClusterPromoteCrossClusterReplicationForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if h.follower == nil {
+		return cluster.NewClusterPromoteCrossClusterReplicationUnprocessableEntity().WithPayload(errPayloadFromSingleErr(
+			errors.New("the cluster does not follow a primary cluster")))
+	}
+	if err := h.follower.Promote(ctx); err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return cluster.NewClusterPromoteCrossClusterReplicationUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterPromoteCrossClusterReplicationInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	h.logger.WithFields(logrus.Fields{
+		"action":  "cross_cluster_replication",
+		"primary": h.primaryHost,
+	}).Info("cluster promoted")
+
+	status, err := h.status(ctx)
+	if err != nil {
+		return cluster.NewClusterPromoteCrossClusterReplicationInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterPromoteCrossClusterReplicationOK().WithPayload(status)
+}
+
+func (h *crossClusterHandlers) status(ctx context.Context) (*models.CrossClusterReplicationStatus, error) {
+	if h.follower == nil {
+		// a cluster which has been promoted may not be configured as a
+		// follower anymore
+		state, err := h.state.GetCrossCluster(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !state.Promoted {
+			return &models.CrossClusterReplicationStatus{Role: models.CrossClusterReplicationStatusRolePRIMARY}, nil
+		}
+		return &models.CrossClusterReplicationStatus{
+			Role:             models.CrossClusterReplicationStatusRolePROMOTED,
+			AppliedIndex:     int64(state.PrimaryIndex),
+			PrimaryIndex:     int64(state.PrimaryIndex),
+			PromotedAtUnixMs: state.PromotedAtUnixMs,
+		}, nil
+	}
+
+	status, err := h.follower.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &models.CrossClusterReplicationStatus{
+		Role:              models.CrossClusterReplicationStatusRoleFOLLOWER,
+		PrimaryHost:       h.primaryHost,
+		AppliedIndex:      int64(status.AppliedIndex),
+		PrimaryIndex:      int64(status.PrimaryIndex),
+		SchemaLag:         int64(status.SchemaLag()),
+		ObjectsLagSeconds: status.ObjectsLag.Seconds(),
+	}
+	if !status.LastSchemaSync.IsZero() {
+		out.LastSchemaSyncUnixMs = status.LastSchemaSync.UnixMilli()
+	}
+	if !status.LastObjectsInSync.IsZero() {
+		out.LastObjectsInSyncUnixMs = status.LastObjectsInSync.UnixMilli()
+	}
+	if status.Promoted {
+		out.Role = models.CrossClusterReplicationStatusRolePROMOTED
+		out.PromotedAtUnixMs = status.PromotedAt.UnixMilli()
+	}
+	return out, nil
+}
+
+func setupCrossClusterHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &crossClusterHandlers{
+		follower:    appState.CrossClusterFollower,
+		state:       appState.ClusterService.Raft,
+		primaryHost: appState.ServerConfig.Config.CrossClusterReplication.PrimaryHost,
+		authorizer:  appState.Authorizer,
+		logger:      appState.Logger,
+	}
+	api.ClusterClusterGetCrossClusterReplicationHandler = cluster.ClusterGetCrossClusterReplicationHandlerFunc(h.getStatus)
+	api.ClusterClusterPromoteCrossClusterReplicationHandler = cluster.ClusterPromoteCrossClusterReplicationHandlerFunc(h.promote)
+}
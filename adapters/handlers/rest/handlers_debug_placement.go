@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type placementViolationsResponse struct {
+	Policy     string                                   `json:"policy"`
+	Domain     string                                   `json:"domain"`
+	Violations map[string][]sharding.PlacementViolation `json:"violations"`
+}
+
+// setupDebugPlacementHandlers registers the endpoint which reports the shards
+// whose replicas are not spread across as many failure domains (zones or
+// racks) as the available nodes allow.
+//
+// Call via something like:
+//
+//	curl "localhost:6060/debug/placement/violations"
+//	curl "localhost:6060/debug/placement/violations?collection=Foo"
+func setupDebugPlacementHandlers(appState *state.State, logger logrus.FieldLogger) {
+	http.HandleFunc("/debug/placement/violations", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		raft := appState.ClusterService.Raft
+		cfg := appState.Cluster.Placement().Config
+		resp := placementViolationsResponse{
+			Policy:     cfg.Policy,
+			Domain:     cfg.Domain,
+			Violations: map[string][]sharding.PlacementViolation{},
+		}
+
+		collections := []string{r.URL.Query().Get("collection")}
+		if collections[0] == "" {
+			collections = collections[:0]
+			for _, class := range raft.SchemaReader().ReadOnlySchema().Classes {
+				collections = append(collections, class.Class)
+			}
+		}
+
+		available := raft.StorageCandidates()
+		domains := raft.StorageCandidateDomains()
+		for _, collection := range collections {
+			var violations []sharding.PlacementViolation
+			if err := raft.SchemaReader().Read(collection, false, func(_ *models.Class, st *sharding.State) error {
+				if st != nil {
+					violations = st.PlacementViolations(available, domains)
+				}
+				return nil
+			}); err != nil {
+				logger.WithField("collection", collection).WithError(err).Error("failed to check replica placement")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if len(violations) > 0 {
+				resp.Violations[collection] = violations
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	rCluster "github.com/weaviate/weaviate/cluster"
+	"github.com/weaviate/weaviate/cluster/types"
+)
+
+type queryNodesResponse struct {
+	Nodes []string `json:"nodes"`
+}
+
+type queryReplicasResponse struct {
+	Collection string                  `json:"collection"`
+	Node       string                  `json:"node"`
+	Replicas   []rCluster.QueryReplica `json:"replicas"`
+	Error      string                  `json:"error,omitempty"`
+}
+
+// setupDebugQueryNodesHandlers registers the endpoints to list the query
+// nodes of the cluster and to copy the shards of a collection to one of them.
+// Query nodes (CLUSTER_NODE_QUERY_ONLY) don't get any shards on their own,
+// the collections they serve reads for are selected with this endpoint.
+//
+// Call via something like:
+//
+//	curl "localhost:6060/debug/query-nodes"
+//	curl -X POST "localhost:6060/debug/query-nodes/replicas?collection=Foo&node=query-0"
+func setupDebugQueryNodesHandlers(appState *state.State, logger logrus.FieldLogger) {
+	http.HandleFunc("/debug/query-nodes", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp := queryNodesResponse{Nodes: appState.ClusterService.Raft.QueryNodes()}
+		if resp.Nodes == nil {
+			resp.Nodes = []string{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+
+	http.HandleFunc("/debug/query-nodes/replicas", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		resp := queryReplicasResponse{
+			Collection: query.Get("collection"),
+			Node:       query.Get("node"),
+		}
+		if resp.Collection == "" || resp.Node == "" {
+			http.Error(w, "collection and node are required", http.StatusBadRequest)
+			return
+		}
+
+		replicas, err := appState.ClusterService.Raft.AddQueryReplicas(context.Background(), resp.Collection, resp.Node)
+		if err != nil && len(replicas) == 0 {
+			if errors.Is(err, types.ErrNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			logger.WithField("collection", resp.Collection).WithError(err).Error("failed to add query replicas")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Replicas = replicas
+		if resp.Replicas == nil {
+			resp.Replicas = []rCluster.QueryReplica{}
+		}
+		if err != nil {
+			// some of the copies got scheduled, report the others
+			resp.Error = err.Error()
+		}
+
+		logger.WithField("collection", resp.Collection).
+			WithField("node", resp.Node).
+			WithField("replicas", len(resp.Replicas)).
+			Info("scheduled replica copies to query node")
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type shardSplitResponse struct {
+	Collection    string `json:"collection"`
+	Shard         string `json:"shard"`
+	TargetShard   string `json:"targetShard,omitempty"`
+	SchemaVersion uint64 `json:"schemaVersion"`
+}
+
+// setupDebugShardSplitHandlers registers the endpoints to split a shard of a
+// non multi-tenant collection in two while it keeps serving traffic, and to
+// abort such a split before it got committed.
+//
+// Call via something like:
+//
+//	curl -X POST "localhost:6060/debug/shards/split?collection=Foo&shard=abc"
+//	curl -X POST "localhost:6060/debug/shards/split/abort?collection=Foo&shard=abc"
+//
+// The name of the new shard is generated, unless it is set with target.
+func setupDebugShardSplitHandlers(appState *state.State, logger logrus.FieldLogger) {
+	http.HandleFunc("/debug/shards/split", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		resp := shardSplitResponse{
+			Collection:  query.Get("collection"),
+			Shard:       query.Get("shard"),
+			TargetShard: query.Get("target"),
+		}
+		if resp.Collection == "" || resp.Shard == "" {
+			http.Error(w, "collection and shard are required", http.StatusBadRequest)
+			return
+		}
+		if resp.TargetShard == "" {
+			resp.TargetShard = sharding.NewShardName()
+		}
+
+		version, err := appState.ClusterService.Raft.SplitShard(context.Background(),
+			resp.Collection, resp.Shard, resp.TargetShard)
+		if err != nil {
+			logger.WithField("shard", resp.Shard).WithError(err).Error("failed to start shard split")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.SchemaVersion = version
+
+		logger.WithField("collection", resp.Collection).
+			WithField("shard", resp.Shard).
+			WithField("target", resp.TargetShard).
+			Info("started shard split")
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+
+	http.HandleFunc("/debug/shards/split/abort", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		resp := shardSplitResponse{
+			Collection: query.Get("collection"),
+			Shard:      query.Get("shard"),
+		}
+		if resp.Collection == "" || resp.Shard == "" {
+			http.Error(w, "collection and shard are required", http.StatusBadRequest)
+			return
+		}
+
+		version, err := appState.ClusterService.Raft.SplitShardAbort(context.Background(), resp.Collection, resp.Shard)
+		if err != nil {
+			logger.WithField("shard", resp.Shard).WithError(err).Error("failed to abort shard split")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.SchemaVersion = version
+
+		logger.WithField("collection", resp.Collection).
+			WithField("shard", resp.Shard).
+			Info("aborted shard split")
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+}
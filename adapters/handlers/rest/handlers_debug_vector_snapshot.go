@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type vectorIndexSnapshotResponse struct {
+	Collection string                          `json:"collection"`
+	Shards     []*db.VectorIndexSnapshotReport `json:"shards"`
+}
+
+// setupDebugVectorSnapshotHandlers registers the endpoint to create vector
+// index snapshots on demand, e.g. right before a node is restarted for
+// maintenance so that it does not need to replay the commit log on startup.
+//
+// Call via something like:
+//
+//	curl -X POST "localhost:6060/debug/index/snapshot/vector?collection=Foo"
+//
+// Optional parameters are shard (defaults to all local shards) and vector.
+func setupDebugVectorSnapshotHandlers(appState *state.State, logger logrus.FieldLogger) {
+	http.HandleFunc("/debug/index/snapshot/vector", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		colName := query.Get("collection")
+		shardName := query.Get("shard")
+		targetVector := query.Get("vector")
+		if colName == "" {
+			http.Error(w, "collection is required", http.StatusBadRequest)
+			return
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		ctx := context.Background()
+		resp := vectorIndexSnapshotResponse{Collection: idx.Config.ClassName.String()}
+
+		if shardName != "" {
+			report, err := idx.CreateVectorIndexSnapshot(ctx, shardName, targetVector)
+			if err != nil {
+				logger.WithField("shard", shardName).WithError(err).Error("failed to create vector index snapshot")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp.Shards = append(resp.Shards, report)
+		} else {
+			err := idx.ForEachLoadedShard(func(name string, shard db.ShardLike) error {
+				report, err := shard.CreateVectorIndexSnapshot(ctx, targetVector)
+				if err != nil {
+					return fmt.Errorf("shard %q: %w", name, err)
+				}
+				resp.Shards = append(resp.Shards, report)
+				return nil
+			})
+			if err != nil {
+				logger.WithError(err).Error("failed to create vector index snapshot")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		logger.WithField("collection", resp.Collection).
+			WithField("targetVector", targetVector).
+			WithField("shards", len(resp.Shards)).
+			Info("created vector index snapshots")
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+}
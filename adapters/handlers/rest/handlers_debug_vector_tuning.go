@@ -0,0 +1,277 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type vectorIndexTuningResponse struct {
+	Collection string                        `json:"collection"`
+	Shards     []*db.VectorIndexTuningReport `json:"shards"`
+	// Recommendation combines the most expensive per-shard settings, so that
+	// every tuned shard reaches the target when it is applied class-wide.
+	Recommendation *db.VectorIndexTuningCandidate `json:"recommendation,omitempty"`
+	MeetsTarget    bool                           `json:"meetsTarget"`
+	Applied        bool                           `json:"applied"`
+}
+
+// setupDebugVectorTuningHandlers registers the vector index tuning endpoint.
+//
+// Call via something like:
+//
+//	curl -X POST "localhost:6060/debug/index/tune/vector?collection=Foo&targetRecall=0.95&latencyBudget=5ms"
+//
+// Optional parameters are shard (defaults to all local shards), vector, k,
+// sampleSize and apply. With apply=true the recommendation is written to the
+// class schema and thereby propagated to every shard of the collection. This
+// is refused unless every shard of the collection was tuned, i.e. all of them
+// are loaded on the node receiving the request.
+func setupDebugVectorTuningHandlers(appState *state.State, logger logrus.FieldLogger) {
+	http.HandleFunc("/debug/index/tune/vector", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		colName := query.Get("collection")
+		shardName := query.Get("shard")
+		targetVector := query.Get("vector")
+		if colName == "" {
+			http.Error(w, "collection is required", http.StatusBadRequest)
+			return
+		}
+
+		params, apply, err := parseVectorIndexTuningParams(query.Get)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		ctx := context.Background()
+		resp := vectorIndexTuningResponse{Collection: idx.Config.ClassName.String()}
+
+		if shardName != "" {
+			report, err := idx.TuneVectorIndex(ctx, shardName, targetVector, params)
+			if err != nil {
+				logger.WithField("shard", shardName).WithError(err).Error("failed to tune vector index")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp.Shards = append(resp.Shards, report)
+		} else {
+			err := idx.ForEachLoadedShard(func(name string, shard db.ShardLike) error {
+				report, err := shard.TuneVectorIndex(ctx, targetVector, params)
+				if err != nil {
+					return fmt.Errorf("shard %q: %w", name, err)
+				}
+				resp.Shards = append(resp.Shards, report)
+				return nil
+			})
+			if err != nil {
+				logger.WithError(err).Error("failed to tune vector index")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		resp.Recommendation, resp.MeetsTarget = combineVectorIndexTuningReports(resp.Shards)
+
+		if apply && resp.Recommendation != nil {
+			missing, err := idx.UntunedShards(resp.Shards)
+			if err != nil {
+				logger.WithField("collection", resp.Collection).WithError(err).Error("failed to check tuned shards")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if len(missing) > 0 {
+				http.Error(w, fmt.Sprintf("cannot apply: shards %v of collection %q were not tuned, "+
+					"the recommendation of this node does not cover the collection", missing, resp.Collection),
+					http.StatusConflict)
+				return
+			}
+
+			if err := applyVectorIndexTuning(ctx, appState, resp.Collection, targetVector, resp.Recommendation); err != nil {
+				logger.WithField("collection", resp.Collection).WithError(err).Error("failed to apply vector index tuning")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp.Applied = true
+			logger.WithField("collection", resp.Collection).
+				WithField("targetVector", targetVector).
+				WithField("recommendation", resp.Recommendation).
+				Info("applied vector index tuning")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		writeJSON(w, http.StatusOK, resp)
+	}))
+}
+
+func parseVectorIndexTuningParams(get func(string) string) (db.VectorIndexTuningParams, bool, error) {
+	var params db.VectorIndexTuningParams
+	var err error
+
+	if params.TargetRecall, err = strconv.ParseFloat(get("targetRecall"), 64); err != nil {
+		return params, false, fmt.Errorf("invalid targetRecall: %w", err)
+	}
+	if v := get("latencyBudget"); v != "" {
+		if params.LatencyBudget, err = time.ParseDuration(v); err != nil {
+			return params, false, fmt.Errorf("invalid latencyBudget: %w", err)
+		}
+	}
+	if v := get("k"); v != "" {
+		if params.K, err = strconv.Atoi(v); err != nil {
+			return params, false, fmt.Errorf("invalid k: %w", err)
+		}
+	}
+	if v := get("sampleSize"); v != "" {
+		if params.SampleSize, err = strconv.Atoi(v); err != nil {
+			return params, false, fmt.Errorf("invalid sampleSize: %w", err)
+		}
+	}
+
+	apply := false
+	if v := get("apply"); v != "" {
+		if apply, err = strconv.ParseBool(v); err != nil {
+			return params, false, fmt.Errorf("invalid apply: %w", err)
+		}
+	}
+
+	return params, apply, nil
+}
+
+// combineVectorIndexTuningReports returns the highest ef and rescore limit
+// recommended for any shard, together with the lowest recall and highest
+// latencies measured for the recommendations. The class-wide setting only
+// meets the target if every shard's recommendation does.
+func combineVectorIndexTuningReports(reports []*db.VectorIndexTuningReport) (*db.VectorIndexTuningCandidate, bool) {
+	var combined *db.VectorIndexTuningCandidate
+	meetsTarget := len(reports) > 0
+	for _, report := range reports {
+		if report.Recommendation == nil {
+			meetsTarget = false
+			continue
+		}
+		meetsTarget = meetsTarget && report.MeetsTarget
+		rec := report.Recommendation
+		if combined == nil {
+			copied := *rec
+			combined = &copied
+			continue
+		}
+		combined.Ef = max(combined.Ef, rec.Ef)
+		combined.RescoreLimit = max(combined.RescoreLimit, rec.RescoreLimit)
+		combined.Recall = min(combined.Recall, rec.Recall)
+		combined.MeanLatency = max(combined.MeanLatency, rec.MeanLatency)
+		combined.P95Latency = max(combined.P95Latency, rec.P95Latency)
+	}
+	return combined, meetsTarget
+}
+
+func applyVectorIndexTuning(ctx context.Context, appState *state.State, className, targetVector string,
+	rec *db.VectorIndexTuningCandidate,
+) error {
+	classes, err := appState.SchemaManager.GetCachedClassNoAuth(ctx, className)
+	if err != nil {
+		return err
+	}
+	versioned, ok := classes[className]
+	if !ok || versioned.Class == nil {
+		return fmt.Errorf("collection %q not found", className)
+	}
+
+	// shallow copy, the class returned by the schema must not be modified
+	updated := *versioned.Class
+	if targetVector == "" {
+		cfg, err := tunedVectorIndexConfig(updated.VectorIndexConfig, rec)
+		if err != nil {
+			return err
+		}
+		updated.VectorIndexConfig = cfg
+	} else {
+		vectorConfig, ok := updated.VectorConfig[targetVector]
+		if !ok {
+			return fmt.Errorf("target vector %q not found", targetVector)
+		}
+		cfg, err := tunedVectorIndexConfig(vectorConfig.VectorIndexConfig, rec)
+		if err != nil {
+			return err
+		}
+		vectorConfig.VectorIndexConfig = cfg
+		updated.VectorConfig = make(map[string]models.VectorConfig, len(versioned.Class.VectorConfig))
+		for name, vc := range versioned.Class.VectorConfig {
+			updated.VectorConfig[name] = vc
+		}
+		updated.VectorConfig[targetVector] = vectorConfig
+	}
+
+	return schemaUC.UpdateClassInternal(&appState.SchemaManager.Handler, ctx, className, &updated)
+}
+
+func tunedVectorIndexConfig(config interface{}, rec *db.VectorIndexTuningCandidate) (interface{}, error) {
+	switch cfg := config.(type) {
+	case hnswent.UserConfig:
+		if rec.Ef == 0 {
+			return nil, fmt.Errorf("recommendation does not contain an ef for hnsw")
+		}
+		cfg.EF = rec.Ef
+		if rec.RescoreLimit == 0 {
+			return cfg, nil
+		}
+		switch {
+		case cfg.SQ.Enabled:
+			cfg.SQ.RescoreLimit = rec.RescoreLimit
+		case cfg.RQ.Enabled:
+			cfg.RQ.RescoreLimit = rec.RescoreLimit
+		default:
+			return nil, fmt.Errorf("hnsw index has no rescore limit to apply")
+		}
+		return cfg, nil
+	case flatent.UserConfig:
+		if rec.RescoreLimit == 0 {
+			return nil, fmt.Errorf("recommendation does not contain a rescore limit for flat")
+		}
+		switch {
+		case cfg.BQ.Enabled:
+			cfg.BQ.RescoreLimit = rec.RescoreLimit
+		case cfg.RQ.Enabled:
+			cfg.RQ.RescoreLimit = rec.RescoreLimit
+		default:
+			return nil, fmt.Errorf("flat index is not compressed, there is nothing to apply")
+		}
+		return cfg, nil
+	default:
+		return nil, fmt.Errorf("applying tuning to vector index config %T is not supported", config)
+	}
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestParseVectorIndexTuningParams(t *testing.T) {
+	q := url.Values{
+		"targetRecall":  {"0.95"},
+		"latencyBudget": {"5ms"},
+		"k":             {"20"},
+		"apply":         {"true"},
+	}
+	params, apply, err := parseVectorIndexTuningParams(q.Get)
+	require.NoError(t, err)
+	assert.True(t, apply)
+	assert.Equal(t, db.VectorIndexTuningParams{
+		TargetRecall:  0.95,
+		LatencyBudget: 5 * time.Millisecond,
+		K:             20,
+	}, params)
+
+	_, _, err = parseVectorIndexTuningParams(url.Values{}.Get)
+	assert.Error(t, err)
+}
+
+func TestCombineVectorIndexTuningReports(t *testing.T) {
+	rec, ok := combineVectorIndexTuningReports([]*db.VectorIndexTuningReport{
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 64}, MeetsTarget: true},
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 128}, MeetsTarget: true},
+	})
+	require.NotNil(t, rec)
+	assert.True(t, ok)
+	assert.Equal(t, 128, rec.Ef)
+
+	rec, ok = combineVectorIndexTuningReports([]*db.VectorIndexTuningReport{
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 64}, MeetsTarget: true},
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 32}, MeetsTarget: false},
+	})
+	require.NotNil(t, rec)
+	assert.False(t, ok)
+	assert.Equal(t, 64, rec.Ef)
+
+	rec, ok = combineVectorIndexTuningReports([]*db.VectorIndexTuningReport{
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 64, RescoreLimit: 64, Recall: 0.97}, MeetsTarget: true},
+		{Recommendation: &db.VectorIndexTuningCandidate{Ef: 32, RescoreLimit: 128, Recall: 0.96}, MeetsTarget: true},
+	})
+	require.NotNil(t, rec)
+	assert.True(t, ok)
+	assert.Equal(t, 64, rec.Ef)
+	assert.Equal(t, 128, rec.RescoreLimit)
+	assert.Equal(t, 0.96, rec.Recall)
+
+	rec, ok = combineVectorIndexTuningReports(nil)
+	assert.Nil(t, rec)
+	assert.False(t, ok)
+}
+
+func TestTunedVectorIndexConfig(t *testing.T) {
+	cfg, err := tunedVectorIndexConfig(hnswent.UserConfig{EF: -1}, &db.VectorIndexTuningCandidate{Ef: 96})
+	require.NoError(t, err)
+	assert.Equal(t, 96, cfg.(hnswent.UserConfig).EF)
+
+	flat := flatent.UserConfig{BQ: flatent.CompressionUserConfig{Enabled: true, RescoreLimit: 10}}
+	cfg, err = tunedVectorIndexConfig(flat, &db.VectorIndexTuningCandidate{RescoreLimit: 512})
+	require.NoError(t, err)
+	assert.Equal(t, 512, cfg.(flatent.UserConfig).BQ.RescoreLimit)
+	// the input must not be modified
+	assert.Equal(t, 10, flat.BQ.RescoreLimit)
+
+	sq := hnswent.UserConfig{SQ: hnswent.SQConfig{Enabled: true, RescoreLimit: 20}}
+	cfg, err = tunedVectorIndexConfig(sq, &db.VectorIndexTuningCandidate{Ef: 128, RescoreLimit: 64})
+	require.NoError(t, err)
+	assert.Equal(t, 128, cfg.(hnswent.UserConfig).EF)
+	assert.Equal(t, 64, cfg.(hnswent.UserConfig).SQ.RescoreLimit)
+	assert.Equal(t, 20, sq.SQ.RescoreLimit)
+
+	_, err = tunedVectorIndexConfig(hnswent.UserConfig{}, &db.VectorIndexTuningCandidate{Ef: 128, RescoreLimit: 64})
+	assert.Error(t, err)
+	_, err = tunedVectorIndexConfig(flatent.UserConfig{}, &db.VectorIndexTuningCandidate{RescoreLimit: 512})
+	assert.Error(t, err)
+	_, err = tunedVectorIndexConfig(hnswent.UserConfig{}, &db.VectorIndexTuningCandidate{RescoreLimit: 512})
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type nodeDrainer interface {
+	DrainNode(ctx context.Context, node string) error
+	GetNodeDrain(ctx context.Context, node string) (api.ReplicationNodeDrainResponse, error)
+	CancelNodeDrain(ctx context.Context, node string) error
+}
+
+type nodeDrainHandlers struct {
+	drainer    nodeDrainer
+	authorizer authorization.Authorizer
+	logger     logrus.FieldLogger
+}
+
+// drainNode starts draining a node. Since this moves replicas of all
+// collections, it requires the permission to create any replication.
+func (h *nodeDrainHandlers) drainNode(params cluster.ClusterDrainNodeParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.CREATE, authorization.Replications("*", "*")); err != nil {
+		return cluster.NewClusterDrainNodeForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.drainer.DrainNode(ctx, params.NodeName); err != nil {
+		switch {
+		case errors.Is(err, clusterTypes.ErrNotFound):
+			return cluster.NewClusterDrainNodeNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, replicationTypes.ErrInvalidRequest):
+			return cluster.NewClusterDrainNodeUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterDrainNodeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+	h.logger.WithFields(logrus.Fields{
+		"action": "node_drain",
+		"node":   params.NodeName,
+	}).Info("node drain started")
+
+	status, err := h.drainer.GetNodeDrain(ctx, params.NodeName)
+	if err != nil {
+		return cluster.NewClusterDrainNodeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterDrainNodeOK().WithPayload(nodeDrainStatus(status))
+}
+
+func (h *nodeDrainHandlers) getNodeDrain(params cluster.ClusterGetNodeDrainParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Cluster()); err != nil {
+		return cluster.NewClusterGetNodeDrainForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	status, err := h.drainer.GetNodeDrain(ctx, params.NodeName)
+	if err != nil {
+		if errors.Is(err, clusterTypes.ErrNotFound) {
+			return cluster.NewClusterGetNodeDrainNotFound().WithPayload(errPayloadFromSingleErr(
+				fmt.Errorf("node %s is not being drained", params.NodeName)))
+		}
+		return cluster.NewClusterGetNodeDrainInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterGetNodeDrainOK().WithPayload(nodeDrainStatus(status))
+}
+
+func (h *nodeDrainHandlers) cancelNodeDrain(params cluster.ClusterCancelNodeDrainParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.DELETE, authorization.Replications("*", "*")); err != nil {
+		return cluster.NewClusterCancelNodeDrainForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.drainer.CancelNodeDrain(ctx, params.NodeName); err != nil {
+		if errors.Is(err, clusterTypes.ErrNotFound) {
+			return cluster.NewClusterCancelNodeDrainNotFound().WithPayload(errPayloadFromSingleErr(
+				fmt.Errorf("node %s is not being drained", params.NodeName)))
+		}
+		return cluster.NewClusterCancelNodeDrainInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	h.logger.WithFields(logrus.Fields{
+		"action": "node_drain",
+		"node":   params.NodeName,
+	}).Info("node drain cancelled")
+	return cluster.NewClusterCancelNodeDrainNoContent()
+}
+
+func nodeDrainStatus(status api.ReplicationNodeDrainResponse) *models.NodeDrainStatus {
+	return &models.NodeDrainStatus{
+		Node:              status.Node,
+		State:             status.State.String(),
+		StartTimeUnixMs:   status.StartTimeUnixMs,
+		Error:             status.Error,
+		ReplicasRemaining: int64(status.ReplicasRemaining),
+		MovesInFlight:     int64(status.MovesInFlight),
+	}
+}
+
+func setupNodeDrainHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &nodeDrainHandlers{
+		drainer:    appState.ClusterService.Raft,
+		authorizer: appState.Authorizer,
+		logger:     appState.Logger,
+	}
+	api.ClusterClusterDrainNodeHandler = cluster.ClusterDrainNodeHandlerFunc(h.drainNode)
+	api.ClusterClusterGetNodeDrainHandler = cluster.ClusterGetNodeDrainHandlerFunc(h.getNodeDrain)
+	api.ClusterClusterCancelNodeDrainHandler = cluster.ClusterCancelNodeDrainHandlerFunc(h.cancelNodeDrain)
+
+	// the replicas of a draining node are moved by the replication engine
+	if !appState.ServerConfig.Config.ReplicaMovementEnabled {
+		api.ClusterClusterDrainNodeHandler = cluster.ClusterDrainNodeHandlerFunc(func(cluster.ClusterDrainNodeParams, *models.Principal) middleware.Responder {
+			return cluster.NewClusterDrainNodeNotImplemented().WithPayload(errPayloadFromSingleErr(
+				errors.New("draining nodes requires replica movement to be enabled")))
+		})
+	}
+}
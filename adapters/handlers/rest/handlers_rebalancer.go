@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type rebalancerPlanner interface {
+	Plan(ctx context.Context, limit int) (rebalancer.Plan, error)
+	Paused() bool
+}
+
+type rebalancerPauser interface {
+	SetRebalancerPaused(ctx context.Context, paused bool) error
+}
+
+type rebalancerHandlers struct {
+	planner    rebalancerPlanner
+	pauser     rebalancerPauser
+	authorizer authorization.Authorizer
+	logger     logrus.FieldLogger
+}
+
+// getPlan computes the moves the rebalancer would schedule without
+// scheduling them. The plan lists the shards of all collections and the load
+// of every storage node, so it requires the permission to read the cluster.
+func (h *rebalancerHandlers) getPlan(params cluster.ClusterGetRebalancerPlanParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Cluster()); err != nil {
+		return cluster.NewClusterGetRebalancerPlanForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	var limit int
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	plan, err := h.planner.Plan(ctx, limit)
+	if err != nil {
+		h.logger.WithError(err).Error("failed to plan rebalancing")
+		return cluster.NewClusterGetRebalancerPlanInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterGetRebalancerPlanOK().WithPayload(rebalancerPlan(plan, h.planner.Paused()))
+}
+
+// pause stops the rebalancer from scheduling moves. Since this affects the
+// replication of all collections, it requires the permission to update any
+// replication.
+func (h *rebalancerHandlers) pause(params cluster.ClusterPauseRebalancerParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.Replications("*", "*")); err != nil {
+		return cluster.NewClusterPauseRebalancerForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.setPaused(ctx, true); err != nil {
+		return cluster.NewClusterPauseRebalancerInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterPauseRebalancerOK().WithPayload(&models.RebalancerStatus{Paused: h.planner.Paused()})
+}
+
+func (h *rebalancerHandlers) resume(params cluster.ClusterResumeRebalancerParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.UPDATE, authorization.Replications("*", "*")); err != nil {
+		return cluster.NewClusterResumeRebalancerForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	if err := h.setPaused(ctx, false); err != nil {
+		return cluster.NewClusterResumeRebalancerInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	// the rebalancer stays paused if it is paused by configuration
+	return cluster.NewClusterResumeRebalancerOK().WithPayload(&models.RebalancerStatus{Paused: h.planner.Paused()})
+}
+
+func (h *rebalancerHandlers) setPaused(ctx context.Context, paused bool) error {
+	if err := h.pauser.SetRebalancerPaused(ctx, paused); err != nil {
+		h.logger.WithField("paused", paused).WithError(err).Error("failed to change the rebalancer pause switch")
+		return err
+	}
+	h.logger.WithFields(logrus.Fields{
+		"action": "rebalancer",
+		"paused": paused,
+	}).Info("rebalancer pause switch changed")
+	return nil
+}
+
+func rebalancerPlan(p rebalancer.Plan, paused bool) *models.RebalancerPlan {
+	nodes := make([]*models.RebalancerNodeLoad, 0, len(p.Nodes))
+	for _, n := range p.Nodes {
+		nodes = append(nodes, &models.RebalancerNodeLoad{
+			Node:     n.Node,
+			Objects:  n.Objects,
+			DiskUsed: int64(n.DiskUsed),
+			Load:     n.Load,
+		})
+	}
+	moves := make([]*models.RebalancerMove, 0, len(p.Moves))
+	for _, m := range p.Moves {
+		moves = append(moves, &models.RebalancerMove{
+			Collection: m.Collection,
+			Shard:      m.Shard,
+			SourceNode: m.SourceNode,
+			TargetNode: m.TargetNode,
+			Load:       m.Load,
+		})
+	}
+	return &models.RebalancerPlan{
+		Metric:         p.Metric,
+		Imbalance:      p.Imbalance,
+		ImbalanceAfter: p.ImbalanceAfter,
+		InFlight:       int64(p.InFlight),
+		Nodes:          nodes,
+		Moves:          moves,
+		Paused:         paused,
+		Reason:         p.Reason,
+	}
+}
+
+func setupRebalancerHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &rebalancerHandlers{
+		planner:    appState.Rebalancer,
+		pauser:     appState.ClusterService.Raft,
+		authorizer: appState.Authorizer,
+		logger:     appState.Logger,
+	}
+	api.ClusterClusterGetRebalancerPlanHandler = cluster.ClusterGetRebalancerPlanHandlerFunc(h.getPlan)
+	api.ClusterClusterPauseRebalancerHandler = cluster.ClusterPauseRebalancerHandlerFunc(h.pause)
+	api.ClusterClusterResumeRebalancerHandler = cluster.ClusterResumeRebalancerHandlerFunc(h.resume)
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// fakeRebalancer stands in for both the rebalancer and raft, pausing it
+// applies to every node just like the switch recorded in raft
+type fakeRebalancer struct {
+	plan           rebalancer.Plan
+	limit          int
+	paused         bool
+	pausedByConfig bool
+	setCalls       int
+}
+
+func (f *fakeRebalancer) Plan(_ context.Context, limit int) (rebalancer.Plan, error) {
+	f.limit = limit
+	return f.plan, nil
+}
+
+func (f *fakeRebalancer) Paused() bool { return f.paused || f.pausedByConfig }
+
+func (f *fakeRebalancer) SetRebalancerPaused(_ context.Context, paused bool) error {
+	f.setCalls++
+	f.paused = paused
+	return nil
+}
+
+func TestRebalancerHandlers(t *testing.T) {
+	principal := &models.Principal{Username: "user"}
+	logger, _ := test.NewNullLogger()
+	newHandlers := func(authorizer authorization.Authorizer, f *fakeRebalancer) *rebalancerHandlers {
+		return &rebalancerHandlers{planner: f, pauser: f, authorizer: authorizer, logger: logger}
+	}
+	pauseParams := cluster.ClusterPauseRebalancerParams{
+		HTTPRequest: httptest.NewRequest("POST", "/v1/cluster/rebalancer/pause", nil),
+	}
+	resumeParams := cluster.ClusterResumeRebalancerParams{
+		HTTPRequest: httptest.NewRequest("POST", "/v1/cluster/rebalancer/resume", nil),
+	}
+
+	t.Run("plan forbidden without cluster read permission", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).
+			Return(errors.New("forbidden"))
+
+		res := newHandlers(authorizer, &fakeRebalancer{}).getPlan(cluster.ClusterGetRebalancerPlanParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/cluster/rebalancer/plan", nil),
+		}, principal)
+		assert.IsType(t, &cluster.ClusterGetRebalancerPlanForbidden{}, res)
+	})
+
+	t.Run("converts the plan", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).Return(nil)
+		f := &fakeRebalancer{paused: true, plan: rebalancer.Plan{
+			Metric:         rebalancer.MetricObjects,
+			Imbalance:      0.5,
+			ImbalanceAfter: 0.1,
+			InFlight:       1,
+			Nodes:          []rebalancer.NodeLoad{{Node: "N1", Objects: 30, Load: 30}, {Node: "N2", Load: 0}},
+			Moves: []rebalancer.Move{{
+				ShardID:    rebalancer.ShardID{Collection: "C", Shard: "a"},
+				SourceNode: "N1",
+				TargetNode: "N2",
+				Load:       10,
+			}},
+		}}
+
+		limit := int64(3)
+		res := newHandlers(authorizer, f).getPlan(cluster.ClusterGetRebalancerPlanParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/cluster/rebalancer/plan?limit=3", nil),
+			Limit:       &limit,
+		}, principal)
+		assert.Equal(t, 3, f.limit)
+
+		ok, isOK := res.(*cluster.ClusterGetRebalancerPlanOK)
+		require.True(t, isOK)
+		assert.Equal(t, &models.RebalancerPlan{
+			Metric:         rebalancer.MetricObjects,
+			Imbalance:      0.5,
+			ImbalanceAfter: 0.1,
+			InFlight:       1,
+			Nodes: []*models.RebalancerNodeLoad{
+				{Node: "N1", Objects: 30, Load: 30},
+				{Node: "N2"},
+			},
+			Moves: []*models.RebalancerMove{
+				{Collection: "C", Shard: "a", SourceNode: "N1", TargetNode: "N2", Load: 10},
+			},
+			Paused: true,
+		}, ok.Payload)
+	})
+
+	t.Run("pause forbidden without permission to update replications", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Replications("*", "*")).
+			Return(errors.New("forbidden"))
+		f := &fakeRebalancer{}
+		h := newHandlers(authorizer, f)
+
+		assert.IsType(t, &cluster.ClusterPauseRebalancerForbidden{}, h.pause(pauseParams, principal))
+		assert.IsType(t, &cluster.ClusterResumeRebalancerForbidden{}, h.resume(resumeParams, principal))
+		assert.Zero(t, f.setCalls)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Replications("*", "*")).Return(nil)
+		f := &fakeRebalancer{}
+		h := newHandlers(authorizer, f)
+
+		paused, isOK := h.pause(pauseParams, principal).(*cluster.ClusterPauseRebalancerOK)
+		require.True(t, isOK)
+		assert.True(t, paused.Payload.Paused)
+		assert.True(t, f.paused)
+
+		resumed, isOK := h.resume(resumeParams, principal).(*cluster.ClusterResumeRebalancerOK)
+		require.True(t, isOK)
+		assert.False(t, resumed.Payload.Paused)
+		assert.False(t, f.paused)
+	})
+
+	t.Run("resume reports a pause by configuration", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.UPDATE, authorization.Replications("*", "*")).Return(nil)
+		f := &fakeRebalancer{paused: true, pausedByConfig: true}
+
+		resumed, isOK := newHandlers(authorizer, f).resume(resumeParams, principal).(*cluster.ClusterResumeRebalancerOK)
+		require.True(t, isOK)
+		assert.True(t, resumed.Payload.Paused)
+		assert.False(t, f.paused)
+	})
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+type replicaVerifier interface {
+	VerifyReplicas(ctx context.Context, className, shard string, limit int) (*replica.VerificationReport, error)
+}
+
+type replicationVerifyHandlers struct {
+	verifier   replicaVerifier
+	authorizer authorization.Authorizer
+	logger     logrus.FieldLogger
+}
+
+// verifyReplication compares the replicas of the shards of a collection and
+// reports the objects which differ between them. Running it reads hashtrees
+// and object digests on every node holding a replica, so it requires the
+// permission to read the cluster. The report lists object ids, so it also
+// requires the permission to read the objects of the compared shards.
+func (h *replicationVerifyHandlers) verifyReplication(params cluster.ClusterVerifyReplicationParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	var shard string
+	if params.Shard != nil {
+		shard = *params.Shard
+	}
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Cluster()); err != nil {
+		return cluster.NewClusterVerifyReplicationForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.ShardsData(params.Collection, shard)...); err != nil {
+		return cluster.NewClusterVerifyReplicationForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	var limit int
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	report, err := h.verifier.VerifyReplicas(ctx, params.Collection, shard, limit)
+	if err != nil {
+		if errors.Is(err, replica.ErrVerificationUnavailable) {
+			return cluster.NewClusterVerifyReplicationUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		}
+		h.logger.WithField("collection", params.Collection).WithError(err).Error("failed to verify replicas")
+		return cluster.NewClusterVerifyReplicationInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+	return cluster.NewClusterVerifyReplicationOK().WithPayload(replicationVerificationReport(report))
+}
+
+func replicationVerificationReport(r *replica.VerificationReport) *models.ReplicationVerificationReport {
+	pairs := make([]*models.ReplicationVerificationPair, 0, len(r.Pairs))
+	for _, p := range r.Pairs {
+		differences := make([]*models.ReplicationVerificationDifference, 0, len(p.Differences))
+		for _, d := range p.Differences {
+			differences = append(differences, &models.ReplicationVerificationDifference{
+				ID:          d.ID,
+				UpdateTimes: []int64{d.UpdateTimes[0], d.UpdateTimes[1]},
+			})
+		}
+		pairs = append(pairs, &models.ReplicationVerificationPair{
+			Shard:           p.Shard,
+			Nodes:           []string{p.Nodes[0], p.Nodes[1]},
+			Consistent:      p.Consistent,
+			DifferingLeaves: int64(p.DifferingLeaves),
+			Differences:     differences,
+			Truncated:       p.Truncated,
+			Error:           p.Error,
+		})
+	}
+	return &models.ReplicationVerificationReport{
+		Collection: r.Collection,
+		Consistent: r.Consistent,
+		Pairs:      pairs,
+	}
+}
+
+func setupReplicationVerifyHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &replicationVerifyHandlers{
+		verifier:   appState.DB,
+		authorizer: appState.Authorizer,
+		logger:     appState.Logger,
+	}
+	api.ClusterClusterVerifyReplicationHandler = cluster.ClusterVerifyReplicationHandlerFunc(h.verifyReplication)
+}
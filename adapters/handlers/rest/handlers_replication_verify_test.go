@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+type fakeReplicaVerifier struct {
+	called       bool
+	class, shard string
+	limit        int
+	report       *replica.VerificationReport
+	err          error
+}
+
+func (f *fakeReplicaVerifier) VerifyReplicas(_ context.Context, className, shard string, limit int,
+) (*replica.VerificationReport, error) {
+	f.called, f.class, f.shard, f.limit = true, className, shard, limit
+	return f.report, f.err
+}
+
+func TestReplicationVerifyHandler(t *testing.T) {
+	principal := &models.Principal{Username: "viewer"}
+	logger, _ := test.NewNullLogger()
+	newParams := func(shard *string, limit *int64) cluster.ClusterVerifyReplicationParams {
+		return cluster.ClusterVerifyReplicationParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/cluster/replication-verify?collection=Foo", nil),
+			Collection:  "Foo",
+			Shard:       shard,
+			Limit:       limit,
+		}
+	}
+
+	t.Run("forbidden without cluster read permission", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).
+			Return(errors.New("forbidden"))
+		verifier := &fakeReplicaVerifier{}
+		h := &replicationVerifyHandlers{verifier: verifier, authorizer: authorizer, logger: logger}
+
+		res := h.verifyReplication(newParams(nil, nil), principal)
+		assert.IsType(t, &cluster.ClusterVerifyReplicationForbidden{}, res)
+		assert.False(t, verifier.called)
+	})
+
+	t.Run("forbidden without permission to read the objects", func(t *testing.T) {
+		shard := "S1"
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).Return(nil)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.ShardsData("Foo", shard)[0]).
+			Return(errors.New("forbidden"))
+		verifier := &fakeReplicaVerifier{}
+		h := &replicationVerifyHandlers{verifier: verifier, authorizer: authorizer, logger: logger}
+
+		res := h.verifyReplication(newParams(&shard, nil), principal)
+		assert.IsType(t, &cluster.ClusterVerifyReplicationForbidden{}, res)
+		assert.False(t, verifier.called)
+	})
+
+	t.Run("unverifiable collection", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, mock.Anything).Return(nil)
+		verifier := &fakeReplicaVerifier{err: fmt.Errorf("%w: async replication is not enabled for class Foo",
+			replica.ErrVerificationUnavailable)}
+		h := &replicationVerifyHandlers{verifier: verifier, authorizer: authorizer, logger: logger}
+
+		res := h.verifyReplication(newParams(nil, nil), principal)
+		assert.IsType(t, &cluster.ClusterVerifyReplicationUnprocessableEntity{}, res)
+	})
+
+	t.Run("converts the report", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, mock.Anything).Return(nil)
+		verifier := &fakeReplicaVerifier{report: &replica.VerificationReport{
+			Collection: "Foo",
+			Pairs: []replica.ReplicaPairReport{{
+				Shard:           "S1",
+				Nodes:           [2]string{"node-1", "node-2"},
+				DifferingLeaves: 1,
+				Differences: []replica.ObjectDifference{
+					{ID: "00000000-0000-0000-0000-000000000001", UpdateTimes: [2]int64{10, 0}},
+				},
+			}},
+		}}
+		h := &replicationVerifyHandlers{verifier: verifier, authorizer: authorizer, logger: logger}
+
+		shard, limit := "S1", int64(5)
+		res := h.verifyReplication(newParams(&shard, &limit), principal)
+		assert.Equal(t, "Foo", verifier.class)
+		assert.Equal(t, shard, verifier.shard)
+		assert.Equal(t, 5, verifier.limit)
+
+		ok, isOK := res.(*cluster.ClusterVerifyReplicationOK)
+		require.True(t, isOK)
+		assert.Equal(t, &models.ReplicationVerificationReport{
+			Collection: "Foo",
+			Pairs: []*models.ReplicationVerificationPair{{
+				Shard:           "S1",
+				Nodes:           []string{"node-1", "node-2"},
+				DifferingLeaves: 1,
+				Differences: []*models.ReplicationVerificationDifference{
+					{ID: "00000000-0000-0000-0000-000000000001", UpdateTimes: []int64{10, 0}},
+				},
+			}},
+		}, ok.Payload)
+	})
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/cluster/audit"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type schemaAuditLog interface {
+	AuditLog(f audit.Filter) []audit.Entry
+}
+
+type schemaAuditHandlers struct {
+	auditLog   schemaAuditLog
+	authorizer authorization.Authorizer
+}
+
+// getSchemaAudit returns the schema, alias and RBAC commands applied through
+// raft. Entries reveal the issuing principals and the changed role
+// definitions, so reading them requires the permission to read the cluster.
+func (h *schemaAuditHandlers) getSchemaAudit(params cluster.ClusterGetSchemaAuditParams, principal *models.Principal) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	if err := h.authorizer.Authorize(ctx, principal, authorization.READ, authorization.Cluster()); err != nil {
+		return cluster.NewClusterGetSchemaAuditForbidden().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	var filter audit.Filter
+	if params.Class != nil {
+		filter.Class = *params.Class
+	}
+	if params.Principal != nil {
+		filter.Principal = *params.Principal
+	}
+	if params.Command != nil {
+		filter.Command = *params.Command
+	}
+	if params.SinceUnixMs != nil {
+		filter.Since = time.UnixMilli(*params.SinceUnixMs)
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+
+	entries := h.auditLog.AuditLog(filter)
+	resp := &models.SchemaAuditLogResponse{Entries: make([]*models.SchemaAuditEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, schemaAuditEntry(e))
+	}
+	return cluster.NewClusterGetSchemaAuditOK().WithPayload(resp)
+}
+
+func schemaAuditEntry(e audit.Entry) *models.SchemaAuditEntry {
+	changes := make([]*models.SchemaAuditChange, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, &models.SchemaAuditChange{
+			Path:   c.Path,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	return &models.SchemaAuditEntry{
+		Index:      int64(e.Index),
+		TimeUnixMs: e.TimeUnixMs,
+		Principal:  e.Principal,
+		Command:    e.Command,
+		Class:      e.Class,
+		Changes:    changes,
+	}
+}
+
+func setupSchemaAuditHandlers(api *operations.WeaviateAPI, appState *state.State) {
+	h := &schemaAuditHandlers{
+		auditLog:   appState.ClusterService.Raft,
+		authorizer: appState.Authorizer,
+	}
+	api.ClusterClusterGetSchemaAuditHandler = cluster.ClusterGetSchemaAuditHandlerFunc(h.getSchemaAudit)
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/cluster/audit"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type fakeSchemaAuditLog struct {
+	filter  audit.Filter
+	entries []audit.Entry
+}
+
+func (f *fakeSchemaAuditLog) AuditLog(filter audit.Filter) []audit.Entry {
+	f.filter = filter
+	return f.entries
+}
+
+func TestSchemaAuditHandler(t *testing.T) {
+	principal := &models.Principal{Username: "viewer"}
+
+	t.Run("forbidden without cluster read permission", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).
+			Return(errors.New("forbidden"))
+		log := &fakeSchemaAuditLog{}
+		h := &schemaAuditHandlers{auditLog: log, authorizer: authorizer}
+
+		res := h.getSchemaAudit(cluster.ClusterGetSchemaAuditParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/cluster/schema-audit", nil),
+		}, principal)
+		assert.IsType(t, &cluster.ClusterGetSchemaAuditForbidden{}, res)
+		assert.Equal(t, audit.Filter{}, log.filter)
+	})
+
+	t.Run("filters and converts entries", func(t *testing.T) {
+		authorizer := authorization.NewMockAuthorizer(t)
+		authorizer.On("Authorize", mock.Anything, principal, authorization.READ, authorization.Cluster()).Return(nil)
+		log := &fakeSchemaAuditLog{entries: []audit.Entry{{
+			Index:      7,
+			TimeUnixMs: 1700000000000,
+			Principal:  "admin",
+			Command:    "update_class",
+			Class:      "Foo",
+			Changes:    []audit.Change{{Path: "properties[title].indexFilterable", Before: true, After: false}},
+		}}}
+		h := &schemaAuditHandlers{auditLog: log, authorizer: authorizer}
+
+		class, command, user := "Foo", "update_class", "admin"
+		since, limit := int64(1600000000000), int64(10)
+		res := h.getSchemaAudit(cluster.ClusterGetSchemaAuditParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/cluster/schema-audit", nil),
+			Class:       &class,
+			Command:     &command,
+			Principal:   &user,
+			SinceUnixMs: &since,
+			Limit:       &limit,
+		}, principal)
+
+		assert.Equal(t, audit.Filter{
+			Class:     class,
+			Principal: user,
+			Command:   command,
+			Since:     time.UnixMilli(since),
+			Limit:     10,
+		}, log.filter)
+		ok, isOK := res.(*cluster.ClusterGetSchemaAuditOK)
+		require.True(t, isOK)
+		require.Len(t, ok.Payload.Entries, 1)
+		assert.Equal(t, &models.SchemaAuditEntry{
+			Index:      7,
+			TimeUnixMs: 1700000000000,
+			Principal:  "admin",
+			Command:    "update_class",
+			Class:      "Foo",
+			Changes: []*models.SchemaAuditChange{
+				{Path: "properties[title].indexFilterable", Before: true, After: false},
+			},
+		}, ok.Payload.Entries[0])
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/telemetry"
 )
 
@@ -113,9 +114,12 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 			handler = telemetry.ClientTrackingMiddleware(telemeter.GetClientTracker())(handler)
 		}
 		handler = addInjectHeadersIntoContext(handler)
+		handler = addSessionToken(handler)
+		handler = addWriteConcern(handler)
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
 		handler = addSourceIpToContext(handler)
 		handler = addOperationalMode(appState, handler)
+		handler = addCrossClusterFollowerMode(appState.CrossClusterFollower.CheckWritable, handler)
 		// Add OpenTelemetry tracing middleware (only has an effect if tracing is enabled)
 		handler = monitoring.AddTracingToHTTPMiddleware(handler, appState.Logger)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
@@ -284,6 +288,39 @@ func addOperationalMode(state *state.State, next http.Handler) http.Handler {
 	})
 }
 
+// crossClusterFollowerNamespaces are the endpoints rejecting writes while the
+// cluster follows a primary cluster, their data is replicated from the
+// primary.
+var crossClusterFollowerNamespaces = map[string]struct{}{
+	"objects": {},
+	"batch":   {},
+	"schema":  {},
+	"aliases": {},
+}
+
+// addCrossClusterFollowerMode rejects client writes to objects and the schema
+// while checkWritable returns an error, see crosscluster.Follower
+func addCrossClusterFollowerMode(checkWritable func() error, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if config.IsHTTPWrite(r.Method) && isCrossClusterFollowerNamespace(r.URL.Path) {
+			if err := checkWritable(); err != nil {
+				writeOperationalModeErrorResponse(w, err)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isCrossClusterFollowerNamespace(path string) bool {
+	split := strings.Split(path, "/")
+	if len(split) < 3 || split[1] != "v1" {
+		return false
+	}
+	_, ok := crossClusterFollowerNamespaces[split[2]]
+	return ok
+}
+
 func writeOperationalModeErrorResponse(w http.ResponseWriter, err error) {
 	resp := models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: err.Error()}}}
 	data, marshalErr := json.Marshal(resp)
@@ -296,6 +333,85 @@ func writeOperationalModeErrorResponse(w http.ResponseWriter, err error) {
 	w.Write(data)
 }
 
+// addSessionToken attaches the replica session of the request's token to
+// its context and returns the session's updated token once the request was
+// handled, see replica.SessionTokenHeader
+func addSessionToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, err := replica.ParseSessionToken(r.Header.Get(replica.SessionTokenHeader))
+		if err != nil {
+			resp := models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: err.Error()}}}
+			data, _ := json.Marshal(resp)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(data)
+			return
+		}
+
+		ctx := replica.ContextWithSession(r.Context(), session)
+		next.ServeHTTP(&lateHeaderWriter{ResponseWriter: w, setHeader: func(h http.Header) {
+			if token := session.Token(); token != "" {
+				h.Set(replica.SessionTokenHeader, token)
+			}
+		}}, r.WithContext(ctx))
+	})
+}
+
+// addWriteConcern attaches the write concern set by the request's headers to
+// its context and returns the replicas which acknowledged the writes once the
+// request has been handled, see replica.WriteAcksHeader
+func addWriteConcern(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wc, err := replica.ParseWriteConcern(r.Header.Get(replica.WriteAcksHeader), r.Header.Get(replica.WriteTimeoutHeader))
+		if err != nil {
+			resp := models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: err.Error()}}}
+			data, _ := json.Marshal(resp)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(data)
+			return
+		}
+		if wc == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := replica.ContextWithWriteConcern(r.Context(), wc)
+		next.ServeHTTP(&lateHeaderWriter{ResponseWriter: w, setHeader: func(h http.Header) {
+			if acks := wc.Header(); acks != "" {
+				h.Set(replica.WriteAcknowledgementsHeader, acks)
+			}
+		}}, r.WithContext(ctx))
+	})
+}
+
+// lateHeaderWriter calls setHeader right before the response header is
+// written, i.e. after the handler performed its writes
+type lateHeaderWriter struct {
+	http.ResponseWriter
+	setHeader   func(http.Header)
+	wroteHeader bool
+}
+
+func (w *lateHeaderWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.setHeader(w.Header())
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *lateHeaderWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *lateHeaderWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func whitelist(path string, whitelist map[string]struct{}) bool {
 	split := strings.Split(path, "/")
 	root := split[1]
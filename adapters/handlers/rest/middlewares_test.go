@@ -12,14 +12,18 @@
 package rest
 
 import (
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 func Test_staticRoute(t *testing.T) {
@@ -74,3 +78,87 @@ func newRequest(t *testing.T, path string) *http.Request {
 	require.NoError(t, err)
 	return r
 }
+
+func Test_addSessionToken(t *testing.T) {
+	in := replica.NewSession()
+	in.Observe("C1", "S1", 5)
+
+	handler := addSessionToken(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session := replica.SessionFromContext(r.Context())
+		require.NotNil(t, session)
+		assert.Equal(t, int64(5), session.UpdateTime("C1", "S1"))
+		session.Observe("C1", "S2", 7)
+		w.Write([]byte("{}"))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/objects", nil)
+	req.Header.Set(replica.SessionTokenHeader, in.Token())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	out, err := replica.ParseSessionToken(rec.Header().Get(replica.SessionTokenHeader))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), out.UpdateTime("C1", "S1"))
+	assert.Equal(t, int64(7), out.UpdateTime("C1", "S2"))
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+	req.Header.Set(replica.SessionTokenHeader, "not a token!")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_addWriteConcern(t *testing.T) {
+	handler := addWriteConcern(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wc := replica.WriteConcernFromContext(r.Context()); wc != nil {
+			assert.Equal(t, 2, wc.Acks)
+			assert.Equal(t, time.Second, wc.Timeout)
+		}
+		w.Write([]byte("{}"))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/batch/objects", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(replica.WriteAcknowledgementsHeader))
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/batch/objects", nil)
+	req.Header.Set(replica.WriteAcksHeader, "2")
+	req.Header.Set(replica.WriteTimeoutHeader, "1s")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/batch/objects", nil)
+	req.Header.Set(replica.WriteAcksHeader, "all")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_addCrossClusterFollowerMode(t *testing.T) {
+	var writable error
+	handler := addCrossClusterFollowerMode(func() error { return writable },
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("{}"))
+		}))
+	serve := func(method, path string) int {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec.Code
+	}
+
+	writable = errors.New("following")
+	assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodPost, "/v1/objects"))
+	assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodPost, "/v1/batch/objects"))
+	assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodDelete, "/v1/schema/Movies"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/objects"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/graphql"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/cluster/cross-cluster-replication/promote"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1"))
+
+	writable = nil
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/objects"))
+}
@@ -125,6 +125,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BackupsBackupsSchedulesDeleteHandler: backups.BackupsSchedulesDeleteHandlerFunc(func(params backups.BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesDelete has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesListHandler: backups.BackupsSchedulesListHandlerFunc(func(params backups.BackupsSchedulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesList has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesPutHandler: backups.BackupsSchedulesPutHandlerFunc(func(params backups.BackupsSchedulesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesPut has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -143,9 +152,39 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterCancelNodeDrainHandler: cluster.ClusterCancelNodeDrainHandlerFunc(func(params cluster.ClusterCancelNodeDrainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterCancelNodeDrain has not yet been implemented")
+		}),
+		ClusterClusterDrainNodeHandler: cluster.ClusterDrainNodeHandlerFunc(func(params cluster.ClusterDrainNodeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterDrainNode has not yet been implemented")
+		}),
+		ClusterClusterGetCrossClusterReplicationHandler: cluster.ClusterGetCrossClusterReplicationHandlerFunc(func(params cluster.ClusterGetCrossClusterReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetCrossClusterReplication has not yet been implemented")
+		}),
+		ClusterClusterGetNodeDrainHandler: cluster.ClusterGetNodeDrainHandlerFunc(func(params cluster.ClusterGetNodeDrainParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetNodeDrain has not yet been implemented")
+		}),
+		ClusterClusterGetRebalancerPlanHandler: cluster.ClusterGetRebalancerPlanHandlerFunc(func(params cluster.ClusterGetRebalancerPlanParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetRebalancerPlan has not yet been implemented")
+		}),
+		ClusterClusterGetSchemaAuditHandler: cluster.ClusterGetSchemaAuditHandlerFunc(func(params cluster.ClusterGetSchemaAuditParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetSchemaAudit has not yet been implemented")
+		}),
 		ClusterClusterGetStatisticsHandler: cluster.ClusterGetStatisticsHandlerFunc(func(params cluster.ClusterGetStatisticsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterGetStatistics has not yet been implemented")
 		}),
+		ClusterClusterPauseRebalancerHandler: cluster.ClusterPauseRebalancerHandlerFunc(func(params cluster.ClusterPauseRebalancerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterPauseRebalancer has not yet been implemented")
+		}),
+		ClusterClusterPromoteCrossClusterReplicationHandler: cluster.ClusterPromoteCrossClusterReplicationHandlerFunc(func(params cluster.ClusterPromoteCrossClusterReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterPromoteCrossClusterReplication has not yet been implemented")
+		}),
+		ClusterClusterResumeRebalancerHandler: cluster.ClusterResumeRebalancerHandlerFunc(func(params cluster.ClusterResumeRebalancerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterResumeRebalancer has not yet been implemented")
+		}),
+		ClusterClusterVerifyReplicationHandler: cluster.ClusterVerifyReplicationHandlerFunc(func(params cluster.ClusterVerifyReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterVerifyReplication has not yet been implemented")
+		}),
 		AuthzCreateRoleHandler: authz.CreateRoleHandlerFunc(func(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.CreateRole has not yet been implemented")
 		}),
@@ -453,6 +492,12 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreCancelHandler backups.BackupsRestoreCancelHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BackupsBackupsSchedulesDeleteHandler sets the operation handler for the backups schedules delete operation
+	BackupsBackupsSchedulesDeleteHandler backups.BackupsSchedulesDeleteHandler
+	// BackupsBackupsSchedulesListHandler sets the operation handler for the backups schedules list operation
+	BackupsBackupsSchedulesListHandler backups.BackupsSchedulesListHandler
+	// BackupsBackupsSchedulesPutHandler sets the operation handler for the backups schedules put operation
+	BackupsBackupsSchedulesPutHandler backups.BackupsSchedulesPutHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
@@ -465,8 +510,28 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterCancelNodeDrainHandler sets the operation handler for the cluster cancel node drain operation
+	ClusterClusterCancelNodeDrainHandler cluster.ClusterCancelNodeDrainHandler
+	// ClusterClusterDrainNodeHandler sets the operation handler for the cluster drain node operation
+	ClusterClusterDrainNodeHandler cluster.ClusterDrainNodeHandler
+	// ClusterClusterGetCrossClusterReplicationHandler sets the operation handler for the cluster get cross cluster replication operation
+	ClusterClusterGetCrossClusterReplicationHandler cluster.ClusterGetCrossClusterReplicationHandler
+	// ClusterClusterGetNodeDrainHandler sets the operation handler for the cluster get node drain operation
+	ClusterClusterGetNodeDrainHandler cluster.ClusterGetNodeDrainHandler
+	// ClusterClusterGetRebalancerPlanHandler sets the operation handler for the cluster get rebalancer plan operation
+	ClusterClusterGetRebalancerPlanHandler cluster.ClusterGetRebalancerPlanHandler
+	// ClusterClusterGetSchemaAuditHandler sets the operation handler for the cluster get schema audit operation
+	ClusterClusterGetSchemaAuditHandler cluster.ClusterGetSchemaAuditHandler
 	// ClusterClusterGetStatisticsHandler sets the operation handler for the cluster get statistics operation
 	ClusterClusterGetStatisticsHandler cluster.ClusterGetStatisticsHandler
+	// ClusterClusterPauseRebalancerHandler sets the operation handler for the cluster pause rebalancer operation
+	ClusterClusterPauseRebalancerHandler cluster.ClusterPauseRebalancerHandler
+	// ClusterClusterPromoteCrossClusterReplicationHandler sets the operation handler for the cluster promote cross cluster replication operation
+	ClusterClusterPromoteCrossClusterReplicationHandler cluster.ClusterPromoteCrossClusterReplicationHandler
+	// ClusterClusterResumeRebalancerHandler sets the operation handler for the cluster resume rebalancer operation
+	ClusterClusterResumeRebalancerHandler cluster.ClusterResumeRebalancerHandler
+	// ClusterClusterVerifyReplicationHandler sets the operation handler for the cluster verify replication operation
+	ClusterClusterVerifyReplicationHandler cluster.ClusterVerifyReplicationHandler
 	// AuthzCreateRoleHandler sets the operation handler for the create role operation
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// UsersCreateUserHandler sets the operation handler for the create user operation
@@ -751,6 +816,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BackupsBackupsSchedulesDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesDeleteHandler")
+	}
+	if o.BackupsBackupsSchedulesListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesListHandler")
+	}
+	if o.BackupsBackupsSchedulesPutHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesPutHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -769,9 +843,39 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterCancelNodeDrainHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterCancelNodeDrainHandler")
+	}
+	if o.ClusterClusterDrainNodeHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterDrainNodeHandler")
+	}
+	if o.ClusterClusterGetCrossClusterReplicationHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetCrossClusterReplicationHandler")
+	}
+	if o.ClusterClusterGetNodeDrainHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetNodeDrainHandler")
+	}
+	if o.ClusterClusterGetRebalancerPlanHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetRebalancerPlanHandler")
+	}
+	if o.ClusterClusterGetSchemaAuditHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetSchemaAuditHandler")
+	}
 	if o.ClusterClusterGetStatisticsHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterGetStatisticsHandler")
 	}
+	if o.ClusterClusterPauseRebalancerHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterPauseRebalancerHandler")
+	}
+	if o.ClusterClusterPromoteCrossClusterReplicationHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterPromoteCrossClusterReplicationHandler")
+	}
+	if o.ClusterClusterResumeRebalancerHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterResumeRebalancerHandler")
+	}
+	if o.ClusterClusterVerifyReplicationHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterVerifyReplicationHandler")
+	}
 	if o.AuthzCreateRoleHandler == nil {
 		unregistered = append(unregistered, "authz.CreateRoleHandler")
 	}
@@ -1163,6 +1267,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/backups/{backend}/{id}/restore"] = backups.NewBackupsRestoreStatus(o.context, o.BackupsBackupsRestoreStatusHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backups/schedules/{id}"] = backups.NewBackupsSchedulesDelete(o.context, o.BackupsBackupsSchedulesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/schedules"] = backups.NewBackupsSchedulesList(o.context, o.BackupsBackupsSchedulesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/backups/schedules/{id}"] = backups.NewBackupsSchedulesPut(o.context, o.BackupsBackupsSchedulesPutHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1187,6 +1303,30 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/classifications"] = classifications.NewClassificationsPost(o.context, o.ClassificationsClassificationsPostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterCancelNodeDrain(o.context, o.ClusterClusterCancelNodeDrainHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterDrainNode(o.context, o.ClusterClusterDrainNodeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/cross-cluster-replication"] = cluster.NewClusterGetCrossClusterReplication(o.context, o.ClusterClusterGetCrossClusterReplicationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/nodes/{nodeName}/drain"] = cluster.NewClusterGetNodeDrain(o.context, o.ClusterClusterGetNodeDrainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/rebalancer/plan"] = cluster.NewClusterGetRebalancerPlan(o.context, o.ClusterClusterGetRebalancerPlanHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/schema-audit"] = cluster.NewClusterGetSchemaAudit(o.context, o.ClusterClusterGetSchemaAuditHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1194,6 +1334,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/rebalancer/pause"] = cluster.NewClusterPauseRebalancer(o.context, o.ClusterClusterPauseRebalancerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/cross-cluster-replication/promote"] = cluster.NewClusterPromoteCrossClusterReplication(o.context, o.ClusterClusterPromoteCrossClusterReplicationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/rebalancer/resume"] = cluster.NewClusterResumeRebalancer(o.context, o.ClusterClusterResumeRebalancerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/replication-verify"] = cluster.NewClusterVerifyReplication(o.context, o.ClusterClusterVerifyReplicationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/roles"] = authz.NewCreateRole(o.context, o.AuthzCreateRoleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -27,6 +27,8 @@ import (
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/fsm"
+	"github.com/weaviate/weaviate/cluster/replication/crosscluster"
+	"github.com/weaviate/weaviate/cluster/replication/rebalancer"
 	grpcconn "github.com/weaviate/weaviate/grpc/conn"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
@@ -92,6 +94,8 @@ type State struct {
 	ObjectTTLCoordinator *objectttl.Coordinator
 
 	DistributedTaskScheduler *distributedtask.Scheduler
+	Rebalancer               *rebalancer.Rebalancer
+	CrossClusterFollower     *crosscluster.Follower
 	Migrator                 *db.Migrator
 
 	GRPCConnManager *grpcconn.ConnManager
@@ -140,6 +140,8 @@ func (a *Aggregator) aggTypeOfProperty(
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
 		return "", "", fmt.Errorf("dataType phoneNumber can't be aggregated")
+	case schema.DataTypeSparseVector:
+		return "", "", fmt.Errorf("dataType sparseVector can't be aggregated")
 	default:
 		return "", "", fmt.Errorf("unrecoginzed dataType %v", schemaProp.DataType[0])
 	}
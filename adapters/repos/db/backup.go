@@ -12,15 +12,22 @@
 package db
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"sync"
 	"time"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/sharding"
 
 	"github.com/weaviate/weaviate/entities/diskio"
@@ -176,6 +183,115 @@ func (db *DB) ListClasses(ctx context.Context) []string {
 	return classNames
 }
 
+// RenameClass sets the class name stored in the objects of every shard found
+// in indexDir to class. It is called on restored files before they are moved
+// into the data directory, when a class is restored under a new name.
+func (db *DB) RenameClass(ctx context.Context, indexDir, class string) error {
+	entries, err := os.ReadDir(indexDir)
+	if err != nil {
+		return fmt.Errorf("read index dir %s: %w", indexDir, err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		shardDir := shardPath(indexDir, e.Name())
+		if err := db.renameShardObjects(ctx, shardDir, class); err != nil {
+			return fmt.Errorf("shard %s: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (db *DB) renameShardObjects(ctx context.Context, shardDir, class string) (err error) {
+	lsmDir := path.Join(shardDir, "lsm")
+	if _, err := os.Stat(path.Join(lsmDir, helpers.ObjectsBucketLSM)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	noop := cyclemanager.NewCallbackGroupNoop()
+	store, err := lsmkv.New(lsmDir, shardDir, db.logger, nil, db.bucketLoadLimiter, noop, noop, noop)
+	if err != nil {
+		return fmt.Errorf("init lsmkv store at %s: %w", lsmDir, err)
+	}
+	defer func() {
+		if serr := store.Shutdown(ctx); serr != nil && err == nil {
+			err = fmt.Errorf("shutdown lsmkv store at %s: %w", lsmDir, serr)
+		}
+	}()
+
+	if err := store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithSecondaryIndices(1),
+		lsmkv.WithKeepTombstones(true),
+		lsmkv.WithCalcCountNetAdditions(true),
+	); err != nil {
+		return fmt.Errorf("load objects bucket: %w", err)
+	}
+
+	return renameObjects(store.Bucket(helpers.ObjectsBucketLSM), class)
+}
+
+// renameObjects rewrites the objects of bucket which are stored under another
+// class name. The memtable is flushed whenever it reaches the bucket's
+// threshold, the cursor is reopened afterwards as it blocks flushing.
+func renameObjects(bucket *lsmkv.Bucket, class string) error {
+	threshold := bucket.GetMemtableThreshold()
+	var next []byte
+	for {
+		var written uint64
+		cursor := bucket.Cursor()
+		var k, v []byte
+		if next == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(next)
+		}
+		for ; k != nil && written < threshold; k, v = cursor.Next() {
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				cursor.Close()
+				return fmt.Errorf("unmarshal object %x: %w", k, err)
+			}
+			if string(obj.Class()) == class {
+				continue
+			}
+			obj.Object.Class = class
+			data, err := obj.MarshalBinary()
+			if err != nil {
+				cursor.Close()
+				return fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+			}
+			docID := make([]byte, 8)
+			binary.LittleEndian.PutUint64(docID, obj.DocID)
+			if err := bucket.Put(bytes.Clone(k), data,
+				lsmkv.WithSecondaryKey(helpers.ObjectsBucketLSMDocIDSecondaryIndex, docID),
+			); err != nil {
+				cursor.Close()
+				return fmt.Errorf("put object %s: %w", obj.ID(), err)
+			}
+			written += uint64(len(k) + len(data))
+		}
+		next = bytes.Clone(k)
+		cursor.Close()
+
+		if written > 0 {
+			if err := bucket.FlushAndSwitch(); err != nil {
+				return fmt.Errorf("flush objects bucket: %w", err)
+			}
+		}
+		if next == nil {
+			return nil
+		}
+	}
+}
+
 // descriptor record everything needed to restore a class
 func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.ClassDescriptor) (err error) {
 	if err := i.initBackup(backupID); err != nil {
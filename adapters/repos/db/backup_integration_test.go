@@ -23,14 +23,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -272,10 +275,58 @@ func TestBackup_BucketLevel(t *testing.T) {
 	})
 }
 
+func TestBackup_RenameClass(t *testing.T) {
+	ctx := testCtx()
+	sourceDir, targetDir := t.TempDir(), t.TempDir()
+	from, to := "RenameSourceClass", "RenameTargetClass"
+	ids := []strfmt.UUID{
+		"ff9fcae5-57b8-431c-b8e2-986fd78f5809",
+		"3b1d0b9c-44b6-4c3c-9a55-0f38e1e1b3a1",
+	}
+
+	// both databases share the shard name
+	shardState := singleShardState()
+	db := setupTestDBWithShardState(t, sourceDir, shardState, makeTestClass(from))
+	for _, id := range ids {
+		require.Nil(t, db.PutObject(ctx, &models.Object{
+			Class:      from,
+			ID:         id,
+			Properties: map[string]interface{}{"stringProp": "restored"},
+		}, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+	require.Nil(t, db.Shutdown(ctx))
+
+	// restored files of the renamed class are laid out under its new index id
+	indexDir := path.Join(targetDir, indexID(schema.ClassName(to)))
+	require.Nil(t, os.CopyFS(indexDir, os.DirFS(path.Join(sourceDir, indexID(schema.ClassName(from))))))
+	require.Nil(t, db.RenameClass(ctx, indexDir, to))
+
+	restored := setupTestDBWithShardState(t, targetDir, shardState, makeTestClass(to))
+	defer func() {
+		require.Nil(t, restored.Shutdown(context.Background()))
+	}()
+
+	for _, id := range ids {
+		res, err := restored.Object(ctx, to, id, search.SelectProperties{}, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, to, res.ClassName)
+		assert.Equal(t, "restored", res.Schema.(map[string]interface{})["stringProp"])
+	}
+
+	res, err := restored.ObjectsByID(ctx, ids[0], search.SelectProperties{}, additional.Properties{}, "")
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, to, res[0].ClassName)
+}
+
 func setupTestDB(t *testing.T, rootDir string, classes ...*models.Class) *DB {
+	return setupTestDBWithShardState(t, rootDir, singleShardState(), classes...)
+}
+
+func setupTestDBWithShardState(t *testing.T, rootDir string, shardState *sharding.State, classes ...*models.Class) *DB {
 	logger, _ := test.NewNullLogger()
 
-	shardState := singleShardState()
 	schemaGetter := &fakeSchemaGetter{
 		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
 		shardState: shardState,
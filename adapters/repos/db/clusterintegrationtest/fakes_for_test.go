@@ -398,7 +398,7 @@ func (f *fakeBackupBackend) HomeDir(backupID, overrideBucket, overridePath strin
 	}
 }
 
-func (f *fakeBackupBackend) AllBackups(context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (f *fakeBackupBackend) AllBackups(context.Context, string, string) ([]*backup.DistributedBackupDescriptor, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
@@ -444,6 +444,12 @@ func (f *fakeBackupBackend) Read(ctx context.Context, backupID, key, overrideBuc
 	return 0, nil
 }
 
+func (f *fakeBackupBackend) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) SourceDataPath() string {
 	f.Lock()
 	defer f.Unlock()
@@ -0,0 +1,335 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+)
+
+// PullFromPrimary makes the local replica of a shard mirror the replica of
+// the same shard held by a node of the primary cluster. The hosts are tried
+// in order until one of them succeeds. The primary always wins: objects that
+// differ are overwritten with the primary's version and objects the primary
+// does not have are deleted locally.
+//
+// At most ASYNC_REPLICATION_PROPAGATION_LIMIT objects are changed per call,
+// a caller is expected to call again until nothing is left to pull.
+func (db *DB) PullFromPrimary(ctx context.Context, className, shardName string,
+	hosts []string,
+) (pulled, deleted int, err error) {
+	index, pr := db.replicatedIndex(className)
+	if pr != nil {
+		return 0, 0, pr.FirstError()
+	}
+
+	if len(hosts) == 0 {
+		return 0, 0, fmt.Errorf("no primary host holds shard %q", shardName)
+	}
+
+	for _, host := range hosts {
+		pulled, deleted, err = index.pullFromPrimary(ctx, db.replicaClient, shardName, host)
+		if err == nil || ctx.Err() != nil {
+			return pulled, deleted, err
+		}
+	}
+	return pulled, deleted, err
+}
+
+func (i *Index) pullFromPrimary(ctx context.Context, client replica.Client,
+	shardName, host string,
+) (pulled, deleted int, err error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return 0, 0, err
+	}
+	if shard == nil {
+		return 0, 0, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	defer release()
+
+	ranges, err := i.primaryDiffRanges(ctx, client, shard, host)
+	if err != nil {
+		return 0, 0, fmt.Errorf("compare with %q: %w", host, err)
+	}
+
+	limit := i.AsyncReplicationConfig().propagationLimit
+
+	for _, r := range ranges {
+		p, d, err := i.pullRangeFromPrimary(ctx, client, shard, host, r, limit-pulled-deleted)
+		pulled += p
+		deleted += d
+		if err != nil {
+			return pulled, deleted, fmt.Errorf("pull from %q: %w", host, err)
+		}
+		if pulled+deleted >= limit {
+			break
+		}
+	}
+	return pulled, deleted, nil
+}
+
+// uuidRange is an inclusive range of object ids.
+type uuidRange struct {
+	from, to strfmt.UUID
+}
+
+// primaryDiffRanges returns the ranges of object ids in which the local shard
+// differs from the primary's. Shards without a hashtree are compared as a
+// whole.
+func (i *Index) primaryDiffRanges(ctx context.Context, client replica.Client,
+	shard ShardLike, host string,
+) ([]uuidRange, error) {
+	if !i.AsyncReplicationEnabled() {
+		return leafRanges(hashtree.NewBitset(1).Set(0), 0), nil
+	}
+
+	height := i.AsyncReplicationConfig().hashtreeHeight
+
+	diff := hashtree.NewBitset(hashtree.NodesCount(height))
+	diff.Set(0)
+
+	for l := 0; l <= height; l++ {
+		local, err := shard.HashTreeLevel(ctx, l, diff)
+		if err != nil {
+			return nil, fmt.Errorf("local hashtree level %d: %w", l, err)
+		}
+
+		remote, err := client.HashTreeLevel(ctx, host, i.Config.ClassName.String(), shard.Name(), l, diff)
+		if err != nil {
+			return nil, fmt.Errorf("remote hashtree level %d: %w", l, err)
+		}
+
+		if len(remote) != len(local) {
+			return nil, fmt.Errorf("hashtree level %d: got %d remote digests, expected %d",
+				l, len(remote), len(local))
+		}
+
+		if hashtree.LevelDiff(l, diff, local, remote) == 0 {
+			return nil, nil
+		}
+	}
+
+	return leafRanges(diff, height), nil
+}
+
+// leafRanges turns the set leaves of a hashtree of the given height into
+// ranges of object ids, merging adjacent leaves.
+func leafRanges(diff *hashtree.Bitset, height int) []uuidRange {
+	var ranges []uuidRange
+
+	firstLeaf := hashtree.InnerNodesCount(height)
+	leaves := hashtree.LeavesCount(height)
+
+	for leaf := 0; leaf < leaves; leaf++ {
+		if !diff.IsSet(firstLeaf + leaf) {
+			continue
+		}
+		last := leaf
+		for last+1 < leaves && diff.IsSet(firstLeaf+last+1) {
+			last++
+		}
+		ranges = append(ranges, leafRange(uint64(leaf), uint64(last), height))
+		leaf = last
+	}
+	return ranges
+}
+
+func leafRange(initialLeaf, finalLeaf uint64, height int) uuidRange {
+	shift := 64 - height
+
+	from := make([]byte, 16)
+	binary.BigEndian.PutUint64(from, initialLeaf<<shift)
+
+	to := make([]byte, 16)
+	binary.BigEndian.PutUint64(to, finalLeaf<<shift|((1<<shift)-1))
+	copy(to[8:], bytes.Repeat([]byte{0xff}, 8))
+
+	fromID, _ := uuidFromBytes(from)
+	toID, _ := uuidFromBytes(to)
+	return uuidRange{from: fromID, to: toID}
+}
+
+// pullRangeFromPrimary walks the range page by page, pulling objects that
+// are missing or differ locally and deleting those the primary does not
+// have.
+func (i *Index) pullRangeFromPrimary(ctx context.Context, client replica.Client,
+	shard ShardLike, host string, r uuidRange, limit int,
+) (pulled, deleted int, err error) {
+	cfg := i.AsyncReplicationConfig()
+	className := i.Config.ClassName.String()
+
+	cursor := r.from
+	for limit > pulled+deleted {
+		remote, err := client.DigestObjectsInRange(ctx, host, className, shard.Name(), cursor, r.to, cfg.diffBatchSize)
+		if err != nil {
+			return pulled, deleted, fmt.Errorf("remote digests: %w", err)
+		}
+
+		upper := r.to
+		if len(remote) == cfg.diffBatchSize {
+			upper = strfmt.UUID(remote[len(remote)-1].ID)
+		}
+
+		local := make(map[string]int64)
+		for from := cursor; ; {
+			page, err := shard.ObjectDigestsInRange(ctx, from, upper, cfg.diffBatchSize)
+			if err != nil {
+				return pulled, deleted, fmt.Errorf("local digests: %w", err)
+			}
+			for _, d := range page {
+				local[d.ID] = d.UpdateTime
+			}
+			if len(page) < cfg.diffBatchSize {
+				break
+			}
+			next, ok := nextUUID(strfmt.UUID(page[len(page)-1].ID))
+			if !ok {
+				break
+			}
+			from = next
+		}
+
+		var toPull []strfmt.UUID
+		for _, d := range remote {
+			if t, ok := local[d.ID]; !ok || t != d.UpdateTime {
+				toPull = append(toPull, strfmt.UUID(d.ID))
+			}
+			delete(local, d.ID)
+		}
+		toDelete := make([]strfmt.UUID, 0, len(local))
+		for id := range local {
+			toDelete = append(toDelete, strfmt.UUID(id))
+		}
+
+		if budget := limit - pulled - deleted; len(toPull) > budget {
+			toPull = toPull[:budget]
+		}
+		for start := 0; start < len(toPull); start += cfg.propagationBatchSize {
+			end := min(start+cfg.propagationBatchSize, len(toPull))
+			n, d, err := i.pullObjectsFromPrimary(ctx, client, shard, host, toPull[start:end])
+			pulled += n
+			deleted += d
+			if err != nil {
+				return pulled, deleted, err
+			}
+		}
+
+		if budget := limit - pulled - deleted; len(toDelete) > budget {
+			toDelete = toDelete[:budget]
+		}
+		if len(toDelete) > 0 {
+			d, err := i.deleteObjectsMissingOnPrimary(ctx, client, shard, host, toDelete)
+			deleted += d
+			if err != nil {
+				return pulled, deleted, err
+			}
+		}
+
+		if upper == r.to {
+			break
+		}
+		next, ok := nextUUID(upper)
+		if !ok {
+			break
+		}
+		cursor = next
+	}
+
+	return pulled, deleted, nil
+}
+
+func (i *Index) pullObjectsFromPrimary(ctx context.Context, client replica.Client,
+	shard ShardLike, host string, ids []strfmt.UUID,
+) (pulled, deleted int, err error) {
+	replicas, err := client.FetchObjects(ctx, host, i.Config.ClassName.String(), shard.Name(), ids)
+	if err != nil {
+		return 0, 0, fmt.Errorf("fetch objects: %w", err)
+	}
+
+	objs := make([]*storobj.Object, 0, len(replicas))
+	for _, r := range replicas {
+		if r.Deleted || r.Object == nil {
+			deletionTime := time.Now()
+			if r.LastUpdateTimeUnixMilli > 0 {
+				deletionTime = time.UnixMilli(r.LastUpdateTimeUnixMilli)
+			}
+			if err := shard.DeleteObject(ctx, r.ID, deletionTime); err != nil {
+				return pulled, deleted, fmt.Errorf("delete object %s: %w", r.ID, err)
+			}
+			deleted++
+			continue
+		}
+		objs = append(objs, r.Object)
+	}
+
+	for j, err := range shard.PutObjectBatch(ctx, objs) {
+		if err != nil {
+			return pulled, deleted, fmt.Errorf("put object %s: %w", objs[j].ID(), err)
+		}
+		pulled++
+	}
+	return pulled, deleted, nil
+}
+
+// deleteObjectsMissingOnPrimary deletes local objects the primary did not
+// list, using the primary's deletion time when it kept a tombstone.
+func (i *Index) deleteObjectsMissingOnPrimary(ctx context.Context, client replica.Client,
+	shard ShardLike, host string, ids []strfmt.UUID,
+) (deleted int, err error) {
+	digests, err := client.DigestObjects(ctx, host, i.Config.ClassName.String(), shard.Name(), ids, 0)
+	if err != nil {
+		return 0, fmt.Errorf("remote digests: %w", err)
+	}
+
+	for j, id := range ids {
+		deletionTime := time.Now()
+		if j < len(digests) {
+			d := digests[j]
+			if !d.Deleted && d.UpdateTime != 0 {
+				// created on the primary in the meantime, pulled next time
+				continue
+			}
+			if d.Deleted && d.UpdateTime > 0 {
+				deletionTime = time.UnixMilli(d.UpdateTime)
+			}
+		}
+		if err := shard.DeleteObject(ctx, id, deletionTime); err != nil {
+			return deleted, fmt.Errorf("delete object %s: %w", id, err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// nextUUID returns the id following the given one in lexical order, ok is
+// false if there is none.
+func nextUUID(id strfmt.UUID) (next strfmt.UUID, ok bool) {
+	b, err := bytesFromUUID(id)
+	if err != nil {
+		return "", false
+	}
+	if overflow := incToNextLexValue(b); overflow {
+		return "", false
+	}
+	next, err = uuidFromBytes(b)
+	return next, err == nil
+}
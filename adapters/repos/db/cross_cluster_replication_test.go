@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+)
+
+func TestLeafRanges(t *testing.T) {
+	t.Run("whole id space", func(t *testing.T) {
+		ranges := leafRanges(hashtree.NewBitset(1).Set(0), 0)
+		require.Len(t, ranges, 1)
+		assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000000"), ranges[0].from)
+		assert.Equal(t, strfmt.UUID("ffffffff-ffff-ffff-ffff-ffffffffffff"), ranges[0].to)
+	})
+
+	t.Run("adjacent leaves are merged", func(t *testing.T) {
+		height := 2
+		diff := hashtree.NewBitset(hashtree.NodesCount(height))
+		firstLeaf := hashtree.InnerNodesCount(height)
+		diff.Set(firstLeaf + 0).Set(firstLeaf + 1).Set(firstLeaf + 3)
+
+		ranges := leafRanges(diff, height)
+		require.Len(t, ranges, 2)
+		assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000000"), ranges[0].from)
+		assert.Equal(t, strfmt.UUID("7fffffff-ffff-ffff-ffff-ffffffffffff"), ranges[0].to)
+		assert.Equal(t, strfmt.UUID("c0000000-0000-0000-0000-000000000000"), ranges[1].from)
+		assert.Equal(t, strfmt.UUID("ffffffff-ffff-ffff-ffff-ffffffffffff"), ranges[1].to)
+	})
+
+	t.Run("nothing differs", func(t *testing.T) {
+		assert.Empty(t, leafRanges(hashtree.NewBitset(hashtree.NodesCount(3)), 3))
+	})
+}
+
+func TestNextUUID(t *testing.T) {
+	next, ok := nextUUID("00000000-0000-0000-0000-0000000000ff")
+	require.True(t, ok)
+	assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000100"), next)
+
+	_, ok = nextUUID("ffffffff-ffff-ffff-ffff-ffffffffffff")
+	assert.False(t, ok)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -393,6 +394,12 @@ func (f *FakeRemoteClient) GetShardStatus(ctx context.Context,
 	return "", nil
 }
 
+func (f *FakeRemoteClient) ReadChanges(ctx context.Context,
+	hostName, indexName, shardName string, after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	return nil, nil
+}
+
 func (f *FakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 	targetStatus string, schemaVersion uint64,
 ) error {
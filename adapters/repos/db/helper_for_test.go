@@ -401,6 +401,7 @@ func setupTestShardWithSettings(t *testing.T, ctx context.Context, class *models
 		localNodeName,
 		getDeletionStrategy,
 		repClient,
+		nil,
 		monitoring.GetMetrics(),
 		logger,
 	)
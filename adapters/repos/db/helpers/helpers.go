@@ -27,6 +27,7 @@ var (
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
 	VectorsCompressedBucketLSM = "vectors_compressed"
+	ChangeLogBucketLSM         = "changelog"
 )
 
 const ObjectsBucketLSMDocIDSecondaryIndex int = 0
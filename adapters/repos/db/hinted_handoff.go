@@ -0,0 +1,370 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const hintsBucket = "hints"
+
+// hintStore is a node-wide lsmkv store of the hints recorded by the
+// coordinator of replicated writes. Keys are made of class, node, shard
+// and object id, so that hints of a class can be read in order of
+// node and shard. Values hold the creation time of a hint.
+type hintStore struct {
+	sync.Mutex
+	store           *lsmkv.Store
+	bucket          *lsmkv.Bucket
+	flushCycle      cyclemanager.CycleManager
+	compactionCycle cyclemanager.CycleManager
+	count           int // number of hints in the bucket
+	maxHints        int
+	closed          bool
+}
+
+var errHintStoreClosed = errors.New("hint store is closed")
+
+func newHintStore(ctx context.Context, rootPath string, maxHints int,
+	logger logrus.FieldLogger,
+) (*hintStore, error) {
+	flushCallbacks := cyclemanager.NewCallbackGroup("hints/flush", logger, 1)
+	compactionCallbacks := cyclemanager.NewCallbackGroup("hints/compaction", logger, 1)
+	s := &hintStore{
+		flushCycle: cyclemanager.NewManager(cyclemanager.MemtableFlushCycleTicker(),
+			flushCallbacks.CycleCallback, logger),
+		compactionCycle: cyclemanager.NewManager(cyclemanager.CompactionCycleTicker(),
+			compactionCallbacks.CycleCallback, logger),
+		maxHints: maxHints,
+	}
+
+	dir := filepath.Join(rootPath, "hints")
+	store, err := lsmkv.New(dir, rootPath, logger, nil, nil,
+		compactionCallbacks, cyclemanager.NewCallbackGroupNoop(), flushCallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("init lsmkv store at %s: %w", dir, err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, hintsBucket, lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		store.Shutdown(ctx)
+		return nil, fmt.Errorf("create hints bucket: %w", err)
+	}
+	s.store = store
+	s.bucket = store.Bucket(hintsBucket)
+
+	c := s.bucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		s.count++
+	}
+	c.Close()
+
+	s.flushCycle.Start()
+	s.compactionCycle.Start()
+	return s, nil
+}
+
+func (s *hintStore) Add(hints []replica.Hint) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return 0, errHintStoreClosed
+	}
+
+	stored := 0
+	for _, h := range hints {
+		key, err := hintKey(h)
+		if err != nil {
+			return stored, err
+		}
+		v, err := s.bucket.Get(key)
+		if err != nil {
+			return stored, fmt.Errorf("get hint: %w", err)
+		}
+		if v == nil && s.count >= s.maxHints {
+			continue
+		}
+		if err := s.bucket.Put(key, binary.BigEndian.AppendUint64(nil, uint64(h.Created))); err != nil {
+			return stored, fmt.Errorf("put hint: %w", err)
+		}
+		if v == nil {
+			s.count++
+		}
+		stored++
+	}
+	return stored, nil
+}
+
+func (s *hintStore) Hints(class string, after *replica.Hint, limit int) ([]replica.Hint, error) {
+	prefix := append([]byte(class), 0)
+	var c *lsmkv.CursorReplace
+	var k, v []byte
+	if after != nil {
+		from, err := hintKey(*after)
+		if err != nil {
+			return nil, err
+		}
+		c = s.bucket.Cursor()
+		k, v = c.Seek(from)
+		if bytes.Equal(k, from) {
+			k, v = c.Next()
+		}
+	} else {
+		c = s.bucket.Cursor()
+		k, v = c.Seek(prefix)
+	}
+	defer c.Close()
+
+	var hints []replica.Hint
+	for ; k != nil && bytes.HasPrefix(k, prefix) && len(hints) < limit; k, v = c.Next() {
+		h, err := parseHint(k, v)
+		if err != nil {
+			return nil, err
+		}
+		hints = append(hints, h)
+	}
+	return hints, nil
+}
+
+func (s *hintStore) Delete(hints []replica.Hint) error {
+	_, err := s.delete(hints)
+	return err
+}
+
+// delete removes hints unless they have been renewed since they were read
+// and returns how many were removed
+func (s *hintStore) delete(hints []replica.Hint) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return 0, errHintStoreClosed
+	}
+
+	deleted := 0
+	for _, h := range hints {
+		key, err := hintKey(h)
+		if err != nil {
+			return deleted, err
+		}
+		v, err := s.bucket.Get(key)
+		if err != nil {
+			return deleted, fmt.Errorf("get hint: %w", err)
+		}
+		if v == nil {
+			continue
+		}
+		// a newer write may have been missed since the hint was read
+		if int64(binary.BigEndian.Uint64(v)) > h.Created {
+			continue
+		}
+		if err := s.bucket.Delete(key); err != nil {
+			return deleted, fmt.Errorf("delete hint: %w", err)
+		}
+		s.count--
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (s *hintStore) Expire(createdBefore int64) (int, error) {
+	return s.deleteMatching(nil, func(h replica.Hint) bool {
+		return h.Created < createdBefore
+	})
+}
+
+func (s *hintStore) DeleteClass(class string) (int, error) {
+	return s.deleteMatching(append([]byte(class), 0), func(replica.Hint) bool {
+		return true
+	})
+}
+
+// deleteMatching removes the hints with keys starting with prefix for which
+// match returns true
+func (s *hintStore) deleteMatching(prefix []byte, match func(replica.Hint) bool) (int, error) {
+	var hints []replica.Hint
+	c := s.bucket.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		h, err := parseHint(k, v)
+		if err != nil {
+			c.Close()
+			return 0, err
+		}
+		if match(h) {
+			hints = append(hints, h)
+		}
+	}
+	c.Close()
+	return s.delete(hints)
+}
+
+func (s *hintStore) Len() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.count
+}
+
+// Shutdown closes the store, hints added afterwards are rejected
+func (s *hintStore) Shutdown(ctx context.Context) error {
+	s.Lock()
+	s.closed = true
+	s.Unlock()
+
+	if err := s.flushCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop flush cycle: %w", err)
+	}
+	if err := s.compactionCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop compaction cycle: %w", err)
+	}
+	return s.store.Shutdown(ctx)
+}
+
+func hintKey(h replica.Hint) ([]byte, error) {
+	id, err := uuid.Parse(h.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid hint id %q: %w", h.ID, err)
+	}
+	key := make([]byte, 0, len(h.Class)+len(h.Node)+len(h.Shard)+3+len(id))
+	key = append(append(key, h.Class...), 0)
+	key = append(append(key, h.Node...), 0)
+	key = append(append(key, h.Shard...), 0)
+	return append(key, id[:]...), nil
+}
+
+func parseHint(k, v []byte) (replica.Hint, error) {
+	if len(k) < 16+3 || len(v) != 8 {
+		return replica.Hint{}, fmt.Errorf("malformed hint %x", k)
+	}
+	parts := bytes.SplitN(k[:len(k)-16], []byte{0}, 4)
+	if len(parts) != 4 {
+		return replica.Hint{}, fmt.Errorf("malformed hint %x", k)
+	}
+	id, err := uuid.FromBytes(k[len(k)-16:])
+	if err != nil {
+		return replica.Hint{}, fmt.Errorf("malformed hint %x: %w", k, err)
+	}
+	return replica.Hint{
+		Class:   string(parts[0]),
+		Node:    string(parts[1]),
+		Shard:   string(parts[2]),
+		ID:      strfmt.UUID(id.String()),
+		Created: int64(binary.BigEndian.Uint64(v)),
+	}, nil
+}
+
+// initHintedHandoff opens the hint store and starts replaying hints, if
+// hinted handoff is enabled
+func (db *DB) initHintedHandoff(ctx context.Context) error {
+	cfg := db.config.HintedHandoff
+	if !cfg.Enabled {
+		return nil
+	}
+	store, err := newHintStore(ctx, db.config.RootPath, cfg.MaxHints, db.logger)
+	if err != nil {
+		return fmt.Errorf("init hint store: %w", err)
+	}
+	hints, err := replica.NewHintedHandoff(store, cfg.TTL, db.promMetrics, db.logger)
+	if err != nil {
+		store.Shutdown(ctx)
+		return err
+	}
+	db.hintStore = store
+	db.hintedHandoff = hints
+	db.hintsReplayStop = make(chan struct{})
+	db.hintsReplayDone = make(chan struct{})
+
+	enterrors.GoWrapper(func() {
+		defer close(db.hintsReplayDone)
+		t := time.NewTicker(cfg.ReplayInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-db.hintsReplayStop:
+				return
+			case <-t.C:
+				db.replayHints()
+			}
+		}
+	}, db.logger)
+	return nil
+}
+
+func (db *DB) replayHints() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	enterrors.GoWrapper(func() {
+		select {
+		case <-db.hintsReplayStop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}, db.logger)
+
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indices = append(indices, index)
+	}
+	db.indexLock.RUnlock()
+
+	// hints of dropped classes are not replayed, they only expire
+	if err := db.hintedHandoff.Expire(); err != nil {
+		db.logger.WithField("action", "hinted_handoff_expire").Error(err)
+	}
+
+	for _, index := range indices {
+		if err := index.replicator.ReplayHints(ctx); err != nil && ctx.Err() == nil {
+			db.logger.WithField("action", "hinted_handoff_replay").
+				WithField("class", index.Config.ClassName).Error(err)
+		}
+	}
+}
+
+// dropClassHints removes the hints of a dropped class
+func (db *DB) dropClassHints(className string) {
+	if db.hintedHandoff == nil {
+		return
+	}
+	if err := db.hintedHandoff.DropClass(className); err != nil {
+		db.logger.WithField("action", "hinted_handoff_drop_class").
+			WithField("class", className).Error(err)
+	}
+}
+
+// shutdownHintedHandoff stops recording and replaying hints and closes the
+// hint store. Indexes are shut down afterwards, writes they still coordinate
+// do not record hints anymore.
+func (db *DB) shutdownHintedHandoff(ctx context.Context) error {
+	if db.hintStore == nil {
+		return nil
+	}
+	db.hintedHandoff.Stop()
+	close(db.hintsReplayStop)
+	<-db.hintsReplayDone
+	if err := db.hintStore.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown hint store: %w", err)
+	}
+	return nil
+}
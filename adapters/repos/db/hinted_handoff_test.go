@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestHintStore(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	var (
+		id1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		id2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+		id3 = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+	)
+	hint := func(class, node, shard string, id strfmt.UUID, created int64) replica.Hint {
+		return replica.Hint{Class: class, Node: node, Shard: shard, ID: id, Created: created}
+	}
+
+	s, err := newHintStore(ctx, dir, 4, logger)
+	require.NoError(t, err)
+
+	n, err := s.Add([]replica.Hint{
+		hint("C", "B", "S1", id2, 1),
+		hint("C", "A", "S2", id1, 1),
+		hint("C", "A", "S1", id3, 1),
+		hint("CC", "A", "S1", id1, 1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	t.Run("the store is full", func(t *testing.T) {
+		n, err := s.Add([]replica.Hint{hint("C", "A", "S1", id1, 2)})
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		// existing hints can still be renewed
+		n, err = s.Add([]replica.Hint{hint("C", "A", "S1", id3, 2)})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 4, s.Len())
+	})
+
+	t.Run("hints are ordered by node and shard", func(t *testing.T) {
+		hints, err := s.Hints("C", nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{
+			hint("C", "A", "S1", id3, 2),
+			hint("C", "A", "S2", id1, 1),
+			hint("C", "B", "S1", id2, 1),
+		}, hints)
+
+		hints, err = s.Hints("C", nil, 2)
+		require.NoError(t, err)
+		require.Len(t, hints, 2)
+		hints, err = s.Hints("C", &hints[1], 2)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{hint("C", "B", "S1", id2, 1)}, hints)
+	})
+
+	t.Run("renewed hints are not deleted", func(t *testing.T) {
+		require.NoError(t, s.Delete([]replica.Hint{
+			hint("C", "A", "S1", id3, 1),
+			hint("C", "A", "S2", id1, 1),
+		}))
+		hints, err := s.Hints("C", nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{
+			hint("C", "A", "S1", id3, 2),
+			hint("C", "B", "S1", id2, 1),
+		}, hints)
+		assert.Equal(t, 3, s.Len())
+	})
+
+	t.Run("hints survive a restart", func(t *testing.T) {
+		require.NoError(t, s.Shutdown(ctx))
+		s, err = newHintStore(ctx, dir, 4, logger)
+		require.NoError(t, err)
+		defer s.Shutdown(ctx)
+
+		assert.Equal(t, 3, s.Len())
+		hints, err := s.Hints("CC", nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{hint("CC", "A", "S1", id1, 1)}, hints)
+	})
+}
+
+func TestHintStoreCleanup(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	var (
+		id1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		id2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	)
+	hint := func(class string, id strfmt.UUID, created int64) replica.Hint {
+		return replica.Hint{Class: class, Node: "A", Shard: "S1", ID: id, Created: created}
+	}
+
+	s, err := newHintStore(ctx, t.TempDir(), 10, logger)
+	require.NoError(t, err)
+	_, err = s.Add([]replica.Hint{
+		hint("C", id1, 1),
+		hint("C", id2, 5),
+		hint("Dropped", id1, 1),
+		hint("Dropped", id2, 5),
+		hint("Other", id1, 5),
+	})
+	require.NoError(t, err)
+
+	t.Run("expiry covers all classes", func(t *testing.T) {
+		n, err := s.Expire(2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, 3, s.Len())
+
+		hints, err := s.Hints("Dropped", nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{hint("Dropped", id2, 5)}, hints)
+	})
+
+	t.Run("delete the hints of a class", func(t *testing.T) {
+		n, err := s.DeleteClass("Dropped")
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 2, s.Len())
+
+		hints, err := s.Hints("Dropped", nil, 10)
+		require.NoError(t, err)
+		assert.Empty(t, hints)
+		hints, err = s.Hints("C", nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []replica.Hint{hint("C", id2, 5)}, hints)
+	})
+
+	t.Run("a closed store rejects hints", func(t *testing.T) {
+		require.NoError(t, s.Shutdown(ctx))
+		_, err := s.Add([]replica.Hint{hint("C", id1, 6)})
+		assert.ErrorIs(t, err, errHintStoreClosed)
+	})
+}
@@ -275,6 +275,10 @@ type Index struct {
 
 	lastBackup atomic.Pointer[BackupState]
 
+	// splits of local shards which have not been committed yet, by source
+	shardSplitsLock sync.Mutex
+	shardSplits     map[string]*indexShardSplit
+
 	// canceled when either Shutdown or Drop called
 	closingCtx    context.Context
 	closingCancel context.CancelFunc
@@ -396,7 +400,7 @@ func NewIndex(
 	}
 
 	// TODO: Fix replica router instantiation to be at the top level
-	index.replicator, err = replica.NewReplicator(cfg.ClassName.String(), router, nodeResolver, sg.NodeName(), getDeletionStrategy, replicaClient, promMetrics, logger)
+	index.replicator, err = replica.NewReplicator(cfg.ClassName.String(), router, nodeResolver, sg.NodeName(), getDeletionStrategy, replicaClient, cfg.HintedHandoff, promMetrics, logger)
 	if err != nil {
 		return nil, fmt.Errorf("create replicator for index %q: %w", index.ID(), err)
 	}
@@ -585,6 +589,10 @@ func (i *Index) loadLocalShardIfActive(shardName string) error {
 func (i *Index) initShard(ctx context.Context, shardName string, class *models.Class,
 	promMetrics *monitoring.PrometheusMetrics, disableLazyLoad bool, implicitShardLoading bool,
 ) (ShardLike, error) {
+	if target := i.pendingSplitTarget(shardName); target != nil {
+		return target, nil
+	}
+
 	if disableLazyLoad {
 		if err := i.allocChecker.CheckMappingAndReserve(3, int(lsmkv.FlushAfterDirtyDefault.Seconds())); err != nil {
 			return nil, errors.Wrap(err, "memory pressure: cannot init shard")
@@ -889,6 +897,7 @@ type IndexConfig struct {
 	HNSWSnapshotMinDeltaCommitlogsNumber         int
 	HNSWSnapshotMinDeltaCommitlogsSizePercentage int
 	HNSWWaitForCachePrefill                      bool
+	HNSWVectorCacheAdmission                     bool
 	HNSWFlatSearchConcurrency                    int
 	HNSWAcornFilterRatio                         float64
 	HNSWGeoIndexEF                               int
@@ -902,6 +911,10 @@ type IndexConfig struct {
 	HFreshEnabled bool
 
 	AutoTenantActivation bool
+
+	ChangeDataCapture config.ChangeDataCapture
+	HintedHandoff     *replica.HintedHandoff
+	RecallSampling    config.RecallSampling
 }
 
 func indexID(class schema.ClassName) string {
@@ -1190,6 +1203,21 @@ func (i *Index) asyncReplicationWorkerRelease() {
 	i.asyncReplicationWorkersLimiter.Release(1)
 }
 
+// isSparseVectorProp reports whether propName is a property of data type
+// sparseVector. Their posting lists live in searchable buckets of
+// StrategyMapCollection, but are never migrated to the block-max format.
+func (i *Index) isSparseVectorProp(propName string) bool {
+	c := i.getSchema.ReadOnlyClass(i.Config.ClassName.String())
+	if c == nil {
+		return false
+	}
+	prop, err := schema.GetPropertyByName(c, propName)
+	if err != nil {
+		return false
+	}
+	return schema.IsSparseVectorDataType(prop.DataType)
+}
+
 // parseDateFieldsInProps checks the schema for the current class for which
 // fields are date fields, then - if they are set - parses them accordingly.
 // Works for both date and date[].
@@ -2641,6 +2669,7 @@ func (i *Index) drop() error {
 	i.closed = true
 
 	i.closingCancel()
+	i.stopShardSplits(context.Background(), true)
 
 	// Check if a backup is in progress. Dont delete files in this case so the backup process can complete successfully
 	// The files will be deleted after the backup is completed and in case of a crash on next startup.
@@ -2783,6 +2812,7 @@ func (i *Index) Shutdown(ctx context.Context) error {
 	i.closed = true
 
 	i.closingCancel()
+	i.stopShardSplits(ctx, false)
 
 	// TODO allow every resource cleanup to run, before returning early with error
 	if err := i.shards.RangeConcurrently(i.logger, func(name string, shard ShardLike) error {
@@ -3336,3 +3366,26 @@ func (i *Index) DebugRequantizeIndex(ctx context.Context, shardName, targetVecto
 
 	return nil
 }
+
+func (i *Index) DebugRetrainVectorIndexCompression(ctx context.Context, shardName, targetVector string) error {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard == nil {
+		release()
+		return errors.New("shard not found")
+	}
+
+	// Retrain in the background, keep the shard from being released until done
+	enterrors.GoWrapper(func() {
+		defer release()
+		err := shard.RetrainVectorIndexCompression(context.Background(), targetVector)
+		if err != nil {
+			i.logger.WithField("shard", shardName).WithError(err).Error("failed to retrain vector index compression")
+			return
+		}
+	}, i.logger)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ChangeSources returns a replica per shard of the class, or of the tenant
+// in case of multi-tenancy, whose change log a stream should read
+func (db *DB) ChangeSources(ctx context.Context, className, tenant string) ([]changelog.Source, error) {
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil, fmt.Errorf("class %s not found", className)
+	}
+	return index.changeSources(tenant)
+}
+
+// ReadChanges reads up to limit changes after the given offset from the
+// change log of the source
+func (db *DB) ReadChanges(ctx context.Context, className string, source changelog.Source,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil, fmt.Errorf("class %s not found", className)
+	}
+	return index.readChanges(ctx, source, after, limit, withObject)
+}
+
+// changeSources prefers the local replica of each shard, so that most
+// streams do not need to go through the network. The targets of pending
+// splits are skipped until the cutover. Neither copying the objects into the
+// target nor purging them from the source is captured, so a stream sees the
+// moved objects change only when clients write them.
+func (i *Index) changeSources(tenant string) ([]changelog.Source, error) {
+	if !i.Config.ChangeDataCapture.Enabled {
+		return nil, fmt.Errorf("change data capture is not enabled")
+	}
+
+	className := i.Config.ClassName.String()
+	localNode := i.getSchema.NodeName()
+
+	var sources []changelog.Source
+	err := i.schemaReader.Read(className, true, func(_ *models.Class, state *sharding.State) error {
+		if state == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+
+		if state.PartitioningEnabled {
+			if tenant == "" {
+				return fmt.Errorf("class %s has multi-tenancy enabled, but request was without tenant", className)
+			}
+			physical, ok := state.Physical[tenant]
+			if !ok {
+				return fmt.Errorf("tenant %q not found", tenant)
+			}
+			if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+				return fmt.Errorf("tenant %q is not active: %s", tenant, status)
+			}
+			sources = append(sources, changeSource(physical, localNode))
+			return nil
+		}
+
+		if tenant != "" {
+			return fmt.Errorf("class %s has multi-tenancy disabled, but request was with tenant", className)
+		}
+		splitTargets := map[string]struct{}{}
+		for _, physical := range state.Physical {
+			if physical.Split != nil {
+				splitTargets[physical.Split.Target] = struct{}{}
+			}
+		}
+		for name, physical := range state.Physical {
+			if _, ok := splitTargets[name]; ok || len(physical.BelongsToNodes) == 0 {
+				continue
+			}
+			sources = append(sources, changeSource(physical, localNode))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sources, func(a, b int) bool { return sources[a].Shard < sources[b].Shard })
+	return sources, nil
+}
+
+func changeSource(physical sharding.Physical, localNode string) changelog.Source {
+	if slices.Contains(physical.BelongsToNodes, localNode) {
+		return changelog.Source{Shard: physical.Name, Node: localNode}
+	}
+	nodes := slices.Clone(physical.BelongsToNodes)
+	slices.Sort(nodes)
+	return changelog.Source{Shard: physical.Name, Node: nodes[0]}
+}
+
+func (i *Index) readChanges(ctx context.Context, source changelog.Source,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	if source.Node != i.getSchema.NodeName() {
+		return i.remote.ReadChanges(ctx, source.Shard, source.Node, after, limit, withObject)
+	}
+	return i.IncomingReadChanges(ctx, source.Shard, after, limit, withObject)
+}
+
+func (i *Index) IncomingReadChanges(ctx context.Context, shardName string,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: shard %q", err, shardName)
+	}
+	defer release()
+	if shard == nil {
+		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.ReadChanges(ctx, after, limit, withObject)
+}
@@ -0,0 +1,363 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ShardSplitReporter reports to the cluster that a local replica of a split
+// source has filled its split target. Once all replicas reported, the split
+// gets committed.
+type ShardSplitReporter interface {
+	SplitShardReady(ctx context.Context, class, shard, node string) (uint64, error)
+}
+
+// SetShardSplitReporter sets the reporter used by the local shard splits. It
+// needs to be set before splits can complete, since the reporter is usually
+// only available after the db got initialized.
+func (db *DB) SetShardSplitReporter(reporter ShardSplitReporter) {
+	db.shardSplitReporter.Store(&reporter)
+}
+
+func (db *DB) reportShardSplitReady(ctx context.Context, class, shard string) error {
+	reporter := db.shardSplitReporter.Load()
+	if reporter == nil {
+		return fmt.Errorf("no shard split reporter configured")
+	}
+	_, err := (*reporter).SplitShardReady(ctx, class, shard, db.localNodeName)
+	return err
+}
+
+type shardSplitReportFn func(ctx context.Context, class, shard string) error
+
+// indexShardSplit is a split of a local shard which has not been committed
+// yet
+type indexShardSplit struct {
+	splitter *shardSplitter
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// startShardSplit starts filling the target of the pending split of the
+// given local shard. It is a no-op if the split is already running.
+func (i *Index) startShardSplit(ctx context.Context, shardName string, report shardSplitReportFn) error {
+	className := i.Config.ClassName.String()
+
+	var split *sharding.ShardSplit
+	var state sharding.State
+	if err := i.schemaReader.Read(className, true, func(_ *models.Class, st *sharding.State) error {
+		if st == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+		split = st.SplitInProgress(shardName)
+		state = st.DeepCopy()
+		return nil
+	}); err != nil {
+		return err
+	}
+	if split == nil {
+		return fmt.Errorf("shard %q of class %s is not being split", shardName, className)
+	}
+
+	// loading the source may init shards, which needs the splits lock
+	source, err := i.loadedLocalShard(ctx, shardName)
+	if err != nil {
+		return fmt.Errorf("load split source: %w", err)
+	}
+
+	i.shardSplitsLock.Lock()
+	defer i.shardSplitsLock.Unlock()
+
+	if _, ok := i.shardSplits[shardName]; ok {
+		return nil
+	}
+
+	if err := writeShardSplitMarker(shardPath(i.path(), shardName), split.Target); err != nil {
+		return fmt.Errorf("write split marker: %w", err)
+	}
+
+	target, err := NewShard(ctx, i.metrics.baseMetrics, split.Target, i, i.getClass(), i.centralJobQueue,
+		i.scheduler, i.indexCheckpoints, i.shardReindexer, false, i.bitmapBufPool)
+	if err != nil {
+		return fmt.Errorf("init split target %s: %w", split.Target, err)
+	}
+
+	sp := newShardSplitter(source, target, *split, &state)
+	source.splitter.Store(sp)
+
+	splitCtx, cancel := context.WithCancel(i.closingCtx)
+	ss := &indexShardSplit{splitter: sp, cancel: cancel, done: make(chan struct{})}
+	if i.shardSplits == nil {
+		i.shardSplits = map[string]*indexShardSplit{}
+	}
+	i.shardSplits[shardName] = ss
+
+	reportReady := func(ctx context.Context) error {
+		return backoff.Retry(func() error {
+			return report(ctx, className, shardName)
+		}, backoff.WithContext(backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(0)), ctx))
+	}
+
+	enterrors.GoWrapper(func() {
+		defer close(ss.done)
+		if err := sp.run(splitCtx, reportReady); err != nil && splitCtx.Err() == nil {
+			sp.logger.WithError(err).Error("shard split failed")
+		}
+	}, i.logger)
+
+	sp.logger.Info("started shard split")
+	return nil
+}
+
+// commitShardSplit makes the split target serve the moved token range and
+// removes the moved objects from the source in the background
+func (i *Index) commitShardSplit(ctx context.Context, shardName, target string) error {
+	i.shardSplitsLock.Lock()
+	ss := i.shardSplits[shardName]
+	i.shardSplitsLock.Unlock()
+
+	if ss == nil {
+		// the split was not running locally, the target was filled before a
+		// restart of this node
+		if err := i.LoadLocalShard(ctx, target, false); err != nil {
+			return fmt.Errorf("load split target %s: %w", target, err)
+		}
+		i.finishShardSplitInBackground(shardName)
+		return nil
+	}
+
+	ss.cancel()
+	<-ss.done
+
+	sp := ss.splitter
+	sp.committedAt.Store(time.Now().UnixMilli())
+
+	i.shardCreateLocks.Lock(target)
+	i.shards.Store(target, sp.target)
+	i.shardSplitsLock.Lock()
+	delete(i.shardSplits, shardName)
+	i.shardSplitsLock.Unlock()
+	i.shardCreateLocks.Unlock(target)
+
+	// writes to the source which have been routed before the cutover may
+	// still be pending, sync them after detaching
+	sp.source.splitter.Store(nil)
+	if err := sp.drainDirty(ctx); err != nil {
+		sp.logger.WithError(err).Error("sync objects written during shard split cutover")
+	}
+
+	sp.logger.Info("committed shard split")
+	i.finishShardSplitInBackground(shardName)
+	return nil
+}
+
+// abortShardSplit stops a running split and discards its target
+func (i *Index) abortShardSplit(ctx context.Context, shardName, target string) error {
+	i.shardSplitsLock.Lock()
+	ss := i.shardSplits[shardName]
+	delete(i.shardSplits, shardName)
+	i.shardSplitsLock.Unlock()
+
+	if ss != nil {
+		ss.cancel()
+		<-ss.done
+		ss.splitter.source.splitter.Store(nil)
+		if err := ss.splitter.target.drop(false); err != nil {
+			return fmt.Errorf("drop split target %s: %w", target, err)
+		}
+		ss.splitter.logger.Info("aborted shard split")
+	} else if err := os.RemoveAll(shardPath(i.path(), target)); err != nil {
+		return fmt.Errorf("remove split target %s: %w", target, err)
+	}
+
+	return removeShardSplitMarker(shardPath(i.path(), shardName))
+}
+
+// finishShardSplitInBackground purges the objects moved by a committed split
+// from the source and removes its split marker afterwards
+func (i *Index) finishShardSplitInBackground(shardName string) {
+	enterrors.GoWrapper(func() {
+		logger := i.logger.WithFields(logrus.Fields{"action": "shard_split", "shard": shardName})
+		if err := i.finishShardSplit(i.closingCtx, shardName); err != nil {
+			logger.WithError(err).Error("purge moved objects from split source")
+		}
+	}, i.logger)
+}
+
+func (i *Index) finishShardSplit(ctx context.Context, shardName string) error {
+	className := i.Config.ClassName.String()
+
+	var state sharding.State
+	if err := i.schemaReader.Read(className, true, func(_ *models.Class, st *sharding.State) error {
+		if st == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+		state = st.DeepCopy()
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	source, err := i.loadedLocalShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	purged, err := source.purgeForeignObjects(ctx, &state)
+	if err != nil {
+		return err
+	}
+	i.logger.WithFields(logrus.Fields{
+		"action":  "shard_split",
+		"shard":   shardName,
+		"objects": purged,
+		"took":    time.Since(start),
+	}).Info("purged moved objects from split source")
+
+	return removeShardSplitMarker(shardPath(i.path(), shardName))
+}
+
+// resumeShardSplits continues the local splits interrupted by a restart. A
+// split still pending in the sharding state is restarted. For a split which
+// got committed or aborted while this node was down, the left-overs are
+// cleaned up.
+func (i *Index) resumeShardSplits(ctx context.Context, report shardSplitReportFn) error {
+	if i.partitioningEnabled {
+		return nil
+	}
+
+	className := i.Config.ClassName.String()
+
+	var pending []string
+	local := map[string]bool{}
+	if err := i.schemaReader.Read(className, true, func(_ *models.Class, st *sharding.State) error {
+		if st == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+		for name, physical := range st.Physical {
+			local[name] = st.IsLocalShard(name)
+			if local[name] && physical.Split != nil {
+				pending = append(pending, name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, name := range pending {
+		if err := i.startShardSplit(ctx, name, report); err != nil {
+			return fmt.Errorf("resume split of shard %s: %w", name, err)
+		}
+	}
+
+	for name, isLocal := range local {
+		if !isLocal {
+			continue
+		}
+		marker, err := readShardSplitMarker(shardPath(i.path(), name))
+		if err != nil {
+			return err
+		}
+		if marker == nil || i.splitRunning(name) {
+			continue
+		}
+		if _, committed := local[marker.Target]; committed {
+			i.finishShardSplitInBackground(name)
+			continue
+		}
+		if err := i.abortShardSplit(ctx, name, marker.Target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stopShardSplits stops all running splits on shutdown or drop of the index.
+// The split targets are closed, or dropped if the index is dropped.
+func (i *Index) stopShardSplits(ctx context.Context, drop bool) {
+	i.shardSplitsLock.Lock()
+	splits := i.shardSplits
+	i.shardSplits = nil
+	i.shardSplitsLock.Unlock()
+
+	for _, ss := range splits {
+		ss.cancel()
+		<-ss.done
+		ss.splitter.source.splitter.Store(nil)
+
+		var err error
+		if drop {
+			err = ss.splitter.target.drop(false)
+		} else {
+			err = ss.splitter.target.Shutdown(ctx)
+		}
+		if err != nil {
+			ss.splitter.logger.WithError(err).Error("close split target")
+		}
+	}
+}
+
+func (i *Index) splitRunning(shardName string) bool {
+	i.shardSplitsLock.Lock()
+	defer i.shardSplitsLock.Unlock()
+
+	_, ok := i.shardSplits[shardName]
+	return ok
+}
+
+// pendingSplitTarget returns the not yet committed split target with the
+// given name, so that it is not opened a second time if it gets requested
+// while the cutover is applied
+func (i *Index) pendingSplitTarget(shardName string) *Shard {
+	i.shardSplitsLock.Lock()
+	defer i.shardSplitsLock.Unlock()
+
+	for _, ss := range i.shardSplits {
+		if ss.splitter.split.Target == shardName {
+			return ss.splitter.target
+		}
+	}
+	return nil
+}
+
+func (i *Index) loadedLocalShard(ctx context.Context, shardName string) (*Shard, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	switch s := shard.(type) {
+	case *Shard:
+		return s, nil
+	case *LazyLoadShard:
+		if err := s.Load(ctx); err != nil {
+			return nil, err
+		}
+		return s.shard, nil
+	default:
+		return nil, fmt.Errorf("unexpected shard type %T", shard)
+	}
+}
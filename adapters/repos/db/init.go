@@ -25,6 +25,7 @@ import (
 	resolver "github.com/weaviate/weaviate/adapters/repos/db/sharding"
 	"github.com/weaviate/weaviate/cluster/router"
 	"github.com/weaviate/weaviate/entities/diskio"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/tenantactivity"
@@ -59,6 +60,10 @@ func (db *DB) init(ctx context.Context) error {
 		}
 	}
 
+	if err := db.initHintedHandoff(ctx); err != nil {
+		return err
+	}
+
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
 		for _, class := range objects.Classes {
@@ -146,6 +151,7 @@ func (db *DB) init(ctx context.Context) error {
 				HNSWSnapshotMinDeltaCommitlogsNumber:         db.config.HNSWSnapshotMinDeltaCommitlogsNumber,
 				HNSWSnapshotMinDeltaCommitlogsSizePercentage: db.config.HNSWSnapshotMinDeltaCommitlogsSizePercentage,
 				HNSWWaitForCachePrefill:                      db.config.HNSWWaitForCachePrefill,
+				HNSWVectorCacheAdmission:                     db.config.HNSWVectorCacheAdmission,
 				HNSWFlatSearchConcurrency:                    db.config.HNSWFlatSearchConcurrency,
 				HNSWAcornFilterRatio:                         db.config.HNSWAcornFilterRatio,
 				HNSWGeoIndexEF:                               db.config.HNSWGeoIndexEF,
@@ -155,6 +161,9 @@ func (db *DB) init(ctx context.Context) error {
 				InvertedSorterDisabled:                       db.config.InvertedSorterDisabled,
 				MaintenanceModeEnabled:                       db.config.MaintenanceModeEnabled,
 				HFreshEnabled:                                db.config.HFreshEnabled,
+				ChangeDataCapture:                            db.config.ChangeDataCapture,
+				HintedHandoff:                                db.hintedHandoff,
+				RecallSampling:                               db.config.RecallSampling,
 				AutoTenantActivation:                         schema.AutoTenantActivationEnabled(class),
 			},
 				inverted.ConfigFromModel(invertedConfig),
@@ -170,6 +179,12 @@ func (db *DB) init(ctx context.Context) error {
 			db.indexLock.Lock()
 			db.indices[idx.ID()] = idx
 			db.indexLock.Unlock()
+
+			enterrors.GoWrapper(func() {
+				if err := idx.resumeShardSplits(context.Background(), db.reportShardSplitReady); err != nil {
+					idx.logger.WithField("action", "shard_split").WithError(err).Error("resume shard splits")
+				}
+			}, db.logger)
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	ent "github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
@@ -113,6 +114,29 @@ func (a *Analyzer) Int(in int64) ([]Countable, error) {
 	}, nil
 }
 
+// SparseVector turns every non-zero dimension into its own term, keyed by the
+// big-endian dimension index. The weight takes the place of the term
+// frequency, so that the posting lists can be stored and updated exactly like
+// the ones of the searchable text index.
+func (a *Analyzer) SparseVector(in *sparsevector.Vector) []Countable {
+	out := make([]Countable, len(in.Indices))
+	for i, dim := range in.Indices {
+		out[i] = Countable{
+			Data:          SparseVectorDimensionKey(dim),
+			TermFrequency: in.Values[i],
+		}
+	}
+	return out
+}
+
+// SparseVectorDimensionKey is the row key of the posting list of a sparse
+// vector dimension.
+func SparseVectorDimensionKey(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
 // UUID requires no analysis, so it's just dumping the raw binary representation
 func (a *Analyzer) UUID(in uuid.UUID) ([]Countable, error) {
 	return []Countable{
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 func TestAnalyzer(t *testing.T) {
@@ -396,6 +397,23 @@ func TestAnalyzer(t *testing.T) {
 	})
 }
 
+func TestAnalyzer_SparseVector(t *testing.T) {
+	a := NewAnalyzer(nil, "")
+
+	vec, err := sparsevector.New([]uint32{70000, 3, 255}, []float32{0.5, 1.25, 2})
+	require.Nil(t, err)
+
+	countable := a.SparseVector(vec)
+	require.Len(t, countable, 3)
+
+	assert.Equal(t, []byte{0, 0, 0, 3}, countable[0].Data)
+	assert.Equal(t, float32(1.25), countable[0].TermFrequency)
+	assert.Equal(t, []byte{0, 0, 0, 255}, countable[1].Data)
+	assert.Equal(t, float32(2), countable[1].TermFrequency)
+	assert.Equal(t, []byte{0, 1, 0x11, 0x70}, countable[2].Data)
+	assert.Equal(t, float32(0.5), countable[2].TermFrequency)
+}
+
 func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 	countable := func(data []string, freq []int) []Countable {
 		countable := make([]Countable, len(data))
@@ -651,6 +651,11 @@ func PropertyHasSearchableIndex(class *models.Class, tentativePropertyName strin
 	if err != nil {
 		return false
 	}
+	// the searchable index of sparse vectors holds weights rather than term
+	// frequencies and can not be queried with BM25
+	if schema.IsSparseVectorDataType(p.DataType) {
+		return false
+	}
 	return HasSearchableIndex(p)
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -442,6 +443,13 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if err != nil {
 			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
 		}
+	case schema.DataTypeSparseVector:
+		asSparse, err := sparsevector.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+		items = a.SparseVector(asSparse)
+		propertyLength = asSparse.Len()
 	default:
 		// ignore unsupported prop type
 		return nil, nil
@@ -578,8 +586,9 @@ func convertToUntyped[T comparable](in []T) []any {
 // (index created using bucket of StrategyMapCollection)
 func HasSearchableIndex(prop *models.Property) bool {
 	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
-	case schema.DataTypeText, schema.DataTypeTextArray:
-		// by default property has searchable index only for text/text[] props
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeSparseVector:
+		// by default property has searchable index only for text/text[] props,
+		// for sparseVector props it holds the posting list of every dimension
 		if prop.IndexSearchable == nil {
 			return true
 		}
@@ -593,6 +602,10 @@ func HasSearchableIndex(prop *models.Property) bool {
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
 func HasFilterableIndex(prop *models.Property) bool {
+	if schema.IsSparseVectorDataType(prop.DataType) {
+		// weights can not be filtered on, only searched
+		return false
+	}
 	// by default property has filterable index
 	if prop.IndexFilterable == nil {
 		return true
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SparseVectorSearch ranks the objects by the dot product of their sparse
+// vector property with the query vector. Every non-zero query dimension is
+// a term whose posting list holds the document weights, the top k are
+// determined with WAND using the largest weight of each list as upper bound.
+func (b *BM25Searcher) SparseVectorSearch(ctx context.Context, filterDocIds helpers.AllowList,
+	className schema.ClassName, limit int, params searchparams.NearSparseVector, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	class := b.getClass(className.String())
+	if class == nil {
+		return nil, nil, fmt.Errorf("could not find class %s in schema", className)
+	}
+	prop, err := schema.GetPropertyByName(class, params.Property)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !schema.IsSparseVectorDataType(prop.DataType) {
+		return nil, nil, fmt.Errorf("property %q is of type %v, nearSparseVector requires a %s property",
+			params.Property, prop.DataType, schema.DataTypeSparseVector)
+	}
+	if !HasSearchableIndex(prop) {
+		return nil, nil, inverted.NewMissingSearchableIndexError(params.Property)
+	}
+
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("could not find bucket for property %v", prop.Name)
+	}
+
+	start := time.Now()
+	queryTerms := make([]string, 0, params.Vector.Len())
+	postings := make([]terms.TermInterface, 0, params.Vector.Len())
+	total := 0
+	for i, dim := range params.Vector.Indices {
+		dimension := strconv.FormatUint(uint64(dim), 10)
+		queryTerms = append(queryTerms, dimension)
+
+		data, err := bucket.DocPointerWithScoreList(ctx, SparseVectorDimensionKey(dim), 1)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read posting list of dimension %d: %w", dim, err)
+		}
+		if filterDocIds != nil {
+			filtered := data[:0]
+			for _, d := range data {
+				if filterDocIds.Contains(d.Id) {
+					filtered = append(filtered, d)
+				}
+			}
+			data = filtered
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		total += len(data)
+		postings = append(postings, terms.NewSparseTerm(dimension, i, params.Vector.Values[i], data))
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "sparse_1_term_time", time.Since(start))
+	helpers.AnnotateSlowQueryLog(ctx, "sparse_2_terms", len(postings))
+
+	if ctx.Err() != nil {
+		return nil, nil, fmt.Errorf("after reading posting lists: %w", ctx.Err())
+	}
+	if len(postings) == 0 {
+		return []*storobj.Object{}, []float32{}, nil
+	}
+	if limit == 0 {
+		limit = total
+	}
+
+	start = time.Now()
+	topKHeap := lsmkv.DoWand(ctx, limit, &terms.Terms{T: postings, Count: len(queryTerms)},
+		0, false, 1, b.logger)
+	helpers.AnnotateSlowQueryLog(ctx, "sparse_3_wand_time", time.Since(start))
+	if ctx.Err() != nil {
+		return nil, nil, fmt.Errorf("after DoWand: %w", ctx.Err())
+	}
+
+	return b.getTopKObjects(topKHeap, false, queryTerms, additional)
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package terms
+
+// SparseTerm is the posting list of a single sparse vector dimension. It
+// reuses the cursor logic of Term, but scores documents with the product of
+// query and document weight instead of BM25.
+type SparseTerm struct {
+	*Term
+	queryWeight float64
+}
+
+// NewSparseTerm creates a term for the given dimension posting list. The
+// frequencies of data hold the document weights. data must be sorted by doc id
+// and must not be empty.
+func NewSparseTerm(dimension string, queryTermIndex int, queryWeight float32,
+	data []DocPointerWithScore,
+) *SparseTerm {
+	t := &SparseTerm{
+		Term:        &Term{queryTerm: dimension, queryTermIndex: queryTermIndex, propertyBoost: 1},
+		queryWeight: float64(queryWeight),
+	}
+	t.Data = data
+
+	// the upper bound of every document in this list is the largest weight
+	// times the query weight, this doubles as the idf for the WAND pivot
+	maxWeight := float32(0)
+	for i := range data {
+		if data[i].Frequency > maxWeight {
+			maxWeight = data[i].Frequency
+		}
+	}
+	t.SetIdf(float64(maxWeight) * t.queryWeight)
+	t.SetPosPointer(0)
+	t.SetIdPointer(data[0].Id)
+	return t
+}
+
+func (t *SparseTerm) Score(averagePropLength float64, additionalExplanations bool) (uint64, float64, *DocPointerWithScore) {
+	pair := t.Data[t.posPointer]
+	score := float64(pair.Frequency) * t.queryWeight
+	if !additionalExplanations {
+		return t.idPointer, score, nil
+	}
+	return t.idPointer, score, &pair
+}
@@ -1155,7 +1155,9 @@ func (t *ShardReindexTask_MapToBlockmax) findPropsToReindex(shard ShardLike) (pr
 		if bucket.Strategy() == lsmkv.StrategyMapCollection {
 			propName, indexType := GetPropNameAndIndexTypeFromBucketName(name)
 
-			if indexType == IndexTypePropSearchableValue && checkPropSelected(propName) {
+			// sparse vector posting lists are never migrated, see createPropertyValueIndex
+			if indexType == IndexTypePropSearchableValue && checkPropSelected(propName) &&
+				!shard.Index().isSparseVectorProp(propName) {
 				propNames = append(propNames, propName)
 			}
 		}
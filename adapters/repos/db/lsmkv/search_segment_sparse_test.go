@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+)
+
+func TestDoWandSparseTerms(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	// doc 1: dim0=0.5, dim1=2.0 -> 0.5*1 + 2*0.5 = 1.5
+	// doc 2: dim0=3.0           -> 3.0
+	// doc 3: dim1=1.0           -> 0.5
+	// doc 4: dim0=1.0, dim1=1.0 -> 1.0 + 0.5 = 1.5
+	dim0 := []terms.DocPointerWithScore{
+		{Id: 1, Frequency: 0.5},
+		{Id: 2, Frequency: 3},
+		{Id: 4, Frequency: 1},
+	}
+	dim1 := []terms.DocPointerWithScore{
+		{Id: 1, Frequency: 2},
+		{Id: 3, Frequency: 1},
+		{Id: 4, Frequency: 1},
+	}
+
+	for _, limit := range []int{1, 2, 4} {
+		sparseTerms := &terms.Terms{
+			T: []terms.TermInterface{
+				terms.NewSparseTerm("dim0", 0, 1, dim0),
+				terms.NewSparseTerm("dim1", 1, 0.5, dim1),
+			},
+			Count: 2,
+		}
+
+		heap := DoWand(context.Background(), limit, sparseTerms, 0, false, 1, logger)
+		require.Equal(t, limit, heap.Len())
+
+		ids := make([]uint64, heap.Len())
+		scores := make([]float32, heap.Len())
+		for i := heap.Len() - 1; i >= 0; i-- {
+			item := heap.Pop()
+			ids[i] = item.ID
+			scores[i] = item.Dist
+		}
+
+		expectedScores := []float32{3, 1.5, 1.5, 0.5}[:limit]
+		assert.Equal(t, expectedScores, scores)
+		assert.Equal(t, uint64(2), ids[0])
+		if limit == 4 {
+			assert.ElementsMatch(t, []uint64{1, 4}, ids[1:3])
+			assert.Equal(t, uint64(3), ids[3])
+		}
+	}
+}
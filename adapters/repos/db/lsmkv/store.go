@@ -469,7 +469,7 @@ func (s *Store) replaceBucket(ctx context.Context, replacementBucket *Bucket, re
 	defer replacementBucket.disk.maintenanceLock.Unlock()
 
 	currBucketDir := bucket.dir
-	newBucketDir := bucket.dir + "___del"
+	newBucketDir := bucket.dir + replacedBucketDirSuffix
 	currReplacementBucketDir := replacementBucket.dir
 	newReplacementBucketDir := currBucketDir
 
@@ -557,6 +557,70 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	return nil
 }
 
+// replacedBucketDirSuffix marks the directory of a bucket replaced by
+// ReplaceBuckets until its files are deleted
+const replacedBucketDirSuffix = "___del"
+
+// FinishReplaceBuckets completes a ReplaceBuckets of the buckets in dir which
+// got interrupted, e.g. by a crash. Neither bucket may be loaded. Nothing is
+// done if there is no replacement bucket or replaced bucket left.
+func FinishReplaceBuckets(dir, bucketName, replacementBucketName string) error {
+	bucketDir := filepath.Join(dir, bucketName)
+	replacedDir := bucketDir + replacedBucketDirSuffix
+	replacementDir := filepath.Join(dir, replacementBucketName)
+
+	ok, err := fileExists(replacementDir)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if ok, err := fileExists(bucketDir); err != nil {
+			return err
+		} else if ok {
+			if err := os.RemoveAll(replacedDir); err != nil {
+				return errors.Wrapf(err, "failed removing dir '%s'", replacedDir)
+			}
+			if err := os.Rename(bucketDir, replacedDir); err != nil {
+				return errors.Wrapf(err, "failed moving orig bucket dir '%s'", bucketDir)
+			}
+		}
+		if err := os.Rename(replacementDir, bucketDir); err != nil {
+			return errors.Wrapf(err, "failed moving replacement bucket dir '%s'", replacementDir)
+		}
+	}
+	if err := os.RemoveAll(replacedDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", replacedDir)
+	}
+	return nil
+}
+
+// AbortReplaceBuckets reverts a ReplaceBuckets of the buckets in dir which got
+// interrupted, e.g. by a crash, and removes the replacement bucket. Neither
+// bucket may be loaded.
+func AbortReplaceBuckets(dir, bucketName, replacementBucketName string) error {
+	bucketDir := filepath.Join(dir, bucketName)
+	replacedDir := bucketDir + replacedBucketDirSuffix
+	replacementDir := filepath.Join(dir, replacementBucketName)
+
+	ok, err := fileExists(bucketDir)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if ok, err := fileExists(replacedDir); err != nil {
+			return err
+		} else if ok {
+			if err := os.Rename(replacedDir, bucketDir); err != nil {
+				return errors.Wrapf(err, "failed restoring orig bucket dir '%s'", replacedDir)
+			}
+		}
+	}
+	if err := os.RemoveAll(replacementDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", replacementDir)
+	}
+	return nil
+}
+
 func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
 	s.closeLock.RLock()
 	defer s.closeLock.RUnlock()
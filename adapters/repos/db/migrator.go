@@ -172,6 +172,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class) error {
 			HNSWSnapshotMinDeltaCommitlogsNumber:         m.db.config.HNSWSnapshotMinDeltaCommitlogsNumber,
 			HNSWSnapshotMinDeltaCommitlogsSizePercentage: m.db.config.HNSWSnapshotMinDeltaCommitlogsSizePercentage,
 			HNSWWaitForCachePrefill:                      m.db.config.HNSWWaitForCachePrefill,
+			HNSWVectorCacheAdmission:                     m.db.config.HNSWVectorCacheAdmission,
 			HNSWFlatSearchConcurrency:                    m.db.config.HNSWFlatSearchConcurrency,
 			HNSWAcornFilterRatio:                         m.db.config.HNSWAcornFilterRatio,
 			VisitedListPoolMaxSize:                       m.db.config.VisitedListPoolMaxSize,
@@ -180,6 +181,9 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class) error {
 			InvertedSorterDisabled:                       m.db.config.InvertedSorterDisabled,
 			MaintenanceModeEnabled:                       m.db.config.MaintenanceModeEnabled,
 			HFreshEnabled:                                m.db.config.HFreshEnabled,
+			ChangeDataCapture:                            m.db.config.ChangeDataCapture,
+			HintedHandoff:                                m.db.hintedHandoff,
+			RecallSampling:                               m.db.config.RecallSampling,
 			AutoTenantActivation:                         schema.AutoTenantActivationEnabled(class),
 		},
 		// no backward-compatibility check required, since newly added classes will
@@ -264,6 +268,30 @@ func (m *Migrator) ShutdownShard(ctx context.Context, class, shard string) error
 	return nil
 }
 
+func (m *Migrator) StartShardSplit(ctx context.Context, class, shard string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.startShardSplit(ctx, shard, m.db.reportShardSplitReady)
+}
+
+func (m *Migrator) CommitShardSplit(ctx context.Context, class, shard, target string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.commitShardSplit(ctx, shard, target)
+}
+
+func (m *Migrator) AbortShardSplit(ctx context.Context, class, shard, target string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.abortShardSplit(ctx, shard, target)
+}
+
 // UpdateIndex ensures that the local index is up2date with the latest sharding
 // state (shards/tenants) and index properties that may have been added in the
 // case that the local node was down during a class update operation.
@@ -19,6 +19,8 @@ import (
 
 	backup "github.com/weaviate/weaviate/entities/backup"
 
+	changelog "github.com/weaviate/weaviate/entities/changelog"
+
 	config "github.com/weaviate/weaviate/entities/schema/config"
 
 	context "context"
@@ -407,6 +409,65 @@ func (_c *MockShardLike_Counter_Call) RunAndReturn(run func() *indexcounter.Coun
 	return _c
 }
 
+// CreateVectorIndexSnapshot provides a mock function with given fields: ctx, targetVector
+func (_m *MockShardLike) CreateVectorIndexSnapshot(ctx context.Context, targetVector string) (*VectorIndexSnapshotReport, error) {
+	ret := _m.Called(ctx, targetVector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVectorIndexSnapshot")
+	}
+
+	var r0 *VectorIndexSnapshotReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*VectorIndexSnapshotReport, error)); ok {
+		return rf(ctx, targetVector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *VectorIndexSnapshotReport); ok {
+		r0 = rf(ctx, targetVector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VectorIndexSnapshotReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, targetVector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_CreateVectorIndexSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVectorIndexSnapshot'
+type MockShardLike_CreateVectorIndexSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateVectorIndexSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+func (_e *MockShardLike_Expecter) CreateVectorIndexSnapshot(ctx interface{}, targetVector interface{}) *MockShardLike_CreateVectorIndexSnapshot_Call {
+	return &MockShardLike_CreateVectorIndexSnapshot_Call{Call: _e.mock.On("CreateVectorIndexSnapshot", ctx, targetVector)}
+}
+
+func (_c *MockShardLike_CreateVectorIndexSnapshot_Call) Run(run func(ctx context.Context, targetVector string)) *MockShardLike_CreateVectorIndexSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_CreateVectorIndexSnapshot_Call) Return(_a0 *VectorIndexSnapshotReport, _a1 error) *MockShardLike_CreateVectorIndexSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_CreateVectorIndexSnapshot_Call) RunAndReturn(run func(context.Context, string) (*VectorIndexSnapshotReport, error)) *MockShardLike_CreateVectorIndexSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DebugGetDocIdLockStatus provides a mock function with no fields
 func (_m *MockShardLike) DebugGetDocIdLockStatus() (bool, error) {
 	ret := _m.Called()
@@ -2231,6 +2292,79 @@ func (_c *MockShardLike_ObjectVectorSearch_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ObjectVectorSearchBatch provides a mock function with given fields: ctx, searchVectors, targetVector, limit, _a4, _a5, properties
+func (_m *MockShardLike) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, _a4 *filters.LocalFilter, _a5 additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error) {
+	ret := _m.Called(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ObjectVectorSearchBatch")
+	}
+
+	var r0 [][]*storobj.Object
+	var r1 [][]float32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) ([][]*storobj.Object, [][]float32, error)); ok {
+		return rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) [][]*storobj.Object); ok {
+		r0 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*storobj.Object)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) [][]float32); ok {
+		r1 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([][]float32)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) error); ok {
+		r2 = rf(ctx, searchVectors, targetVector, limit, _a4, _a5, properties)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockShardLike_ObjectVectorSearchBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObjectVectorSearchBatch'
+type MockShardLike_ObjectVectorSearchBatch_Call struct {
+	*mock.Call
+}
+
+// ObjectVectorSearchBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - searchVectors [][]float32
+//   - targetVector string
+//   - limit int
+//   - _a4 *filters.LocalFilter
+//   - _a5 additional.Properties
+//   - properties []string
+func (_e *MockShardLike_Expecter) ObjectVectorSearchBatch(ctx interface{}, searchVectors interface{}, targetVector interface{}, limit interface{}, _a4 interface{}, _a5 interface{}, properties interface{}) *MockShardLike_ObjectVectorSearchBatch_Call {
+	return &MockShardLike_ObjectVectorSearchBatch_Call{Call: _e.mock.On("ObjectVectorSearchBatch", ctx, searchVectors, targetVector, limit, _a4, _a5, properties)}
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) Run(run func(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, _a4 *filters.LocalFilter, _a5 additional.Properties, properties []string)) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([][]float32), args[2].(string), args[3].(int), args[4].(*filters.LocalFilter), args[5].(additional.Properties), args[6].([]string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) Return(_a0 [][]*storobj.Object, _a1 [][]float32, _a2 error) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockShardLike_ObjectVectorSearchBatch_Call) RunAndReturn(run func(context.Context, [][]float32, string, int, *filters.LocalFilter, additional.Properties, []string) ([][]*storobj.Object, [][]float32, error)) *MockShardLike_ObjectVectorSearchBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PutObject provides a mock function with given fields: _a0, _a1
 func (_m *MockShardLike) PutObject(_a0 context.Context, _a1 *storobj.Object) error {
 	ret := _m.Called(_a0, _a1)
@@ -2385,6 +2519,67 @@ func (_c *MockShardLike_QuantizedDimensions_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ReadChanges provides a mock function with given fields: ctx, after, limit, withObject
+func (_m *MockShardLike) ReadChanges(ctx context.Context, after uint64, limit int, withObject bool) ([]changelog.Change, error) {
+	ret := _m.Called(ctx, after, limit, withObject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadChanges")
+	}
+
+	var r0 []changelog.Change
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, bool) ([]changelog.Change, error)); ok {
+		return rf(ctx, after, limit, withObject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, bool) []changelog.Change); ok {
+		r0 = rf(ctx, after, limit, withObject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]changelog.Change)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int, bool) error); ok {
+		r1 = rf(ctx, after, limit, withObject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_ReadChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadChanges'
+type MockShardLike_ReadChanges_Call struct {
+	*mock.Call
+}
+
+// ReadChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uint64
+//   - limit int
+//   - withObject bool
+func (_e *MockShardLike_Expecter) ReadChanges(ctx interface{}, after interface{}, limit interface{}, withObject interface{}) *MockShardLike_ReadChanges_Call {
+	return &MockShardLike_ReadChanges_Call{Call: _e.mock.On("ReadChanges", ctx, after, limit, withObject)}
+}
+
+func (_c *MockShardLike_ReadChanges_Call) Run(run func(ctx context.Context, after uint64, limit int, withObject bool)) *MockShardLike_ReadChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_ReadChanges_Call) Return(_a0 []changelog.Change, _a1 error) *MockShardLike_ReadChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_ReadChanges_Call) RunAndReturn(run func(context.Context, uint64, int, bool) ([]changelog.Change, error)) *MockShardLike_ReadChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RepairIndex provides a mock function with given fields: ctx, targetVector
 func (_m *MockShardLike) RepairIndex(ctx context.Context, targetVector string) error {
 	ret := _m.Called(ctx, targetVector)
@@ -2479,6 +2674,53 @@ func (_c *MockShardLike_RequantizeIndex_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// RetrainVectorIndexCompression provides a mock function with given fields: ctx, targetVector
+func (_m *MockShardLike) RetrainVectorIndexCompression(ctx context.Context, targetVector string) error {
+	ret := _m.Called(ctx, targetVector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrainVectorIndexCompression")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, targetVector)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShardLike_RetrainVectorIndexCompression_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrainVectorIndexCompression'
+type MockShardLike_RetrainVectorIndexCompression_Call struct {
+	*mock.Call
+}
+
+// RetrainVectorIndexCompression is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+func (_e *MockShardLike_Expecter) RetrainVectorIndexCompression(ctx interface{}, targetVector interface{}) *MockShardLike_RetrainVectorIndexCompression_Call {
+	return &MockShardLike_RetrainVectorIndexCompression_Call{Call: _e.mock.On("RetrainVectorIndexCompression", ctx, targetVector)}
+}
+
+func (_c *MockShardLike_RetrainVectorIndexCompression_Call) Run(run func(ctx context.Context, targetVector string)) *MockShardLike_RetrainVectorIndexCompression_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_RetrainVectorIndexCompression_Call) Return(_a0 error) *MockShardLike_RetrainVectorIndexCompression_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardLike_RetrainVectorIndexCompression_Call) RunAndReturn(run func(context.Context, string) error) *MockShardLike_RetrainVectorIndexCompression_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAsyncReplicationState provides a mock function with given fields: ctx, _a1, enabled
 func (_m *MockShardLike) SetAsyncReplicationState(ctx context.Context, _a1 AsyncReplicationConfig, enabled bool) error {
 	ret := _m.Called(ctx, _a1, enabled)
@@ -2757,6 +2999,66 @@ func (_c *MockShardLike_Store_Call) RunAndReturn(run func() *lsmkv.Store) *MockS
 	return _c
 }
 
+// TuneVectorIndex provides a mock function with given fields: ctx, targetVector, params
+func (_m *MockShardLike) TuneVectorIndex(ctx context.Context, targetVector string, params VectorIndexTuningParams) (*VectorIndexTuningReport, error) {
+	ret := _m.Called(ctx, targetVector, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TuneVectorIndex")
+	}
+
+	var r0 *VectorIndexTuningReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, VectorIndexTuningParams) (*VectorIndexTuningReport, error)); ok {
+		return rf(ctx, targetVector, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, VectorIndexTuningParams) *VectorIndexTuningReport); ok {
+		r0 = rf(ctx, targetVector, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VectorIndexTuningReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, VectorIndexTuningParams) error); ok {
+		r1 = rf(ctx, targetVector, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardLike_TuneVectorIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TuneVectorIndex'
+type MockShardLike_TuneVectorIndex_Call struct {
+	*mock.Call
+}
+
+// TuneVectorIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetVector string
+//   - params VectorIndexTuningParams
+func (_e *MockShardLike_Expecter) TuneVectorIndex(ctx interface{}, targetVector interface{}, params interface{}) *MockShardLike_TuneVectorIndex_Call {
+	return &MockShardLike_TuneVectorIndex_Call{Call: _e.mock.On("TuneVectorIndex", ctx, targetVector, params)}
+}
+
+func (_c *MockShardLike_TuneVectorIndex_Call) Run(run func(ctx context.Context, targetVector string, params VectorIndexTuningParams)) *MockShardLike_TuneVectorIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(VectorIndexTuningParams))
+	})
+	return _c
+}
+
+func (_c *MockShardLike_TuneVectorIndex_Call) Return(_a0 *VectorIndexTuningReport, _a1 error) *MockShardLike_TuneVectorIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardLike_TuneVectorIndex_Call) RunAndReturn(run func(context.Context, string, VectorIndexTuningParams) (*VectorIndexTuningReport, error)) *MockShardLike_TuneVectorIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function with given fields: status, reason
 func (_m *MockShardLike) UpdateStatus(status string, reason string) error {
 	ret := _m.Called(status, reason)
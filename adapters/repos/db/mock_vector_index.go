@@ -836,6 +836,76 @@ func (_c *MockVectorIndex_SearchByVector_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SearchByVectorBatch provides a mock function with given fields: ctx, vectors, k, allow
+func (_m *MockVectorIndex) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error) {
+	ret := _m.Called(ctx, vectors, k, allow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByVectorBatch")
+	}
+
+	var r0 [][]uint64
+	var r1 [][]float32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, int, helpers.AllowList) ([][]uint64, [][]float32, error)); ok {
+		return rf(ctx, vectors, k, allow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, [][]float32, int, helpers.AllowList) [][]uint64); ok {
+		r0 = rf(ctx, vectors, k, allow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, [][]float32, int, helpers.AllowList) [][]float32); ok {
+		r1 = rf(ctx, vectors, k, allow)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([][]float32)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, [][]float32, int, helpers.AllowList) error); ok {
+		r2 = rf(ctx, vectors, k, allow)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVectorIndex_SearchByVectorBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchByVectorBatch'
+type MockVectorIndex_SearchByVectorBatch_Call struct {
+	*mock.Call
+}
+
+// SearchByVectorBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vectors [][]float32
+//   - k int
+//   - allow helpers.AllowList
+func (_e *MockVectorIndex_Expecter) SearchByVectorBatch(ctx interface{}, vectors interface{}, k interface{}, allow interface{}) *MockVectorIndex_SearchByVectorBatch_Call {
+	return &MockVectorIndex_SearchByVectorBatch_Call{Call: _e.mock.On("SearchByVectorBatch", ctx, vectors, k, allow)}
+}
+
+func (_c *MockVectorIndex_SearchByVectorBatch_Call) Run(run func(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList)) *MockVectorIndex_SearchByVectorBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([][]float32), args[2].(int), args[3].(helpers.AllowList))
+	})
+	return _c
+}
+
+func (_c *MockVectorIndex_SearchByVectorBatch_Call) Return(_a0 [][]uint64, _a1 [][]float32, _a2 error) *MockVectorIndex_SearchByVectorBatch_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVectorIndex_SearchByVectorBatch_Call) RunAndReturn(run func(context.Context, [][]float32, int, helpers.AllowList) ([][]uint64, [][]float32, error)) *MockVectorIndex_SearchByVectorBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchByVectorDistance provides a mock function with given fields: ctx, vector, dist, maxLimit, allow
 func (_m *MockVectorIndex) SearchByVectorDistance(ctx context.Context, vector []float32, dist float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
 	ret := _m.Called(ctx, vector, dist, maxLimit, allow)
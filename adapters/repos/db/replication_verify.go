@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// DefaultVerificationLimit is the maximum number of differing objects
+// reported per pair of replicas, unless another limit is requested
+const DefaultVerificationLimit = 100
+
+// VerifyReplicas compares the replicas of every shard of the class, or only
+// of the given shard or tenant, by their hashtrees and reports the objects
+// which differ without repairing them. It requires async replication to be
+// enabled, since the hashtrees are maintained by it. Errors caused by the
+// request rather than the cluster wrap replica.ErrVerificationUnavailable.
+func (db *DB) VerifyReplicas(ctx context.Context, className, shard string, limit int,
+) (*replica.VerificationReport, error) {
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil, fmt.Errorf("%w: class %s not found", replica.ErrVerificationUnavailable, className)
+	}
+	if !index.asyncReplicationEnabled() {
+		return nil, fmt.Errorf("%w: async replication is not enabled for class %s",
+			replica.ErrVerificationUnavailable, className)
+	}
+	if limit <= 0 {
+		limit = DefaultVerificationLimit
+	}
+
+	shards, err := index.verifiableShards(shard)
+	if err != nil {
+		return nil, err
+	}
+
+	height := index.AsyncReplicationConfig().hashtreeHeight
+	report := &replica.VerificationReport{Collection: index.Config.ClassName.String(), Consistent: true}
+	for _, name := range shards {
+		pairs, err := index.replicator.VerifyShard(ctx, name, height, limit)
+		if err != nil {
+			return nil, fmt.Errorf("verify shard %q: %w", name, err)
+		}
+		for _, p := range pairs {
+			report.Consistent = report.Consistent && p.Consistent
+		}
+		report.Pairs = append(report.Pairs, pairs...)
+	}
+	return report, nil
+}
+
+// verifiableShards returns the given shard if it exists, or all the shards
+// of the index with more than one replica. Inactive tenants are skipped
+// since their hashtrees are not loaded.
+func (i *Index) verifiableShards(shard string) ([]string, error) {
+	className := i.Config.ClassName.String()
+
+	var shards []string
+	err := i.schemaReader.Read(className, true, func(_ *models.Class, state *sharding.State) error {
+		if state == nil {
+			return fmt.Errorf("unable to retrieve sharding state for class %s", className)
+		}
+		if shard != "" {
+			physical, ok := state.Physical[shard]
+			if !ok {
+				return fmt.Errorf("%w: shard %q not found", replica.ErrVerificationUnavailable, shard)
+			}
+			if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+				return fmt.Errorf("%w: shard %q is not active: %s",
+					replica.ErrVerificationUnavailable, shard, status)
+			}
+			shards = append(shards, shard)
+			return nil
+		}
+		for name, physical := range state.Physical {
+			if len(physical.BelongsToNodes) > 1 && physical.ActivityStatus() == models.TenantActivityStatusHOT {
+				shards = append(shards, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(shards)
+	return shards, nil
+}
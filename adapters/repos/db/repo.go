@@ -118,6 +118,14 @@ type DB struct {
 	AsyncIndexingEnabled bool
 
 	tenantsManager schemaUC.TenantsActivityManager
+
+	shardSplitReporter atomic.Pointer[ShardSplitReporter]
+
+	// hinted handoff of writes missed by unavailable replicas, nil if disabled
+	hintStore       *hintStore
+	hintedHandoff   *replica.HintedHandoff
+	hintsReplayStop chan struct{}
+	hintsReplayDone chan struct{}
 }
 
 func (db *DB) GetSchemaGetter() schemaUC.SchemaGetter {
@@ -311,6 +319,7 @@ type Config struct {
 	HNSWSnapshotMinDeltaCommitlogsNumber         int
 	HNSWSnapshotMinDeltaCommitlogsSizePercentage int
 	HNSWWaitForCachePrefill                      bool
+	HNSWVectorCacheAdmission                     bool
 	HNSWFlatSearchConcurrency                    int
 	HNSWAcornFilterRatio                         float64
 	HNSWGeoIndexEF                               int
@@ -322,6 +331,9 @@ type Config struct {
 	QuerySlowLogThreshold       *configRuntime.DynamicValue[time.Duration]
 	InvertedSorterDisabled      *configRuntime.DynamicValue[bool]
 	MaintenanceModeEnabled      func() bool
+	ChangeDataCapture           config.ChangeDataCapture
+	HintedHandoff               config.HintedHandoff
+	RecallSampling              config.RecallSampling
 	AsyncIndexingEnabled        bool
 
 	HFreshEnabled   bool
@@ -390,6 +402,7 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 	}
 
 	delete(db.indices, indexID(className))
+	db.dropClassHints(className.String())
 
 	if err := db.promMetrics.DeleteClass(className.String()); err != nil {
 		db.logger.Error("can't delete prometheus metrics", err)
@@ -420,6 +433,10 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		db.metricsObserver.Shutdown()
 	}
 
+	if err := db.shutdownHintedHandoff(ctx); err != nil {
+		return err
+	}
+
 	db.indexLock.Lock()
 	defer db.indexLock.Unlock()
 	for id, index := range db.indices {
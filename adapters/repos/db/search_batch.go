@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/router/executor"
+	routerTypes "github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// VectorSearchBatch runs several nearVector queries which share the class,
+// target vector, filters and limit. Local shards build the allow list once
+// and evaluate all queries together. Results are returned in query order.
+func (db *DB) VectorSearchBatch(ctx context.Context, params dto.BatchVectorSearchParams) ([]search.Results, error) {
+	start := time.Now()
+	defer func() {
+		took := time.Since(start)
+		db.logger.WithFields(logrus.Fields{
+			"action":       "vector_search_batch_completed",
+			"took":         took,
+			"class":        params.ClassName,
+			"queries":      len(params.Vectors),
+			"targetVector": params.TargetVector,
+		}).Debugf("vector batch search completed in %s", took)
+	}()
+
+	if params.Limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d: must be positive", params.Limit)
+	}
+	if int64(params.Limit) > db.config.QueryMaximumResults {
+		return nil, fmt.Errorf("invalid limit %d: exceeds the maximum of %d", params.Limit, db.config.QueryMaximumResults)
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	objss, distss, err := idx.objectVectorSearchBatch(ctx, params.Vectors, params.TargetVector,
+		params.Limit, params.Filters, params.AdditionalProperties, params.ReplicationProperties, params.Tenant,
+		params.Properties)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object vector batch search at index %s", idx.ID())
+	}
+
+	out := make([]search.Results, len(objss))
+	for i := range objss {
+		out[i] = storobj.SearchResultsWithDists(objss[i], params.AdditionalProperties, distss[i])
+	}
+	return out, nil
+}
+
+func (i *Index) objectVectorSearchBatch(ctx context.Context, searchVectors [][]float32,
+	targetVector string, limit int, localFilters *filters.LocalFilter, additionalProps additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	if i.Config.ForceFullReplicasSearch {
+		// the full replica search needs deduplication across replicas, which
+		// the single query path already takes care of
+		return i.objectVectorSearchEach(ctx, searchVectors, targetVector, limit, localFilters,
+			additionalProps, replProps, tenant, properties)
+	}
+
+	cl := i.consistencyLevel(replProps, routerTypes.ConsistencyLevelOne)
+	readPlan, err := i.buildReadRoutingPlan(cl, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := &sync.Mutex{}
+	out := make([][]*storobj.Object, len(searchVectors))
+	dists := make([][]float32, len(searchVectors))
+	collect := func(objss [][]*storobj.Object, distss [][]float32) {
+		m.Lock()
+		defer m.Unlock()
+		for q := range objss {
+			out[q] = append(out[q], objss[q]...)
+			dists[q] = append(dists[q], distss[q]...)
+		}
+	}
+
+	// shards without a local replica don't support batching, every query is
+	// sent on its own
+	remoteSearch := func(shardName string) error {
+		objss := make([][]*storobj.Object, len(searchVectors))
+		distss := make([][]float32, len(searchVectors))
+		for q, vector := range searchVectors {
+			objs, qDists, err := i.remoteShardSearch(ctx, []models.Vector{vector}, []string{targetVector},
+				0, limit, localFilters, nil, nil, additionalProps, nil, properties, tenant, shardName)
+			if err != nil {
+				return fmt.Errorf("remote shard object search %s: %w", shardName, err)
+			}
+			objss[q], distss[q] = objs, qDists
+		}
+		collect(objss, distss)
+		return nil
+	}
+	localSearch := func(shardName string) error {
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			return err
+		}
+		defer release()
+		if shard == nil {
+			return remoteSearch(shardName)
+		}
+
+		localCtx := helpers.InitSlowQueryDetails(ctx)
+		helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
+		objss, distss, err := shard.ObjectVectorSearchBatch(localCtx, searchVectors, targetVector,
+			limit, localFilters, additionalProps, properties)
+		if err != nil {
+			return fmt.Errorf("local shard object search %s: %w", shard.ID(), err)
+		}
+		if i.shardHasMultipleReplicasRead(tenant, shardName) {
+			for _, objs := range objss {
+				storobj.AddOwnership(objs, i.getSchema.NodeName(), shardName)
+			}
+		}
+		collect(objss, distss)
+		return nil
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger, "tenant:", tenant)
+	eg.SetLimit(_NUMCPU*2 + 1)
+	err = executor.ExecuteForEachShard(readPlan,
+		func(replica routerTypes.Replica) error {
+			shardName := replica.ShardName
+			eg.Go(func() error {
+				return localSearch(shardName)
+			}, shardName)
+			return nil
+		},
+		func(replica routerTypes.Replica) error {
+			shardName := replica.ShardName
+			eg.Go(func() error {
+				return remoteSearch(shardName)
+			}, shardName)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing search for each shard: %w", err)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(readPlan.Shards()) > 1 {
+		for q := range out {
+			out[q], dists[q] = newDistancesSorter().sort(out[q], dists[q])
+			if len(out[q]) > limit {
+				out[q] = out[q][:limit]
+				dists[q] = dists[q][:limit]
+			}
+		}
+	}
+
+	if i.anyShardHasMultipleReplicasRead(tenant, readPlan.Shards()) {
+		for q := range out {
+			if err := i.replicator.CheckConsistency(ctx, cl, out[q]); err != nil {
+				i.logger.WithField("action", "object_vector_search_batch").
+					Errorf("failed to check consistency of search results: %v", err)
+			}
+		}
+	}
+
+	return out, dists, nil
+}
+
+// objectVectorSearchEach runs the queries of a batch one after another
+func (i *Index) objectVectorSearchEach(ctx context.Context, searchVectors [][]float32,
+	targetVector string, limit int, localFilters *filters.LocalFilter, additionalProps additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	out := make([][]*storobj.Object, len(searchVectors))
+	dists := make([][]float32, len(searchVectors))
+	for q, vector := range searchVectors {
+		objs, qDists, err := i.objectVectorSearch(ctx, []models.Vector{vector}, []string{targetVector},
+			0, limit, localFilters, nil, nil, additionalProps, replProps, tenant, nil, properties)
+		if err != nil {
+			return nil, nil, fmt.Errorf("query %d: %w", q, err)
+		}
+		out[q], dists[q] = objs, qDists
+	}
+	return out, dists, nil
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -89,6 +90,7 @@ type ShardLike interface {
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, properties []string) ([]*storobj.Object, []float32, error)
 	ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error)
+	ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error)
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
@@ -109,6 +111,7 @@ type ShardLike interface {
 	AnalyzeObject(*storobj.Object) ([]inverted.Property, []inverted.NilProperty, error)
 	Aggregate(ctx context.Context, params aggregation.Params, modules *modules.Provider) (*aggregation.Result, error)
 	HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	ReadChanges(ctx context.Context, after uint64, limit int, withObject bool) ([]changelog.Change, error)
 	MergeObject(ctx context.Context, object objects.MergeDocument) error
 	VectorDistanceForQuery(ctx context.Context, id uint64, searchVectors []models.Vector, targets []string) ([]float32, error)
 	ConvertQueue(targetVector string) error
@@ -192,6 +195,9 @@ type ShardLike interface {
 	DebugResetVectorIndex(ctx context.Context, targetVector string) error
 	RepairIndex(ctx context.Context, targetVector string) error
 	RequantizeIndex(ctx context.Context, targetVector string) error
+	RetrainVectorIndexCompression(ctx context.Context, targetVector string) error
+	TuneVectorIndex(ctx context.Context, targetVector string, params VectorIndexTuningParams) (*VectorIndexTuningReport, error)
+	CreateVectorIndexSnapshot(ctx context.Context, targetVector string) (*VectorIndexSnapshotReport, error)
 
 	// Debug method for docID lock debugging and contention detection and simulation
 	DebugGetDocIdLockStatus() (bool, error)
@@ -251,6 +257,10 @@ type Shard struct {
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
+	// set while this shard is the source of a pending split, see shard_split.go
+	splitter atomic.Pointer[shardSplitter]
+	// set if change data capture is enabled, see shard_change_log.go
+	changeLog *shardChangeLog
 	// replication
 	replicationMap pendingReplicaTasks
 
@@ -590,3 +590,27 @@ func (s *Shard) RequantizeIndex(ctx context.Context, targetVector string) error
 
 	return nil
 }
+
+// compressionRetrainer is implemented by vector indexes which can retrain
+// their quantization codebook without rebuilding the index, e.g. hnsw.
+type compressionRetrainer interface {
+	RetrainCompression(ctx context.Context) error
+}
+
+// RetrainVectorIndexCompression fits a new codebook on the current data and
+// re-encodes all vectors with it. Use it when the quantization drift reported
+// in the compression stats shows that the data has moved away from the
+// training sample.
+func (s *Shard) RetrainVectorIndexCompression(ctx context.Context, targetVector string) error {
+	vectorIndex, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return errors.Errorf("vector index for target vector %q not found", targetVector)
+	}
+
+	retrainer, ok := vectorIndex.(compressionRetrainer)
+	if !ok {
+		return errors.Errorf("vector index of type %T does not support retraining compression", vectorIndex)
+	}
+
+	return retrainer.RetrainCompression(ctx)
+}
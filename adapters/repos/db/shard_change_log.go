@@ -0,0 +1,319 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	changeLogPruneInterval = time.Minute
+	changeLogPrunePageSize = 1000
+	changeLogEntrySize     = 16 + 1 + 8
+)
+
+var changeLogOperations = []changelog.Operation{
+	changelog.OperationInsert,
+	changelog.OperationUpdate,
+	changelog.OperationDelete,
+}
+
+// shardChangeLog records every mutation of the objects of a shard in the
+// changelog bucket, keyed by a big-endian offset.
+//
+// Offsets are derived from the wall clock in nanoseconds, but never go
+// backwards, so they stay strictly increasing across restarts even if the
+// clock does. Entries older than the retention are pruned in the background.
+type shardChangeLog struct {
+	bucket    *lsmkv.Bucket
+	retention time.Duration
+	logger    logrus.FieldLogger
+
+	sync.Mutex
+	last uint64
+
+	lastPrune atomic.Int64
+	pruning   atomic.Bool
+}
+
+func (s *Shard) initChangeLog(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx, helpers.ChangeLogBucketLSM,
+		s.makeDefaultBucketOptions(lsmkv.StrategyReplace)...)
+	if err != nil {
+		return fmt.Errorf("create changelog bucket: %w", err)
+	}
+
+	bucket := s.store.Bucket(helpers.ChangeLogBucketLSM)
+	cl := &shardChangeLog{
+		bucket:    bucket,
+		retention: s.index.Config.ChangeDataCapture.Retention,
+		logger:    s.index.logger.WithFields(logrus.Fields{"action": "change_log", "shard": s.name}),
+		last:      lastChangeOffset(bucket),
+	}
+	cl.lastPrune.Store(time.Now().UnixNano())
+	s.changeLog = cl
+	return nil
+}
+
+// lastChangeOffset finds the highest offset in the bucket by binary search
+// over the key space, as cursors can only move forward
+func lastChangeOffset(bucket *lsmkv.Bucket) uint64 {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	k, _ := cursor.First()
+	if k == nil {
+		return 0
+	}
+
+	// lo is an existing offset, there is no offset above hi
+	lo, hi := binary.BigEndian.Uint64(k), uint64(math.MaxUint64)
+	for lo < hi {
+		mid := lo + (hi-lo)/2 + 1
+		if k, _ := cursor.Seek(changeOffsetKey(mid)); k != nil {
+			lo = binary.BigEndian.Uint64(k)
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+type skipChangeCaptureKey struct{}
+
+// withoutChangeCapture marks the writes made with the returned context as
+// internal, e.g. objects moved between shards by a split. They do not change
+// the objects as seen by clients and are therefore not captured.
+func withoutChangeCapture(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipChangeCaptureKey{}, true)
+}
+
+func changeCaptureSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipChangeCaptureKey{}).(bool)
+	return skip
+}
+
+// captureChange appends a mutation of the object to the change log of the
+// shard, if change data capture is enabled and the write is not internal.
+// Like trackSplitWrite it must be called after each change of an object in
+// the objects bucket.
+func (s *Shard) captureChange(ctx context.Context, idBytes []byte, op changelog.Operation, at time.Time) error {
+	cl := s.changeLog
+	if cl == nil || changeCaptureSkipped(ctx) {
+		return nil
+	}
+
+	if err := cl.append(idBytes, op, at); err != nil {
+		return fmt.Errorf("append to change log: %w", err)
+	}
+	cl.mayPrune(time.Now())
+	return nil
+}
+
+func (cl *shardChangeLog) append(idBytes []byte, op changelog.Operation, at time.Time) error {
+	value, err := encodeChange(idBytes, op, at)
+	if err != nil {
+		return err
+	}
+
+	// the lock keeps the order of the offsets in line with the order of the
+	// entries becoming visible to readers
+	cl.Lock()
+	defer cl.Unlock()
+
+	offset := max(uint64(time.Now().UnixNano()), cl.last+1)
+	if err := cl.bucket.Put(changeOffsetKey(offset), value); err != nil {
+		return err
+	}
+	cl.last = offset
+	return nil
+}
+
+func (cl *shardChangeLog) mayPrune(now time.Time) {
+	if now.UnixNano()-cl.lastPrune.Load() < int64(changeLogPruneInterval) {
+		return
+	}
+	if !cl.pruning.CompareAndSwap(false, true) {
+		return
+	}
+	cl.lastPrune.Store(now.UnixNano())
+
+	enterrors.GoWrapper(func() {
+		defer cl.pruning.Store(false)
+
+		pruned, err := cl.prune(uint64(now.Add(-cl.retention).UnixNano()))
+		if err != nil {
+			cl.logger.WithError(err).Warn("prune change log")
+			return
+		}
+		if pruned > 0 {
+			cl.logger.WithField("entries", pruned).Debug("pruned change log")
+		}
+	}, cl.logger)
+}
+
+// prune deletes all entries with an offset below cutoff
+func (cl *shardChangeLog) prune(cutoff uint64) (int, error) {
+	pruned := 0
+	for {
+		// collect a page first, as the cursor must be closed before writing
+		keys := make([][]byte, 0, changeLogPrunePageSize)
+		func() {
+			cursor := cl.bucket.Cursor()
+			defer cursor.Close()
+
+			for k, _ := cursor.First(); k != nil && len(keys) < changeLogPrunePageSize; k, _ = cursor.Next() {
+				if binary.BigEndian.Uint64(k) >= cutoff {
+					break
+				}
+				keys = append(keys, append([]byte(nil), k...))
+			}
+		}()
+
+		for _, k := range keys {
+			if err := cl.bucket.Delete(k); err != nil {
+				return pruned, err
+			}
+			pruned++
+		}
+		if len(keys) < changeLogPrunePageSize {
+			return pruned, nil
+		}
+	}
+}
+
+// ReadChanges returns up to limit changes with an offset above after. If
+// withObject is set, the changes carry the current properties of the
+// objects which still exist.
+func (s *Shard) ReadChanges(ctx context.Context, after uint64, limit int, withObject bool) ([]changelog.Change, error) {
+	cl := s.changeLog
+	if cl == nil {
+		return nil, fmt.Errorf("change data capture is not enabled")
+	}
+	if after == math.MaxUint64 {
+		return nil, nil
+	}
+
+	var changes []changelog.Change
+	var decodeErr error
+	func() {
+		cursor := cl.bucket.Cursor()
+		defer cursor.Close()
+
+		for k, v := cursor.Seek(changeOffsetKey(after + 1)); k != nil && len(changes) < limit; k, v = cursor.Next() {
+			change, err := decodeChange(v)
+			if err != nil {
+				decodeErr = fmt.Errorf("decode change at offset %d: %w", binary.BigEndian.Uint64(k), err)
+				return
+			}
+			change.Offset = binary.BigEndian.Uint64(k)
+			changes = append(changes, change)
+		}
+	}()
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	if !withObject {
+		return changes, nil
+	}
+	for i := range changes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if changes[i].Operation == changelog.OperationDelete {
+			continue
+		}
+		idBytes, err := uuid.MustParse(changes[i].ID.String()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		obj, err := objectFromBucket(s, idBytes)
+		if err != nil {
+			return nil, fmt.Errorf("read object %s: %w", changes[i].ID, err)
+		}
+		if obj == nil {
+			continue
+		}
+		props, err := json.Marshal(obj.Properties())
+		if err != nil {
+			return nil, fmt.Errorf("marshal properties of object %s: %w", changes[i].ID, err)
+		}
+		changes[i].Properties = props
+	}
+	return changes, nil
+}
+
+func deletionTimeOrNow(deletionTime time.Time) time.Time {
+	if deletionTime.IsZero() {
+		return time.Now()
+	}
+	return deletionTime
+}
+
+func changeOffsetKey(offset uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, offset)
+	return key
+}
+
+func encodeChange(idBytes []byte, op changelog.Operation, at time.Time) ([]byte, error) {
+	if len(idBytes) != 16 {
+		return nil, fmt.Errorf("invalid object id of length %d", len(idBytes))
+	}
+
+	value := make([]byte, changeLogEntrySize)
+	copy(value, idBytes)
+	for i, known := range changeLogOperations {
+		if known == op {
+			value[16] = byte(i)
+			binary.BigEndian.PutUint64(value[17:], uint64(at.UnixMilli()))
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", op)
+}
+
+func decodeChange(value []byte) (changelog.Change, error) {
+	if len(value) != changeLogEntrySize {
+		return changelog.Change{}, fmt.Errorf("invalid entry of length %d", len(value))
+	}
+	if int(value[16]) >= len(changeLogOperations) {
+		return changelog.Change{}, fmt.Errorf("unknown operation %d", value[16])
+	}
+
+	id, err := uuid.FromBytes(value[:16])
+	if err != nil {
+		return changelog.Change{}, err
+	}
+	return changelog.Change{
+		ID:        strfmt.UUID(id.String()),
+		Operation: changeLogOperations[value[16]],
+		Timestamp: int64(binary.BigEndian.Uint64(value[17:])),
+	}, nil
+}
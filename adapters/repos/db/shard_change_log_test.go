@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestShardChangeLog(t *testing.T) {
+	ctx := context.Background()
+	className := "ChangeLogTestClass"
+
+	shard, _ := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeDataCapture.Enabled = true
+		i.Config.ChangeDataCapture.Retention = time.Hour
+	})
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"name": "first"}
+	require.NoError(t, shard.PutObject(ctx, obj))
+
+	obj.Object.Properties = map[string]interface{}{"name": "second"}
+	obj.Object.LastUpdateTimeUnix = time.Now().UnixMilli()
+	require.NoError(t, shard.PutObject(ctx, obj))
+
+	other := testObject(className)
+	require.NoError(t, shard.PutObject(ctx, other))
+	require.NoError(t, shard.DeleteObject(ctx, other.ID(), time.Time{}))
+
+	t.Run("read all changes in order", func(t *testing.T) {
+		changes, err := shard.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+		require.Len(t, changes, 4)
+
+		expected := []struct {
+			op changelog.Operation
+			id string
+		}{
+			{changelog.OperationInsert, obj.ID().String()},
+			{changelog.OperationUpdate, obj.ID().String()},
+			{changelog.OperationInsert, other.ID().String()},
+			{changelog.OperationDelete, other.ID().String()},
+		}
+		for i, change := range changes {
+			assert.Equal(t, expected[i].op, change.Operation)
+			assert.Equal(t, expected[i].id, change.ID.String())
+			assert.Nil(t, change.Properties)
+			if i > 0 {
+				assert.Greater(t, change.Offset, changes[i-1].Offset)
+			}
+		}
+		assert.Equal(t, obj.LastUpdateTimeUnix(), changes[1].Timestamp)
+	})
+
+	t.Run("resume after offset with objects", func(t *testing.T) {
+		all, err := shard.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+
+		changes, err := shard.ReadChanges(ctx, all[0].Offset, 2, true)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, all[1].Offset, changes[0].Offset)
+
+		var props map[string]interface{}
+		require.NoError(t, json.Unmarshal(changes[0].Properties, &props))
+		assert.Equal(t, "second", props["name"])
+		// the object got deleted
+		assert.Nil(t, changes[1].Properties)
+	})
+
+	t.Run("offsets survive a restart of the change log", func(t *testing.T) {
+		s := shard.(*Shard)
+		all, err := s.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+		assert.Equal(t, all[len(all)-1].Offset, lastChangeOffset(s.store.Bucket(helpers.ChangeLogBucketLSM)))
+	})
+
+	t.Run("merges are updates", func(t *testing.T) {
+		all, err := shard.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+
+		require.NoError(t, shard.MergeObject(ctx, objects.MergeDocument{
+			Class:              className,
+			ID:                 obj.ID(),
+			PrimitiveSchema:    map[string]interface{}{"name": "third"},
+			UpdateTime:         time.Now().UnixMilli(),
+			PropertiesToDelete: []string{},
+		}))
+
+		changes, err := shard.ReadChanges(ctx, all[len(all)-1].Offset, 10, false)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, changelog.OperationUpdate, changes[0].Operation)
+	})
+
+	t.Run("prune old changes", func(t *testing.T) {
+		s := shard.(*Shard)
+		all, err := s.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+
+		pruned, err := s.changeLog.prune(all[2].Offset)
+		require.NoError(t, err)
+		assert.Equal(t, 2, pruned)
+
+		changes, err := s.ReadChanges(ctx, 0, 10, false)
+		require.NoError(t, err)
+		assert.Equal(t, all[2:], changes)
+	})
+}
+
+func TestShardChangeLogDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	shard, _ := testShard(t, ctx, "ChangeLogDisabledTestClass")
+	require.NoError(t, shard.PutObject(ctx, testObject("ChangeLogDisabledTestClass")))
+
+	_, err := shard.ReadChanges(ctx, 0, 10, false)
+	require.ErrorContains(t, err, "not enabled")
+	assert.Nil(t, shard.Store().Bucket(helpers.ChangeLogBucketLSM))
+}
+
+func TestChangeLogEncoding(t *testing.T) {
+	id := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	at := time.UnixMilli(1700000000123)
+
+	for _, op := range changeLogOperations {
+		value, err := encodeChange(id, op, at)
+		require.NoError(t, err)
+
+		change, err := decodeChange(value)
+		require.NoError(t, err)
+		assert.Equal(t, op, change.Operation)
+		assert.Equal(t, at.UnixMilli(), change.Timestamp)
+		assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10", change.ID.String())
+	}
+
+	_, err := encodeChange(id, changelog.Operation("UPSERT"), at)
+	require.Error(t, err)
+	_, err = encodeChange(id[:8], changelog.OperationInsert, at)
+	require.Error(t, err)
+	_, err = decodeChange([]byte{1, 2})
+	require.Error(t, err)
+}
+
+func TestChangeSource(t *testing.T) {
+	physical := sharding.Physical{Name: "shard1", BelongsToNodes: []string{"node3", "node1", "node2"}}
+
+	t.Run("prefer the local replica", func(t *testing.T) {
+		assert.Equal(t, changelog.Source{Shard: "shard1", Node: "node2"}, changeSource(physical, "node2"))
+	})
+
+	t.Run("stable choice of a remote replica", func(t *testing.T) {
+		assert.Equal(t, changelog.Source{Shard: "shard1", Node: "node1"}, changeSource(physical, "node4"))
+		assert.Equal(t, []string{"node3", "node1", "node2"}, physical.BelongsToNodes)
+	})
+}
@@ -24,8 +24,9 @@ import (
 // that isn't using the block max inverted index
 func (s *Shard) areAllSearchableBucketsBlockMax() bool {
 	for name, bucket := range s.Store().GetBucketsByName() {
-		_, indexType := GetPropNameAndIndexTypeFromBucketName(name)
-		if bucket.Strategy() == lsmkv.StrategyMapCollection && indexType == IndexTypePropSearchableValue {
+		propName, indexType := GetPropNameAndIndexTypeFromBucketName(name)
+		if bucket.Strategy() == lsmkv.StrategyMapCollection && indexType == IndexTypePropSearchableValue &&
+			!s.index.isSparseVectorProp(propName) {
 			return false
 		}
 	}
@@ -91,6 +91,12 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	if s.index.Config.ChangeDataCapture.Enabled {
+		if err := s.initChangeLog(ctx); err != nil {
+			return fmt.Errorf("init shard %q: %w", s.ID(), err)
+		}
+	}
+
 	// Object bucket must be available, initAsyncReplication depends on it
 	if s.index.AsyncReplicationEnabled() {
 		s.asyncReplicationRWMux.Lock()
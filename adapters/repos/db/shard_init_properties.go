@@ -181,6 +181,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasSearchableIndex(prop) && schema.IsSparseVectorDataType(prop.DataType) {
+		// sparse vector weights are scored as they are, the block-max
+		// segments of StrategyInverted only support BM25 scoring
+		return s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketSearchableFromPropNameLSM(prop.Name),
+			makeBucketOptions(lsmkv.StrategyMapCollection)...,
+		)
+	}
+
 	if inverted.HasSearchableIndex(prop) {
 		strategy := lsmkv.DefaultSearchableStrategy(s.usingBlockMaxWAND)
 		searchableBucketOpts := makeBucketOptions(strategy)
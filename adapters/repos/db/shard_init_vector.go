@@ -52,26 +52,52 @@ func (s *Shard) initShardVectors(ctx context.Context) error {
 	return nil
 }
 
-func (s *Shard) initVectorIndex(ctx context.Context,
-	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig, lazyLoadSegments bool,
-) (VectorIndex, error) {
-	var distProv distancer.Provider
-
-	switch vectorIndexUserConfig.DistanceName() {
+// distanceProviderFor returns the distancer matching the configured distance
+// metric. An empty name falls back to cosine, the schema default.
+func distanceProviderFor(cfg schemaConfig.VectorIndexConfig) (distancer.Provider, error) {
+	switch distanceName := cfg.DistanceName(); distanceName {
 	case "", common.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case common.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case common.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case common.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case common.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
+	case common.DistanceJaccard:
+		return distancer.NewJaccardProvider(), nil
+	case common.DistanceWeightedDot:
+		weights := distanceWeightsFor(cfg)
+		if len(weights) == 0 {
+			return nil, errors.Errorf("distance metric %q requires distanceWeights", distanceName)
+		}
+		return distancer.NewWeightedDotProductProvider(weights), nil
+	default:
+		return nil, common.ValidateDistance(distanceName, nil)
+	}
+}
+
+func distanceWeightsFor(cfg schemaConfig.VectorIndexConfig) []float32 {
+	switch typed := cfg.(type) {
+	case hnswent.UserConfig:
+		return typed.DistanceWeights
+	case flatent.UserConfig:
+		return typed.DistanceWeights
+	case dynamicent.UserConfig:
+		return typed.DistanceWeights
 	default:
-		return nil, fmt.Errorf("init vector index: %w",
-			errors.Errorf("unrecognized distance metric %q,"+
-				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", vectorIndexUserConfig.DistanceName()))
+		return nil
+	}
+}
+
+func (s *Shard) initVectorIndex(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig, lazyLoadSegments bool,
+) (VectorIndex, error) {
+	distProv, err := distanceProviderFor(vectorIndexUserConfig)
+	if err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
 	}
 
 	var vectorIndex VectorIndex
@@ -128,10 +154,12 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 						hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
 						hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
 						hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+						hnsw.WithSnapshotMetrics(s.promMetrics, s.index.Config.ClassName.String(), s.name, targetVector),
 					)
 				},
 				AllocChecker:           s.index.allocChecker,
 				WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
+				VectorCacheAdmission:   s.index.Config.HNSWVectorCacheAdmission,
 				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
 				AcornFilterRatio:       s.index.Config.HNSWAcornFilterRatio,
 				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
@@ -193,6 +221,15 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 
+		var hfreshConfig *hfresh.Config
+		if dynamicUserConfig.Target == dynamicent.TargetHFresh {
+			if !s.index.HFreshEnabled {
+				return nil, errors.New("hfresh index is available only in experimental mode")
+			}
+			s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+			hfreshConfig = s.hfreshConfig(targetVector, distProv, makeBucketOptions)
+		}
+
 		vi, err := dynamic.New(dynamic.Config{
 			ID:                           vecIdxID,
 			TargetVector:                 targetVector,
@@ -216,15 +253,18 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 					hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
 					hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
 					hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+					hnsw.WithSnapshotMetrics(s.promMetrics, s.index.Config.ClassName.String(), s.name, targetVector),
 				)
 			},
-			TombstoneCallbacks:    s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-			SharedDB:              sharedDB,
-			HNSWDisableSnapshots:  s.index.Config.HNSWDisableSnapshots,
-			HNSWSnapshotOnStartup: s.index.Config.HNSWSnapshotOnStartup,
-			AllocChecker:          s.index.allocChecker,
-			MakeBucketOptions:     makeBucketOptions,
-			AsyncIndexingEnabled:  s.index.AsyncIndexingEnabled,
+			TombstoneCallbacks:       s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+			SharedDB:                 sharedDB,
+			HNSWDisableSnapshots:     s.index.Config.HNSWDisableSnapshots,
+			HNSWSnapshotOnStartup:    s.index.Config.HNSWSnapshotOnStartup,
+			HNSWVectorCacheAdmission: s.index.Config.HNSWVectorCacheAdmission,
+			AllocChecker:             s.index.allocChecker,
+			MakeBucketOptions:        makeBucketOptions,
+			AsyncIndexingEnabled:     s.index.AsyncIndexingEnabled,
+			HFreshConfig:             hfreshConfig,
 		}, dynamicUserConfig, s.store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
@@ -243,63 +283,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
 		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
 
-		hfreshConfigID := s.vectorIndexID(targetVector)
-		rootPath := filepath.Join(s.path(), fmt.Sprintf("%s.hfresh.d", hfreshConfigID))
-
-		hfreshConfig := &hfresh.Config{
-			Logger:            s.index.logger,
-			Scheduler:         s.index.scheduler,
-			DistanceProvider:  distProv,
-			RootPath:          rootPath,
-			ID:                hfreshConfigID,
-			TargetVector:      targetVector,
-			ShardName:         s.name,
-			ClassName:         s.index.Config.ClassName.String(),
-			PrometheusMetrics: s.promMetrics,
-			Store: hfresh.StoreConfig{
-				MakeBucketOptions: makeBucketOptions,
-			},
-			VectorForIDThunk:   hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
-			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-			Centroids: hfresh.CentroidConfig{
-				HNSWConfig: &hnsw.Config{
-					Logger:                            s.index.logger,
-					RootPath:                          rootPath,
-					ID:                                hfreshConfigID + "_centroids",
-					ShardName:                         s.name,
-					ClassName:                         s.index.Config.ClassName.String(),
-					PrometheusMetrics:                 s.promMetrics,
-					HFreshMode:                        true,
-					TempMultiVectorForIDThunk:         hnsw.NewTempMultiVectorForIDThunk(targetVector, s.readMultiVectorByIndexIDIntoSlice),
-					GetViewThunk:                      func() vcommon.BucketView { return s.GetObjectsBucketView() },
-					TempVectorForIDWithViewThunk:      hnsw.NewTempVectorForIDWithViewThunk(targetVector, s.readVectorByIndexIDIntoSliceWithView),
-					TempMultiVectorForIDWithViewThunk: hnsw.NewTempVectorForIDWithViewThunk(targetVector, s.readMultiVectorByIndexIDIntoSliceWithView),
-					DistanceProvider:                  distProv,
-					MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-						return hnsw.NewCommitLogger(rootPath, hfreshConfigID+"_centroids",
-							s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
-							hnsw.WithAllocChecker(s.index.allocChecker),
-							hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
-							// consistent with previous logic where the individual limit is 1/5 of the combined limit
-							hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
-							hnsw.WithSnapshotDisabled(s.index.Config.HNSWDisableSnapshots),
-							hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
-							hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
-							hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
-						)
-					},
-					AllocChecker:           s.index.allocChecker,
-					WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
-					FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
-					AcornFilterRatio:       s.index.Config.HNSWAcornFilterRatio,
-					VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
-					DisableSnapshots:       s.index.Config.HNSWDisableSnapshots,
-					SnapshotOnStartup:      s.index.Config.HNSWSnapshotOnStartup,
-					MakeBucketOptions:      makeBucketOptions,
-				},
-			},
-		}
-
+		hfreshConfig := s.hfreshConfig(targetVector, distProv, makeBucketOptions)
 		vi, err := hfresh.New(hfreshConfig, userConfig, s.store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: hfresh index", s.ID())
@@ -313,6 +297,71 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 	return vectorIndex, nil
 }
 
+// hfreshConfig builds the config of an hfresh index, either standalone or
+// as the upgrade target of a dynamic index.
+func (s *Shard) hfreshConfig(targetVector string, distProv distancer.Provider,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+) *hfresh.Config {
+	hfreshConfigID := s.vectorIndexID(targetVector)
+	rootPath := filepath.Join(s.path(), fmt.Sprintf("%s.hfresh.d", hfreshConfigID))
+
+	return &hfresh.Config{
+		Logger:            s.index.logger,
+		Scheduler:         s.index.scheduler,
+		DistanceProvider:  distProv,
+		RootPath:          rootPath,
+		ID:                hfreshConfigID,
+		TargetVector:      targetVector,
+		ShardName:         s.name,
+		ClassName:         s.index.Config.ClassName.String(),
+		PrometheusMetrics: s.promMetrics,
+		Store: hfresh.StoreConfig{
+			MakeBucketOptions: makeBucketOptions,
+		},
+		VectorForIDThunk:   hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
+		TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		Centroids: hfresh.CentroidConfig{
+			HNSWConfig: &hnsw.Config{
+				Logger:                            s.index.logger,
+				RootPath:                          rootPath,
+				ID:                                hfreshConfigID + "_centroids",
+				ShardName:                         s.name,
+				ClassName:                         s.index.Config.ClassName.String(),
+				PrometheusMetrics:                 s.promMetrics,
+				HFreshMode:                        true,
+				TempMultiVectorForIDThunk:         hnsw.NewTempMultiVectorForIDThunk(targetVector, s.readMultiVectorByIndexIDIntoSlice),
+				GetViewThunk:                      func() vcommon.BucketView { return s.GetObjectsBucketView() },
+				TempVectorForIDWithViewThunk:      hnsw.NewTempVectorForIDWithViewThunk(targetVector, s.readVectorByIndexIDIntoSliceWithView),
+				TempMultiVectorForIDWithViewThunk: hnsw.NewTempVectorForIDWithViewThunk(targetVector, s.readMultiVectorByIndexIDIntoSliceWithView),
+				DistanceProvider:                  distProv,
+				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+					return hnsw.NewCommitLogger(rootPath, hfreshConfigID+"_centroids",
+						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+						hnsw.WithAllocChecker(s.index.allocChecker),
+						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
+						// consistent with previous logic where the individual limit is 1/5 of the combined limit
+						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+						hnsw.WithSnapshotDisabled(s.index.Config.HNSWDisableSnapshots),
+						hnsw.WithSnapshotCreateInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
+						hnsw.WithSnapshotMinDeltaCommitlogsNumer(s.index.Config.HNSWSnapshotMinDeltaCommitlogsNumber),
+						hnsw.WithSnapshotMinDeltaCommitlogsSizePercentage(s.index.Config.HNSWSnapshotMinDeltaCommitlogsSizePercentage),
+						hnsw.WithSnapshotMetrics(s.promMetrics, s.index.Config.ClassName.String(), s.name, targetVector),
+					)
+				},
+				AllocChecker:           s.index.allocChecker,
+				WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
+				VectorCacheAdmission:   s.index.Config.HNSWVectorCacheAdmission,
+				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
+				AcornFilterRatio:       s.index.Config.HNSWAcornFilterRatio,
+				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
+				DisableSnapshots:       s.index.Config.HNSWDisableSnapshots,
+				SnapshotOnStartup:      s.index.Config.HNSWSnapshotOnStartup,
+				MakeBucketOptions:      makeBucketOptions,
+			},
+		},
+	}
+}
+
 func (s *Shard) getOrInitDynamicVectorIndexDB() (*bbolt.DB, error) {
 	if s.dynamicVectorIndexDB == nil {
 		path := filepath.Join(s.path(), "index.db")
@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -283,6 +284,13 @@ func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors []
 	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties)
 }
 
+func (l *LazyLoadShard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, nil, err
+	}
+	return l.shard.ObjectVectorSearchBatch(ctx, searchVectors, targetVector, limit, filters, additional, properties)
+}
+
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -602,6 +610,24 @@ func (l *LazyLoadShard) RequantizeIndex(ctx context.Context, targetVector string
 	return l.shard.RequantizeIndex(ctx, targetVector)
 }
 
+func (l *LazyLoadShard) RetrainVectorIndexCompression(ctx context.Context, targetVector string) error {
+	l.mustLoad()
+	return l.shard.RetrainVectorIndexCompression(ctx, targetVector)
+}
+
+func (l *LazyLoadShard) TuneVectorIndex(ctx context.Context, targetVector string,
+	params VectorIndexTuningParams,
+) (*VectorIndexTuningReport, error) {
+	l.mustLoad()
+	return l.shard.TuneVectorIndex(ctx, targetVector, params)
+}
+
+func (l *LazyLoadShard) CreateVectorIndexSnapshot(ctx context.Context, targetVector string,
+) (*VectorIndexSnapshotReport, error) {
+	l.mustLoad()
+	return l.shard.CreateVectorIndexSnapshot(ctx, targetVector)
+}
+
 func (l *LazyLoadShard) Shutdown(ctx context.Context) error {
 	if !l.isLoaded() {
 		return nil
@@ -616,6 +642,13 @@ func (l *LazyLoadShard) preventShutdown() (release func(), err error) {
 	return l.shard.preventShutdown()
 }
 
+func (l *LazyLoadShard) ReadChanges(ctx context.Context, after uint64, limit int, withObject bool) ([]changelog.Change, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.ReadChanges(ctx, after, limit, withObject)
+}
+
 func (l *LazyLoadShard) HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error) {
 	if !l.isLoaded() {
 		return []hashtree.Digest{}, nil
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -358,7 +359,15 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
 			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher, s.index.stopwords,
 			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
-		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
+		if keywordRanking.Type == "sparseVector" {
+			if keywordRanking.SparseVector == nil {
+				return nil, nil, errors.New("sparse vector ranking without a sparse vector")
+			}
+			bm25objs, bm25count, err = bm25searcher.SparseVectorSearch(ctx, filterDocIds, className, limit,
+				*keywordRanking.SparseVector, additional)
+		} else {
+			bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -511,6 +520,9 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 							s.index.Config.ClassName, s.name, err))
 						return err
 					}
+					if q, ok := s.GetVectorIndexQueue(targetVector); ok {
+						q.SampleRecall(searchVector, limit, allowList, ids, dists)
+					}
 				case [][]float32:
 					ids, dists, err = vidx.(VectorIndexMulti).SearchByMultiVector(ctx, searchVector, limit, allowList)
 					if err != nil {
@@ -594,6 +606,98 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	return objs, distCombined, nil
 }
 
+// ObjectVectorSearchBatch runs several nearVector queries against the same
+// target vector. The allow list is built once and shared by all queries.
+func (s *Shard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string,
+	limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	startTime := time.Now()
+	defer func() {
+		s.slowQueryReporter.LogIfSlow(ctx, startTime, map[string]any{
+			"collection": s.index.Config.ClassName,
+			"shard":      s.ID(),
+			"tenant":     s.tenant(),
+			"query":      "ObjectVectorSearchBatch",
+			"queries":    len(searchVectors),
+			"filters":    filters,
+			"limit":      limit,
+			"version":    s.versioner.Version(),
+			"additional": additional,
+		})
+	}()
+
+	s.activityTrackerRead.Add(1)
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		beforeFilter := time.Now()
+		list, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		allowList = list
+		defer allowList.Close()
+		took := time.Since(beforeFilter)
+		s.metrics.FilteredVectorFilter(took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+	}
+
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, nil, fmt.Errorf("index for target vector %q not found", targetVector)
+	}
+
+	beforeVector := time.Now()
+	idss, distss, err := vidx.SearchByVectorBatch(ctx, searchVectors, limit, allowList)
+	if err != nil {
+		err = fmt.Errorf("vector batch search: %w", err)
+		entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+			s.index.Config.ClassName, s.name, err))
+		return nil, nil, err
+	}
+	if filters != nil {
+		s.metrics.FilteredVectorVector(time.Since(beforeVector))
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "vector_search_took", time.Since(beforeVector))
+
+	beforeObjects := time.Now()
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objss := make([][]*storobj.Object, len(idss))
+	for i, ids := range idss {
+		objs, err := storobj.ObjectsByDocID(bucket, ids, additional, properties, s.index.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(objs) != len(ids) {
+			// ObjectsByDocID skips objects which have been deleted in the
+			// meantime, the distances have to be realigned
+			distss[i] = realignDists(objs, ids, distss[i])
+		}
+		objss[i] = objs
+	}
+
+	took := time.Since(beforeObjects)
+	if filters != nil {
+		s.metrics.FilteredVectorObjects(took)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+
+	return objss, distss, nil
+}
+
+func realignDists(objs []*storobj.Object, ids []uint64, dists []float32) []float32 {
+	byID := make(map[uint64]float32, len(ids))
+	for i, id := range ids {
+		byID[id] = dists[i]
+	}
+	out := make([]float32, len(objs))
+	for i, obj := range objs {
+		out[i] = byID[obj.DocID]
+	}
+	return out
+}
+
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
 	s.activityTrackerRead.Add(1)
 	if len(sort) > 0 {
@@ -771,6 +875,10 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	s.trackSplitWrite(idBytes)
+	if err := s.captureChange(ctx, idBytes, changelog.OperationDelete, deletionTimeOrNow(deletionTime)); err != nil {
+		return err
+	}
 
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
 		return errors.Wrap(err, "object deletion in hashtree")
@@ -0,0 +1,357 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	// shardSplitMarkerFile is written into the directory of a shard that is
+	// the source of a split. It is removed once the moved objects have been
+	// purged from the source, or when the split got aborted.
+	shardSplitMarkerFile = "split.json"
+
+	shardSplitScanPageSize = 1000
+	shardSplitLockStripes  = 64
+)
+
+type shardSplitMarker struct {
+	Target string `json:"target"`
+}
+
+// shardSplitter fills the target of a pending split with the objects of the
+// source shard which hash into the moved virtual shards.
+//
+// Every object is copied by syncObject, which reads the current state of the
+// object from the source and applies it to the target. Writes to the source
+// during the split mark the object as dirty, so that it is synced again. As
+// each sync reads the latest source state under a per-object lock, the
+// target converges to the source regardless of the order of copy and writes.
+type shardSplitter struct {
+	source *Shard
+	target *Shard
+	split  sharding.ShardSplit
+	state  *sharding.State
+	moved  map[string]struct{}
+	logger logrus.FieldLogger
+
+	locks [shardSplitLockStripes]sync.Mutex
+
+	dirtyLock sync.Mutex
+	dirty     map[strfmt.UUID]struct{}
+	dirtyCh   chan struct{}
+
+	// committedAt is the unix millis timestamp of the cutover. From then on
+	// the target receives writes directly, which must not be overwritten by
+	// older source state.
+	committedAt atomic.Int64
+}
+
+func newShardSplitter(source, target *Shard, split sharding.ShardSplit, state *sharding.State) *shardSplitter {
+	moved := make(map[string]struct{}, len(split.Virtual))
+	for _, name := range split.Virtual {
+		moved[name] = struct{}{}
+	}
+
+	return &shardSplitter{
+		source:  source,
+		target:  target,
+		split:   split,
+		state:   state,
+		moved:   moved,
+		logger:  source.index.logger.WithFields(logrus.Fields{"action": "shard_split", "shard": source.name, "target": split.Target}),
+		dirty:   map[strfmt.UUID]struct{}{},
+		dirtyCh: make(chan struct{}, 1),
+	}
+}
+
+func (sp *shardSplitter) moves(id strfmt.UUID) bool {
+	_, ok := sp.moved[sp.state.VirtualShard([]byte(id))]
+	return ok
+}
+
+func (sp *shardSplitter) markDirty(id strfmt.UUID) {
+	sp.dirtyLock.Lock()
+	sp.dirty[id] = struct{}{}
+	sp.dirtyLock.Unlock()
+
+	select {
+	case sp.dirtyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (sp *shardSplitter) lockFor(idBytes []byte) *sync.Mutex {
+	return &sp.locks[binary.LittleEndian.Uint16(idBytes[14:])%shardSplitLockStripes]
+}
+
+// syncObject makes the target reflect the current state of the object in the
+// source shard. The writes to the target are not captured in its change log,
+// as the source already captured the changes they replay.
+func (sp *shardSplitter) syncObject(ctx context.Context, id strfmt.UUID) error {
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := sp.lockFor(idBytes)
+	lock.Lock()
+	defer lock.Unlock()
+
+	sourceObj, err := objectFromBucket(sp.source, idBytes)
+	if err != nil {
+		return fmt.Errorf("read %s from source: %w", id, err)
+	}
+	targetObj, err := objectFromBucket(sp.target, idBytes)
+	if err != nil {
+		return fmt.Errorf("read %s from target: %w", id, err)
+	}
+
+	if sourceObj == nil {
+		if targetObj == nil {
+			return nil
+		}
+		if committedAt := sp.committedAt.Load(); committedAt > 0 && targetObj.LastUpdateTimeUnix() >= committedAt {
+			// written to the target after the cutover
+			return nil
+		}
+		return sp.target.DeleteObject(withoutChangeCapture(ctx), id, time.Time{})
+	}
+
+	if targetObj != nil && targetObj.LastUpdateTimeUnix() > sourceObj.LastUpdateTimeUnix() {
+		return nil
+	}
+	return sp.target.PutObject(withoutChangeCapture(ctx), sourceObj)
+}
+
+// copyAll syncs every object of the source that hashes into a moved virtual
+// shard
+func (sp *shardSplitter) copyAll(ctx context.Context) (int, error) {
+	copied := 0
+	err := scanObjectIDs(ctx, sp.source, func(ids []strfmt.UUID) error {
+		for _, id := range ids {
+			if !sp.moves(id) {
+				continue
+			}
+			if err := sp.syncObject(ctx, id); err != nil {
+				return err
+			}
+			copied++
+		}
+		return nil
+	})
+	return copied, err
+}
+
+// drainDirty syncs all objects written to the source since the last drain
+func (sp *shardSplitter) drainDirty(ctx context.Context) error {
+	sp.dirtyLock.Lock()
+	dirty := sp.dirty
+	sp.dirty = map[strfmt.UUID]struct{}{}
+	sp.dirtyLock.Unlock()
+
+	for id := range dirty {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := sp.syncObject(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run copies the moved objects into the target, reports the local replica
+// as ready and then keeps the target in sync with the writes to the source
+// until ctx is cancelled on cutover, abort or shutdown
+func (sp *shardSplitter) run(ctx context.Context, reportReady func(context.Context) error) error {
+	start := time.Now()
+	copied, err := sp.copyAll(ctx)
+	if err != nil {
+		return fmt.Errorf("copy objects: %w", err)
+	}
+	if err := sp.drainDirty(ctx); err != nil {
+		return fmt.Errorf("sync written objects: %w", err)
+	}
+	sp.logger.WithFields(logrus.Fields{
+		"objects": copied,
+		"took":    time.Since(start),
+	}).Info("copied objects into split target")
+
+	if err := reportReady(ctx); err != nil {
+		return fmt.Errorf("report ready: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sp.dirtyCh:
+			if err := sp.drainDirty(ctx); err != nil {
+				return fmt.Errorf("sync written objects: %w", err)
+			}
+		}
+	}
+}
+
+// trackSplitWrite marks the object as written if the shard is being split
+// and the object moves to the split target. It must be called after each
+// change of an object in the objects bucket.
+func (s *Shard) trackSplitWrite(idBytes []byte) {
+	sp := s.splitter.Load()
+	if sp == nil {
+		return
+	}
+	parsed, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return
+	}
+	id := strfmt.UUID(parsed.String())
+	if sp.moves(id) {
+		sp.markDirty(id)
+	}
+}
+
+// purgeForeignObjects deletes all objects that according to state are no
+// longer owned by this shard, i.e. objects moved away by a split. The objects
+// live on in their new shard, so the deletes are not captured as changes.
+func (s *Shard) purgeForeignObjects(ctx context.Context, state *sharding.State) (int, error) {
+	ctx = withoutChangeCapture(ctx)
+	purged := 0
+	err := scanObjectIDs(ctx, s, func(ids []strfmt.UUID) error {
+		for _, id := range ids {
+			if state.PhysicalShard([]byte(id)) == s.name {
+				continue
+			}
+			if err := s.DeleteObject(ctx, id, time.Time{}); err != nil {
+				return fmt.Errorf("delete %s: %w", id, err)
+			}
+			purged++
+		}
+		return nil
+	})
+	return purged, err
+}
+
+// scanObjectIDs passes the ids of all objects of the shard to fn in pages.
+// The cursor is not held while fn runs, so that fn is free to write.
+func scanObjectIDs(ctx context.Context, s *Shard, fn func(ids []strfmt.UUID) error) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	var last []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ids := make([]strfmt.UUID, 0, shardSplitScanPageSize)
+		func() {
+			cursor := bucket.Cursor()
+			defer cursor.Close()
+
+			var k []byte
+			if last == nil {
+				k, _ = cursor.First()
+			} else {
+				k, _ = cursor.Seek(last)
+				if k != nil && bytes.Equal(k, last) {
+					k, _ = cursor.Next()
+				}
+			}
+			for ; k != nil && len(ids) < shardSplitScanPageSize; k, _ = cursor.Next() {
+				parsed, err := uuid.FromBytes(k)
+				if err != nil {
+					continue
+				}
+				ids = append(ids, strfmt.UUID(parsed.String()))
+				last = append(last[:0], k...)
+			}
+		}()
+
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := fn(ids); err != nil {
+			return err
+		}
+		if len(ids) < shardSplitScanPageSize {
+			return nil
+		}
+	}
+}
+
+func objectFromBucket(s *Shard, idBytes []byte) (*storobj.Object, error) {
+	data, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return storobj.FromBinary(data)
+}
+
+func writeShardSplitMarker(shardDir, target string) error {
+	data, err := json.Marshal(shardSplitMarker{Target: target})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(shardDir, os.ModePerm); err != nil {
+		return err
+	}
+	tmp := filepath.Join(shardDir, shardSplitMarkerFile+".tmp")
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(shardDir, shardSplitMarkerFile))
+}
+
+func readShardSplitMarker(shardDir string) (*shardSplitMarker, error) {
+	data, err := os.ReadFile(filepath.Join(shardDir, shardSplitMarkerFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	marker := &shardSplitMarker{}
+	if err := json.Unmarshal(data, marker); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", shardSplitMarkerFile, err)
+	}
+	return marker, nil
+}
+
+func removeShardSplitMarker(shardDir string) error {
+	err := os.Remove(filepath.Join(shardDir, shardSplitMarkerFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestShardSplitter(t *testing.T) {
+	ctx := context.Background()
+	className := "SplitTestClass"
+
+	shard, idx := testShard(t, ctx, className)
+	source, err := idx.loadedLocalShard(ctx, shard.Name())
+	require.NoError(t, err)
+
+	target, err := idx.initShard(ctx, "target", &models.Class{Class: className}, nil, true, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { target.Shutdown(ctx) })
+
+	state := singleShardState()
+	stateShard := state.AllPhysicalShards()[0]
+	require.NoError(t, state.StartSplit(stateShard, "target"))
+	split := state.SplitInProgress(stateShard)
+	require.NotNil(t, split)
+
+	sp := newShardSplitter(source, target.(*Shard), *split, state)
+	source.splitter.Store(sp)
+
+	now := time.Now().UnixMilli()
+	var moved, kept []*models.Object
+	for range 200 {
+		obj := testObject(className)
+		obj.Object.LastUpdateTimeUnix = now
+		require.NoError(t, source.PutObject(ctx, obj))
+		if sp.moves(obj.ID()) {
+			moved = append(moved, &obj.Object)
+		} else {
+			kept = append(kept, &obj.Object)
+		}
+	}
+	require.NotEmpty(t, moved)
+	require.NotEmpty(t, kept)
+
+	exists := func(s ShardLike, id strfmt.UUID) bool {
+		ok, err := s.Exists(ctx, id)
+		require.NoError(t, err)
+		return ok
+	}
+
+	t.Run("copy only the moved objects", func(t *testing.T) {
+		copied, err := sp.copyAll(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, len(moved), copied)
+
+		for _, obj := range moved {
+			assert.True(t, exists(target, obj.ID))
+		}
+		for _, obj := range kept {
+			assert.False(t, exists(target, obj.ID))
+		}
+	})
+
+	t.Run("writes to the source during the split are synced", func(t *testing.T) {
+		updated := testObject(className)
+		updated.Object.ID = moved[0].ID
+		updated.Object.LastUpdateTimeUnix = now + 1
+		updated.Vector = []float32{1, 2, 3}
+		require.NoError(t, source.PutObject(ctx, updated))
+		require.NoError(t, source.DeleteObject(ctx, moved[1].ID, time.Time{}))
+
+		require.NoError(t, sp.drainDirty(ctx))
+
+		obj, err := objectFromBucket(target.(*Shard), mustUUIDBytes(t, moved[0].ID))
+		require.NoError(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, now+1, obj.LastUpdateTimeUnix())
+		assert.False(t, exists(target, moved[1].ID))
+	})
+
+	t.Run("writes to the target after the cutover are kept", func(t *testing.T) {
+		sp.committedAt.Store(now + 2)
+
+		obj := testObject(className)
+		obj.Object.ID = moved[1].ID
+		obj.Object.LastUpdateTimeUnix = now + 3
+		require.NoError(t, target.PutObject(ctx, obj))
+
+		sp.markDirty(moved[1].ID)
+		require.NoError(t, sp.drainDirty(ctx))
+		assert.True(t, exists(target, moved[1].ID))
+	})
+
+	t.Run("purge moved objects from the source", func(t *testing.T) {
+		source.splitter.Store(nil)
+
+		_, err := state.MarkSplitReady(stateShard, "node1")
+		require.NoError(t, err)
+
+		// the test shard is named independently of the state used for routing
+		renamePhysicalShard(state, stateShard, source.Name())
+
+		purged, err := source.purgeForeignObjects(ctx, state)
+		require.NoError(t, err)
+		assert.Equal(t, len(moved)-1, purged)
+
+		for _, obj := range moved {
+			assert.False(t, exists(source, obj.ID))
+		}
+		for _, obj := range kept {
+			assert.True(t, exists(source, obj.ID))
+		}
+	})
+}
+
+func TestShardSplitChangeCapture(t *testing.T) {
+	ctx := context.Background()
+	className := "SplitChangeCaptureTestClass"
+
+	shard, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ChangeDataCapture.Enabled = true
+		i.Config.ChangeDataCapture.Retention = time.Hour
+	})
+	source, err := idx.loadedLocalShard(ctx, shard.Name())
+	require.NoError(t, err)
+
+	target, err := idx.initShard(ctx, "target", &models.Class{Class: className}, nil, true, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { target.Shutdown(ctx) })
+
+	state := singleShardState()
+	stateShard := state.AllPhysicalShards()[0]
+	require.NoError(t, state.StartSplit(stateShard, "target"))
+	split := state.SplitInProgress(stateShard)
+	require.NotNil(t, split)
+
+	sp := newShardSplitter(source, target.(*Shard), *split, state)
+	source.splitter.Store(sp)
+
+	now := time.Now().UnixMilli()
+	var moved []*models.Object
+	for range 100 {
+		obj := testObject(className)
+		obj.Object.LastUpdateTimeUnix = now
+		require.NoError(t, source.PutObject(ctx, obj))
+		if sp.moves(obj.ID()) {
+			moved = append(moved, &obj.Object)
+		}
+	}
+	require.GreaterOrEqual(t, len(moved), 2)
+
+	// a stream started before the split resumes the source here and, as the
+	// target has no offset yet, reads the target from the beginning
+	before, err := source.ReadChanges(ctx, 0, 1000, false)
+	require.NoError(t, err)
+	require.Len(t, before, 100)
+	sourceOffset := before[len(before)-1].Offset
+
+	_, err = sp.copyAll(ctx)
+	require.NoError(t, err)
+
+	// a client write to the source during the split
+	updated := testObject(className)
+	updated.Object.ID = moved[0].ID
+	updated.Object.LastUpdateTimeUnix = now + 1
+	require.NoError(t, source.PutObject(ctx, updated))
+	require.NoError(t, sp.drainDirty(ctx))
+
+	// cutover, then a client write to the target
+	sp.committedAt.Store(now + 2)
+	source.splitter.Store(nil)
+	afterCutover := testObject(className)
+	afterCutover.Object.ID = moved[1].ID
+	afterCutover.Object.LastUpdateTimeUnix = now + 3
+	require.NoError(t, target.PutObject(ctx, afterCutover))
+
+	_, err = state.MarkSplitReady(stateShard, "node1")
+	require.NoError(t, err)
+	renamePhysicalShard(state, stateShard, source.Name())
+	purged, err := source.purgeForeignObjects(ctx, state)
+	require.NoError(t, err)
+	require.Equal(t, len(moved), purged)
+
+	// only the writes of clients show up in the stream
+	sourceChanges, err := source.ReadChanges(ctx, sourceOffset, 1000, false)
+	require.NoError(t, err)
+	require.Len(t, sourceChanges, 1)
+	assert.Equal(t, changelog.OperationUpdate, sourceChanges[0].Operation)
+	assert.Equal(t, moved[0].ID, sourceChanges[0].ID)
+
+	targetChanges, err := target.(*Shard).ReadChanges(ctx, 0, 1000, false)
+	require.NoError(t, err)
+	require.Len(t, targetChanges, 1)
+	assert.Equal(t, changelog.OperationUpdate, targetChanges[0].Operation)
+	assert.Equal(t, moved[1].ID, targetChanges[0].ID)
+}
+
+// renamePhysicalShard renames a physical shard of the state, including the
+// assignments of its virtual shards
+func renamePhysicalShard(state *sharding.State, from, to string) {
+	physical := state.Physical[from]
+	physical.Name = to
+	delete(state.Physical, from)
+	state.Physical[to] = physical
+	for i := range state.Virtual {
+		if state.Virtual[i].AssignedToPhysical == from {
+			state.Virtual[i].AssignedToPhysical = to
+		}
+	}
+}
+
+func TestShardSplitMarker(t *testing.T) {
+	dir := t.TempDir()
+
+	marker, err := readShardSplitMarker(dir)
+	require.NoError(t, err)
+	assert.Nil(t, marker)
+
+	require.NoError(t, writeShardSplitMarker(dir, "target"))
+	marker, err = readShardSplitMarker(dir)
+	require.NoError(t, err)
+	require.NotNil(t, marker)
+	assert.Equal(t, "target", marker.Target)
+
+	require.NoError(t, removeShardSplitMarker(dir))
+	require.NoError(t, removeShardSplitMarker(dir))
+	marker, err = readShardSplitMarker(dir)
+	require.NoError(t, err)
+	assert.Nil(t, marker)
+}
+
+func mustUUIDBytes(t *testing.T, id strfmt.UUID) []byte {
+	t.Helper()
+	parsed, err := uuid.Parse(id.String())
+	require.NoError(t, err)
+	b, err := parsed.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// snapshottableIndex is implemented by vector indexes that persist a full
+// graph snapshot next to their commit log, e.g. hnsw.
+type snapshottableIndex interface {
+	CreateSnapshot() (bool, int64, error)
+}
+
+// VectorIndexSnapshotReport is the outcome of a manually triggered vector
+// index snapshot of a single shard.
+type VectorIndexSnapshotReport struct {
+	Shard        string `json:"shard"`
+	TargetVector string `json:"targetVector,omitempty"`
+	// Created is false if the last snapshot is already up to date.
+	Created bool `json:"created"`
+	// StateTimestamp is the unix timestamp of the commit log state contained
+	// in the snapshot.
+	StateTimestamp int64  `json:"stateTimestamp,omitempty"`
+	Took           string `json:"took"`
+}
+
+// CreateVectorIndexSnapshot writes a snapshot of the vector index of the
+// given target vector, so that a subsequent restart does not need to replay
+// the commit log.
+func (s *Shard) CreateVectorIndexSnapshot(ctx context.Context, targetVector string) (*VectorIndexSnapshotReport, error) {
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	idx, ok := vidx.(snapshottableIndex)
+	if !ok {
+		return nil, fmt.Errorf("vector index of type %T does not support snapshots", vidx)
+	}
+
+	started := time.Now()
+	created, stateTimestamp, err := idx.CreateSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot: %w", err)
+	}
+
+	return &VectorIndexSnapshotReport{
+		Shard:          s.name,
+		TargetVector:   targetVector,
+		Created:        created,
+		StateTimestamp: stateTimestamp,
+		Took:           time.Since(started).String(),
+	}, nil
+}
+
+func (i *Index) CreateVectorIndexSnapshot(ctx context.Context, shardName, targetVector string,
+) (*VectorIndexSnapshotReport, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if shard == nil {
+		return nil, errors.New("shard not found")
+	}
+
+	return shard.CreateVectorIndexSnapshot(ctx, targetVector)
+}
@@ -0,0 +1,445 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+const (
+	defaultTuningSampleSize = 200
+	defaultTuningK          = 10
+	maxTuningSampleSize     = 10_000
+)
+
+// hnswTuningEfs and tuningRescoreLimits are the values evaluated by the
+// tuner. Values lower than k are skipped.
+var (
+	hnswTuningEfs       = []int{16, 32, 48, 64, 96, 128, 192, 256, 384, 512, 768, 1024, 1536, 2048}
+	tuningRescoreLimits = []int{16, 32, 64, 128, 256, 512, 1024, 2048, 4096}
+)
+
+// efTunableIndex is implemented by vector indexes whose search-time ef can be
+// overridden per query, e.g. hnsw.
+type efTunableIndex interface {
+	SearchByVectorWithEF(ctx context.Context, vector []float32, k int, ef int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// rescoreTunableIndex is implemented by vector indexes whose rescore limit can
+// be overridden per query, e.g. flat.
+type rescoreTunableIndex interface {
+	SearchByVectorWithRescore(ctx context.Context, vector []float32, k int, rescoreLimit int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// efRescoreTunableIndex is implemented by vector indexes whose ef and rescore
+// limit can both be overridden per query, e.g. hnsw compressed with SQ or RQ.
+type efRescoreTunableIndex interface {
+	efTunableIndex
+	SearchByVectorWithEFAndRescore(ctx context.Context, vector []float32, k int, ef int,
+		rescoreLimit int, allow helpers.AllowList) ([]uint64, []float32, error)
+	RescoreLimitTunable() bool
+}
+
+// VectorIndexTuningParams describes the goal of a tuning run.
+type VectorIndexTuningParams struct {
+	// TargetRecall is the minimum mean recall@k the recommendation must reach.
+	TargetRecall float64 `json:"targetRecall"`
+	// LatencyBudget is the maximum p95 per-query latency the recommendation
+	// may take. Zero means no budget.
+	LatencyBudget time.Duration `json:"latencyBudget"`
+	// K is the number of results per query, defaults to 10.
+	K int `json:"k"`
+	// SampleSize is the number of vectors sampled from the shard and used as
+	// queries, defaults to 200.
+	SampleSize int `json:"sampleSize"`
+}
+
+func (p *VectorIndexTuningParams) setDefaults() {
+	if p.K <= 0 {
+		p.K = defaultTuningK
+	}
+	if p.SampleSize <= 0 {
+		p.SampleSize = defaultTuningSampleSize
+	}
+	p.SampleSize = min(p.SampleSize, maxTuningSampleSize)
+}
+
+func (p VectorIndexTuningParams) validate() error {
+	if p.TargetRecall <= 0 || p.TargetRecall > 1 {
+		return fmt.Errorf("target recall must be in (0, 1], got %v", p.TargetRecall)
+	}
+	if p.LatencyBudget < 0 {
+		return fmt.Errorf("latency budget must not be negative, got %v", p.LatencyBudget)
+	}
+	return nil
+}
+
+// VectorIndexTuningCandidate holds the measurements for a single evaluated
+// setting. Ef is set for hnsw indexes and RescoreLimit for flat indexes and
+// for hnsw indexes whose rescore limit can be tuned.
+type VectorIndexTuningCandidate struct {
+	Ef           int           `json:"ef,omitempty"`
+	RescoreLimit int           `json:"rescoreLimit,omitempty"`
+	Recall       float64       `json:"recall"`
+	MeanLatency  time.Duration `json:"meanLatency"`
+	P95Latency   time.Duration `json:"p95Latency"`
+}
+
+// VectorIndexTuningReport is the result of tuning the vector index of a
+// single shard.
+type VectorIndexTuningReport struct {
+	Shard        string                       `json:"shard"`
+	TargetVector string                       `json:"targetVector,omitempty"`
+	IndexType    string                       `json:"indexType"`
+	Params       VectorIndexTuningParams      `json:"params"`
+	Queries      int                          `json:"queries"`
+	Candidates   []VectorIndexTuningCandidate `json:"candidates"`
+	// Recommendation is the cheapest candidate, by ef and then by rescore
+	// limit, meeting both the target recall and the latency budget. If no candidate meets both, it is the candidate
+	// with the highest recall within the latency budget and MeetsTarget is
+	// false. It is nil if no candidate fits the latency budget at all.
+	Recommendation *VectorIndexTuningCandidate `json:"recommendation,omitempty"`
+	MeetsTarget    bool                        `json:"meetsTarget"`
+}
+
+// TuneVectorIndex samples vectors from the shard, computes their exact
+// nearest neighbors with a brute-force scan and measures recall and latency
+// of the vector index for a range of search-time settings. The index
+// configuration is not modified.
+//
+// Hnsw indexes compressed with SQ or RQ are tuned in two passes: the ef sweep
+// rescores all ef candidates, then the rescore limit is lowered at the first
+// ef that meets the target.
+func (s *Shard) TuneVectorIndex(ctx context.Context, targetVector string,
+	params VectorIndexTuningParams,
+) (*VectorIndexTuningReport, error) {
+	params.setDefaults()
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, fmt.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	if vidx.Multivector() {
+		return nil, fmt.Errorf("tuning multi vector indexes is not supported")
+	}
+
+	var (
+		sweep []VectorIndexTuningCandidate
+		// refine returns the settings to try after the sweep met the target
+		// with the given candidate
+		refine func(met VectorIndexTuningCandidate) []VectorIndexTuningCandidate
+		search func(ctx context.Context, vector []float32, k int, c VectorIndexTuningCandidate) ([]uint64, []float32, error)
+	)
+	switch idx := vidx.(type) {
+	case efRescoreTunableIndex:
+		if !idx.RescoreLimitTunable() {
+			sweep = tuningSettings(hnswTuningEfs, func(ef int) VectorIndexTuningCandidate {
+				return VectorIndexTuningCandidate{Ef: ef}
+			})
+			search = func(ctx context.Context, vector []float32, k int, c VectorIndexTuningCandidate) ([]uint64, []float32, error) {
+				return idx.SearchByVectorWithEF(ctx, vector, k, c.Ef, nil)
+			}
+			break
+		}
+		sweep = tuningSettings(hnswTuningEfs, func(ef int) VectorIndexTuningCandidate {
+			return VectorIndexTuningCandidate{Ef: ef, RescoreLimit: ef}
+		})
+		refine = func(met VectorIndexTuningCandidate) []VectorIndexTuningCandidate {
+			var limits []int
+			for _, limit := range tuningRescoreLimits {
+				if limit < met.Ef {
+					limits = append(limits, limit)
+				}
+			}
+			return tuningSettings(limits, func(limit int) VectorIndexTuningCandidate {
+				return VectorIndexTuningCandidate{Ef: met.Ef, RescoreLimit: limit}
+			})
+		}
+		search = func(ctx context.Context, vector []float32, k int, c VectorIndexTuningCandidate) ([]uint64, []float32, error) {
+			return idx.SearchByVectorWithEFAndRescore(ctx, vector, k, c.Ef, c.RescoreLimit, nil)
+		}
+	case efTunableIndex:
+		sweep = tuningSettings(hnswTuningEfs, func(ef int) VectorIndexTuningCandidate {
+			return VectorIndexTuningCandidate{Ef: ef}
+		})
+		search = func(ctx context.Context, vector []float32, k int, c VectorIndexTuningCandidate) ([]uint64, []float32, error) {
+			return idx.SearchByVectorWithEF(ctx, vector, k, c.Ef, nil)
+		}
+	case rescoreTunableIndex:
+		if !vidx.Compressed() {
+			return nil, fmt.Errorf("uncompressed flat indexes are exact, there is nothing to tune")
+		}
+		sweep = tuningSettings(tuningRescoreLimits, func(limit int) VectorIndexTuningCandidate {
+			return VectorIndexTuningCandidate{RescoreLimit: limit}
+		})
+		search = func(ctx context.Context, vector []float32, k int, c VectorIndexTuningCandidate) ([]uint64, []float32, error) {
+			return idx.SearchByVectorWithRescore(ctx, vector, k, c.RescoreLimit, nil)
+		}
+	default:
+		return nil, fmt.Errorf("tuning vector index of type %q is not supported", vidx.Type())
+	}
+
+	distProv, err := distanceProviderFor(s.index.GetVectorIndexConfig(targetVector))
+	if err != nil {
+		return nil, err
+	}
+
+	view := s.GetObjectsBucketView()
+	defer view.ReleaseView()
+	vectorForID := func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error) {
+		return s.readVectorByIndexIDIntoSliceWithView(ctx, id, container, targetVector, view)
+	}
+
+	queries, err := s.sampleTuningQueries(ctx, vidx, vectorForID, view, params.SampleSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "sample queries")
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("vector index for target vector %q is empty", targetVector)
+	}
+
+	// every query is a vector of the shard, so it finds itself at distance 0.
+	// The query document is removed from both the ground truth and the
+	// results, otherwise it would inflate the recall.
+	vectors := make([][]float32, len(queries))
+	for i, q := range queries {
+		vectors[i] = q.vector
+	}
+	truths, _, err := bruteForceSearch(ctx, vidx, distProv, vectorForID, view, vectors, params.K+1, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "compute ground truth")
+	}
+	for i, q := range queries {
+		truths[i] = withoutID(truths[i], q.id, params.K)
+	}
+
+	report := &VectorIndexTuningReport{
+		Shard:        s.Name(),
+		TargetVector: targetVector,
+		IndexType:    vidx.Type().String(),
+		Params:       params,
+		Queries:      len(queries),
+	}
+
+	measure := func(settings []VectorIndexTuningCandidate) (*VectorIndexTuningCandidate, error) {
+		for _, candidate := range settings {
+			if (candidate.Ef != 0 && candidate.Ef < params.K) ||
+				(candidate.RescoreLimit != 0 && candidate.RescoreLimit < params.K) {
+				continue
+			}
+
+			latencies := make([]time.Duration, len(queries))
+			var recallSum float64
+			for i, q := range queries {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
+				start := time.Now()
+				ids, _, err := search(ctx, q.vector, params.K+1, candidate)
+				latencies[i] = time.Since(start)
+				if err != nil {
+					return nil, errors.Wrapf(err, "search with %+v", candidate)
+				}
+				recallSum += recallAtK(withoutID(ids, q.id, params.K), truths[i], params.K)
+			}
+
+			candidate.Recall = recallSum / float64(len(queries))
+			candidate.MeanLatency, candidate.P95Latency = latencyStats(latencies)
+			report.Candidates = append(report.Candidates, candidate)
+
+			// the settings are ordered by cost, once a candidate meets the
+			// target there is no need to try more expensive ones
+			if candidate.Recall >= params.TargetRecall {
+				return &candidate, nil
+			}
+		}
+		return nil, nil
+	}
+
+	met, err := measure(sweep)
+	if err != nil {
+		return nil, err
+	}
+	if met != nil && refine != nil {
+		if _, err := measure(refine(*met)); err != nil {
+			return nil, err
+		}
+	}
+
+	report.Recommendation, report.MeetsTarget = recommendTuningCandidate(report.Candidates, params)
+	return report, nil
+}
+
+type tuningQuery struct {
+	id     uint64
+	vector []float32
+}
+
+// sampleTuningQueries draws a uniform sample of the indexed vectors using
+// reservoir sampling over the ids of the index.
+func (s *Shard) sampleTuningQueries(ctx context.Context, vidx VectorIndex,
+	vectorForID func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error),
+	view common.BucketView, size int,
+) ([]tuningQuery, error) {
+	ids := make([]uint64, 0, size)
+	seen := 0
+	vidx.Iterate(func(id uint64) bool {
+		seen++
+		if len(ids) < size {
+			ids = append(ids, id)
+		} else if j := rand.IntN(seen); j < size {
+			ids[j] = id
+		}
+		return ctx.Err() == nil
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	queries := make([]tuningQuery, 0, len(ids))
+	for _, id := range ids {
+		container := &common.VectorSlice{Buff8: make([]byte, 8)}
+		vec, err := vectorForID(ctx, id, container, view)
+		if err != nil || len(vec) == 0 {
+			// deleted concurrently
+			continue
+		}
+		queries = append(queries, tuningQuery{id: id, vector: vec})
+	}
+
+	return queries, nil
+}
+
+// tuningSettings turns the values of a sweep into candidate settings.
+func tuningSettings(values []int, setting func(value int) VectorIndexTuningCandidate) []VectorIndexTuningCandidate {
+	settings := make([]VectorIndexTuningCandidate, len(values))
+	for i, value := range values {
+		settings[i] = setting(value)
+	}
+	return settings
+}
+
+// recommendTuningCandidate picks the cheapest candidate, by ef and then by
+// rescore limit, that meets the target recall within the latency budget.
+// Otherwise it falls back to the candidate with the highest recall within the
+// budget.
+func recommendTuningCandidate(candidates []VectorIndexTuningCandidate,
+	params VectorIndexTuningParams,
+) (*VectorIndexTuningCandidate, bool) {
+	var best, cheapest *VectorIndexTuningCandidate
+	for i := range candidates {
+		c := &candidates[i]
+		if params.LatencyBudget > 0 && c.P95Latency > params.LatencyBudget {
+			continue
+		}
+		if c.Recall >= params.TargetRecall {
+			if cheapest == nil || c.Ef < cheapest.Ef ||
+				(c.Ef == cheapest.Ef && c.RescoreLimit < cheapest.RescoreLimit) {
+				cheapest = c
+			}
+			continue
+		}
+		if best == nil || c.Recall > best.Recall {
+			best = c
+		}
+	}
+	if cheapest != nil {
+		return cheapest, true
+	}
+	return best, false
+}
+
+// withoutID removes id from ids and truncates the result to k entries.
+func withoutID(ids []uint64, id uint64, k int) []uint64 {
+	out := make([]uint64, 0, k)
+	for _, candidate := range ids {
+		if candidate == id {
+			continue
+		}
+		if len(out) == k {
+			break
+		}
+		out = append(out, candidate)
+	}
+	return out
+}
+
+func latencyStats(latencies []time.Duration) (mean, p95 time.Duration) {
+	if len(latencies) == 0 {
+		return 0, 0
+	}
+
+	var sum time.Duration
+	for _, l := range latencies {
+		sum += l
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	idx := (len(sorted)*95+99)/100 - 1
+	return sum / time.Duration(len(latencies)), sorted[max(idx, 0)]
+}
+
+// TuneVectorIndex runs a tuning sweep on the given local shard. See
+// [Shard.TuneVectorIndex].
+func (i *Index) TuneVectorIndex(ctx context.Context, shardName, targetVector string,
+	params VectorIndexTuningParams,
+) (*VectorIndexTuningReport, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if shard == nil {
+		return nil, errors.New("shard not found")
+	}
+
+	return shard.TuneVectorIndex(ctx, targetVector, params)
+}
+
+// UntunedShards returns the shards of the index which are not covered by the
+// given reports. A setting derived from the reports is only representative
+// for the whole class if no shard is missing.
+func (i *Index) UntunedShards(reports []*VectorIndexTuningReport) ([]string, error) {
+	shards, err := i.schemaReader.Shards(i.Config.ClassName.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tuned := make(map[string]struct{}, len(reports))
+	for _, report := range reports {
+		tuned[report.Shard] = struct{}{}
+	}
+
+	var missing []string
+	for _, shard := range shards {
+		if _, ok := tuned[shard]; !ok {
+			missing = append(missing, shard)
+		}
+	}
+	return missing, nil
+}
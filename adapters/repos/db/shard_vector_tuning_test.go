@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecommendTuningCandidate(t *testing.T) {
+	candidates := []VectorIndexTuningCandidate{
+		{Ef: 32, Recall: 0.80, P95Latency: 1 * time.Millisecond},
+		{Ef: 64, Recall: 0.91, P95Latency: 2 * time.Millisecond},
+		{Ef: 128, Recall: 0.96, P95Latency: 4 * time.Millisecond},
+		{Ef: 256, Recall: 0.99, P95Latency: 8 * time.Millisecond},
+	}
+
+	t.Run("cheapest candidate meeting the target", func(t *testing.T) {
+		rec, ok := recommendTuningCandidate(candidates, VectorIndexTuningParams{TargetRecall: 0.95})
+		require.NotNil(t, rec)
+		assert.True(t, ok)
+		assert.Equal(t, 128, rec.Ef)
+	})
+
+	t.Run("target not reachable within latency budget", func(t *testing.T) {
+		rec, ok := recommendTuningCandidate(candidates, VectorIndexTuningParams{
+			TargetRecall: 0.95, LatencyBudget: 3 * time.Millisecond,
+		})
+		require.NotNil(t, rec)
+		assert.False(t, ok)
+		assert.Equal(t, 64, rec.Ef)
+	})
+
+	t.Run("nothing fits the latency budget", func(t *testing.T) {
+		rec, ok := recommendTuningCandidate(candidates, VectorIndexTuningParams{
+			TargetRecall: 0.95, LatencyBudget: time.Microsecond,
+		})
+		assert.Nil(t, rec)
+		assert.False(t, ok)
+	})
+}
+
+func TestRecommendTuningCandidateWithRescoreLimit(t *testing.T) {
+	// the ef sweep rescores all candidates, the refinement lowers the
+	// rescore limit at the first ef meeting the target
+	candidates := []VectorIndexTuningCandidate{
+		{Ef: 64, RescoreLimit: 64, Recall: 0.90, P95Latency: 2 * time.Millisecond},
+		{Ef: 128, RescoreLimit: 128, Recall: 0.97, P95Latency: 4 * time.Millisecond},
+		{Ef: 128, RescoreLimit: 16, Recall: 0.93, P95Latency: 2 * time.Millisecond},
+		{Ef: 128, RescoreLimit: 32, Recall: 0.96, P95Latency: 3 * time.Millisecond},
+	}
+
+	rec, ok := recommendTuningCandidate(candidates, VectorIndexTuningParams{TargetRecall: 0.95})
+	require.NotNil(t, rec)
+	assert.True(t, ok)
+	assert.Equal(t, 128, rec.Ef)
+	assert.Equal(t, 32, rec.RescoreLimit)
+}
+
+func TestTuningParams(t *testing.T) {
+	params := VectorIndexTuningParams{TargetRecall: 0.9, SampleSize: 1_000_000}
+	params.setDefaults()
+	assert.Equal(t, defaultTuningK, params.K)
+	assert.Equal(t, maxTuningSampleSize, params.SampleSize)
+	require.NoError(t, params.validate())
+
+	assert.Error(t, VectorIndexTuningParams{TargetRecall: 0}.validate())
+	assert.Error(t, VectorIndexTuningParams{TargetRecall: 1.1}.validate())
+	assert.Error(t, VectorIndexTuningParams{TargetRecall: 0.9, LatencyBudget: -1}.validate())
+}
+
+func TestWithoutID(t *testing.T) {
+	assert.Equal(t, []uint64{1, 2}, withoutID([]uint64{7, 1, 2, 3}, 7, 2))
+	assert.Equal(t, []uint64{1, 2}, withoutID([]uint64{1, 2, 3}, 7, 2))
+	assert.Equal(t, []uint64{}, withoutID(nil, 7, 2))
+}
+
+func TestLatencyStats(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(100-i) * time.Millisecond
+	}
+
+	mean, p95 := latencyStats(latencies)
+	assert.Equal(t, 50500*time.Microsecond, mean)
+	assert.Equal(t, 95*time.Millisecond, p95)
+
+	mean, p95 = latencyStats(nil)
+	assert.Zero(t, mean)
+	assert.Zero(t, p95)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -74,6 +75,10 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.trackSplitWrite(idBytes)
+	if err := s.captureChange(ctx, idBytes, changelog.OperationDelete, deletionTimeOrNow(deletionTime)); err != nil {
+		return err
+	}
 
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
 		return fmt.Errorf("object deletion in hashtree: %w", err)
@@ -14,10 +14,12 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -171,6 +173,10 @@ func (s *Shard) mergeObjectInStorage(ctx context.Context, merge objects.MergeDoc
 		if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
 			return errors.Wrap(err, "upsert object data")
 		}
+		s.trackSplitWrite(idBytes)
+		if err := s.captureChange(ctx, idBytes, changelog.OperationUpdate, time.UnixMilli(obj.LastUpdateTimeUnix())); err != nil {
+			return err
+		}
 
 		if err := s.mayUpsertObjectHashTree(obj, idBytes, status); err != nil {
 			return errors.Wrap(err, "object merge in hashtree")
@@ -262,6 +268,10 @@ func (s *Shard) mutableMergeObjectLSM(ctx context.Context, merge objects.MergeDo
 	if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
+	s.trackSplitWrite(idBytes)
+	if err := s.captureChange(ctx, idBytes, changelog.OperationUpdate, time.UnixMilli(obj.LastUpdateTimeUnix())); err != nil {
+		return out, err
+	}
 
 	if err := s.mayUpsertObjectHashTree(obj, idBytes, status); err != nil {
 		return out, fmt.Errorf("object merge in hashtree: %w", err)
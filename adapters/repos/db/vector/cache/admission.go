@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// the share of the max size which is kept when a full cache evicts its
+// coldest vectors
+const admissionKeepRatio = 0.8
+
+type options struct {
+	admission bool
+}
+
+type Option func(*options)
+
+// WithAdmissionPolicy tracks how often each vector is accessed. Once the
+// cache is full, it evicts the least frequently accessed vectors instead of
+// clearing the whole cache and only admits new vectors that are at least as
+// hot as the ones that survived the last eviction.
+func WithAdmissionPolicy() Option {
+	return func(o *options) {
+		o.admission = true
+	}
+}
+
+// HotEntry is a cached id together with its estimated access frequency
+type HotEntry struct {
+	ID        uint64
+	Frequency uint32
+}
+
+// FrequencyTracker is implemented by caches which can report their hot set,
+// so it can be persisted and used to warm up the cache after a restart. Both
+// methods are no-ops unless the cache was created WithAdmissionPolicy.
+type FrequencyTracker interface {
+	// HotSet returns up to limit cached ids, hottest first
+	HotSet(limit int) []HotEntry
+	// SeedFrequencies restores access frequencies, e.g. from a persisted hot
+	// set
+	SeedFrequencies(entries []HotEntry)
+}
+
+type untrackedAccessKey struct{}
+
+// WithoutAccessTracking marks reads through the cache with the returned
+// context as not being accesses, e.g. when warming up the cache. They neither
+// count towards the access frequencies nor make an id look hot.
+func WithoutAccessTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, untrackedAccessKey{}, true)
+}
+
+func (s *shardedLockCache[T]) recordAccess(ctx context.Context, id uint64) {
+	if s.frequencies == nil {
+		return
+	}
+	if untracked, _ := ctx.Value(untrackedAccessKey{}).(bool); untracked {
+		return
+	}
+	s.frequencies.increment(id)
+}
+
+// admit decides whether a vector loaded on a cache miss is stored
+func (s *shardedLockCache[T]) admit(id uint64) bool {
+	if s.frequencies == nil || atomic.LoadInt64(&s.count) < atomic.LoadInt64(&s.maxSize) {
+		return true
+	}
+	return s.frequencies.estimate(id) >= atomic.LoadUint32(&s.admissionThreshold)
+}
+
+// evictCold removes the least frequently accessed vectors until the cache
+// is down to admissionKeepRatio of its max size, then ages the frequencies.
+func (s *shardedLockCache[T]) evictCold() {
+	s.shardedLocks.LockAll()
+	defer s.shardedLocks.UnlockAll()
+
+	var histogram [sketchMaxCount + 1]int
+	cached := 0
+	for id, vec := range s.cache {
+		if vec == nil {
+			continue
+		}
+		histogram[s.frequencies.estimate(uint64(id))]++
+		cached++
+	}
+
+	keep := int(float64(atomic.LoadInt64(&s.maxSize)) * admissionKeepRatio)
+	// walk down from the hottest vectors to find the frequency at which the
+	// cache overflows. Vectors above it are kept, vectors below it are evicted
+	// and vectors at the cutoff are kept while there is room.
+	cutoff := uint32(0)
+	room := keep
+	for f := sketchMaxCount; f >= 0; f-- {
+		if histogram[f] > room {
+			cutoff = uint32(f)
+			break
+		}
+		room -= histogram[f]
+	}
+
+	remaining := 0
+	for id, vec := range s.cache {
+		if vec == nil {
+			continue
+		}
+		f := s.frequencies.estimate(uint64(id))
+		if f < cutoff || (f == cutoff && room <= 0) {
+			s.cache[id] = nil
+			continue
+		}
+		if f == cutoff {
+			room--
+		}
+		remaining++
+	}
+
+	atomic.StoreInt64(&s.count, int64(remaining))
+	// the counters are halved, so is the threshold to stay comparable
+	s.frequencies.age()
+	atomic.StoreUint32(&s.admissionThreshold, cutoff/2)
+
+	s.logger.WithFields(logrus.Fields{
+		"action":              "vector_cache_evict_cold",
+		"cached":              cached,
+		"remaining":           remaining,
+		"admission_threshold": cutoff,
+	}).Debug("evicted least frequently accessed vectors")
+}
+
+func (s *shardedLockCache[T]) HotSet(limit int) []HotEntry {
+	if s.frequencies == nil || limit <= 0 {
+		return nil
+	}
+
+	s.maintenanceLock.RLock()
+	defer s.maintenanceLock.RUnlock()
+
+	var entries []HotEntry
+	for id := range s.cache {
+		s.shardedLocks.RLock(uint64(id))
+		cached := s.cache[id] != nil
+		s.shardedLocks.RUnlock(uint64(id))
+		if !cached {
+			continue
+		}
+		entries = append(entries, HotEntry{
+			ID:        uint64(id),
+			Frequency: s.frequencies.estimate(uint64(id)),
+		})
+	}
+
+	slices.SortStableFunc(entries, func(a, b HotEntry) int {
+		return int(b.Frequency) - int(a.Frequency)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+func (s *shardedLockCache[T]) SeedFrequencies(entries []HotEntry) {
+	if s.frequencies == nil {
+		return
+	}
+	for _, e := range entries {
+		s.frequencies.add(e.ID, e.Frequency)
+	}
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdmissionTestCache(t *testing.T, maxSize int) *shardedLockCache[float32] {
+	logger, _ := test.NewNullLogger()
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{float32(id)}, nil
+	}
+	c := NewShardedFloat32LockCache(vecForID, nil, maxSize, 1, logger, false,
+		time.Hour, nil, WithAdmissionPolicy()).(*shardedLockCache[float32])
+	t.Cleanup(c.Drop)
+	return c
+}
+
+func TestFrequencySketch(t *testing.T) {
+	s := newFrequencySketch(100)
+
+	for i := 0; i < 10; i++ {
+		s.increment(1)
+	}
+	s.increment(2)
+
+	assert.GreaterOrEqual(t, s.estimate(1), uint32(10))
+	assert.GreaterOrEqual(t, s.estimate(2), uint32(1))
+	assert.Less(t, s.estimate(2), s.estimate(1))
+
+	t.Run("counters saturate", func(t *testing.T) {
+		s.add(3, 1000)
+		assert.Equal(t, uint32(sketchMaxCount), s.estimate(3))
+	})
+
+	t.Run("aging halves counters", func(t *testing.T) {
+		before := s.estimate(1)
+		s.age()
+		assert.Equal(t, before/2, s.estimate(1))
+	})
+}
+
+func TestAdmissionEvictsColdVectors(t *testing.T) {
+	ctx := context.Background()
+	c := newAdmissionTestCache(t, 10)
+
+	// ids 0-4 are hot, ids 5-14 are only accessed once
+	for round := 0; round < 10; round++ {
+		for id := uint64(0); id < 5; id++ {
+			_, err := c.Get(ctx, id)
+			require.NoError(t, err)
+		}
+	}
+	for id := uint64(5); id < 15; id++ {
+		_, err := c.Get(ctx, id)
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, c.CountVectors(), int64(10))
+
+	c.replaceIfFull()
+
+	assert.LessOrEqual(t, c.CountVectors(), int64(8))
+	for id := 0; id < 5; id++ {
+		assert.NotNil(t, c.cache[id], "hot id %d must survive eviction", id)
+	}
+}
+
+func TestAdmissionRejectsColdVectorsWhenFull(t *testing.T) {
+	ctx := context.Background()
+	c := newAdmissionTestCache(t, 10)
+
+	atomic.StoreInt64(&c.count, 10)
+	atomic.StoreUint32(&c.admissionThreshold, 3)
+
+	vec, err := c.Get(ctx, 42)
+	require.NoError(t, err)
+	assert.Equal(t, []float32{42}, vec, "rejected vectors are still returned")
+	assert.Nil(t, c.cache[42])
+	assert.Equal(t, int64(10), c.CountVectors())
+
+	for i := 0; i < 3; i++ {
+		_, err = c.Get(ctx, 43)
+		require.NoError(t, err)
+	}
+	assert.NotNil(t, c.cache[43])
+	assert.Equal(t, int64(11), c.CountVectors())
+}
+
+func TestAdmissionHotSet(t *testing.T) {
+	ctx := context.Background()
+	c := newAdmissionTestCache(t, 100)
+
+	for id := uint64(1); id <= 5; id++ {
+		for i := uint64(0); i < id*2; i++ {
+			_, err := c.Get(ctx, id)
+			require.NoError(t, err)
+		}
+	}
+
+	hotSet := c.HotSet(3)
+	require.Len(t, hotSet, 3)
+	assert.Equal(t, uint64(5), hotSet[0].ID)
+	assert.Equal(t, uint64(4), hotSet[1].ID)
+	assert.Equal(t, uint64(3), hotSet[2].ID)
+	assert.GreaterOrEqual(t, hotSet[0].Frequency, uint32(10))
+
+	t.Run("seeding restores frequencies", func(t *testing.T) {
+		restored := newAdmissionTestCache(t, 100)
+		restored.SeedFrequencies(hotSet)
+		for _, e := range hotSet {
+			assert.GreaterOrEqual(t, restored.frequencies.estimate(e.ID), e.Frequency)
+		}
+	})
+
+	t.Run("reads without access tracking", func(t *testing.T) {
+		before := c.frequencies.estimate(1)
+		_, err := c.Get(WithoutAccessTracking(ctx), 1)
+		require.NoError(t, err)
+		_, errs := c.MultiGet(WithoutAccessTracking(ctx), []uint64{1, 99})
+		require.Empty(t, errs)
+		assert.Equal(t, before, c.frequencies.estimate(1))
+		assert.Zero(t, c.frequencies.estimate(99))
+	})
+
+	t.Run("without admission policy", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		plain := NewShardedFloat32LockCache(nil, nil, 100, 1, logger, false, time.Hour, nil)
+		defer plain.Drop()
+		tracker, ok := plain.(FrequencyTracker)
+		require.True(t, ok)
+		assert.Nil(t, tracker.HotSet(10))
+	})
+}
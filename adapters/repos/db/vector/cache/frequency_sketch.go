@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"sync/atomic"
+)
+
+const (
+	sketchDepth = 4
+	// counters saturate, so once an id is hot, recording further accesses is a
+	// plain load and hot ids do not contend on their counters
+	sketchMaxCount = 255
+	// the minimum and maximum number of counters per row
+	sketchMinWidth = 1 << 10
+	sketchMaxWidth = 1 << 20
+)
+
+var sketchSeeds = [sketchDepth]uint64{
+	0x9e3779b97f4a7c15, 0xbf58476d1ce4e5b9, 0x94d049bb133111eb, 0xd6e8feb86659fd93,
+}
+
+// frequencySketch is a count-min sketch estimating how often each id was
+// accessed. Estimates never undercount, collisions can only make an id look
+// hotter than it is. age halves all counters, so the sketch favors recent
+// accesses over accesses that happened long ago.
+type frequencySketch struct {
+	rows [sketchDepth][]uint32
+	mask uint64
+}
+
+func newFrequencySketch(expectedItems int) *frequencySketch {
+	width := sketchMinWidth
+	for width < expectedItems && width < sketchMaxWidth {
+		width <<= 1
+	}
+
+	s := &frequencySketch{mask: uint64(width - 1)}
+	for i := range s.rows {
+		s.rows[i] = make([]uint32, width)
+	}
+	return s
+}
+
+func (s *frequencySketch) slot(row int, id uint64) uint64 {
+	h := (id + sketchSeeds[row]) * 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h & s.mask
+}
+
+func (s *frequencySketch) increment(id uint64) {
+	s.add(id, 1)
+}
+
+// add records delta accesses of id
+func (s *frequencySketch) add(id uint64, delta uint32) {
+	for row := range s.rows {
+		counter := &s.rows[row][s.slot(row, id)]
+		for {
+			old := atomic.LoadUint32(counter)
+			if old >= sketchMaxCount {
+				break
+			}
+			next := min(old+delta, sketchMaxCount)
+			if atomic.CompareAndSwapUint32(counter, old, next) {
+				break
+			}
+		}
+	}
+}
+
+func (s *frequencySketch) estimate(id uint64) uint32 {
+	est := uint32(sketchMaxCount)
+	for row := range s.rows {
+		est = min(est, atomic.LoadUint32(&s.rows[row][s.slot(row, id)]))
+	}
+	return est
+}
+
+// age halves every counter. Concurrent increments may be lost, which is
+// acceptable for an estimate.
+func (s *frequencySketch) age() {
+	for row := range s.rows {
+		for i := range s.rows[row] {
+			counter := &s.rows[row][i]
+			atomic.StoreUint32(counter, atomic.LoadUint32(counter)>>1)
+		}
+	}
+}
@@ -39,6 +39,10 @@ type shardedLockCache[T float32 | byte | uint64] struct {
 	deletionInterval       time.Duration
 	allocChecker           memwatch.AllocChecker
 
+	// frequencies is only set WithAdmissionPolicy
+	frequencies        *frequencySketch
+	admissionThreshold uint32
+
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
 	maintenanceLock sync.RWMutex
@@ -55,8 +59,13 @@ const (
 
 func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], multiVecForID common.VectorForID[[]float32], maxSize int, pageSize uint64,
 	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[float32] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	vc := &shardedLockCache[float32]{
 		vectorForID: func(ctx context.Context, id uint64) ([]float32, error) {
 			vec, err := vecForID(ctx, id)
@@ -80,6 +89,9 @@ func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], multiVecFo
 		deletionInterval:       deletionInterval,
 		allocChecker:           allocChecker,
 	}
+	if o.admission {
+		vc.frequencies = newFrequencySketch(maxSize)
+	}
 
 	vc.watchForDeletion()
 	return vc
@@ -87,8 +99,13 @@ func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], multiVecFo
 
 func NewShardedByteLockCache(vecForID common.VectorForID[byte], maxSize int, pageSize uint64,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[byte] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	vc := &shardedLockCache[byte]{
 		vectorForID:      vecForID,
 		cache:            make([][]byte, InitialSize),
@@ -103,14 +120,23 @@ func NewShardedByteLockCache(vecForID common.VectorForID[byte], maxSize int, pag
 		allocChecker:     allocChecker,
 	}
 
+	if o.admission {
+		vc.frequencies = newFrequencySketch(maxSize)
+	}
+
 	vc.watchForDeletion()
 	return vc
 }
 
 func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int, pageSize uint64,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[uint64] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	vc := &shardedLockCache[uint64]{
 		vectorForID:      vecForID,
 		cache:            make([][]uint64, InitialSize),
@@ -125,6 +151,10 @@ func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int,
 		allocChecker:     allocChecker,
 	}
 
+	if o.admission {
+		vc.frequencies = newFrequencySketch(maxSize)
+	}
+
 	vc.watchForDeletion()
 	return vc
 }
@@ -134,6 +164,8 @@ func (s *shardedLockCache[T]) All() [][]T {
 }
 
 func (s *shardedLockCache[T]) Get(ctx context.Context, id uint64) ([]T, error) {
+	s.recordAccess(ctx, id)
+
 	s.shardedLocks.RLock(id)
 	vec := s.cache[id]
 	s.shardedLocks.RUnlock(id)
@@ -183,6 +215,10 @@ func (s *shardedLockCache[T]) handleCacheMiss(ctx context.Context, id uint64) ([
 		return nil, err
 	}
 
+	if !s.admit(id) {
+		return vec, nil
+	}
+
 	atomic.AddInt64(&s.count, 1)
 
 	if vec != nil {
@@ -199,6 +235,8 @@ func (s *shardedLockCache[T]) MultiGet(ctx context.Context, ids []uint64) ([][]T
 	var errs []error // Only allocate if we encounter an error
 
 	for i, id := range ids {
+		s.recordAccess(ctx, id)
+
 		s.shardedLocks.RLock(id)
 		vec := s.cache[id]
 		s.shardedLocks.RUnlock(id)
@@ -381,6 +419,10 @@ func (s *shardedLockCache[T]) watchForDeletion() {
 
 func (s *shardedLockCache[T]) replaceIfFull() {
 	if atomic.LoadInt64(&s.count) >= atomic.LoadInt64(&s.maxSize) {
+		if s.frequencies != nil {
+			s.evictCold()
+			return
+		}
 		s.deleteAllVectors()
 	}
 }
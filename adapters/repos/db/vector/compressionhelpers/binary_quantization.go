@@ -72,6 +72,10 @@ func (b BQStats) CompressionRatio(_ int) float64 {
 	return 32.0
 }
 
+func (b BQStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
+
 func (bq *BinaryQuantizer) Stats() CompressionStats {
 	return BQStats{}
 }
@@ -59,6 +59,7 @@ func NewBinaryRotationalQuantizer(inputDim int, seed uint64, distancer distancer
 	// complete distribution, it seems like 3 rounds suffice.
 	rotation := NewFastRotation(inputDim, rotationRounds, seed)
 
+	distancer = quantizerDistance(distancer)
 	cos, l2, err := distancerIndicatorsAndError(distancer)
 	if err != nil {
 		return nil
@@ -86,6 +87,7 @@ func NewBinaryRotationalQuantizer(inputDim int, seed uint64, distancer distancer
 }
 
 func RestoreBinaryRotationalQuantizer(inputDim int, outputDim int, rounds int, swaps [][]compression.Swap, signs [][]float32, rounding []float32, distancer distancer.Provider) (*BinaryRotationalQuantizer, error) {
+	distancer = quantizerDistance(distancer)
 	cos, l2, err := distancerIndicatorsAndError(distancer)
 	if err != nil {
 		return nil, err
@@ -543,6 +545,10 @@ func (brq BinaryRQStats) CompressionRatio(dimensionality int) float64 {
 	return float64(originalSize) / float64(compressedSize)
 }
 
+func (brq BinaryRQStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
+
 func (brq *BinaryRotationalQuantizer) Stats() CompressionStats {
 	return BinaryRQStats{
 		dataBits:  1,
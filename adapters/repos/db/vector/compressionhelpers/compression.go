@@ -45,6 +45,13 @@ type CompressorDistancer interface {
 
 type ReturnDistancerFn func()
 
+// MultiQueryDistancer scores compressed vectors against a batch of queries.
+type MultiQueryDistancer interface {
+	// DistancesToNode writes the distance between the node and each of the
+	// queries into out, which must be at least as long as the batch.
+	DistancesToNode(id uint64, out []float32) error
+}
+
 type CommitLogger interface {
 	AddPQCompression(compression.PQData) error
 	AddSQCompression(compression.SQData) error
@@ -55,6 +62,8 @@ type CommitLogger interface {
 type CompressionStats interface {
 	CompressionType() string
 	CompressionRatio(dimensions int) float64
+	// QuantizationDrift is nil for quantizers that are not trained on the data
+	QuantizationDrift() *QuantizationDriftStats
 }
 
 type VectorCompressor interface {
@@ -77,24 +86,40 @@ type VectorCompressor interface {
 
 	DistanceBetweenCompressedVectorsFromIDs(ctx context.Context, x, y uint64) (float32, error)
 	NewDistancer(vector []float32) (CompressorDistancer, ReturnDistancerFn)
+	NewMultiQueryDistancer(vectors [][]float32) (MultiQueryDistancer, ReturnDistancerFn)
 	NewDistancerFromID(id uint64) (CompressorDistancer, error)
 	NewBag() CompressionDistanceBag
 
 	PersistCompression(CommitLogger)
 	Stats() CompressionStats
+	// TrackQuantizationError samples the quantization error of an inserted
+	// vector to detect drift away from the data the codebook was trained on.
+	TrackQuantizationError(vector []float32)
 	Get(id uint64) ([]float32, error)
 	GetCompressed(id uint64) (any, error)
 }
 
 type quantizedVectorsCompressor[T byte | uint64] struct {
-	cache             cache.Cache[T]
-	compressedStore   *lsmkv.Store
-	quantizer         quantizer[T]
-	storeId           func([]byte, uint64)
-	loadId            func([]byte) uint64
-	logger            logrus.FieldLogger
-	targetVector      string
+	cache           cache.Cache[T]
+	compressedStore *lsmkv.Store
+	quantizer       quantizer[T]
+	storeId         func([]byte, uint64)
+	loadId          func([]byte) uint64
+	logger          logrus.FieldLogger
+	targetVector    string
+	// replacementBucket is set while the compressed vectors are written to a
+	// separate bucket, see NewHNSWPQReplacementCompressor
+	replacementBucket string
 	makeBucketOptions lsmkv.MakeBucketOptions
+	// drift is nil for quantizers that are not trained on the data
+	drift *quantizationDriftTracker
+}
+
+func (compressor *quantizedVectorsCompressor[T]) bucketName() string {
+	if compressor.replacementBucket != "" {
+		return compressor.replacementBucket
+	}
+	return helpers.GetCompressedBucketName(compressor.targetVector)
 }
 
 func (compressor *quantizedVectorsCompressor[T]) Get(id uint64) ([]float32, error) {
@@ -114,6 +139,22 @@ func (compressor *quantizedVectorsCompressor[T]) Drop() error {
 	return nil
 }
 
+// HotSet implements cache.FrequencyTracker, it is empty unless the cache of
+// compressed vectors was created with cache.WithAdmissionPolicy.
+func (compressor *quantizedVectorsCompressor[T]) HotSet(limit int) []cache.HotEntry {
+	if tracker, ok := compressor.cache.(cache.FrequencyTracker); ok {
+		return tracker.HotSet(limit)
+	}
+	return nil
+}
+
+// SeedFrequencies implements cache.FrequencyTracker
+func (compressor *quantizedVectorsCompressor[T]) SeedFrequencies(entries []cache.HotEntry) {
+	if tracker, ok := compressor.cache.(cache.FrequencyTracker); ok {
+		tracker.SeedFrequencies(entries)
+	}
+}
+
 func (compressor *quantizedVectorsCompressor[T]) GrowCache(size uint64) {
 	compressor.cache.Grow(size)
 }
@@ -142,7 +183,7 @@ func (compressor *quantizedVectorsCompressor[T]) Delete(ctx context.Context, id
 	compressor.cache.Delete(ctx, id)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	if err := compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)).Delete(idBytes); err != nil {
+	if err := compressor.compressedStore.Bucket(compressor.bucketName()).Delete(idBytes); err != nil {
 		compressor.logger.WithFields(logrus.Fields{
 			"action": "compressor_delete",
 			"id":     id,
@@ -155,7 +196,7 @@ func (compressor *quantizedVectorsCompressor[T]) Preload(id uint64, vector []flo
 	compressedVector := compressor.quantizer.Encode(vector)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
+	compressor.compressedStore.Bucket(compressor.bucketName()).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
 	compressor.cache.Grow(id)
 	compressor.cache.Preload(id, compressedVector)
 }
@@ -169,7 +210,7 @@ func (compressor *quantizedVectorsCompressor[T]) PreloadMulti(docID uint64, ids
 	for i, id := range ids {
 		idBytes := make([]byte, 8)
 		compressor.storeId(idBytes, id)
-		compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVectors[i]))
+		compressor.compressedStore.Bucket(compressor.bucketName()).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVectors[i]))
 		if id > maxID {
 			maxID = id
 		}
@@ -182,7 +223,7 @@ func (compressor *quantizedVectorsCompressor[T]) PreloadPassage(id, docID, relat
 	compressedVector := compressor.quantizer.Encode(vec)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
+	compressor.compressedStore.Bucket(compressor.bucketName()).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
 	compressor.cache.Grow(id)
 	compressor.cache.PreloadPassage(id, docID, relativeID, compressedVector)
 }
@@ -200,7 +241,20 @@ func (compressor *quantizedVectorsCompressor[T]) Prefetch(id uint64) {
 }
 
 func (compressor *quantizedVectorsCompressor[T]) Stats() CompressionStats {
-	return compressor.quantizer.Stats()
+	if compressor.drift == nil {
+		return compressor.quantizer.Stats()
+	}
+	return driftCompressionStats{
+		CompressionStats: compressor.quantizer.Stats(),
+		drift:            compressor.drift.stats(),
+	}
+}
+
+func (compressor *quantizedVectorsCompressor[T]) TrackQuantizationError(vector []float32) {
+	if compressor.drift == nil || !compressor.drift.shouldSample() {
+		return
+	}
+	compressor.drift.observe(relativeQuantizationError(compressor.quantizer, vector))
 }
 
 func (compressor *quantizedVectorsCompressor[T]) DistanceBetweenCompressedVectors(x, y []T) (float32, error) {
@@ -236,7 +290,7 @@ func (compressor *quantizedVectorsCompressor[T]) DistanceBetweenCompressedVector
 func (compressor *quantizedVectorsCompressor[T]) getCompressedVectorForID(ctx context.Context, id uint64) ([]T, error) {
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressedVector, err := compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)).Get(idBytes)
+	compressedVector, err := compressor.compressedStore.Bucket(compressor.bucketName()).Get(idBytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "Getting vector for id")
 	}
@@ -290,9 +344,15 @@ func (compressor *quantizedVectorsCompressor[T]) NewBag() CompressionDistanceBag
 }
 
 func (compressor *quantizedVectorsCompressor[T]) initCompressedStore() error {
-	err := compressor.compressedStore.CreateOrLoadBucket(
+	createBucket := compressor.compressedStore.CreateOrLoadBucket
+	if compressor.replacementBucket != "" {
+		// a leftover replacement bucket belongs to an interrupted retraining
+		// and must not be loaded
+		createBucket = compressor.compressedStore.CreateBucket
+	}
+	err := createBucket(
 		context.Background(),
-		helpers.GetCompressedBucketName(compressor.targetVector),
+		compressor.bucketName(),
 		compressor.makeBucketOptions(lsmkv.StrategyReplace)...,
 	)
 	if err != nil {
@@ -318,7 +378,7 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillCache(ctx context.Contex
 	vecs := make([]VecAndID[T], 0, 10_000)
 
 	it := NewParallelIterator(
-		compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)),
+		compressor.compressedStore.Bucket(compressor.bucketName()),
 		parallel, compressor.loadId, compressor.quantizer.FromCompressedBytesWithSubsliceBuffer,
 		compressor.logger)
 	vecsCh, abortedCh := it.IterateAll(ctx)
@@ -373,7 +433,7 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillMultiCache(ctx context.C
 	vecs := make([]VecAndID[T], 0, 10_000)
 
 	it := NewParallelIterator(
-		compressor.compressedStore.Bucket(helpers.GetCompressedBucketName(compressor.targetVector)),
+		compressor.compressedStore.Bucket(compressor.bucketName()),
 		parallel, compressor.loadId, compressor.quantizer.FromCompressedBytesWithSubsliceBuffer,
 		compressor.logger)
 	vecsCh, abortedCh := it.IterateAll(ctx)
@@ -446,7 +506,30 @@ func NewHNSWPQCompressor(
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	allocChecker memwatch.AllocChecker,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
+	compressor, err := newHNSWPQCompressor(cfg, distance, dimensions, vectorCacheMaxObjects, logger,
+		data, store, makeBucketOptions, allocChecker, targetVector, "", cacheOpts)
+	if err != nil {
+		return nil, err
+	}
+	return compressor, nil
+}
+
+func newHNSWPQCompressor(
+	cfg hnsw.PQConfig,
+	distance distancer.Provider,
+	dimensions int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+	allocChecker memwatch.AllocChecker,
+	targetVector string,
+	replacementBucket string,
+	cacheOpts []cache.Option,
+) (*quantizedVectorsCompressor[byte], error) {
 	quantizer, err := NewProductQuantizer(cfg, distance, dimensions, logger)
 	if err != nil {
 		return nil, err
@@ -458,19 +541,22 @@ func NewHNSWPQCompressor(
 		loadId:            binary.LittleEndian.Uint64,
 		logger:            logger,
 		targetVector:      targetVector,
+		replacementBucket: replacementBucket,
 		makeBucketOptions: makeBucketOptions,
+		drift:             newQuantizationDriftTracker(),
 	}
 	if err := pqVectorsCompressor.initCompressedStore(); err != nil {
 		return nil, err
 	}
 	pqVectorsCompressor.cache = cache.NewShardedByteLockCache(
 		pqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-		0, allocChecker)
+		0, allocChecker, cacheOpts...)
 	pqVectorsCompressor.cache.Grow(uint64(len(data)))
 	err = quantizer.Fit(data)
 	if err != nil {
 		return nil, err
 	}
+	setBaselineFromTraining(pqVectorsCompressor.drift, quantizer, data)
 	return pqVectorsCompressor, nil
 }
 
@@ -485,6 +571,7 @@ func RestoreHNSWPQCompressor(
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	allocChecker memwatch.AllocChecker,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
 	quantizer, err := NewProductQuantizerWithEncoders(cfg, distance, dimensions, encoders, logger)
 	if err != nil {
@@ -498,13 +585,14 @@ func RestoreHNSWPQCompressor(
 		logger:            logger,
 		targetVector:      targetVector,
 		makeBucketOptions: makeBucketOptions,
+		drift:             newQuantizationDriftTracker(),
 	}
 	if err := pqVectorsCompressor.initCompressedStore(); err != nil {
 		return nil, err
 	}
 	pqVectorsCompressor.cache = cache.NewShardedByteLockCache(
 		pqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger, 0,
-		allocChecker)
+		allocChecker, cacheOpts...)
 	return pqVectorsCompressor, nil
 }
 
@@ -589,6 +677,7 @@ func NewBQCompressor(
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	allocChecker memwatch.AllocChecker,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
 	quantizer := NewBinaryQuantizer(distance)
 	bqVectorsCompressor := &quantizedVectorsCompressor[uint64]{
@@ -605,7 +694,7 @@ func NewBQCompressor(
 	}
 	bqVectorsCompressor.cache = cache.NewShardedUInt64LockCache(
 		bqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger, 0,
-		allocChecker)
+		allocChecker, cacheOpts...)
 	return bqVectorsCompressor, nil
 }
 
@@ -646,7 +735,28 @@ func NewHNSWSQCompressor(
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	allocChecker memwatch.AllocChecker,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
+	compressor, err := newHNSWSQCompressor(distance, vectorCacheMaxObjects, logger, data, store,
+		makeBucketOptions, allocChecker, targetVector, "", cacheOpts)
+	if err != nil {
+		return nil, err
+	}
+	return compressor, nil
+}
+
+func newHNSWSQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+	allocChecker memwatch.AllocChecker,
+	targetVector string,
+	replacementBucket string,
+	cacheOpts []cache.Option,
+) (*quantizedVectorsCompressor[byte], error) {
 	quantizer := NewScalarQuantizer(data, distance)
 	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
 		quantizer:         quantizer,
@@ -655,15 +765,18 @@ func NewHNSWSQCompressor(
 		loadId:            binary.BigEndian.Uint64,
 		logger:            logger,
 		targetVector:      targetVector,
+		replacementBucket: replacementBucket,
 		makeBucketOptions: makeBucketOptions,
+		drift:             newQuantizationDriftTracker(),
 	}
 	if err := sqVectorsCompressor.initCompressedStore(); err != nil {
 		return nil, err
 	}
 	sqVectorsCompressor.cache = cache.NewShardedByteLockCache(
 		sqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-		0, allocChecker)
+		0, allocChecker, cacheOpts...)
 	sqVectorsCompressor.cache.Grow(uint64(len(data)))
+	setBaselineFromTraining(sqVectorsCompressor.drift, quantizer, data)
 	return sqVectorsCompressor, nil
 }
 
@@ -677,6 +790,7 @@ func RestoreHNSWSQCompressor(
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	allocChecker memwatch.AllocChecker,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
 	quantizer, err := RestoreScalarQuantizer(a, b, dimensions, distance)
 	if err != nil {
@@ -690,13 +804,14 @@ func RestoreHNSWSQCompressor(
 		logger:            logger,
 		targetVector:      targetVector,
 		makeBucketOptions: makeBucketOptions,
+		drift:             newQuantizationDriftTracker(),
 	}
 	if err := sqVectorsCompressor.initCompressedStore(); err != nil {
 		return nil, err
 	}
 	sqVectorsCompressor.cache = cache.NewShardedByteLockCache(
 		sqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-		0, allocChecker)
+		0, allocChecker, cacheOpts...)
 	return sqVectorsCompressor, nil
 }
 
@@ -773,6 +888,7 @@ func NewRQCompressor(
 	bits int,
 	dim int,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
 	var rqVectorsCompressor VectorCompressor
 	switch bits {
@@ -792,7 +908,7 @@ func NewRQCompressor(
 		}
 		rqVectorsCompressor.(*quantizedVectorsCompressor[uint64]).cache = cache.NewShardedUInt64LockCache(
 			rqVectorsCompressor.(*quantizedVectorsCompressor[uint64]).getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-			0, allocChecker)
+			0, allocChecker, cacheOpts...)
 	case 8:
 		quantizer := NewRotationalQuantizer(dim, DefaultFastRotationSeed, bits, distance)
 		rqVectorsCompressor = &quantizedVectorsCompressor[byte]{
@@ -809,7 +925,7 @@ func NewRQCompressor(
 		}
 		rqVectorsCompressor.(*quantizedVectorsCompressor[byte]).cache = cache.NewShardedByteLockCache(
 			rqVectorsCompressor.(*quantizedVectorsCompressor[byte]).getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-			0, allocChecker)
+			0, allocChecker, cacheOpts...)
 	default:
 		return nil, errors.New("invalid bits value, only 1 and 8 bits are supported")
 	}
@@ -831,6 +947,7 @@ func RestoreRQCompressor(
 	allocChecker memwatch.AllocChecker,
 	makeBucketOptions lsmkv.MakeBucketOptions,
 	targetVector string,
+	cacheOpts ...cache.Option,
 ) (VectorCompressor, error) {
 	var rqVectorsCompressor VectorCompressor
 	switch bits {
@@ -853,7 +970,7 @@ func RestoreRQCompressor(
 		}
 		rqVectorsCompressor.(*quantizedVectorsCompressor[uint64]).cache = cache.NewShardedUInt64LockCache(
 			rqVectorsCompressor.(*quantizedVectorsCompressor[uint64]).getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-			0, allocChecker)
+			0, allocChecker, cacheOpts...)
 	case 8:
 		quantizer, err := RestoreRotationalQuantizer(dimensions, bits, outputDim, rounds, swaps, signs, distance)
 		if err != nil {
@@ -873,7 +990,7 @@ func RestoreRQCompressor(
 		}
 		rqVectorsCompressor.(*quantizedVectorsCompressor[byte]).cache = cache.NewShardedByteLockCache(
 			rqVectorsCompressor.(*quantizedVectorsCompressor[byte]).getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
-			0, allocChecker)
+			0, allocChecker, cacheOpts...)
 	default:
 		return nil, errors.New("invalid bits value, only 1 and 8 bits are supported")
 	}
@@ -1028,3 +1145,7 @@ func (u UncompressedStats) CompressionRatio(_ int) float64 {
 	// Uncompressed vectors have no compression
 	return 1.0
 }
+
+func (u UncompressedStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
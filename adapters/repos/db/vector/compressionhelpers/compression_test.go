@@ -15,6 +15,7 @@ package compressionhelpers_test
 
 import (
 	"context"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -154,3 +155,83 @@ func Test_NoRaceQuantizedVectorCompressor(t *testing.T) {
 		assert.EqualError(t, err, "2 vs 3: vector lengths don't match")
 	})
 }
+
+func Test_NoRaceMultiQueryDistancer(t *testing.T) {
+	const (
+		dims    = 16
+		objects = 200
+	)
+	data := make([][]float32, objects)
+	for i := range data {
+		data[i] = make([]float32, dims)
+		for j := range data[i] {
+			data[i][j] = rand.Float32()
+		}
+	}
+	queries := data[:9]
+
+	newCompressors := map[string]func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error){
+		"PQ": func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error) {
+			cfg := hnsw.PQConfig{
+				Enabled:  true,
+				Segments: 4,
+				Encoder: hnsw.PQEncoder{
+					Type:         hnsw.PQEncoderTypeKMeans,
+					Distribution: hnsw.PQEncoderDistributionLogNormal,
+				},
+				Centroids: 16,
+			}
+			return compressionhelpers.NewHNSWPQCompressor(cfg, dist, dims, 1e12, nil, data,
+				testinghelpers.NewDummyStore(t), lsmkv.MakeNoopBucketOptions, memwatch.NewDummyMonitor(), "name")
+		},
+		"SQ": func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error) {
+			return compressionhelpers.NewHNSWSQCompressor(dist, 1e12, nil, data,
+				testinghelpers.NewDummyStore(t), lsmkv.MakeNoopBucketOptions, memwatch.NewDummyMonitor(), "name")
+		},
+		"BQ": func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error) {
+			return compressionhelpers.NewBQCompressor(dist, 1e12, nil,
+				testinghelpers.NewDummyStore(t), lsmkv.MakeNoopBucketOptions, nil, "name")
+		},
+		"RQ1": func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error) {
+			return compressionhelpers.NewRQCompressor(dist, 1e12, nil, testinghelpers.NewDummyStore(t),
+				memwatch.NewDummyMonitor(), lsmkv.MakeNoopBucketOptions, 1, dims, "name")
+		},
+		"RQ8": func(t *testing.T, dist distancer.Provider) (compressionhelpers.VectorCompressor, error) {
+			return compressionhelpers.NewRQCompressor(dist, 1e12, nil, testinghelpers.NewDummyStore(t),
+				memwatch.NewDummyMonitor(), lsmkv.MakeNoopBucketOptions, 8, dims, "name")
+		},
+	}
+
+	for name, newCompressor := range newCompressors {
+		for _, dist := range []distancer.Provider{
+			distancer.NewL2SquaredProvider(),
+			distancer.NewDotProductProvider(),
+		} {
+			t.Run(name+" "+dist.Type(), func(t *testing.T) {
+				compressor, err := newCompressor(t, dist)
+				require.Nil(t, err)
+				for i, vec := range data {
+					compressor.Preload(uint64(i), vec)
+				}
+
+				multi, returnFn := compressor.NewMultiQueryDistancer(queries)
+				defer returnFn()
+
+				out := make([]float32, len(queries))
+				for id := range data {
+					require.Nil(t, multi.DistancesToNode(uint64(id), out))
+					for q, query := range queries {
+						single, returnSingle := compressor.NewDistancer(query)
+						expected, err := single.DistanceToNode(uint64(id))
+						returnSingle()
+						require.Nil(t, err)
+						assert.InDelta(t, expected, out[q], 1e-4)
+					}
+				}
+
+				err = multi.DistancesToNode(objects+1, out)
+				assert.NotNil(t, err)
+			})
+		}
+	}
+}
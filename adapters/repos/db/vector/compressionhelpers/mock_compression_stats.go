@@ -119,6 +119,53 @@ func (_c *MockCompressionStats_CompressionType_Call) RunAndReturn(run func() str
 	return _c
 }
 
+// QuantizationDrift provides a mock function with no fields
+func (_m *MockCompressionStats) QuantizationDrift() *QuantizationDriftStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuantizationDrift")
+	}
+
+	var r0 *QuantizationDriftStats
+	if rf, ok := ret.Get(0).(func() *QuantizationDriftStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*QuantizationDriftStats)
+		}
+	}
+
+	return r0
+}
+
+// MockCompressionStats_QuantizationDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuantizationDrift'
+type MockCompressionStats_QuantizationDrift_Call struct {
+	*mock.Call
+}
+
+// QuantizationDrift is a helper method to define mock.On call
+func (_e *MockCompressionStats_Expecter) QuantizationDrift() *MockCompressionStats_QuantizationDrift_Call {
+	return &MockCompressionStats_QuantizationDrift_Call{Call: _e.mock.On("QuantizationDrift")}
+}
+
+func (_c *MockCompressionStats_QuantizationDrift_Call) Run(run func()) *MockCompressionStats_QuantizationDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCompressionStats_QuantizationDrift_Call) Return(_a0 *QuantizationDriftStats) *MockCompressionStats_QuantizationDrift_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompressionStats_QuantizationDrift_Call) RunAndReturn(run func() *QuantizationDriftStats) *MockCompressionStats_QuantizationDrift_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCompressionStats creates a new instance of MockCompressionStats. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCompressionStats(t interface {
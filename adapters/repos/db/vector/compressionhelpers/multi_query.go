@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"context"
+	"fmt"
+)
+
+// NewMultiQueryDistancer returns a distancer which reads the compressed
+// vector of a node once and scores it against all vectors. Product
+// quantization additionally evaluates four lookup tables per pass over the
+// codes, other quantizers score the queries one after another.
+func (compressor *quantizedVectorsCompressor[T]) NewMultiQueryDistancer(vectors [][]float32,
+) (MultiQueryDistancer, ReturnDistancerFn) {
+	d := &quantizedMultiQueryDistancer[T]{
+		compressor: compressor,
+		distancers: make([]quantizerDistancer[T], len(vectors)),
+	}
+	for i, vector := range vectors {
+		d.distancers[i] = compressor.quantizer.NewQuantizerDistancer(vector)
+	}
+
+	if pq, ok := any(compressor.quantizer).(*ProductQuantizer); ok {
+		luts := make([]*DistanceLookUpTable, len(vectors))
+		for i, distancer := range d.distancers {
+			luts[i] = any(distancer).(*PQDistancer).lut
+		}
+		d.distances = func(code []T, out []float32) error {
+			encoded := any(code).([]byte)
+			if len(encoded) != pq.m {
+				return fmt.Errorf("inconsistent compressed vector length")
+			}
+			pq.multiDistance(encoded, luts, out)
+			return nil
+		}
+	}
+
+	return d, func() {
+		for _, distancer := range d.distancers {
+			compressor.quantizer.ReturnQuantizerDistancer(distancer)
+		}
+	}
+}
+
+type quantizedMultiQueryDistancer[T byte | uint64] struct {
+	compressor *quantizedVectorsCompressor[T]
+	distancers []quantizerDistancer[T]
+	// distances is set if the quantizer can score all queries at once
+	distances func(code []T, out []float32) error
+}
+
+func (d *quantizedMultiQueryDistancer[T]) DistancesToNode(id uint64, out []float32) error {
+	compressedVector, err := d.compressor.cache.Get(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if len(compressedVector) == 0 {
+		return fmt.Errorf(
+			"got a nil or zero-length vector at docID %d", id)
+	}
+
+	if d.distances != nil {
+		return d.distances(compressedVector, out)
+	}
+	for i, distancer := range d.distancers {
+		if out[i], err = distancer.Distance(compressedVector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// multiDistance writes the distance between encoded and the query of each
+// lookup table into out. The tables are evaluated four at a time, so every
+// code is extracted once for four queries.
+func (pq *ProductQuantizer) multiDistance(encoded []byte, luts []*DistanceLookUpTable, out []float32) {
+	if len(encoded) < len(pq.kms) {
+		// compiler hint for Bounds-Check Elimination
+		panic("multiDistance: encoded length less than number of segments")
+	}
+
+	q := 0
+	for ; q+3 < len(luts); q += 4 {
+		lut0, lut1, lut2, lut3 := luts[q], luts[q+1], luts[q+2], luts[q+3]
+		var sum0, sum1, sum2, sum3 float32
+		for i := range pq.kms {
+			pos := lut0.posForSegmentAndCode(i, ExtractCode8(encoded, i))
+			sum0 += lut0.distances[pos]
+			sum1 += lut1.distances[pos]
+			sum2 += lut2.distances[pos]
+			sum3 += lut3.distances[pos]
+		}
+		out[q] = pq.distance.Wrap(sum0)
+		out[q+1] = pq.distance.Wrap(sum1)
+		out[q+2] = pq.distance.Wrap(sum2)
+		out[q+3] = pq.distance.Wrap(sum3)
+	}
+
+	// handle tail (if any)
+	for ; q < len(luts); q++ {
+		out[q] = luts[q].LookUp(encoded, pq)
+	}
+}
@@ -205,6 +205,10 @@ func (p PQStats) CompressionRatio(dimensions int) float64 {
 	return float64(originalSize) / float64(compressedSize)
 }
 
+func (p PQStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
+
 func NewProductQuantizer(cfg ent.PQConfig, distance distancer.Provider, dimensions int, logger logrus.FieldLogger) (*ProductQuantizer, error) {
 	if cfg.Segments <= 0 {
 		return nil, errors.New("segments cannot be 0 nor negative")
@@ -228,7 +232,7 @@ func NewProductQuantizer(cfg ent.PQConfig, distance distancer.Provider, dimensio
 		ks:                  cfg.Centroids,
 		m:                   cfg.Segments,
 		ds:                  int(dimensions / cfg.Segments),
-		distance:            distance,
+		distance:            quantizerDistance(distance),
 		trainingLimit:       cfg.TrainingLimit,
 		dimensions:          dimensions,
 		encoderType:         encoderType,
@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// the quantization error is measured for every n-th tracked vector, as
+	// decoding is considerably more expensive than encoding
+	driftSampleEvery = 32
+	// maximum number of vectors used to establish the baseline error
+	driftBaselineSamples = 1000
+	// weight of a new sample in the moving average of the recent error
+	driftRecentWeight = 0.01
+)
+
+// QuantizationDriftStats compares the quantization error of recently inserted
+// vectors to the error on the data the codebook was trained on. A drift well
+// above 1 indicates that the data distribution has shifted and the codebook
+// should be retrained.
+type QuantizationDriftStats struct {
+	// BaselineError is the mean relative quantization error of the training
+	// sample. Restored codebooks don't have their training sample anymore, in
+	// that case the baseline is established from the first tracked inserts.
+	BaselineError float64 `json:"baselineError"`
+	// BaselineFromTraining is false if the baseline was established from
+	// inserts after a restart.
+	BaselineFromTraining bool `json:"baselineFromTraining"`
+	// RecentError is a moving average of the relative quantization error of
+	// recently inserted vectors.
+	RecentError float64 `json:"recentError"`
+	// Drift is RecentError / BaselineError, 0 if not enough data is available
+	// yet.
+	Drift float64 `json:"drift"`
+	// Samples is the number of inserts the recent error is based on.
+	Samples int64 `json:"samples"`
+}
+
+// quantizationDriftTracker samples the quantization error of inserts for
+// quantizers which are trained on the data, i.e. PQ and SQ. RQ is data
+// independent and therefore not tracked.
+type quantizationDriftTracker struct {
+	calls atomic.Uint64
+
+	sync.Mutex
+	baseline             float64
+	baselineFromTraining bool
+	baselineSum          float64
+	baselineCount        int
+	recent               float64
+	samples              int64
+}
+
+func newQuantizationDriftTracker() *quantizationDriftTracker {
+	return &quantizationDriftTracker{}
+}
+
+// relativeQuantizationError is the squared reconstruction error of the vector
+// relative to its squared norm, so that it is comparable across vectors of
+// different magnitude.
+func relativeQuantizationError[T byte | uint64](q quantizer[T], vec []float32) float64 {
+	decoded := q.Decode(q.Encode(vec))
+
+	var diff, norm float64
+	for i := range vec {
+		if i >= len(decoded) {
+			break
+		}
+		d := float64(vec[i] - decoded[i])
+		diff += d * d
+		norm += float64(vec[i]) * float64(vec[i])
+	}
+	if norm == 0 {
+		return 0
+	}
+	return diff / norm
+}
+
+// setBaselineFromTraining measures the error on (a sample of) the training
+// data right after the quantizer was fit.
+func setBaselineFromTraining[T byte | uint64](d *quantizationDriftTracker, q quantizer[T], data [][]float32) {
+	step := 1
+	if len(data) > driftBaselineSamples {
+		step = len(data) / driftBaselineSamples
+	}
+
+	var sum float64
+	count := 0
+	for i := 0; i < len(data); i += step {
+		if len(data[i]) == 0 {
+			continue
+		}
+		sum += relativeQuantizationError(q, data[i])
+		count++
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if count > 0 {
+		d.baseline = sum / float64(count)
+		d.baselineFromTraining = true
+	}
+}
+
+func (d *quantizationDriftTracker) shouldSample() bool {
+	return d.calls.Add(1)%driftSampleEvery == 0
+}
+
+func (d *quantizationDriftTracker) observe(err float64) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.baseline == 0 {
+		d.baselineSum += err
+		d.baselineCount++
+		if d.baselineCount >= driftBaselineSamples {
+			d.baseline = d.baselineSum / float64(d.baselineCount)
+		}
+		return
+	}
+
+	if d.samples == 0 {
+		d.recent = err
+	} else {
+		d.recent = (1-driftRecentWeight)*d.recent + driftRecentWeight*err
+	}
+	d.samples++
+}
+
+func (d *quantizationDriftTracker) stats() QuantizationDriftStats {
+	d.Lock()
+	defer d.Unlock()
+
+	stats := QuantizationDriftStats{
+		BaselineError:        d.baseline,
+		BaselineFromTraining: d.baselineFromTraining,
+		RecentError:          d.recent,
+		Samples:              d.samples,
+	}
+	if d.baseline > 0 && d.samples > 0 {
+		stats.Drift = d.recent / d.baseline
+	}
+	return stats
+}
+
+// driftCompressionStats adds the quantization drift to the stats of the
+// underlying quantizer.
+type driftCompressionStats struct {
+	CompressionStats
+	drift QuantizationDriftStats
+}
+
+func (s driftCompressionStats) QuantizationDrift() *QuantizationDriftStats {
+	return &s.drift
+}
+
+func (s driftCompressionStats) MarshalJSON() ([]byte, error) {
+	inner, err := json.Marshal(s.CompressionStats)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(inner, &fields); err != nil {
+		return nil, err
+	}
+	fields["drift"] = s.drift
+	return json.Marshal(fields)
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func driftTestVecs(n, dimensions int) [][]float32 {
+	r := rand.New(rand.NewSource(42))
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vecs[i] = make([]float32, dimensions)
+		for j := range vecs[i] {
+			vecs[i][j] = r.Float32()*2 - 1
+		}
+	}
+	return vecs
+}
+
+func shiftVecs(vecs [][]float32, scale, offset float32) [][]float32 {
+	shifted := make([][]float32, len(vecs))
+	for i, vec := range vecs {
+		shifted[i] = make([]float32, len(vec))
+		for j, x := range vec {
+			shifted[i][j] = x*scale + offset
+		}
+	}
+	return shifted
+}
+
+func TestSQDecode(t *testing.T) {
+	vecs := driftTestVecs(100, 32)
+	sq := NewScalarQuantizer(vecs, distancer.NewL2SquaredProvider())
+
+	step := sq.a / codes
+	for _, vec := range vecs {
+		decoded := sq.Decode(sq.Encode(vec))
+		require.Len(t, decoded, len(vec))
+		for i := range vec {
+			assert.InDelta(t, vec[i], decoded[i], float64(step)+1e-5)
+		}
+	}
+}
+
+func TestQuantizationDriftTracker(t *testing.T) {
+	t.Run("baseline from inserts", func(t *testing.T) {
+		d := newQuantizationDriftTracker()
+		for i := 0; i < driftBaselineSamples-1; i++ {
+			d.observe(0.1)
+		}
+		stats := d.stats()
+		assert.Zero(t, stats.BaselineError)
+		assert.Zero(t, stats.Drift)
+
+		d.observe(0.1)
+		stats = d.stats()
+		assert.InDelta(t, 0.1, stats.BaselineError, 1e-9)
+		assert.False(t, stats.BaselineFromTraining)
+		assert.Zero(t, stats.Samples)
+
+		d.observe(0.2)
+		stats = d.stats()
+		assert.Equal(t, int64(1), stats.Samples)
+		assert.InDelta(t, 2.0, stats.Drift, 1e-9)
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		d := newQuantizationDriftTracker()
+		sampled := 0
+		for i := 0; i < driftSampleEvery*10; i++ {
+			if d.shouldSample() {
+				sampled++
+			}
+		}
+		assert.Equal(t, 10, sampled)
+	})
+}
+
+func TestQuantizationDrift(t *testing.T) {
+	dimensions := 32
+	training := driftTestVecs(1000, dimensions)
+	logger, _ := test.NewNullLogger()
+
+	pq, err := NewProductQuantizer(ent.PQConfig{
+		Enabled: true,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeKMeans,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+		Centroids: 64,
+		Segments:  dimensions / 4,
+	}, distancer.NewL2SquaredProvider(), dimensions, logger)
+	require.NoError(t, err)
+	require.NoError(t, pq.Fit(training))
+
+	sq := NewScalarQuantizer(training, distancer.NewL2SquaredProvider())
+
+	for name, q := range map[string]quantizer[byte]{"pq": pq, "sq": sq} {
+		t.Run(name, func(t *testing.T) {
+			d := newQuantizationDriftTracker()
+			setBaselineFromTraining(d, q, training)
+
+			stats := d.stats()
+			require.True(t, stats.BaselineFromTraining)
+			require.Greater(t, stats.BaselineError, float64(0))
+
+			for _, vec := range shiftVecs(training, 4, 2) {
+				d.observe(relativeQuantizationError(q, vec))
+			}
+			stats = d.stats()
+			assert.Equal(t, int64(len(training)), stats.Samples)
+			assert.Greater(t, stats.Drift, 2.0)
+		})
+	}
+}
+
+func TestQuantizationDriftStatsJSON(t *testing.T) {
+	stats := driftCompressionStats{
+		CompressionStats: SQStats{},
+		drift:            QuantizationDriftStats{BaselineError: 0.1, RecentError: 0.2, Drift: 2, Samples: 5},
+	}
+	assert.Equal(t, 2.0, stats.QuantizationDrift().Drift)
+	assert.Equal(t, SQStats{}.CompressionRatio(32), stats.CompressionRatio(32))
+
+	raw, err := json.Marshal(stats)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(raw, &fields))
+	require.Contains(t, fields, "drift")
+	drift := fields["drift"].(map[string]any)
+	assert.Equal(t, float64(5), drift["samples"])
+	assert.Equal(t, float64(2), drift["drift"])
+}
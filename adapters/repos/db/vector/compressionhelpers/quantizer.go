@@ -13,8 +13,17 @@ package compressionhelpers
 
 import (
 	"encoding/binary"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 )
 
+// quantizerDistance returns the distance encoded vectors are compared with.
+// Distances without a quantized implementation fall back to their proxy, the
+// index rescores the candidates with the exact distance.
+func quantizerDistance(p distancer.Provider) distancer.Provider {
+	return distancer.ForCompression(p)
+}
+
 type quantizerDistancer[T byte | uint64] interface {
 	Distance(x []T) (float32, error)
 	DistanceToFloat(x []float32) (float32, error)
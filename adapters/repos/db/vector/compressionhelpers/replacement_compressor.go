@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// ReplacementCompressor is a VectorCompressor which keeps its compressed
+// vectors in a separate bucket. This allows to re-encode all vectors while the
+// current compressor keeps serving reads from the regular bucket.
+type ReplacementCompressor interface {
+	VectorCompressor
+	// FlushBucket writes all compressed vectors of the replacement bucket to
+	// disk.
+	FlushBucket() error
+	// CommitBucket replaces the regular bucket of compressed vectors with the
+	// replacement bucket. Afterwards the compressor uses the regular bucket,
+	// the compressor previously using it must not be used anymore.
+	CommitBucket(ctx context.Context) error
+	// DiscardBucket removes the replacement bucket. The compressor must not be
+	// used anymore afterwards.
+	DiscardBucket(ctx context.Context) error
+}
+
+// NewHNSWPQReplacementCompressor is like NewHNSWPQCompressor, but writes the
+// compressed vectors to a replacement bucket until CommitBucket is called.
+func NewHNSWPQReplacementCompressor(
+	cfg hnsw.PQConfig,
+	distance distancer.Provider,
+	dimensions int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+	allocChecker memwatch.AllocChecker,
+	targetVector string,
+	cacheOpts ...cache.Option,
+) (ReplacementCompressor, error) {
+	compressor, err := newHNSWPQCompressor(cfg, distance, dimensions, vectorCacheMaxObjects, logger,
+		data, store, makeBucketOptions, allocChecker, targetVector, ReplacementBucketName(targetVector), cacheOpts)
+	if err != nil {
+		return nil, err
+	}
+	return compressor, nil
+}
+
+// NewHNSWSQReplacementCompressor is like NewHNSWSQCompressor, but writes the
+// compressed vectors to a replacement bucket until CommitBucket is called.
+func NewHNSWSQReplacementCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	makeBucketOptions lsmkv.MakeBucketOptions,
+	allocChecker memwatch.AllocChecker,
+	targetVector string,
+	cacheOpts ...cache.Option,
+) (ReplacementCompressor, error) {
+	compressor, err := newHNSWSQCompressor(distance, vectorCacheMaxObjects, logger, data, store,
+		makeBucketOptions, allocChecker, targetVector, ReplacementBucketName(targetVector), cacheOpts)
+	if err != nil {
+		return nil, err
+	}
+	return compressor, nil
+}
+
+// ReplacementBucketName is the name of the bucket a ReplacementCompressor of
+// the target vector writes to until CommitBucket is called.
+func ReplacementBucketName(targetVector string) string {
+	return helpers.TempBucketFromBucketName(helpers.GetCompressedBucketName(targetVector))
+}
+
+func (compressor *quantizedVectorsCompressor[T]) FlushBucket() error {
+	if compressor.replacementBucket == "" {
+		return fmt.Errorf("compressor does not use a replacement bucket")
+	}
+	bucket := compressor.compressedStore.Bucket(compressor.replacementBucket)
+	if bucket == nil {
+		return fmt.Errorf("replacement bucket %q not found", compressor.replacementBucket)
+	}
+	if err := bucket.FlushMemtable(); err != nil {
+		return fmt.Errorf("flush replacement bucket: %w", err)
+	}
+	return nil
+}
+
+func (compressor *quantizedVectorsCompressor[T]) CommitBucket(ctx context.Context) error {
+	// replacing the buckets starts a new memtable, everything written so far
+	// has to be on disk
+	if err := compressor.FlushBucket(); err != nil {
+		return err
+	}
+	if err := compressor.compressedStore.ReplaceBuckets(ctx,
+		helpers.GetCompressedBucketName(compressor.targetVector), compressor.replacementBucket); err != nil {
+		return fmt.Errorf("replace compressed vectors bucket: %w", err)
+	}
+	compressor.replacementBucket = ""
+	return nil
+}
+
+func (compressor *quantizedVectorsCompressor[T]) DiscardBucket(ctx context.Context) error {
+	if compressor.replacementBucket == "" {
+		return fmt.Errorf("compressor does not use a replacement bucket")
+	}
+	compressor.cache.Drop()
+	if err := compressor.compressedStore.ShutdownBucket(ctx, compressor.replacementBucket); err != nil {
+		return err
+	}
+	dir := path.Join(compressor.compressedStore.GetDir(), compressor.replacementBucket)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("remove replacement bucket: %w", err)
+	}
+	return nil
+}
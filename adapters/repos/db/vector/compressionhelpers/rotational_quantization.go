@@ -61,6 +61,7 @@ func NewRotationalQuantizer(inputDim int, seed uint64, bits int, distancer dista
 	// biased in some of the unit tests.
 	rotationRounds := 3
 	rotation := NewFastRotation(inputDim, rotationRounds, seed)
+	distancer = quantizerDistance(distancer)
 	cos, l2, err := distancerIndicatorsAndError(distancer)
 	rq := &RotationalQuantizer{
 		inputDim:  uint32(inputDim),
@@ -76,6 +77,7 @@ func NewRotationalQuantizer(inputDim int, seed uint64, bits int, distancer dista
 }
 
 func RestoreRotationalQuantizer(inputDim int, bits int, outputDim int, rounds int, swaps [][]compression.Swap, signs [][]float32, distancer distancer.Provider) (*RotationalQuantizer, error) {
+	distancer = quantizerDistance(distancer)
 	cos, l2, err := distancerIndicatorsAndError(distancer)
 	rq := &RotationalQuantizer{
 		inputDim:  uint32(inputDim),
@@ -330,6 +332,10 @@ func (rq RQStats) CompressionRatio(dimensionality int) float64 {
 	return float64(originalSize) / float64(compressedSize)
 }
 
+func (rq RQStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
+
 func (rq *RotationalQuantizer) Stats() CompressionStats {
 	return RQStats{
 		Bits: rq.bits,
@@ -71,7 +71,7 @@ func NewScalarQuantizer(data [][]float32, distance distancer.Provider) *ScalarQu
 	}
 
 	sq := &ScalarQuantizer{
-		distancer:  distance,
+		distancer:  quantizerDistance(distance),
 		dimensions: len(data[0]),
 	}
 	sq.b = data[0][0]
@@ -98,7 +98,7 @@ func RestoreScalarQuantizer(a, b float32, dimensions uint16, distance distancer.
 	}
 
 	sq := &ScalarQuantizer{
-		distancer:  distance,
+		distancer:  quantizerDistance(distance),
 		a:          a,
 		b:          b,
 		a2:         a * a / codes2,
@@ -134,7 +134,12 @@ func (sq *ScalarQuantizer) Encode(vec []float32) []byte {
 }
 
 func (sq *ScalarQuantizer) Decode(compressed []byte) []float32 {
-	panic("unimplemented")
+	// the last 8 bytes hold the sums of the codes
+	vec := make([]float32, len(compressed)-8)
+	for i := range vec {
+		vec[i] = sq.a/codes*float32(compressed[i]) + sq.b
+	}
+	return vec
 }
 
 type SQDistancer struct {
@@ -224,6 +229,10 @@ func (s SQStats) CompressionRatio(_ int) float64 {
 	return 4.0
 }
 
+func (s SQStats) QuantizationDrift() *QuantizationDriftStats {
+	return nil
+}
+
 func (sq *ScalarQuantizer) Stats() CompressionStats {
 	return SQStats{
 		A: sq.a,
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hfresh"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
@@ -47,9 +48,13 @@ type Config struct {
 	HNSWDisableSnapshots         bool
 	HNSWSnapshotOnStartup        bool
 	HNSWWaitForCachePrefill      bool
+	HNSWVectorCacheAdmission     bool
 	AllocChecker                 memwatch.AllocChecker
 	MakeBucketOptions            lsmkv.MakeBucketOptions
 	AsyncIndexingEnabled         bool
+	// HFreshConfig is used to create the hfresh index when the user config
+	// targets hfresh instead of hnsw.
+	HFreshConfig *hfresh.Config
 }
 
 func (c Config) Validate() error {
@@ -86,6 +91,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "target",
+			accessor: func(c ent.UserConfig) interface{} { return c.Target },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -99,6 +108,9 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HnswUC, updatedParsed.HnswUC); err != nil {
 		return err
 	}
+	if err := hfresh.ValidateUserConfigUpdate(initialParsed.HfreshUC, updatedParsed.HfreshUC); err != nil {
+		return err
+	}
 	return nil
 }
 
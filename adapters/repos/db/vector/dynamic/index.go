@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -32,11 +33,13 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hfresh"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -45,12 +48,23 @@ import (
 const (
 	composerUpgradedKey = "upgraded"
 	batchSize           = 500
+	// counting the live vectors of an upgraded index is not free, so the
+	// downgrade condition is evaluated at most once per interval
+	downgradeCheckInterval = time.Minute
+)
+
+// the state persisted under composerUpgradedKey. Indexes upgraded before the
+// upgrade target became configurable only ever stored 0 or 1.
+const (
+	stateFlat   byte = 0
+	stateHNSW   byte = 1
+	stateHFresh byte = 2
 )
 
 var dynamicBucket = []byte("dynamic")
 
 type Index interface {
-	// UnderlyingIndex returns the underlying index type (flat or hnsw)
+	// UnderlyingIndex returns the underlying index type (flat, hnsw or hfresh)
 	UnderlyingIndex() common.IndexType
 	IsUpgraded() bool
 }
@@ -60,6 +74,7 @@ type VectorIndex interface {
 	AddBatch(ctx context.Context, id []uint64, vector [][]float32) error
 	Delete(id ...uint64) error
 	SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error)
 	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
 	UpdateUserConfig(updated schemaconfig.VectorIndexConfig, callback func()) error
@@ -108,7 +123,9 @@ type dynamic struct {
 	threshold                    uint64
 	index                        VectorIndex
 	upgraded                     atomic.Bool
-	upgradeOnce                  sync.Once
+	transitioning                atomic.Bool
+	downgradeThreshold           atomic.Uint64
+	lastDowngradeCheck           atomic.Int64
 	tombstoneCallbacks           cyclemanager.CycleCallbackGroup
 	uc                           ent.UserConfig
 	db                           *bbolt.DB
@@ -117,9 +134,11 @@ type dynamic struct {
 	hnswDisableSnapshots         bool
 	hnswSnapshotOnStartup        bool
 	hnswWaitForCachePrefill      bool
+	hnswVectorCacheAdmission     bool
 	AllocChecker                 memwatch.AllocChecker
 	MakeBucketOptions            lsmkv.MakeBucketOptions
 	AsyncIndexingEnabled         bool
+	hfreshConfig                 *hfresh.Config
 }
 
 func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
@@ -134,14 +153,8 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		logger = l
 	}
 
-	flatConfig := flat.Config{
-		ID:                cfg.ID,
-		RootPath:          cfg.RootPath,
-		TargetVector:      cfg.TargetVector,
-		Logger:            cfg.Logger,
-		DistanceProvider:  cfg.DistanceProvider,
-		AllocChecker:      cfg.AllocChecker,
-		MakeBucketOptions: cfg.MakeBucketOptions,
+	if uc.Target == ent.TargetHFresh && cfg.HFreshConfig == nil {
+		return nil, errors.New("invalid config: hfreshConfig cannot be nil when targeting hfresh")
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -169,57 +182,102 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		hnswDisableSnapshots:         cfg.HNSWDisableSnapshots,
 		hnswSnapshotOnStartup:        cfg.HNSWSnapshotOnStartup,
 		hnswWaitForCachePrefill:      cfg.HNSWWaitForCachePrefill,
+		hnswVectorCacheAdmission:     cfg.HNSWVectorCacheAdmission,
 		AllocChecker:                 cfg.AllocChecker,
 		MakeBucketOptions:            cfg.MakeBucketOptions,
 		AsyncIndexingEnabled:         cfg.AsyncIndexingEnabled,
+		hfreshConfig:                 cfg.HFreshConfig,
 	}
+	index.downgradeThreshold.Store(uc.DowngradeThreshold)
 
-	upgraded, err := index.init(&cfg)
+	state, err := index.init(&cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if upgraded {
-		index.upgraded.Store(true)
-		hnsw, err := hnsw.New(
-			hnsw.Config{
-				Logger:                       index.logger,
-				RootPath:                     index.rootPath,
-				ID:                           index.id,
-				ShardName:                    index.shardName,
-				ClassName:                    index.className,
-				PrometheusMetrics:            index.prometheusMetrics,
-				VectorForIDThunk:             index.vectorForIDThunk,
-				GetViewThunk:                 index.getViewThunk,
-				TempVectorForIDWithViewThunk: index.tempVectorForIDWithViewThunk,
-				DistanceProvider:             index.distanceProvider,
-				MakeCommitLoggerThunk:        index.makeCommitLoggerThunk,
-				DisableSnapshots:             index.hnswDisableSnapshots,
-				SnapshotOnStartup:            index.hnswSnapshotOnStartup,
-				WaitForCachePrefill:          index.hnswWaitForCachePrefill,
-				AllocChecker:                 index.AllocChecker,
-				MakeBucketOptions:            index.MakeBucketOptions,
-				AsyncIndexingEnabled:         index.AsyncIndexingEnabled,
-			},
-			index.uc.HnswUC,
-			index.tombstoneCallbacks,
-			index.store,
-		)
-		if err != nil {
-			return nil, err
+	var vi VectorIndex
+	switch state {
+	case stateFlat:
+		vi, err = index.newFlat()
+	case stateHNSW:
+		vi, err = index.newHNSW()
+	case stateHFresh:
+		if index.hfreshConfig == nil {
+			return nil, errors.New("index was upgraded to hfresh, but no hfreshConfig was provided")
 		}
-		index.index = hnsw
-	} else {
-		flat, err := flat.New(flatConfig, uc.FlatUC, store)
-		if err != nil {
-			return nil, err
-		}
-		index.index = flat
+		vi, err = index.newHFresh()
+	default:
+		return nil, errors.Errorf("unknown dynamic index state %d", state)
+	}
+	if err != nil {
+		return nil, err
 	}
+	index.index = vi
+	index.upgraded.Store(state != stateFlat)
+
+	return index, nil
+}
+
+func (dynamic *dynamic) newFlat() (VectorIndex, error) {
+	return flat.New(flat.Config{
+		ID:                dynamic.id,
+		RootPath:          dynamic.rootPath,
+		TargetVector:      dynamic.targetVector,
+		Logger:            dynamic.logger,
+		DistanceProvider:  dynamic.distanceProvider,
+		AllocChecker:      dynamic.AllocChecker,
+		MakeBucketOptions: dynamic.MakeBucketOptions,
+	}, dynamic.uc.FlatUC, dynamic.store)
+}
+
+func (dynamic *dynamic) newHNSW() (VectorIndex, error) {
+	return hnsw.New(
+		hnsw.Config{
+			Logger:                       dynamic.logger,
+			RootPath:                     dynamic.rootPath,
+			ID:                           dynamic.id,
+			ShardName:                    dynamic.shardName,
+			ClassName:                    dynamic.className,
+			PrometheusMetrics:            dynamic.prometheusMetrics,
+			VectorForIDThunk:             dynamic.vectorForIDThunk,
+			GetViewThunk:                 dynamic.getViewThunk,
+			TempVectorForIDWithViewThunk: dynamic.tempVectorForIDWithViewThunk,
+			DistanceProvider:             dynamic.distanceProvider,
+			MakeCommitLoggerThunk:        dynamic.makeCommitLoggerThunk,
+			DisableSnapshots:             dynamic.hnswDisableSnapshots,
+			SnapshotOnStartup:            dynamic.hnswSnapshotOnStartup,
+			WaitForCachePrefill:          dynamic.hnswWaitForCachePrefill,
+			VectorCacheAdmission:         dynamic.hnswVectorCacheAdmission,
+			AllocChecker:                 dynamic.AllocChecker,
+			MakeBucketOptions:            dynamic.MakeBucketOptions,
+			AsyncIndexingEnabled:         dynamic.AsyncIndexingEnabled,
+		},
+		dynamic.uc.HnswUC,
+		dynamic.tombstoneCallbacks,
+		dynamic.store,
+	)
+}
 
+func (dynamic *dynamic) newHFresh() (VectorIndex, error) {
+	// hfresh.New validates and completes the config in place, give it a copy
+	// so every upgrade starts from the config the shard handed us
+	cfg := *dynamic.hfreshConfig
+	index, err := hfresh.New(&cfg, dynamic.uc.HfreshUC, dynamic.store)
+	if err != nil {
+		return nil, err
+	}
+	index.PostStartup(dynamic.ctx)
 	return index, nil
 }
 
+// targetState is the state an upgrade from flat moves to
+func (dynamic *dynamic) targetState() byte {
+	if dynamic.uc.Target == ent.TargetHFresh {
+		return stateHFresh
+	}
+	return stateHNSW
+}
+
 func (dynamic *dynamic) Type() common.IndexType {
 	return common.IndexTypeDynamic
 }
@@ -246,8 +304,8 @@ func (dynamic *dynamic) getBucketName() string {
 	return helpers.VectorsBucketLSM
 }
 
-func (dynamic *dynamic) init(cfg *Config) (bool, error) {
-	upgraded := false
+func (dynamic *dynamic) init(cfg *Config) (byte, error) {
+	state := stateFlat
 
 	hnswDirExists := false
 	_, err := os.Stat(hnswCommitLogDirectory(cfg.RootPath, cfg.ID))
@@ -268,7 +326,7 @@ func (dynamic *dynamic) init(cfg *Config) (bool, error) {
 				return nil
 			}
 
-			upgraded = v[0] != 0
+			state = v[0]
 			return nil
 		}
 
@@ -279,31 +337,34 @@ func (dynamic *dynamic) init(cfg *Config) (bool, error) {
 		// first, check if there's an entry for this specific target vector
 		v := b.Get(dbKey)
 		if v != nil {
-			upgraded = v[0] != 0
+			state = v[0]
 			return nil
 		}
 
 		// if not, let's create one by default
 		// and infer the upgraded state from the existence of the HNSW dir
+		// if the HNSW dir exists, we assume it was upgraded
 		if hnswDirExists {
-			err = b.Put(dbKey, []byte{1})
-		} else {
-			err = b.Put(dbKey, []byte{0})
+			state = stateHNSW
 		}
-		if err != nil {
+		if err := b.Put(dbKey, []byte{state}); err != nil {
 			return errors.Wrap(err, "migrate dynamic state for target vector")
 		}
 
-		// if the HNSW dir exists, we assume it was upgraded
-		upgraded = hnswDirExists
-
 		return nil
 	})
 	if err != nil {
-		return false, errors.Wrap(err, "get dynamic state")
+		return stateFlat, errors.Wrap(err, "get dynamic state")
 	}
 
-	return upgraded, nil
+	return state, nil
+}
+
+func (dynamic *dynamic) storeState(state byte) error {
+	return dynamic.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(dynamicBucket)
+		return b.Put(dynamic.dbKey(), []byte{state})
+	})
 }
 
 func (dynamic *dynamic) getCompressedBucketName() string {
@@ -346,6 +407,12 @@ func (dynamic *dynamic) SearchByVector(ctx context.Context, vector []float32, k
 	return dynamic.index.SearchByVector(ctx, vector, k, allow)
 }
 
+func (dynamic *dynamic) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	return dynamic.index.SearchByVectorBatch(ctx, vectors, k, allow)
+}
+
 func (dynamic *dynamic) SearchByVectorDistance(ctx context.Context, vector []float32, targetDistance float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -358,14 +425,22 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 		callback()
 		return errors.Errorf("config is not UserConfig, but %T", updated)
 	}
-	if dynamic.upgraded.Load() {
-		dynamic.RLock()
-		defer dynamic.RUnlock()
+	dynamic.downgradeThreshold.Store(parsed.DowngradeThreshold)
+
+	// the config is kept in full, as a later upgrade or downgrade creates
+	// the other index from it
+	dynamic.Lock()
+	dynamic.uc = parsed
+	dynamic.Unlock()
+
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	switch dynamic.index.Type() {
+	case common.IndexTypeHNSW:
 		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
-	} else {
-		dynamic.uc = parsed
-		dynamic.RLock()
-		defer dynamic.RUnlock()
+	case common.IndexTypeHFresh:
+		dynamic.index.UpdateUserConfig(parsed.HfreshUC, callback)
+	default:
 		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
 	}
 	return nil
@@ -460,7 +535,10 @@ func (dynamic *dynamic) Preload(id uint64, vector []float32) {
 func (dynamic *dynamic) AlreadyIndexed() uint64 {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return (dynamic.index).(upgradableIndexer).AlreadyIndexed()
+	if u, ok := dynamic.index.(upgradableIndexer); ok {
+		return u.AlreadyIndexed()
+	}
+	return dynamic.countLiveLocked(math.MaxUint64)
 }
 
 func (dynamic *dynamic) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
@@ -475,13 +553,22 @@ func (dynamic *dynamic) ShouldUpgrade() (bool, int) {
 	}
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return (dynamic.index).(upgradableIndexer).ShouldUpgrade()
+	if u, ok := dynamic.index.(upgradableIndexer); ok {
+		return u.ShouldUpgrade()
+	}
+	return false, 0
 }
 
 func (dynamic *dynamic) Upgraded() bool {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.upgraded.Load() && dynamic.index.(upgradableIndexer).Upgraded()
+	if !dynamic.upgraded.Load() {
+		return false
+	}
+	if u, ok := dynamic.index.(upgradableIndexer); ok {
+		return u.Upgraded()
+	}
+	return true
 }
 
 func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
@@ -498,22 +585,40 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 	}
 
 	if dynamic.upgraded.Load() {
-		return dynamic.index.(upgradableIndexer).Upgrade(callback)
+		dynamic.RLock()
+		u, ok := dynamic.index.(upgradableIndexer)
+		dynamic.RUnlock()
+		if !ok {
+			callback()
+			return nil
+		}
+		return u.Upgrade(callback)
 	}
 
-	dynamic.upgradeOnce.Do(func() {
-		enterrors.GoWrapper(func() {
-			defer callback()
-			dynamic.logger.WithField("shard", dynamic.shardName).WithField("class", dynamic.className).Debugf("upgrade to HNSW started")
+	// the queue is paused until the callback runs, so a concurrent call can
+	// only come from outside of it and is dropped
+	if !dynamic.transitioning.CompareAndSwap(false, true) {
+		return nil
+	}
 
-			err := dynamic.doUpgrade()
-			if err != nil {
-				dynamic.logger.WithError(err).Error("failed to upgrade index")
-				return
-			}
-			dynamic.logger.WithField("shard", dynamic.shardName).WithField("class", dynamic.className).Debugf("upgrade to HNSW completed")
-		}, dynamic.logger)
-	})
+	enterrors.GoWrapper(func() {
+		defer callback()
+		defer dynamic.transitioning.Store(false)
+
+		dynamic.RLock()
+		target := dynamic.uc.Target
+		dynamic.RUnlock()
+		logger := dynamic.logger.WithField("shard", dynamic.shardName).
+			WithField("class", dynamic.className).WithField("target", target)
+		logger.Debugf("upgrade started")
+
+		err := dynamic.doUpgrade()
+		if err != nil {
+			logger.WithError(err).Error("failed to upgrade index")
+			return
+		}
+		logger.Debugf("upgrade completed")
+	}, dynamic.logger)
 
 	return nil
 }
@@ -525,30 +630,14 @@ func (dynamic *dynamic) doUpgrade() error {
 	// upgraded.
 	dynamic.RLock()
 
-	index, err := hnsw.New(
-		hnsw.Config{
-			Logger:                       dynamic.logger,
-			RootPath:                     dynamic.rootPath,
-			ID:                           dynamic.id,
-			ShardName:                    dynamic.shardName,
-			ClassName:                    dynamic.className,
-			PrometheusMetrics:            dynamic.prometheusMetrics,
-			VectorForIDThunk:             dynamic.vectorForIDThunk,
-			GetViewThunk:                 dynamic.getViewThunk,
-			TempVectorForIDWithViewThunk: dynamic.tempVectorForIDWithViewThunk,
-			DistanceProvider:             dynamic.distanceProvider,
-			MakeCommitLoggerThunk:        dynamic.makeCommitLoggerThunk,
-			DisableSnapshots:             dynamic.hnswDisableSnapshots,
-			SnapshotOnStartup:            dynamic.hnswSnapshotOnStartup,
-			WaitForCachePrefill:          dynamic.hnswWaitForCachePrefill,
-			AllocChecker:                 dynamic.AllocChecker,
-			MakeBucketOptions:            dynamic.MakeBucketOptions,
-			AsyncIndexingEnabled:         dynamic.AsyncIndexingEnabled,
-		},
-		dynamic.uc.HnswUC,
-		dynamic.tombstoneCallbacks,
-		dynamic.store,
-	)
+	state := dynamic.targetState()
+	var index VectorIndex
+	var err error
+	if state == stateHFresh {
+		index, err = dynamic.newHFresh()
+	} else {
+		index, err = dynamic.newHNSW()
+	}
 	if err != nil {
 		dynamic.RUnlock()
 		return err
@@ -573,11 +662,7 @@ func (dynamic *dynamic) doUpgrade() error {
 		return errors.Wrap(err, "index was closed while upgrading")
 	}
 
-	err = dynamic.db.Update(func(tx *bbolt.Tx) error {
-		b := tx.Bucket(dynamicBucket)
-		return b.Put(dynamic.dbKey(), []byte{1})
-	})
-	if err != nil {
+	if err := dynamic.storeState(state); err != nil {
 		return errors.Wrap(err, "update dynamic")
 	}
 
@@ -599,7 +684,8 @@ func (dynamic *dynamic) doUpgrade() error {
 	// we remove the bucket here if needed
 	removeCompressedBucket := false
 	if dynamic.uc.FlatUC.BQ.Enabled || dynamic.uc.FlatUC.RQ.Enabled {
-		if !dynamic.uc.HnswUC.BQ.Enabled && !dynamic.uc.HnswUC.RQ.Enabled {
+		// hfresh keeps its compressed vectors in its own postings
+		if state == stateHFresh || (!dynamic.uc.HnswUC.BQ.Enabled && !dynamic.uc.HnswUC.RQ.Enabled) {
 			removeCompressedBucket = true
 		}
 	}
@@ -679,6 +765,344 @@ func (dynamic *dynamic) copyToVectorIndex(index VectorIndex) error {
 	return nil
 }
 
+// ShouldDowngrade reports whether an upgraded index has shrunk below the
+// configured downgrade threshold and should be converted back to flat.
+func (dynamic *dynamic) ShouldDowngrade() bool {
+	threshold := dynamic.downgradeThreshold.Load()
+	if threshold == 0 || !dynamic.upgraded.Load() || dynamic.transitioning.Load() {
+		return false
+	}
+
+	now := time.Now().UnixNano()
+	last := dynamic.lastDowngradeCheck.Load()
+	if now-last < int64(downgradeCheckInterval) || !dynamic.lastDowngradeCheck.CompareAndSwap(last, now) {
+		return false
+	}
+
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if dynamic.compressedBucketConflict() {
+		return false
+	}
+	return dynamic.countLiveLocked(threshold) < threshold
+}
+
+// countLiveLocked counts the live vectors of the underlying index, stopping
+// at limit so the cost stays bounded for large indexes. The caller must hold
+// at least a read lock.
+func (dynamic *dynamic) countLiveLocked(limit uint64) uint64 {
+	var count uint64
+	dynamic.index.Iterate(func(uint64) bool {
+		count++
+		return count < limit
+	})
+	return count
+}
+
+// Downgrade converts an upgraded index back to flat in the background and
+// calls callback once done.
+func (dynamic *dynamic) Downgrade(callback func()) error {
+	if dynamic.ctx.Err() != nil {
+		// already closed
+		return dynamic.ctx.Err()
+	}
+
+	if !dynamic.upgraded.Load() || !dynamic.transitioning.CompareAndSwap(false, true) {
+		callback()
+		return nil
+	}
+
+	enterrors.GoWrapper(func() {
+		defer callback()
+		defer dynamic.transitioning.Store(false)
+
+		logger := dynamic.logger.WithField("shard", dynamic.shardName).WithField("class", dynamic.className)
+		logger.Debugf("downgrade to flat started")
+
+		err := dynamic.doDowngrade()
+		if err != nil {
+			logger.WithError(err).Error("failed to downgrade index")
+			return
+		}
+		logger.Debugf("downgrade to flat completed")
+	}, dynamic.logger)
+
+	return nil
+}
+
+// doDowngrade replaces the upgraded index with a flat index holding the same
+// vectors. Like an upgrade, the flat index is built and filled first while
+// the upgraded index keeps serving searches, the new state is persisted next
+// and the upgraded index is dropped last. An interrupted downgrade therefore
+// leaves the upgraded index intact.
+func (dynamic *dynamic) doDowngrade() error {
+	// Start with a read lock to prevent reading from the index
+	// while it's being dropped or closed.
+	dynamic.RLock()
+
+	if dynamic.compressedBucketConflict() {
+		dynamic.RUnlock()
+		return errors.New("flat compression is incompatible with the compressed vectors of the upgraded index")
+	}
+
+	live, vectors, err := dynamic.liveVectors(nil)
+	if err != nil {
+		dynamic.RUnlock()
+		return err
+	}
+
+	// a flat bucket left behind by an interrupted downgrade must not be
+	// loaded, it can hold vectors which have been deleted since
+	if err := dynamic.removeFlatBucket(); err != nil {
+		dynamic.RUnlock()
+		return err
+	}
+	index, err := dynamic.newFlat()
+	if err != nil {
+		dynamic.RUnlock()
+		return errors.Wrap(err, "create flat index")
+	}
+	if err := addInBatches(dynamic.ctx, index, live, vectors); err != nil {
+		dynamic.RUnlock()
+		dynamic.discardFlat(index)
+		return err
+	}
+
+	// end of read-only zone
+	dynamic.RUnlock()
+
+	// Lock the index for writing but check if it was already
+	// closed in the meantime
+	dynamic.Lock()
+	defer dynamic.Unlock()
+
+	if err := dynamic.ctx.Err(); err != nil {
+		// already closed
+		return errors.Wrap(err, "index was closed while downgrading")
+	}
+
+	current, err := dynamic.catchUpFlat(index, live)
+	if err != nil {
+		dynamic.discardFlat(index)
+		return err
+	}
+
+	if err := dynamic.storeState(stateFlat); err != nil {
+		dynamic.discardFlat(index)
+		return errors.Wrap(err, "update dynamic")
+	}
+
+	// a compressed flat index took over the compressed vectors bucket
+	shared := dynamic.flatCompressed()
+	if err := dynamic.dropUpgradedIndex(!shared); err != nil {
+		dynamic.logger.WithError(err).Warn("drop upgraded index after downgrade")
+	}
+	dynamic.index = index
+	dynamic.upgraded.Store(false)
+	if shared {
+		if err := dynamic.removeStaleCompressedVectors(current); err != nil {
+			dynamic.logger.WithError(err).Warn("remove stale compressed vectors after downgrade")
+		}
+	}
+
+	return nil
+}
+
+// liveVectors returns the ids and vectors of the upgraded index, skipping the
+// ids contained in skip. The caller must hold at least a read lock.
+func (dynamic *dynamic) liveVectors(skip map[uint64]struct{}) ([]uint64, [][]float32, error) {
+	var ids []uint64
+	dynamic.index.Iterate(func(id uint64) bool {
+		if _, ok := skip[id]; !ok {
+			ids = append(ids, id)
+		}
+		return dynamic.ctx.Err() == nil
+	})
+
+	vectors := make([][]float32, 0, len(ids))
+	live := ids[:0]
+	for _, id := range ids {
+		if err := dynamic.ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		vec, err := dynamic.vectorForIDThunk(dynamic.ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// deleted in the meantime
+				continue
+			}
+			return nil, nil, errors.Wrapf(err, "get vector for id %d", id)
+		}
+		if len(vec) == 0 {
+			continue
+		}
+		live = append(live, id)
+		vectors = append(vectors, vec)
+	}
+	return live, vectors, nil
+}
+
+// catchUpFlat applies the changes made to the upgraded index while the flat
+// index was filled and returns the ids held by the flat index. The caller
+// must hold the write lock.
+func (dynamic *dynamic) catchUpFlat(index VectorIndex, copied []uint64) (map[uint64]struct{}, error) {
+	current := make(map[uint64]struct{}, len(copied))
+	dynamic.index.Iterate(func(id uint64) bool {
+		current[id] = struct{}{}
+		return true
+	})
+
+	copiedSet := make(map[uint64]struct{}, len(copied))
+	var deleted []uint64
+	for _, id := range copied {
+		copiedSet[id] = struct{}{}
+		if _, ok := current[id]; !ok {
+			deleted = append(deleted, id)
+		}
+	}
+	if len(deleted) > 0 {
+		if err := index.Delete(deleted...); err != nil {
+			return nil, errors.Wrap(err, "delete vectors from flat index")
+		}
+	}
+
+	added, vectors, err := dynamic.liveVectors(copiedSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := addInBatches(dynamic.ctx, index, added, vectors); err != nil {
+		return nil, err
+	}
+
+	live := make(map[uint64]struct{}, len(copied)+len(added))
+	for _, id := range copied {
+		if _, ok := current[id]; ok {
+			live[id] = struct{}{}
+		}
+	}
+	for _, id := range added {
+		live[id] = struct{}{}
+	}
+	return live, nil
+}
+
+// removeStaleCompressedVectors deletes the vectors which the upgraded index
+// left in the shared compressed vectors bucket for deleted ids, so the flat
+// index does not return them.
+func (dynamic *dynamic) removeStaleCompressedVectors(live map[uint64]struct{}) error {
+	bucket := dynamic.store.Bucket(dynamic.getCompressedBucketName())
+	if bucket == nil {
+		return nil
+	}
+
+	var stale [][]byte
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if _, ok := live[binary.BigEndian.Uint64(k)]; !ok {
+			stale = append(stale, append([]byte(nil), k...))
+		}
+	}
+	cursor.Close()
+
+	for _, k := range stale {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addInBatches(ctx context.Context, index VectorIndex, ids []uint64, vectors [][]float32) error {
+	for i := 0; i < len(ids); i += batchSize {
+		end := min(i+batchSize, len(ids))
+		if err := index.AddBatch(ctx, ids[i:end], vectors[i:end]); err != nil {
+			return errors.Wrap(err, "add vectors to flat index")
+		}
+	}
+	return nil
+}
+
+// compressedBucketConflict reports whether the flat index would write to a
+// compressed vectors bucket of the upgraded index which uses a different
+// encoding. As during an upgrade the bucket can only be shared if both
+// indexes use BQ or RQ. The caller must hold at least a read lock.
+func (dynamic *dynamic) compressedBucketConflict() bool {
+	if !dynamic.flatCompressed() {
+		return false
+	}
+	if dynamic.store.Bucket(dynamic.getCompressedBucketName()) == nil {
+		return false
+	}
+	if dynamic.index.Type() != common.IndexTypeHNSW {
+		return true
+	}
+	return !dynamic.uc.HnswUC.BQ.Enabled && !dynamic.uc.HnswUC.RQ.Enabled
+}
+
+func (dynamic *dynamic) flatCompressed() bool {
+	return dynamic.uc.FlatUC.BQ.Enabled || dynamic.uc.FlatUC.RQ.Enabled
+}
+
+// removeFlatBucket removes the files of the flat vectors bucket. It must not
+// be loaded.
+func (dynamic *dynamic) removeFlatBucket() error {
+	name := dynamic.getBucketName()
+	if dynamic.store.Bucket(name) != nil {
+		return errors.Errorf("flat vectors bucket %q is still loaded", name)
+	}
+	return os.RemoveAll(filepath.Join(dynamic.store.GetDir(), name))
+}
+
+// discardFlat removes a flat index which could not be completed. A shared
+// compressed vectors bucket is kept, as it still belongs to the upgraded
+// index.
+func (dynamic *dynamic) discardFlat(index VectorIndex) {
+	if err := index.Drop(dynamic.ctx, false); err != nil {
+		dynamic.logger.WithError(err).Warn("drop incomplete flat index")
+	}
+	name := dynamic.getBucketName()
+	if err := dynamic.store.ShutdownBucket(dynamic.ctx, name); err != nil {
+		dynamic.logger.WithError(err).Warn("shutdown incomplete flat vectors bucket")
+		return
+	}
+	if err := dynamic.removeFlatBucket(); err != nil {
+		dynamic.logger.WithError(err).Warn("remove incomplete flat vectors bucket")
+	}
+}
+
+type destroyer interface {
+	Destroy(ctx context.Context) error
+}
+
+// dropUpgradedIndex drops the current hnsw or hfresh index. If
+// dropCompressed is set, the compressed vectors bucket, which hnsw leaves
+// behind, is removed as well.
+func (dynamic *dynamic) dropUpgradedIndex(dropCompressed bool) error {
+	if d, ok := dynamic.index.(destroyer); ok {
+		if err := d.Destroy(dynamic.ctx); err != nil {
+			return errors.Wrap(err, "destroy index")
+		}
+	} else if err := dynamic.index.Drop(dynamic.ctx, false); err != nil {
+		return errors.Wrap(err, "drop index")
+	}
+	if !dropCompressed {
+		return nil
+	}
+
+	name := dynamic.getCompressedBucketName()
+	b := dynamic.store.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	dir := b.GetDir()
+	if err := dynamic.store.ShutdownBucket(dynamic.ctx, name); err != nil {
+		return errors.Wrap(err, "shutdown compressed bucket")
+	}
+	return os.RemoveAll(dir)
+}
+
 func (dynamic *dynamic) Iterate(fn func(id uint64) bool) {
 	dynamic.index.Iterate(fn)
 }
@@ -698,6 +1122,36 @@ func (dynamic *dynamic) Stats() (*hnsw.HnswStats, error) {
 	return h.Stats()
 }
 
+type hnswSnapshotter interface {
+	CreateSnapshot() (bool, int64, error)
+}
+
+func (dynamic *dynamic) CreateSnapshot() (bool, int64, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+
+	h, ok := dynamic.index.(hnswSnapshotter)
+	if !ok {
+		return false, 0, errors.New("index is not hnsw")
+	}
+	return h.CreateSnapshot()
+}
+
+type hnswCompressionRetrainer interface {
+	RetrainCompression(ctx context.Context) error
+}
+
+func (dynamic *dynamic) RetrainCompression(ctx context.Context) error {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+
+	h, ok := dynamic.index.(hnswCompressionRetrainer)
+	if !ok {
+		return errors.New("index is not hnsw")
+	}
+	return h.RetrainCompression(ctx)
+}
+
 func (dynamic *dynamic) CompressionStats() compressionhelpers.CompressionStats {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -26,8 +26,10 @@ import (
 	"go.etcd.io/bbolt"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hfresh"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
@@ -1021,3 +1023,252 @@ func TestDynamicStoreMigrationBug(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestDynamicDowngrade(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 20
+	vectorsSize := 500
+	k := 10
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "index.db"), 0o666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 1, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	fuc := flatent.NewDefaultUserConfig()
+	hnswuc := hnswent.NewDefaultUserConfig()
+	// the vector of invalidID can not be added to the flat index
+	invalidID := -1
+
+	dynamic, err := New(Config{
+		AllocChecker:          memwatch.NewDummyMonitor(),
+		RootPath:              t.TempDir(),
+		ID:                    "downgrade",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) == invalidID {
+				return vectors[int(id)][:dimensions/2], nil
+			}
+			return vectors[int(id)], nil
+		},
+		GetViewThunk:                 GetViewThunk,
+		TempVectorForIDWithViewThunk: TempVectorForIDWithViewThunk(vectors),
+		TombstoneCallbacks:           cyclemanager.NewCallbackGroupNoop(),
+		SharedDB:                     db,
+		MakeBucketOptions:            lsmkv.MakeNoopBucketOptions,
+		AsyncIndexingEnabled:         true,
+	}, ent.UserConfig{
+		Threshold:          300,
+		DowngradeThreshold: 100,
+		Distance:           distancer.Type(),
+		HnswUC:             hnswuc,
+		FlatUC:             fuc,
+	}, testinghelpers.NewDummyStore(t))
+	require.NoError(t, err)
+
+	upgrade := func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		require.NoError(t, dynamic.Upgrade(wg.Done))
+		wg.Wait()
+	}
+	downgrade := func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		require.NoError(t, dynamic.Downgrade(wg.Done))
+		wg.Wait()
+	}
+	state := func() byte {
+		var v []byte
+		require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+			v = tx.Bucket(dynamicBucket).Get(dynamic.dbKey())
+			return nil
+		}))
+		return v[0]
+	}
+
+	for i := range vectors {
+		require.NoError(t, dynamic.Add(ctx, uint64(i), vectors[i]))
+	}
+	require.False(t, dynamic.ShouldDowngrade(), "flat index can not be downgraded")
+
+	upgrade()
+	require.EqualValues(t, common.IndexTypeHNSW, dynamic.UnderlyingIndex())
+	require.Equal(t, stateHNSW, state())
+	require.False(t, dynamic.ShouldDowngrade(), "index is above the downgrade threshold")
+
+	t.Run("checks are rate limited", func(t *testing.T) {
+		for i := 0; i < 450; i++ {
+			require.NoError(t, dynamic.Delete(uint64(i)))
+		}
+		require.False(t, dynamic.ShouldDowngrade())
+
+		dynamic.lastDowngradeCheck.Store(0)
+		require.True(t, dynamic.ShouldDowngrade())
+	})
+
+	t.Run("failed downgrade keeps the upgraded index", func(t *testing.T) {
+		invalidID = vectorsSize - 1
+		defer func() { invalidID = -1 }()
+
+		downgrade()
+		require.EqualValues(t, common.IndexTypeHNSW, dynamic.UnderlyingIndex())
+		require.True(t, dynamic.IsUpgraded())
+		require.Equal(t, stateHNSW, state())
+		require.Nil(t, dynamic.store.Bucket(dynamic.getBucketName()))
+
+		ids, _, err := dynamic.SearchByVector(ctx, queries[0], k, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, k)
+	})
+
+	t.Run("downgrade keeps the live vectors", func(t *testing.T) {
+		downgrade()
+		require.EqualValues(t, common.IndexTypeFlat, dynamic.UnderlyingIndex())
+		require.False(t, dynamic.IsUpgraded())
+		require.False(t, dynamic.Upgraded())
+		require.Equal(t, stateFlat, state())
+		require.Equal(t, uint64(50), dynamic.AlreadyIndexed())
+
+		ids, _, err := dynamic.SearchByVector(ctx, queries[0], k, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, k)
+		for _, id := range ids {
+			require.GreaterOrEqual(t, id, uint64(450))
+		}
+	})
+
+	t.Run("hysteresis keeps the small index flat", func(t *testing.T) {
+		shouldUpgrade, at := dynamic.ShouldUpgrade()
+		require.True(t, shouldUpgrade)
+		require.Equal(t, 300, at)
+		require.Less(t, dynamic.AlreadyIndexed(), uint64(at))
+		require.False(t, dynamic.ShouldDowngrade())
+	})
+
+	t.Run("index can be upgraded again", func(t *testing.T) {
+		for i := 0; i < 450; i++ {
+			require.NoError(t, dynamic.Add(ctx, uint64(i), vectors[i]))
+		}
+		upgrade()
+		require.EqualValues(t, common.IndexTypeHNSW, dynamic.UnderlyingIndex())
+		require.Equal(t, stateHNSW, state())
+
+		ids, _, err := dynamic.SearchByVector(ctx, queries[0], k, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, k)
+	})
+}
+
+func TestDynamicHFreshTarget(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 32
+	vectorsSize := 300
+	k := 10
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "index.db"), 0o666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 1, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	vectorForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return vectors[int(id)], nil
+	}
+
+	scheduler := queue.NewScheduler(queue.SchedulerOptions{Logger: logger})
+	scheduler.Start()
+	t.Cleanup(func() {
+		scheduler.Close()
+	})
+	hfreshRoot := t.TempDir()
+	hfreshConfig := hfresh.DefaultConfig()
+	hfreshConfig.Logger = logger
+	hfreshConfig.ID = "hfresh-target"
+	hfreshConfig.RootPath = filepath.Join(hfreshRoot, "hfresh-target.hfresh.d")
+	hfreshConfig.Scheduler = scheduler
+	hfreshConfig.DistanceProvider = distancer
+	hfreshConfig.VectorForIDThunk = vectorForID
+	hfreshConfig.TombstoneCallbacks = cyclemanager.NewCallbackGroupNoop()
+	hfreshConfig.Centroids.HNSWConfig = &hnsw.Config{
+		RootPath:              hfreshConfig.RootPath,
+		ID:                    "hfresh-target_centroids",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		MakeBucketOptions:     lsmkv.MakeNoopBucketOptions,
+		AllocChecker:          memwatch.NewDummyMonitor(),
+		GetViewThunk:          GetViewThunk,
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Threshold = 200
+	uc.DowngradeThreshold = 50
+	uc.Target = ent.TargetHFresh
+	uc.Distance = distancer.Type()
+
+	store := testinghelpers.NewDummyStore(t)
+	dynamic, err := New(Config{
+		AllocChecker:                 memwatch.NewDummyMonitor(),
+		RootPath:                     hfreshRoot,
+		ID:                           "hfresh-target",
+		MakeCommitLoggerThunk:        hnsw.MakeNoopCommitLogger,
+		DistanceProvider:             distancer,
+		VectorForIDThunk:             vectorForID,
+		GetViewThunk:                 GetViewThunk,
+		TempVectorForIDWithViewThunk: TempVectorForIDWithViewThunk(vectors),
+		TombstoneCallbacks:           cyclemanager.NewCallbackGroupNoop(),
+		SharedDB:                     db,
+		MakeBucketOptions:            lsmkv.MakeNoopBucketOptions,
+		AsyncIndexingEnabled:         true,
+		HFreshConfig:                 hfreshConfig,
+	}, uc, store)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		dynamic.Shutdown(context.Background())
+	})
+
+	for i := range vectors {
+		require.NoError(t, dynamic.Add(ctx, uint64(i), vectors[i]))
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	require.NoError(t, dynamic.Upgrade(wg.Done))
+	wg.Wait()
+
+	require.EqualValues(t, common.IndexTypeHFresh, dynamic.UnderlyingIndex())
+	require.True(t, dynamic.Upgraded())
+	shouldUpgrade, _ := dynamic.ShouldUpgrade()
+	require.False(t, shouldUpgrade)
+	require.Equal(t, uint64(vectorsSize), dynamic.AlreadyIndexed())
+
+	ids, _, err := dynamic.SearchByVector(ctx, queries[0], k, nil)
+	require.NoError(t, err)
+	require.Len(t, ids, k)
+
+	for i := 0; i < 280; i++ {
+		require.NoError(t, dynamic.Delete(uint64(i)))
+	}
+	require.True(t, dynamic.ShouldDowngrade())
+
+	wg.Add(1)
+	require.NoError(t, dynamic.Downgrade(wg.Done))
+	wg.Wait()
+
+	require.EqualValues(t, common.IndexTypeFlat, dynamic.UnderlyingIndex())
+	require.Equal(t, uint64(20), dynamic.AlreadyIndexed())
+	require.NoDirExists(t, hfreshConfig.RootPath)
+
+	ids, _, err = dynamic.SearchByVector(ctx, queries[0], k, nil)
+	require.NoError(t, err)
+	require.Len(t, ids, k)
+	for _, id := range ids {
+		require.GreaterOrEqual(t, id, uint64(280))
+	}
+}
@@ -423,7 +423,22 @@ func (index *flat) searchTimeRescore(k int) int {
 func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	switch index.compressionType {
 	case CompressionBQ, CompressionRQ1, CompressionRQ8:
-		return index.searchByVectorQuantized(ctx, vector, k, allow)
+		return index.searchByVectorQuantized(ctx, vector, k, index.searchTimeRescore(k), allow)
+	default:
+		return index.searchByVector(ctx, vector, k, allow)
+	}
+}
+
+// SearchByVectorWithRescore behaves like SearchByVector, but uses the given
+// rescore limit instead of the configured one. This allows evaluating other
+// settings without changing the configuration of a live index. The limit is
+// ignored for uncompressed indexes.
+func (index *flat) SearchByVectorWithRescore(ctx context.Context, vector []float32, k int,
+	rescoreLimit int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	switch index.compressionType {
+	case CompressionBQ, CompressionRQ1, CompressionRQ8:
+		return index.searchByVectorQuantized(ctx, vector, k, max(rescoreLimit, k), allow)
 	default:
 		return index.searchByVector(ctx, vector, k, allow)
 	}
@@ -447,6 +462,272 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 	return ids, dists, nil
 }
 
+// SearchByVectorBatch runs one search per query with a shared allow list.
+// The stored vectors are scanned once and every candidate is scored against
+// all queries. Quantized indexes then rescore the candidates of all queries,
+// reading each uncompressed vector once.
+func (index *flat) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	switch index.compressionType {
+	case CompressionBQ, CompressionRQ1, CompressionRQ8:
+		return index.searchByVectorQuantizedBatch(ctx, vectors, k, index.searchTimeRescore(k), allow)
+	default:
+		return index.searchByVectorBatch(ctx, vectors, k, allow)
+	}
+}
+
+func (index *flat) searchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	heaps := make([]*priorityqueue.Queue[any], len(vectors))
+	queries := make([][]float32, len(vectors))
+	for i := range vectors {
+		heaps[i] = index.pqResults.GetMax(k)
+		queries[i] = index.normalized(vectors[i])
+	}
+	defer func() {
+		for _, heap := range heaps {
+			index.pqResults.Put(heap)
+		}
+	}()
+
+	dists := make([]float32, len(queries))
+	if err := index.iterateAllowed(allow, index.store.Bucket(index.getBucketName()).Cursor,
+		func(id uint64, vecAsBytes []byte) error {
+			vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
+			defer index.pool.float32SlicePool.Put(vecSlice)
+
+			candidate := float32SliceFromByteSlice(vecAsBytes, vecSlice.slice)
+			if err := distancer.MultiQueryDist(index.distancerProvider, queries, candidate, dists); err != nil {
+				return err
+			}
+			for i, dist := range dists {
+				index.insertToHeap(heaps[i], k, id, dist)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([][]uint64, len(vectors))
+	outDists := make([][]float32, len(vectors))
+	for i, heap := range heaps {
+		ids[i], outDists[i] = index.extractHeap(heap)
+	}
+	return ids, outDists, nil
+}
+
+func (index *flat) searchByVectorQuantizedBatch(ctx context.Context, vectors [][]float32, k int,
+	rescore int, allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	// Ensure quantizer is initialized
+	if index.quantizer == nil {
+		return nil, nil, fmt.Errorf("quantizer not initialized")
+	}
+
+	heaps := make([]*priorityqueue.Queue[any], len(vectors))
+	queries := make([][]float32, len(vectors))
+	for i := range vectors {
+		heaps[i] = index.pqResults.GetMax(rescore)
+		queries[i] = index.normalized(vectors[i])
+	}
+	defer func() {
+		for _, heap := range heaps {
+			index.pqResults.Put(heap)
+		}
+	}()
+
+	scorer, err := index.newQuantizedBatchScorer(queries)
+	if err != nil {
+		return nil, nil, err
+	}
+	dists := make([]float32, len(queries))
+	insert := func(id uint64) {
+		for i, dist := range dists {
+			index.insertToHeap(heaps[i], rescore, id, dist)
+		}
+	}
+
+	if index.Compressed() && index.Cached() {
+		if scorer.uint64s != nil {
+			err = index.cache.IterateUint64WithAllowlist(allow, func(id uint64, vec []uint64) error {
+				if err := scorer.uint64s(vec, dists); err != nil {
+					return err
+				}
+				insert(id)
+				return nil
+			})
+		} else {
+			err = index.cache.IterateBytesWithAllowlist(allow, func(id uint64, vec []byte) error {
+				if err := scorer.bytes(vec, dists); err != nil {
+					return err
+				}
+				insert(id)
+				return nil
+			})
+		}
+	} else {
+		err = index.iterateAllowed(allow, index.store.Bucket(index.getCompressedBucketName()).Cursor,
+			func(id uint64, vecAsBytes []byte) error {
+				if err := scorer.score(index, vecAsBytes, dists); err != nil {
+					return err
+				}
+				insert(id)
+				return nil
+			})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	// Queries of a batch often share candidates, so each uncompressed vector
+	// is read once and scored against every query that selected it.
+	var candidates []uint64
+	selectedBy := map[uint64][]int{}
+	for q, heap := range heaps {
+		for heap.Len() > 0 {
+			id := heap.Pop().ID
+			if _, ok := selectedBy[id]; !ok {
+				candidates = append(candidates, id)
+			}
+			selectedBy[id] = append(selectedBy[id], q)
+		}
+	}
+
+	// we expect to be mostly IO-bound, so more goroutines than CPUs is fine
+	distancesUncompressedVectors := make([][]float32, len(candidates))
+
+	eg := enterrors.NewErrorGroupWrapper(index.logger)
+	for workerID := 0; workerID < index.concurrentCacheReads; workerID++ {
+		workerID := workerID
+		eg.Go(func() error {
+			for idPos := workerID; idPos < len(candidates); idPos += index.concurrentCacheReads {
+				id := candidates[idPos]
+				candidateAsBytes, err := index.vectorById(id)
+				if err != nil {
+					return err
+				}
+				if len(candidateAsBytes) == 0 {
+					continue
+				}
+
+				vecSlice := index.pool.float32SlicePool.Get(len(candidateAsBytes) / 4)
+				candidate := float32SliceFromByteSlice(candidateAsBytes, vecSlice.slice)
+				distances := make([]float32, len(selectedBy[id]))
+				for i, q := range selectedBy[id] {
+					if distances[i], err = index.distancerProvider.SingleDist(queries[q], candidate); err != nil {
+						break
+					}
+				}
+				index.pool.float32SlicePool.Put(vecSlice)
+				if err != nil {
+					return err
+				}
+
+				distancesUncompressedVectors[idPos] = distances
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	for idPos, id := range candidates {
+		for i, q := range selectedBy[id] {
+			if distances := distancesUncompressedVectors[idPos]; distances != nil {
+				index.insertToHeap(heaps[q], k, id, distances[i])
+			}
+		}
+	}
+
+	ids := make([][]uint64, len(vectors))
+	outDists := make([][]float32, len(vectors))
+	for i, heap := range heaps {
+		ids[i], outDists[i] = index.extractHeap(heap)
+	}
+	return ids, outDists, nil
+}
+
+// quantizedBatchScorer scores a quantized vector against all queries of a
+// batch. Exactly one of uint64s and bytes is set, depending on how the
+// compression type stores its vectors.
+type quantizedBatchScorer struct {
+	uint64s func(vec []uint64, out []float32) error
+	bytes   func(vec []byte, out []float32) error
+}
+
+func (index *flat) newQuantizedBatchScorer(queries [][]float32) (quantizedBatchScorer, error) {
+	switch index.compressionType {
+	case CompressionRQ1:
+		// For RQ-1 bit, use NewDistancer to get 5-bit query quantization for better accuracy
+		wrapper := index.quantizer.(*BinaryRotationalQuantizerWrapper)
+		distancers := make([]*compressionhelpers.BinaryRQDistancer, len(queries))
+		for i, query := range queries {
+			distancers[i] = wrapper.NewDistancer(query)
+		}
+		return quantizedBatchScorer{uint64s: func(vec []uint64, out []float32) error {
+			for i, distancer := range distancers {
+				var err error
+				if out[i], err = distancer.Distance(vec); err != nil {
+					return err
+				}
+			}
+			return nil
+		}}, nil
+	case CompressionBQ:
+		queriesQuantized := make([][]uint64, len(queries))
+		for i, query := range queries {
+			queriesQuantized[i] = index.quantizer.EncodeUint64(query)
+		}
+		return quantizedBatchScorer{uint64s: func(vec []uint64, out []float32) error {
+			for i, queryQuantized := range queriesQuantized {
+				var err error
+				if out[i], err = index.quantizer.DistanceBetweenUint64Vectors(vec, queryQuantized); err != nil {
+					return err
+				}
+			}
+			return nil
+		}}, nil
+	case CompressionRQ8:
+		queriesQuantized := make([][]byte, len(queries))
+		for i, query := range queries {
+			queriesQuantized[i] = index.quantizer.EncodeBytes(query)
+		}
+		return quantizedBatchScorer{bytes: func(vec []byte, out []float32) error {
+			for i, queryQuantized := range queriesQuantized {
+				var err error
+				if out[i], err = index.quantizer.DistanceBetweenByteVectors(vec, queryQuantized); err != nil {
+					return err
+				}
+			}
+			return nil
+		}}, nil
+	default:
+		return quantizedBatchScorer{}, fmt.Errorf("unsupported quantizer data type: %v", index.compressionType)
+	}
+}
+
+// score scores a quantized vector as stored in the compressed bucket.
+func (s quantizedBatchScorer) score(index *flat, vecAsBytes []byte, out []float32) error {
+	if s.bytes != nil {
+		// For byte quantizer, use the bytes directly without conversion
+		return s.bytes(vecAsBytes, out)
+	}
+
+	vecSliceQuantized := index.pool.uint64SlicePool.Get(len(vecAsBytes) / 8)
+	defer index.pool.uint64SlicePool.Put(vecSliceQuantized)
+
+	return s.uint64s(uint64SliceFromByteSlice(vecAsBytes, vecSliceQuantized.slice), out)
+}
+
 func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
 	return func(vecAsBytes []byte) (float32, error) {
 		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
@@ -457,14 +738,13 @@ func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
 	}
 }
 
-func (index *flat) searchByVectorQuantized(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+func (index *flat) searchByVectorQuantized(ctx context.Context, vector []float32, k int, rescore int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	// Ensure quantizer is initialized
 	if index.quantizer == nil {
 		return nil, nil, fmt.Errorf("quantizer not initialized")
 	}
 
 	// TODO: pass context into inner methods, so it can be checked more granuarly
-	rescore := index.searchTimeRescore(k)
 	heap := index.pqResults.GetMax(rescore)
 	defer index.pqResults.Put(heap)
 
@@ -578,6 +858,21 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.iterateAllowed(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// iterateAllowed calls fn for every stored vector whose id is in allow, or for
+// all vectors if allow is nil
+func (index *flat) iterateAllowed(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, fn func(id uint64, v []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -608,11 +903,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := fn(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
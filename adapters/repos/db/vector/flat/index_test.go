@@ -1238,6 +1238,78 @@ func Test_NoRace_QuantizedSearchFunctionality(t *testing.T) {
 	}
 }
 
+func Test_NoRace_QuantizedSearchCustomDistances(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 64
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(200, 10, dimensions)
+	fingerprints := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		fingerprints[i] = make([]float32, dimensions)
+		for j := range vec {
+			if vec[j] > 0 {
+				fingerprints[i][j] = 1
+			}
+		}
+	}
+	weights := make([]float32, dimensions)
+	for i := range weights {
+		weights[i] = float32(i%4) / 2
+	}
+
+	providers := map[string]struct {
+		provider distancer.Provider
+		vectors  [][]float32
+	}{
+		"jaccard":      {distancer.NewJaccardProvider(), fingerprints},
+		"weighted-dot": {distancer.NewWeightedDotProductProvider(weights), vectors},
+	}
+	configs := map[string]flatent.UserConfig{
+		"rq1": {RQ: flatent.RQUserConfig{Enabled: true, RescoreLimit: 20, Bits: 1}},
+		"rq8": {RQ: flatent.RQUserConfig{Enabled: true, RescoreLimit: 20, Bits: 8}},
+	}
+
+	for name, p := range providers {
+		for compression, uc := range configs {
+			t.Run(name+" "+compression, func(t *testing.T) {
+				store, dirName := createTestStore(t)
+				index, err := New(Config{
+					ID:                "test-" + name + "-" + compression,
+					RootPath:          dirName,
+					DistanceProvider:  p.provider,
+					MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+				}, uc, store)
+				require.Nil(t, err)
+				defer index.Shutdown(context.Background())
+
+				for i, vec := range p.vectors {
+					require.Nil(t, index.Add(ctx, uint64(i), vec))
+				}
+
+				for i, query := range queries[:5] {
+					if name == "jaccard" {
+						// fingerprint queries, the stored one has to be found
+						query = p.vectors[i*37]
+					}
+					results, distances, err := index.SearchByVector(ctx, query, 3, nil)
+					require.Nil(t, err)
+					require.Len(t, results, 3)
+					if name == "jaccard" {
+						assert.Equal(t, uint64(i*37), results[0])
+					}
+
+					// candidates are selected with a proxy distance, but the
+					// returned distances must be the exact ones
+					for pos, id := range results {
+						exact, err := p.provider.SingleDist(query, p.vectors[id])
+						require.Nil(t, err)
+						assert.InDelta(t, exact, distances[pos], 1e-4)
+					}
+				}
+			})
+		}
+	}
+}
+
 func Test_NoRace_EdgeCases(t *testing.T) {
 	ctx := context.Background()
 	distancer := distancer.NewCosineDistanceProvider()
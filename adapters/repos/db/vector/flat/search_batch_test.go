@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatSearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	index := createTestIndex(t)
+	vectors := createTestVectors(200)
+	for i, vec := range vectors {
+		require.NoError(t, index.Add(ctx, uint64(i), vec))
+	}
+	queries := createTestVectors(5)
+
+	t.Run("matches single-query results", func(t *testing.T) {
+		ids, dists, err := index.SearchByVectorBatch(ctx, queries, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, len(queries))
+		require.Len(t, dists, len(queries))
+
+		for i, query := range queries {
+			expectedIDs, expectedDists, err := index.SearchByVector(ctx, query, 10, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expectedIDs, ids[i])
+			assert.InDeltaSlice(t, expectedDists, dists[i], 1e-5)
+		}
+	})
+
+	t.Run("respects allow list", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < 200; i += 3 {
+			allow.Insert(i)
+		}
+
+		ids, _, err := index.SearchByVectorBatch(ctx, queries, 10, allow)
+		require.NoError(t, err)
+
+		for i, query := range queries {
+			expectedIDs, _, err := index.SearchByVector(ctx, query, 10, allow)
+			require.NoError(t, err)
+			assert.Equal(t, expectedIDs, ids[i])
+			for _, id := range ids[i] {
+				assert.True(t, allow.Contains(id))
+			}
+		}
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		ids, dists, err := index.SearchByVectorBatch(ctx, nil, 10, nil)
+		require.NoError(t, err)
+		assert.Len(t, ids, 0)
+		assert.Len(t, dists, 0)
+	})
+}
+
+func TestFlatSearchByVectorBatchQuantized(t *testing.T) {
+	ctx := context.Background()
+	vectors := createTestVectors(200)
+	queries := createTestVectors(6)
+
+	configs := map[string]flatent.UserConfig{
+		"bq":  {BQ: flatent.CompressionUserConfig{Enabled: true, RescoreLimit: 20}},
+		"rq1": {RQ: flatent.RQUserConfig{Enabled: true, RescoreLimit: 20, Bits: 1}},
+		"rq8": {RQ: flatent.RQUserConfig{Enabled: true, RescoreLimit: 20, Bits: 8}},
+	}
+	for name, config := range configs {
+		for _, cache := range []bool{false, true} {
+			config.BQ.Cache = cache
+			config.RQ.Cache = cache
+			t.Run(fmt.Sprintf("%s cache=%v", name, cache), func(t *testing.T) {
+				dirName := t.TempDir()
+				logger, _ := test.NewNullLogger()
+				store, err := lsmkv.New(dirName, dirName, logger, nil, nil,
+					cyclemanager.NewCallbackGroupNoop(),
+					cyclemanager.NewCallbackGroupNoop(),
+					cyclemanager.NewCallbackGroupNoop())
+				require.NoError(t, err)
+				defer store.Shutdown(context.Background())
+
+				index, err := New(Config{
+					ID:                "test-batch",
+					RootPath:          dirName,
+					DistanceProvider:  distancer.NewCosineDistanceProvider(),
+					MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+				}, config, store)
+				require.NoError(t, err)
+				defer index.Shutdown(context.Background())
+
+				for i, vec := range vectors {
+					require.NoError(t, index.Add(ctx, uint64(i), vec))
+				}
+
+				allow := helpers.NewAllowList()
+				for i := uint64(0); i < 200; i += 2 {
+					allow.Insert(i)
+				}
+
+				for _, allow := range []helpers.AllowList{nil, allow} {
+					ids, dists, err := index.SearchByVectorBatch(ctx, queries, 10, allow)
+					require.NoError(t, err)
+					require.Len(t, ids, len(queries))
+
+					for i, query := range queries {
+						expectedIDs, expectedDists, err := index.SearchByVector(ctx, query, 10, allow)
+						require.NoError(t, err)
+						assert.Equal(t, expectedIDs, ids[i])
+						assert.InDeltaSlice(t, expectedDists, dists[i], 1e-5)
+					}
+				}
+			})
+		}
+	}
+}
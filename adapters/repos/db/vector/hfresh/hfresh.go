@@ -14,6 +14,7 @@ package hfresh
 import (
 	"context"
 	stderrors "errors"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -356,8 +357,80 @@ func (h *HFresh) ContainsDoc(id uint64) bool {
 	return !v.Deleted() && v.Version() > 0
 }
 
+// Iterate calls fn once for every live vector in the index. Vectors are
+// replicated across postings and may linger in postings with a stale version
+// until they are garbage collected, so entries are checked against the
+// version map and deduplicated.
 func (h *HFresh) Iterate(fn func(id uint64) bool) {
-	h.logger.Warn("Iterate is not implemented for HFresh index")
+	visited := h.visitedPool.Borrow()
+	defer h.visitedPool.Return(visited)
+
+	var ids []uint64
+	var versions []VectorVersion
+	for _, m := range h.PostingMap.Iter() {
+		if h.ctx.Err() != nil {
+			return
+		}
+
+		ids, versions = ids[:0], versions[:0]
+		m.RLock()
+		for id, version := range m.Iter() {
+			ids = append(ids, id)
+			versions = append(versions, version)
+		}
+		m.RUnlock()
+
+		for i, id := range ids {
+			if visited.Visited(id) {
+				continue
+			}
+
+			current, err := h.VersionMap.Get(h.ctx, id)
+			if err != nil {
+				h.logger.WithField("vectorID", id).WithError(err).
+					Debug("vector version get failed, skipping")
+				continue
+			}
+			if current.Deleted() || current.Version() > versions[i].Version() {
+				continue
+			}
+
+			visited.Visit(id)
+			if !fn(id) {
+				return
+			}
+		}
+	}
+}
+
+// Destroy shuts the index down and removes its buckets and files. Drop
+// leaves the buckets to the shard, which is not enough when the index is
+// replaced at runtime, e.g. by a dynamic index downgrading to flat.
+func (h *HFresh) Destroy(ctx context.Context) error {
+	var errs []error
+	if err := h.Shutdown(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		errs = append(errs, err)
+	}
+
+	for _, name := range []string{postingsBucketName(h.id), sharedBucketName(h.id)} {
+		b := h.store.Bucket(name)
+		if b == nil {
+			continue
+		}
+		dir := b.GetDir()
+		if err := h.store.ShutdownBucket(ctx, name); err != nil {
+			errs = append(errs, err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := os.RemoveAll(h.rootPath); err != nil {
+		errs = append(errs, err)
+	}
+
+	return stderrors.Join(errs...)
 }
 
 func (h *HFresh) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
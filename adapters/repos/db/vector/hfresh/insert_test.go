@@ -353,3 +353,53 @@ func TestDelete(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestIterate(t *testing.T) {
+	tf := createHFreshIndex(t)
+	defer tf.Index.Shutdown(t.Context())
+
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 32)
+	for i := range vectors {
+		err := tf.Index.Add(t.Context(), uint64(i), vectors[i])
+		require.NoError(t, err)
+	}
+	require.NoError(t, tf.Index.Delete(3, 7, 150))
+
+	t.Run("yields every live vector once", func(t *testing.T) {
+		seen := make(map[uint64]int)
+		tf.Index.Iterate(func(id uint64) bool {
+			seen[id]++
+			return true
+		})
+
+		require.Len(t, seen, len(vectors)-3)
+		for id, n := range seen {
+			require.Equal(t, 1, n, "vector %d was yielded %d times", id, n)
+			require.True(t, tf.Index.ContainsDoc(id))
+		}
+	})
+
+	t.Run("stops when the callback returns false", func(t *testing.T) {
+		var count int
+		tf.Index.Iterate(func(id uint64) bool {
+			count++
+			return count < 10
+		})
+		require.Equal(t, 10, count)
+	})
+}
+
+func TestDestroy(t *testing.T) {
+	tf := createHFreshIndex(t)
+
+	vectors, _ := testinghelpers.RandomVecs(10, 0, 32)
+	for i := range vectors {
+		err := tf.Index.Add(t.Context(), uint64(i), vectors[i])
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tf.Index.Destroy(t.Context()))
+	require.Nil(t, tf.Index.store.Bucket(postingsBucketName(tf.Index.id)))
+	require.Nil(t, tf.Index.store.Bucket(sharedBucketName(tf.Index.id)))
+	require.NoDirExists(t, tf.Index.rootPath)
+}
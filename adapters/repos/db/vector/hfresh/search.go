@@ -14,11 +14,13 @@ package hfresh
 import (
 	"context"
 	"iter"
+	"runtime"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -28,6 +30,35 @@ const (
 	flatSearchCutoff      = 5_000
 )
 
+// SearchByVectorBatch runs the queries concurrently. Every query probes its
+// own centroids and postings, so only the allow list is shared.
+func (h *HFresh) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allowList helpers.AllowList) ([][]uint64, [][]float32, error) {
+	ids := make([][]uint64, len(vectors))
+	dists := make([][]float32, len(vectors))
+
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range vectors {
+		i := i
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var err error
+			ids[i], dists[i], err = h.SearchByVector(ctx, vectors[i], k, allowList)
+			if err != nil {
+				return errors.Wrapf(err, "query %d", i)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	return ids, dists, nil
+}
+
 func (h *HFresh) SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	if allowList != nil && allowList.Len() < flatSearchCutoff {
 		return h.flatSearch(ctx, vector, k, allowList)
@@ -31,6 +31,19 @@ func (h *hnsw) PrepareForBackup(ctx context.Context) error {
 	return nil
 }
 
+// CreateSnapshot switches the commitlog and writes a snapshot containing the
+// full graph state up to this point, including compression codebooks. This
+// keeps the startup time low, e.g. when the node is about to be restarted for
+// maintenance. It returns whether a snapshot was created and the timestamp of
+// the contained state.
+func (h *hnsw) CreateSnapshot() (bool, int64, error) {
+	if err := h.commitLog.PrepareForBackup(true); err != nil {
+		return false, 0, fmt.Errorf("switch commitlogs: %w", err)
+	}
+
+	return h.commitLog.CreateSnapshot()
+}
+
 func (h *hnsw) ResumeAfterBackup(ctx context.Context) error {
 	// nothing to do, as we always write to new files and never modify existing ones, so backup files are always consistent and up-to-date
 	return nil
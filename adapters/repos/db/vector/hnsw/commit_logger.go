@@ -73,6 +73,7 @@ type hnswCommitLogger struct {
 	snapshotLastCheckedAt time.Time
 	// size of each snapshot block. non-configurable except for testing
 	snapshotBlockSize int64
+	snapshotMetrics   *snapshotMetrics
 	// partitions mark commitlogs (left ones) that should not be combined with
 	// logs on the right side (newer ones).
 	// example: given logs 0001.condensed, 0002.condensed, 0003.condensed and 0004.condensed
@@ -101,6 +102,7 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		snapshotMinDeltaCommitlogsNumber:         1,
 		snapshotMinDeltaCommitlogsSizePercentage: 0,
 		snapshotBlockSize:                        blockSize,
+		snapshotMetrics:                          newSnapshotMetrics(nil, "", "", ""),
 		fs:                                       common.NewOSFS(),
 	}
 
@@ -728,7 +730,12 @@ func (l *hnswCommitLogger) combineLogs() (bool, error) {
 
 // TODO al:snapshot handle should abort
 func (l *hnswCommitLogger) createSnapshot(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
-	if l.snapshotDisabled || l.snapshotCreateInterval <= 0 {
+	if l.snapshotDisabled {
+		return false, nil
+	}
+	l.snapshotMetrics.updateAge()
+
+	if l.snapshotCreateInterval <= 0 {
 		return false, nil
 	}
 
@@ -741,7 +748,7 @@ func (l *hnswCommitLogger) createSnapshot(shouldAbort cyclemanager.ShouldAbortCa
 		return false, nil
 	}
 
-	created, _, err := l.CreateSnapshot()
+	created, _, err := l.createSnapshotWithTrigger(snapshotTriggerPeriodic)
 	return created, err
 }
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 type CommitlogOption func(l *hnswCommitLogger) error
@@ -76,6 +77,13 @@ func WithSnapshotMinDeltaCommitlogsSizePercentage(percentage int) CommitlogOptio
 	}
 }
 
+func WithSnapshotMetrics(prom *monitoring.PrometheusMetrics, className, shardName, targetVector string) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.snapshotMetrics = newSnapshotMetrics(prom, className, shardName, targetVector)
+		return nil
+	}
+}
+
 func WithFS(fs common.FS) CommitlogOption {
 	return func(l *hnswCommitLogger) error {
 		l.fs = fs
@@ -112,13 +112,20 @@ func (l *hnswCommitLogger) LoadSnapshot() (state *DeserializationResult, created
 // or from the entire commit log if there is no previous snapshot.
 // The snapshot state contains all but last commitlog (may still be in use and mutable).
 func (l *hnswCommitLogger) CreateSnapshot() (created bool, createdAt int64, err error) {
+	if l.snapshotDisabled {
+		return false, 0, errors.New("snapshots are disabled")
+	}
+	return l.createSnapshotWithTrigger(snapshotTriggerManual)
+}
+
+func (l *hnswCommitLogger) createSnapshotWithTrigger(trigger string) (created bool, createdAt int64, err error) {
 	l.snapshotLock.Lock()
 	defer l.snapshotLock.Unlock()
 
-	logger, onFinish := l.setupSnapshotLogger(logrus.Fields{"method": "create_snapshot"})
+	logger, onFinish := l.setupSnapshotLogger(logrus.Fields{"method": "create_snapshot", "trigger": trigger})
 	defer func() { onFinish(err) }()
 
-	state, createdAt, err := l.createAndOptionallyLoadSnapshot(logger, false)
+	state, createdAt, err := l.createAndOptionallyLoadSnapshot(logger, false, trigger)
 	return state != nil, createdAt, err
 }
 
@@ -132,7 +139,7 @@ func (l *hnswCommitLogger) CreateAndLoadSnapshot() (state *DeserializationResult
 	logger, onFinish := l.setupSnapshotLogger(logrus.Fields{"method": "create_and_load_snapshot"})
 	defer func() { onFinish(err) }()
 
-	return l.createAndOptionallyLoadSnapshot(logger, true)
+	return l.createAndOptionallyLoadSnapshot(logger, true, snapshotTriggerStartup)
 }
 
 func (l *hnswCommitLogger) setupSnapshotLogger(fields logrus.Fields) (logger logrus.FieldLogger, onFinish func(err error)) {
@@ -151,6 +158,7 @@ func (l *hnswCommitLogger) setupSnapshotLogger(fields logrus.Fields) (logger log
 }
 
 func (l *hnswCommitLogger) createAndOptionallyLoadSnapshot(logger logrus.FieldLogger, load bool,
+	trigger string,
 ) (*DeserializationResult, int64, error) {
 	lastSnapshotPath, lastCreatedAt, err := l.getLastSnapshot()
 	if err != nil {
@@ -161,6 +169,7 @@ func (l *hnswCommitLogger) createAndOptionallyLoadSnapshot(logger logrus.FieldLo
 	if path != "" {
 		l.snapshotLastCreatedAt = time.Now()
 		l.snapshotPartitions = []string{snapshotName(path)}
+		l.snapshotMetrics.snapshotCreated(trigger, createdAt)
 	}
 	return state, createdAt, err
 }
@@ -299,6 +308,7 @@ func (l *hnswCommitLogger) initSnapshotData() error {
 		}
 
 		l.snapshotLogger = snapshotLogger
+		l.snapshotMetrics.setLastCreated(createdAt)
 		if l.snapshotCreateInterval > 0 {
 			l.snapshotLastCreatedAt = time.Unix(createdAt, 0)
 			l.snapshotLastCheckedAt = time.Now()
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	snapshotTriggerStartup  = "startup"
+	snapshotTriggerPeriodic = "periodic"
+	snapshotTriggerManual   = "manual"
+)
+
+// snapshotMetrics tracks the age of the last snapshot of a commit logger.
+// The age refers to the commit log state contained in the snapshot, which is
+// what determines how many commit logs need to be replayed on startup.
+type snapshotMetrics struct {
+	enabled     bool
+	lastCreated prometheus.Gauge
+	age         prometheus.Gauge
+	created     *prometheus.CounterVec
+
+	// unix timestamp of the last snapshot, 0 if none exists
+	lastCreatedAt atomic.Int64
+}
+
+func newSnapshotMetrics(prom *monitoring.PrometheusMetrics,
+	className, shardName, targetVector string,
+) *snapshotMetrics {
+	if prom == nil {
+		return &snapshotMetrics{enabled: false}
+	}
+
+	if prom.Group {
+		className = "n/a"
+		shardName = "n/a"
+	}
+
+	labels := prometheus.Labels{
+		"class_name":    className,
+		"shard_name":    shardName,
+		"target_vector": targetVector,
+	}
+
+	return &snapshotMetrics{
+		enabled:     true,
+		lastCreated: prom.VectorIndexSnapshotLastCreated.With(labels),
+		age:         prom.VectorIndexSnapshotAge.With(labels),
+		created:     prom.VectorIndexSnapshotsCreated.MustCurryWith(labels),
+	}
+}
+
+func (m *snapshotMetrics) setLastCreated(createdAt int64) {
+	m.lastCreatedAt.Store(createdAt)
+	if !m.enabled {
+		return
+	}
+
+	m.lastCreated.Set(float64(createdAt))
+	m.updateAge()
+}
+
+func (m *snapshotMetrics) updateAge() {
+	if !m.enabled {
+		return
+	}
+
+	createdAt := m.lastCreatedAt.Load()
+	if createdAt == 0 {
+		m.age.Set(-1)
+		return
+	}
+	m.age.Set(time.Since(time.Unix(createdAt, 0)).Seconds())
+}
+
+func (m *snapshotMetrics) snapshotCreated(trigger string, createdAt int64) {
+	m.setLastCreated(createdAt)
+	if !m.enabled {
+		return
+	}
+
+	m.created.With(prometheus.Labels{"trigger": trigger}).Inc()
+}
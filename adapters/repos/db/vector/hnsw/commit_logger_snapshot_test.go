@@ -20,6 +20,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
@@ -29,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/vectorindex/compression"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw/packedconn"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 func createTestCommitLoggerForSnapshotsWithOpts(t *testing.T, rootDir, id string, opts ...CommitlogOption) *hnswCommitLogger {
@@ -1680,3 +1683,34 @@ func TestCreateSnapshotCrashSafety(t *testing.T) {
 		require.Equal(t, []string{"1002.snapshot"}, got)
 	})
 }
+
+func TestSnapshotMetrics(t *testing.T) {
+	prom := monitoring.GetMetrics()
+	labels := prometheus.Labels{
+		"class_name":    "SnapshotMetrics",
+		"shard_name":    "shard",
+		"target_vector": "",
+	}
+
+	dir := t.TempDir()
+	id := "main"
+	cl := createTestCommitLoggerForSnapshotsWithOpts(t, dir, id,
+		WithSnapshotMetrics(prom, labels["class_name"], labels["shard_name"], labels["target_vector"]),
+		WithSnapshotCreateInterval(time.Hour))
+
+	// no snapshot yet
+	cl.snapshotMetrics.updateAge()
+	require.Equal(t, float64(0), testutil.ToFloat64(prom.VectorIndexSnapshotLastCreated.With(labels)))
+	require.Equal(t, float64(-1), testutil.ToFloat64(prom.VectorIndexSnapshotAge.With(labels)))
+
+	createCommitlogAndSnapshotTestData(t, cl, "1000.condensed", 1000, "1001.condensed", 1000, "1002.condensed", 1000)
+
+	require.Equal(t, float64(1001), testutil.ToFloat64(prom.VectorIndexSnapshotLastCreated.With(labels)))
+	require.Greater(t, testutil.ToFloat64(prom.VectorIndexSnapshotAge.With(labels)), float64(0))
+
+	manual := prometheus.Labels{"trigger": snapshotTriggerManual}
+	for k, v := range labels {
+		manual[k] = v
+	}
+	require.Equal(t, float64(1), testutil.ToFloat64(prom.VectorIndexSnapshotsCreated.With(manual)))
+}
@@ -84,7 +84,7 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 			if singleVector {
 				h.compressor, err = compressionhelpers.NewHNSWPQCompressor(
 					cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store,
-					h.makeBucketOptions, h.allocChecker, h.getTargetVector())
+					h.makeBucketOptions, h.allocChecker, h.getTargetVector(), h.cacheOpts...)
 			} else {
 				h.compressor, err = compressionhelpers.NewHNSWPQMultiCompressor(
 					cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store,
@@ -99,7 +99,7 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 			if singleVector {
 				h.compressor, err = compressionhelpers.NewHNSWSQCompressor(
 					h.distancerProvider, 1e12, h.logger, cleanData, h.store,
-					h.makeBucketOptions, h.allocChecker, h.getTargetVector())
+					h.makeBucketOptions, h.allocChecker, h.getTargetVector(), h.cacheOpts...)
 			} else {
 				h.compressor, err = compressionhelpers.NewHNSWSQMultiCompressor(
 					h.distancerProvider, 1e12, h.logger, cleanData, h.store,
@@ -116,7 +116,7 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 		if singleVector {
 			h.compressor, err = compressionhelpers.NewBQCompressor(
 				h.distancerProvider, 1e12, h.logger, h.store,
-				h.makeBucketOptions, h.allocChecker, h.getTargetVector())
+				h.makeBucketOptions, h.allocChecker, h.getTargetVector(), h.cacheOpts...)
 		} else {
 			h.compressor, err = compressionhelpers.NewBQMultiCompressor(
 				h.distancerProvider, 1e12, h.logger, h.store,
@@ -0,0 +1,462 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/compression"
+)
+
+// RetrainCompression fits a new PQ or SQ codebook on a fresh sample of the
+// indexed vectors and re-encodes all vectors with it. The graph is not
+// touched, only the compressed vectors and the codebook are swapped. This is
+// meant to be used when CompressionStats reports a drift of the quantization
+// error away from the training data.
+//
+// Vectors are re-encoded into a separate bucket while the index keeps serving
+// queries from the regular bucket with the old codebook. The catch-up of
+// vectors inserted in the meantime and the swap of the bucket and the
+// compressor happen while holding the compression lock, which blocks queries
+// and writes. If the process is interrupted while re-encoding, the regular
+// bucket is untouched and the replacement bucket is recreated on the next
+// retraining. An interrupted swap is finished or rolled back on startup, see
+// commitRetrainedCompressor.
+func (h *hnsw) RetrainCompression(ctx context.Context) error {
+	if !h.compressed.Load() {
+		return errors.New("index is not compressed")
+	}
+	if h.multivector.Load() {
+		return errors.New("retraining compression of multi vector indexes is not supported")
+	}
+	if !h.pqConfig.Enabled && !h.sqConfig.Enabled {
+		return errors.New("retraining is only supported for pq and sq, other quantizers are not trained on the data")
+	}
+
+	started := time.Now()
+	logger := h.logger.WithFields(logrus.Fields{
+		"action":        "hnsw_retrain_compression",
+		"class_name":    h.className,
+		"shard_name":    h.shardName,
+		"target_vector": h.getTargetVector(),
+	})
+
+	h.RLock()
+	maxID := uint64(len(h.nodes))
+	h.RUnlock()
+
+	training, err := h.retrainingSample(ctx, maxID)
+	if err != nil {
+		return err
+	}
+	if len(training) == 0 {
+		return errors.New("no vectors available for retraining")
+	}
+
+	compressor, err := h.newTrainedCompressor(training)
+	if err != nil {
+		return fmt.Errorf("train compressor: %w", err)
+	}
+	// commitRetrainedCompressor takes over the replacement bucket
+	handedOver := false
+	defer func() {
+		if handedOver {
+			return
+		}
+		if derr := compressor.DiscardBucket(context.Background()); derr != nil {
+			logger.WithError(derr).Warn("discard replacement bucket of compressed vectors")
+		}
+	}()
+
+	encoded, err := h.reencode(ctx, compressor, 0, maxID)
+	if err != nil {
+		return err
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	// catch up with vectors inserted while re-encoding and drop the ones that
+	// have been deleted in the meantime
+	h.RLock()
+	newMaxID := uint64(len(h.nodes))
+	h.RUnlock()
+	caughtUp, err := h.reencode(ctx, compressor, maxID, newMaxID)
+	if err != nil {
+		return err
+	}
+	for id := uint64(0); id < maxID; id++ {
+		if h.nodeByID(id) == nil {
+			compressor.Delete(ctx, id)
+		}
+	}
+
+	handedOver = true
+	if err := h.commitRetrainedCompressor(ctx, compressor, logger); err != nil {
+		return err
+	}
+
+	old := h.compressor
+	h.compressor = compressor
+	if err := old.Drop(); err != nil {
+		logger.WithError(err).Warn("drop previous compressed vector cache")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"training_vectors": len(training),
+		"reencoded":        encoded + caughtUp,
+		"took":             time.Since(started),
+	}).Info("retrained vector index compression")
+
+	return nil
+}
+
+// commitRetrainedCompressor replaces the codebook in the commit log and the
+// compressed vectors in the regular bucket with the ones of the retrained
+// compressor. Neither of the two can be swapped atomically, so the steps are
+// ordered such that startup can tell how far a crash got
+// (recoverRetrainedCompression):
+//
+//  1. the replacement bucket is flushed and a marker with the fingerprint of
+//     the new codebook is written
+//  2. the new codebook is logged and the commit log is flushed. Once the new
+//     codebook is on disk, startup finishes the swap, otherwise it rolls back
+//  3. the replacement bucket replaces the regular one and the marker is
+//     removed
+//
+// The replacement bucket is discarded if the swap fails, unless startup needs
+// it to finish the swap.
+func (h *hnsw) commitRetrainedCompressor(ctx context.Context,
+	compressor compressionhelpers.ReplacementCompressor, logger logrus.FieldLogger,
+) error {
+	discard := func(err error) error {
+		if derr := compressor.DiscardBucket(context.Background()); derr != nil {
+			logger.WithError(derr).Warn("discard replacement bucket of compressed vectors")
+		}
+		return err
+	}
+
+	fingerprint, err := codebookFingerprint(compressor)
+	if err != nil {
+		return discard(err)
+	}
+	if err := compressor.FlushBucket(); err != nil {
+		return discard(err)
+	}
+	if err := writeRetrainMarker(h.retrainMarkerPath(), fingerprint); err != nil {
+		return discard(err)
+	}
+
+	err = persistCodebook(compressor, h.commitLog)
+	if err == nil {
+		err = h.commitLog.Flush()
+	}
+	if err == nil {
+		err = compressor.CommitBucket(ctx)
+	}
+	if err != nil {
+		// the new codebook might be logged already, log the current one again
+		// so that it is the one in effect after a restart
+		rerr := persistCodebook(h.compressor, h.commitLog)
+		if rerr == nil {
+			rerr = h.commitLog.Flush()
+		}
+		if rerr != nil {
+			// startup decides based on the codebook that made it to disk
+			logger.WithError(rerr).Error("restore codebook of compressed vectors, " +
+				"the swap of the retrained compression is finished or rolled back on restart")
+			return fmt.Errorf("%w, restore previous codebook: %w", err, rerr)
+		}
+		if rerr := removeRetrainMarker(h.retrainMarkerPath()); rerr != nil {
+			// the marker does not match the current codebook, startup rolls back
+			logger.WithError(rerr).Warn("remove marker of retrained compression")
+		}
+		return discard(err)
+	}
+
+	if err := removeRetrainMarker(h.retrainMarkerPath()); err != nil {
+		// startup finds the swap completed already
+		logger.WithError(err).Warn("remove marker of retrained compression")
+	}
+	return nil
+}
+
+// recoverRetrainedCompression finishes or rolls back a swap of the compressed
+// vectors that was interrupted by a crash, see commitRetrainedCompressor. The
+// swap is finished if the codebook restored from disk is the retrained one.
+// It has to run before the compressed vectors bucket is loaded.
+func (h *hnsw) recoverRetrainedCompression(state *DeserializationResult) error {
+	fingerprint, err := readRetrainMarker(h.retrainMarkerPath())
+	if err != nil {
+		return err
+	}
+	if fingerprint == "" {
+		return nil
+	}
+
+	restored := ""
+	if state != nil && state.CompressionPQData != nil {
+		restored = pqCodebookFingerprint(*state.CompressionPQData)
+	} else if state != nil && state.CompressionSQData != nil {
+		restored = sqCodebookFingerprint(*state.CompressionSQData)
+	}
+
+	bucket := helpers.GetCompressedBucketName(h.getTargetVector())
+	replacement := compressionhelpers.ReplacementBucketName(h.getTargetVector())
+	logger := h.logger.WithFields(logrus.Fields{
+		"action":        "hnsw_retrain_compression_recover",
+		"class_name":    h.className,
+		"shard_name":    h.shardName,
+		"target_vector": h.getTargetVector(),
+	})
+	if restored == fingerprint {
+		if err := lsmkv.FinishReplaceBuckets(h.store.GetDir(), bucket, replacement); err != nil {
+			return fmt.Errorf("finish swap of retrained compressed vectors: %w", err)
+		}
+		logger.Info("finished interrupted swap of retrained compressed vectors")
+	} else {
+		if err := lsmkv.AbortReplaceBuckets(h.store.GetDir(), bucket, replacement); err != nil {
+			return fmt.Errorf("roll back swap of retrained compressed vectors: %w", err)
+		}
+		logger.Info("rolled back interrupted swap of retrained compressed vectors")
+	}
+	return removeRetrainMarker(h.retrainMarkerPath())
+}
+
+func (h *hnsw) retrainMarkerPath() string {
+	return filepath.Join(h.rootPath, fmt.Sprintf("%s.hnsw.retrain", h.id))
+}
+
+// writeRetrainMarker atomically writes the fingerprint of the retrained
+// codebook to the marker file.
+func writeRetrainMarker(path, fingerprint string) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("create retrain marker: %w", err)
+	}
+	if _, err := f.WriteString(fingerprint); err != nil {
+		f.Close()
+		return fmt.Errorf("write retrain marker: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync retrain marker: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close retrain marker: %w", err)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readRetrainMarker returns the fingerprint of the marker file, or an empty
+// string if there is none.
+func readRetrainMarker(path string) (string, error) {
+	fingerprint, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read retrain marker: %w", err)
+	}
+	return string(fingerprint), nil
+}
+
+func removeRetrainMarker(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove retrain marker: %w", err)
+	}
+	return nil
+}
+
+// codebookRecorder is a compressionhelpers.CommitLogger which fingerprints the
+// codebook passed to it and optionally logs it to the next commit logger. It
+// keeps the first error, as PersistCompression does not return errors.
+type codebookRecorder struct {
+	next        compressionhelpers.CommitLogger
+	fingerprint string
+	err         error
+}
+
+func (r *codebookRecorder) AddPQCompression(data compression.PQData) error {
+	r.fingerprint = pqCodebookFingerprint(data)
+	if r.next != nil && r.err == nil {
+		r.err = r.next.AddPQCompression(data)
+	}
+	return r.err
+}
+
+func (r *codebookRecorder) AddSQCompression(data compression.SQData) error {
+	r.fingerprint = sqCodebookFingerprint(data)
+	if r.next != nil && r.err == nil {
+		r.err = r.next.AddSQCompression(data)
+	}
+	return r.err
+}
+
+func (r *codebookRecorder) AddRQCompression(compression.RQData) error {
+	r.err = errors.New("rq is not trained on the data")
+	return r.err
+}
+
+func (r *codebookRecorder) AddBRQCompression(compression.BRQData) error {
+	r.err = errors.New("brq is not trained on the data")
+	return r.err
+}
+
+func codebookFingerprint(compressor compressionhelpers.VectorCompressor) (string, error) {
+	recorder := &codebookRecorder{}
+	compressor.PersistCompression(recorder)
+	if recorder.err != nil {
+		return "", recorder.err
+	}
+	if recorder.fingerprint == "" {
+		return "", errors.New("compressor has no codebook")
+	}
+	return recorder.fingerprint, nil
+}
+
+func persistCodebook(compressor compressionhelpers.VectorCompressor, commitLog CommitLogger) error {
+	recorder := &codebookRecorder{next: commitLog}
+	compressor.PersistCompression(recorder)
+	if recorder.err != nil {
+		return fmt.Errorf("persist codebook: %w", recorder.err)
+	}
+	return nil
+}
+
+// pqCodebookFingerprint hashes the PQ data the commit log keeps, so that the
+// fingerprint of a codebook matches the one of the codebook restored from it.
+func pqCodebookFingerprint(data compression.PQData) string {
+	hash := sha256.New()
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint16(header[0:2], data.Dimensions)
+	binary.LittleEndian.PutUint16(header[2:4], data.Ks)
+	binary.LittleEndian.PutUint16(header[4:6], data.M)
+	header[6] = byte(data.EncoderType)
+	header[7] = data.EncoderDistribution
+	hash.Write(header)
+	for _, encoder := range data.Encoders {
+		hash.Write(encoder.ExposeDataForRestore())
+	}
+	return "pq:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+func sqCodebookFingerprint(data compression.SQData) string {
+	hash := sha256.New()
+	buf := make([]byte, 10)
+	binary.LittleEndian.PutUint32(buf[0:4], math.Float32bits(data.A))
+	binary.LittleEndian.PutUint32(buf[4:8], math.Float32bits(data.B))
+	binary.LittleEndian.PutUint16(buf[8:10], data.Dimensions)
+	hash.Write(buf)
+	return "sq:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// retrainingSample draws a random sample of up to TrainingLimit vectors of
+// the active quantizer from the objects store. The compressed index does not
+// keep the uncompressed vectors in memory.
+func (h *hnsw) retrainingSample(ctx context.Context, maxID uint64) ([][]float32, error) {
+	limit := h.sqConfig.TrainingLimit
+	if h.pqConfig.Enabled {
+		limit = h.pqConfig.TrainingLimit
+	}
+	training := make([][]float32, 0, min(uint64(limit), maxID))
+	sampler := common.NewSparseFisherYatesIterator(int(maxID))
+	for !sampler.IsDone() && len(training) < limit {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sampledIndex := sampler.Next()
+		if sampledIndex == nil {
+			break
+		}
+		vec, err := h.uncompressedVector(ctx, uint64(*sampledIndex))
+		if err != nil {
+			return nil, err
+		}
+		if vec != nil {
+			training = append(training, vec)
+		}
+	}
+	return training, nil
+}
+
+// reencode encodes the vectors of all nodes in [from, to) with the given
+// compressor. It returns the number of encoded vectors.
+func (h *hnsw) reencode(ctx context.Context, compressor compressionhelpers.VectorCompressor,
+	from, to uint64,
+) (int, error) {
+	compressor.GrowCache(to)
+	count := 0
+	for id := from; id < to; id++ {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		vec, err := h.uncompressedVector(ctx, id)
+		if err != nil {
+			return count, err
+		}
+		if vec == nil {
+			continue
+		}
+		compressor.Preload(id, vec)
+		count++
+	}
+	return count, nil
+}
+
+// uncompressedVector returns the normalized vector of the node, or nil if the
+// node or its object does not exist (anymore).
+func (h *hnsw) uncompressedVector(ctx context.Context, id uint64) ([]float32, error) {
+	if h.nodeByID(id) == nil {
+		return nil, nil
+	}
+	vec, err := h.VectorForIDThunk(ctx, id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get vector for docID %d: %w", id, err)
+	}
+	if len(vec) == 0 {
+		return nil, nil
+	}
+	return h.normalizeVec(vec), nil
+}
+
+func (h *hnsw) newTrainedCompressor(training [][]float32) (compressionhelpers.ReplacementCompressor, error) {
+	if h.pqConfig.Enabled {
+		return compressionhelpers.NewHNSWPQReplacementCompressor(
+			h.pqConfig, h.distancerProvider, int(h.dims.Load()), 1e12, h.logger, training, h.store,
+			h.makeBucketOptions, h.allocChecker, h.getTargetVector(), h.cacheOpts...)
+	}
+	return compressionhelpers.NewHNSWSQReplacementCompressor(
+		h.distancerProvider, 1e12, h.logger, training, h.store,
+		h.makeBucketOptions, h.allocChecker, h.getTargetVector(), h.cacheOpts...)
+}
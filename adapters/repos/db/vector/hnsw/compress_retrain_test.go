@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRetrainCompression(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 16
+	initial := 500
+	shifted := 1000
+	logger, _ := test.NewNullLogger()
+
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(initial, 0, dimensions)
+	// vectors outside of the value range seen during training, SQ has to
+	// clip them
+	shiftedVecs := func() [][]float32 {
+		vecs, _ := testinghelpers.RandomVecsFixedSeed(shifted, 0, dimensions)
+		for i := range vecs {
+			for j := range vecs[i] {
+				vecs[i][j] = vecs[i][j]*4 + 2
+			}
+		}
+		return vecs
+	}
+
+	uc := ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10e12,
+		PQ:                    ent.PQConfig{TrainingLimit: initial},
+		SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: initial},
+	}
+	store := testinghelpers.NewDummyStore(t)
+	cfg := indexConfig("retrain", t.TempDir(), logger, vectors, distancer.NewL2SquaredProvider())
+	// vectors grows during the test, so the thunks must not capture the slice
+	var onVectorRead func()
+	cfg.VectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+		if onVectorRead != nil {
+			onVectorRead()
+		}
+		if int(id) >= len(vectors) {
+			return nil, storobj.NewErrNotFoundf(id, "out of range")
+		}
+		return vectors[int(id)], nil
+	}
+	cfg.TempVectorForIDWithViewThunk = func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error) {
+		copy(container.Slice, vectors[int(id)])
+		return container.Slice, nil
+	}
+	index, err := New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), store)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = index.Shutdown(context.Background()) })
+
+	for i := 0; i < initial; i++ {
+		require.NoError(t, index.Add(ctx, uint64(i), vectors[i]))
+	}
+	require.NoError(t, index.compress(uc))
+
+	drift := index.CompressionStats().QuantizationDrift()
+	require.NotNil(t, drift)
+	require.True(t, drift.BaselineFromTraining)
+	require.Greater(t, drift.BaselineError, float64(0))
+	require.Zero(t, drift.Samples)
+
+	vectors = append(vectors, shiftedVecs()...)
+	for i := initial; i < len(vectors); i++ {
+		require.NoError(t, index.Add(ctx, uint64(i), vectors[i]))
+	}
+
+	drift = index.CompressionStats().QuantizationDrift()
+	require.Positive(t, drift.Samples)
+	require.Greater(t, drift.Drift, 2.0)
+	driftBefore := drift.RecentError
+
+	t.Run("interrupted retraining keeps the previous compressed vectors", func(t *testing.T) {
+		before := index.compressor
+		compressedBefore, err := store.Bucket(helpers.GetCompressedBucketName(index.getTargetVector())).Get(idBytesSQ(initial))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(ctx)
+		reads := 0
+		onVectorRead = func() {
+			// cancel while re-encoding, after the training sample was drawn
+			if reads++; reads == initial+len(vectors)/2 {
+				cancel()
+			}
+		}
+		t.Cleanup(func() { onVectorRead = nil })
+
+		require.ErrorIs(t, index.RetrainCompression(ctx), context.Canceled)
+		require.True(t, before == index.compressor)
+		require.Nil(t, store.Bucket(helpers.TempBucketFromBucketName(helpers.GetCompressedBucketName(index.getTargetVector()))))
+
+		compressedAfter, err := store.Bucket(helpers.GetCompressedBucketName(index.getTargetVector())).Get(idBytesSQ(initial))
+		require.NoError(t, err)
+		require.Equal(t, compressedBefore, compressedAfter)
+	})
+
+	t.Run("retrain and re-encode", func(t *testing.T) {
+		onVectorRead = nil
+
+		require.NoError(t, index.RetrainCompression(ctx))
+
+		drift := index.CompressionStats().QuantizationDrift()
+		require.NotNil(t, drift)
+		require.True(t, drift.BaselineFromTraining)
+		require.Zero(t, drift.Samples)
+		require.Equal(t, int64(len(vectors)), index.compressor.CountVectors())
+		require.Nil(t, store.Bucket(helpers.TempBucketFromBucketName(helpers.GetCompressedBucketName(index.getTargetVector()))))
+
+		// the stored codes must match the new codebook
+		for i := initial; i < len(vectors); i += 97 {
+			ids, _, err := index.SearchByVector(ctx, vectors[i], 1, nil)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{uint64(i)}, ids)
+		}
+	})
+
+	t.Run("new inserts match the retrained codebook", func(t *testing.T) {
+		offset := len(vectors)
+		vectors = append(vectors, shiftedVecs()...)
+		for i := offset; i < len(vectors); i++ {
+			require.NoError(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+
+		drift := index.CompressionStats().QuantizationDrift()
+		require.Positive(t, drift.Samples)
+		require.Less(t, drift.RecentError, driftBefore)
+	})
+
+	t.Run("not supported for uncompressed indexes", func(t *testing.T) {
+		uncompressed, err := New(indexConfig("uncompressed", t.TempDir(), logger, vectors, distancer.NewL2SquaredProvider()),
+			ent.UserConfig{MaxConnections: 16, EFConstruction: 64, EF: 64, VectorCacheMaxObjects: 10e12},
+			cyclemanager.NewCallbackGroupNoop(), store)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = uncompressed.Shutdown(context.Background()) })
+
+		require.ErrorContains(t, uncompressed.RetrainCompression(ctx), "not compressed")
+	})
+}
+
+func TestRetrainCompressionCrashRecovery(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 16
+	initial := 300
+	logger, _ := test.NewNullLogger()
+
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(2*initial, 0, dimensions)
+	for i := initial; i < len(vectors); i++ {
+		for j := range vectors[i] {
+			vectors[i][j] = vectors[i][j]*4 + 2
+		}
+	}
+	uc := ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10e12,
+		PQ:                    ent.PQConfig{TrainingLimit: initial},
+		SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: initial},
+	}
+
+	type crash struct {
+		name string
+		// codebookPersisted crashes after the retrained codebook was logged
+		codebookPersisted bool
+		// midReplace crashes after the regular bucket was moved aside
+		midReplace    bool
+		wantRetrained bool
+	}
+	for _, tc := range []crash{
+		{name: "before the codebook is logged"},
+		{name: "after the codebook is logged", codebookPersisted: true, wantRetrained: true},
+		{name: "while replacing the bucket", codebookPersisted: true, midReplace: true, wantRetrained: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rootPath := t.TempDir()
+			storeDir := t.TempDir()
+			store := testinghelpers.NewDummyStoreFromFolder(storeDir, t)
+			cfg := indexConfig("retrain_crash", rootPath, logger, vectors, distancer.NewL2SquaredProvider())
+			// the initial codebook must not be trained on the shifted vectors
+			added := 0
+			cfg.VectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+				if int(id) >= added {
+					return nil, storobj.NewErrNotFoundf(id, "out of range")
+				}
+				return vectors[int(id)], nil
+			}
+			index, err := New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), store)
+			require.NoError(t, err)
+			for ; added < initial; added++ {
+				require.NoError(t, index.Add(ctx, uint64(added), vectors[added]))
+			}
+			require.NoError(t, index.compress(uc))
+			for ; added < len(vectors); added++ {
+				require.NoError(t, index.Add(ctx, uint64(added), vectors[added]))
+			}
+
+			bucketName := helpers.GetCompressedBucketName(index.getTargetVector())
+			replacementName := compressionhelpers.ReplacementBucketName(index.getTargetVector())
+			codesBefore, err := store.Bucket(bucketName).Get(idBytesSQ(initial))
+			require.NoError(t, err)
+
+			// run the steps of RetrainCompression up to the crash
+			training, err := index.retrainingSample(ctx, uint64(len(vectors)))
+			require.NoError(t, err)
+			compressor, err := index.newTrainedCompressor(training)
+			require.NoError(t, err)
+			_, err = index.reencode(ctx, compressor, 0, uint64(len(vectors)))
+			require.NoError(t, err)
+			fingerprint, err := codebookFingerprint(compressor)
+			require.NoError(t, err)
+			require.NoError(t, compressor.FlushBucket())
+			require.NoError(t, writeRetrainMarker(index.retrainMarkerPath(), fingerprint))
+			if tc.codebookPersisted {
+				require.NoError(t, persistCodebook(compressor, index.commitLog))
+				require.NoError(t, index.commitLog.Flush())
+			}
+			retrainedCodes, err := store.Bucket(replacementName).Get(idBytesSQ(initial))
+			require.NoError(t, err)
+			require.NotEqual(t, codesBefore, retrainedCodes)
+
+			require.NoError(t, index.Shutdown(ctx))
+			require.NoError(t, store.Shutdown(ctx))
+			if tc.midReplace {
+				require.NoError(t, os.Rename(filepath.Join(storeDir, bucketName),
+					filepath.Join(storeDir, bucketName+"___del")))
+			}
+
+			store = testinghelpers.NewDummyStoreFromFolder(storeDir, t)
+			t.Cleanup(func() { _ = store.Shutdown(context.Background()) })
+			index, err = New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), store)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = index.Shutdown(context.Background()) })
+			index.PostStartup(ctx)
+
+			require.NoFileExists(t, index.retrainMarkerPath())
+			require.NoDirExists(t, filepath.Join(storeDir, replacementName))
+			require.NoDirExists(t, filepath.Join(storeDir, bucketName+"___del"))
+
+			codesAfter, err := store.Bucket(bucketName).Get(idBytesSQ(initial))
+			require.NoError(t, err)
+			restored, err := codebookFingerprint(index.compressor)
+			require.NoError(t, err)
+			// the previous codebook clips the shifted vectors
+			covered := initial
+			if tc.wantRetrained {
+				require.Equal(t, retrainedCodes, codesAfter)
+				require.Equal(t, fingerprint, restored)
+				covered = len(vectors)
+			} else {
+				require.Equal(t, codesBefore, codesAfter)
+				require.NotEqual(t, fingerprint, restored)
+			}
+
+			// codes and codebook have to match either way
+			for i := 0; i < covered; i += 37 {
+				ids, _, err := index.SearchByVector(ctx, vectors[i], 1, nil)
+				require.NoError(t, err)
+				require.Equal(t, []uint64{uint64(i)}, ids)
+			}
+		})
+	}
+}
+
+func idBytesSQ(id int) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, uint64(id))
+	return idBytes
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
@@ -358,3 +359,94 @@ func Test_NoRaceCompressNamedVectorsDoNotMessEachOther(t *testing.T) {
 	assert.ElementsMatch(t, control1, sample1)
 	assert.ElementsMatch(t, control2, sample2)
 }
+
+func Test_NoRaceCompressedCustomDistancesAreRescored(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 32
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(300, 10, dimensions)
+	weights := make([]float32, dimensions)
+	for i := range weights {
+		weights[i] = float32(i%3) + 0.5
+	}
+	logger, _ := test.NewNullLogger()
+
+	for _, provider := range []distancer.Provider{
+		distancer.NewJaccardProvider(),
+		distancer.NewWeightedDotProductProvider(weights),
+	} {
+		t.Run(provider.Type(), func(t *testing.T) {
+			// a rescore limit of 0 disables rescoring for the built-in distances
+			uc := ent.UserConfig{
+				MaxConnections:        16,
+				EFConstruction:        64,
+				EF:                    64,
+				VectorCacheMaxObjects: 10e12,
+				PQ:                    ent.PQConfig{TrainingLimit: len(vectors)},
+				SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: len(vectors)},
+			}
+			index, err := New(indexConfig(provider.Type(), t.TempDir(), logger, vectors, provider),
+				uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			assert.Nil(t, err)
+			defer index.Shutdown(context.Background())
+
+			for i, vec := range vectors {
+				assert.Nil(t, index.Add(ctx, uint64(i), vec))
+			}
+			assert.Nil(t, index.compress(uc))
+			assert.True(t, index.shouldRescore())
+
+			for _, query := range queries {
+				ids, dists, err := index.SearchByVector(ctx, query, 5, nil)
+				assert.Nil(t, err)
+				for i, id := range ids {
+					exact, err := provider.SingleDist(query, vectors[id])
+					assert.Nil(t, err)
+					assert.InDelta(t, exact, dists[i], 1e-4)
+				}
+			}
+		})
+	}
+}
+
+func Test_NoRaceCompressedRescoreLimitOverride(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(300, 10, 32)
+	logger, _ := test.NewNullLogger()
+	provider := distancer.NewL2SquaredProvider()
+
+	uc := ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10e12,
+		PQ:                    ent.PQConfig{TrainingLimit: len(vectors)},
+		SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: len(vectors), RescoreLimit: 20},
+	}
+	index, err := New(indexConfig(provider.Type(), t.TempDir(), logger, vectors, provider),
+		uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	assert.False(t, index.RescoreLimitTunable())
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+	require.Nil(t, index.compress(uc))
+	assert.True(t, index.RescoreLimitTunable())
+
+	limit, ok := index.rescoreLimit(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, 20, limit)
+
+	for _, query := range queries {
+		ids, dists, err := index.SearchByVectorWithEFAndRescore(ctx, query, 5, 64, 64, nil)
+		require.Nil(t, err)
+		expectedIDs, expectedDists, err := index.SearchByVectorWithEF(ctx, query, 5, 64, nil)
+		require.Nil(t, err)
+		// rescoring more candidates can only improve the results
+		assert.Len(t, ids, len(expectedIDs))
+		for i := range dists {
+			assert.LessOrEqual(t, dists[i], expectedDists[i]+1e-5)
+		}
+	}
+}
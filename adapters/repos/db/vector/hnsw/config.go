@@ -42,6 +42,7 @@ type Config struct {
 	PrometheusMetrics                 *monitoring.PrometheusMetrics
 	AllocChecker                      memwatch.AllocChecker
 	WaitForCachePrefill               bool
+	VectorCacheAdmission              bool
 	FlatSearchConcurrency             int
 	AcornFilterRatio                  float64
 	DisableSnapshots                  bool
@@ -62,6 +62,22 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "muvera enabled",
 			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.MuveraConfig.Enabled },
 		},
+		{
+			name:     "plaid enabled",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.PlaidConfig.Enabled },
+		},
+		{
+			name:     "plaid centroids",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.PlaidConfig.Centroids },
+		},
+		{
+			name:     "plaid residualBits",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.PlaidConfig.ResidualBits },
+		},
+		{
+			name:     "plaid trainingLimit",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.PlaidConfig.TrainingLimit },
+		},
 		{
 			name:     "skipDefaultQuantization",
 			accessor: func(c ent.UserConfig) interface{} { return c.SkipDefaultQuantization },
@@ -116,6 +132,10 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 
 	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
+	if h.plaid.Load() {
+		h.plaidIndex.UpdateSearchConfig(parsed.Multivector.PlaidConfig)
+	}
+
 	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
 		return nil
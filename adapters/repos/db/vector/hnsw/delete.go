@@ -109,6 +109,10 @@ func (h *hnsw) DeleteMulti(docIDs ...uint64) error {
 		return h.Delete(docIDs...)
 	}
 
+	if h.plaid.Load() {
+		return h.plaidIndex.Delete(docIDs...)
+	}
+
 	for _, docID := range docIDs {
 		h.RLock()
 		vecIDs := h.docIDVectors[docID]
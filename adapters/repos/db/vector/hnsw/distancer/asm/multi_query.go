@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//nolint:govet
+//go:build ignore
+
+package main
+
+var (
+	// queries is the number of queries scored against a candidate at once
+	queries = 4
+	// queryParams are the names of the query parameters of the kernels
+	queryParams = []string{"y0", "y1", "y2", "y3"}
+)
+
+// Multi-query kernels load each block of the candidate x once and accumulate
+// its products with all queries in separate registers, so scoring a candidate
+// against a batch of queries loads it once per four queries rather than once
+// per query.
+func main() {
+	multiQuery("Dot4", false)
+	multiQuery("L2Squared4", true)
+
+	Generate()
+}
+
+func multiQuery(name string, l2 bool) {
+	TEXT(name, NOSPLIT, "func(x, y0, y1, y2, y3 []float32, out *[4]float32)")
+	x := Mem{Base: Load(Param("x").Base(), GP64())}
+	ys := make([]Mem, queries)
+	for i := 0; i < queries; i++ {
+		ys[i] = Mem{Base: Load(Param(queryParams[i]).Base(), GP64())}
+	}
+	n := Load(Param("x").Len(), GP64())
+
+	// Two accumulators per query hide the latency of the fused
+	// multiply-adds in the main loop.
+	acc := make([]VecVirtual, queries)
+	acc2 := make([]VecVirtual, queries)
+	for i := 0; i < queries; i++ {
+		acc[i] = YMM()
+		VXORPS(acc[i], acc[i], acc[i])
+	}
+	for i := 0; i < queries; i++ {
+		acc2[i] = YMM()
+		VXORPS(acc2[i], acc2[i], acc2[i])
+	}
+
+	// Process 16 entries per iteration.
+	Label("blockloop")
+	CMPQ(n, U32(16))
+	JL(LabelRef("block"))
+
+	xs := YMM()
+	xs2 := YMM()
+	VMOVUPS(x, xs)
+	VMOVUPS(x.Offset(32), xs2)
+	for i := 0; i < queries; i++ {
+		accumulate(ys[i], xs, acc[i], l2)
+		accumulate(ys[i].Offset(32), xs2, acc2[i], l2)
+	}
+
+	advance(x, ys, 64)
+	SUBQ(U32(16), n)
+	JMP(LabelRef("blockloop"))
+
+	// Fold the second accumulators and process a remaining block of 8.
+	Label("block")
+	for i := 0; i < queries; i++ {
+		VADDPS(acc[i], acc2[i], acc[i])
+	}
+	CMPQ(n, U32(8))
+	JL(LabelRef("tail"))
+
+	xb := YMM()
+	VMOVUPS(x, xb)
+	for i := 0; i < queries; i++ {
+		accumulate(ys[i], xb, acc[i], l2)
+	}
+
+	advance(x, ys, 32)
+	SUBQ(U32(8), n)
+
+	// Process any trailing entries.
+	Label("tail")
+	tail := make([]VecVirtual, queries)
+	for i := 0; i < queries; i++ {
+		tail[i] = XMM()
+		VXORPS(tail[i], tail[i], tail[i])
+	}
+
+	Label("tailloop")
+	CMPQ(n, U32(0))
+	JE(LabelRef("reduce"))
+
+	xt := XMM()
+	VMOVSS(x, xt)
+	for i := 0; i < queries; i++ {
+		if l2 {
+			difft := XMM()
+			VSUBSS(ys[i], xt, difft)
+			VFMADD231SS(difft, difft, tail[i])
+		} else {
+			VFMADD231SS(ys[i], xt, tail[i])
+		}
+	}
+
+	advance(x, ys, 4)
+	DECQ(n)
+	JMP(LabelRef("tailloop"))
+
+	// Reduce the lanes of each accumulator to one and store it.
+	Label("reduce")
+	out := Mem{Base: Load(Param("out"), GP64())}
+	for i := 0; i < queries; i++ {
+		result := acc[i].AsX()
+		top := XMM()
+		VEXTRACTF128(U8(1), acc[i], top)
+		VADDPS(result, top, result)
+		VADDPS(result, tail[i], result)
+		VHADDPS(result, result, result)
+		VHADDPS(result, result, result)
+		VMOVSS(result, out.Offset(4*i))
+	}
+
+	VZEROUPPER()
+	RET()
+}
+
+// accumulate adds the product (dot) or squared difference (l2) of y and xs to
+// acc.
+func accumulate(y Mem, xs, acc VecVirtual, l2 bool) {
+	if l2 {
+		diff := YMM()
+		VSUBPS(y, xs, diff)
+		VFMADD231PS(diff, diff, acc)
+	} else {
+		VFMADD231PS(y, xs, acc)
+	}
+}
+
+// advance moves the candidate and all query pointers forward by bytes.
+func advance(x Mem, ys []Mem, bytes int) {
+	ADDQ(U32(bytes), x.Base)
+	for i := range ys {
+		ADDQ(U32(bytes), ys[i].Base)
+	}
+}
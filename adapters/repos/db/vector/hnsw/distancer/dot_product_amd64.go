@@ -22,4 +22,7 @@ func init() {
 	} else if cpu.X86.HasAVX2 {
 		dotProductImplementation = asm.DotAVX256
 	}
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		dotProduct4Implementation = asm.Dot4
+	}
 }
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// jaccardFromProducts computes the Tanimoto distance 1 - a·b / (|a|² + |b|² - a·b)
+// from the inner products of both vectors. On binary fingerprints (0/1 values)
+// this is exactly the Jaccard distance, for other vectors it is the continuous
+// Tanimoto extension. The products are computed with dotProductImplementation,
+// so this uses the same SIMD kernels as the dot product.
+func jaccardFromProducts(ab, aa, bb float32) float32 {
+	denominator := aa + bb - ab
+	if denominator == 0 {
+		// two empty fingerprints are considered identical
+		return 0
+	}
+
+	dist := 1 - ab/denominator
+	if dist < 0 {
+		return 0
+	}
+	return dist
+}
+
+type Jaccard struct {
+	a  []float32
+	aa float32
+}
+
+func (j *Jaccard) Distance(b []float32) (float32, error) {
+	if len(j.a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(j.a), len(b))
+	}
+
+	return jaccardFromProducts(dotProductImplementation(j.a, b), j.aa,
+		dotProductImplementation(b, b)), nil
+}
+
+type JaccardProvider struct{}
+
+func NewJaccardProvider() JaccardProvider {
+	return JaccardProvider{}
+}
+
+func (j JaccardProvider) SingleDist(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(a), len(b))
+	}
+
+	return jaccardFromProducts(dotProductImplementation(a, b),
+		dotProductImplementation(a, a), dotProductImplementation(b, b)), nil
+}
+
+func (j JaccardProvider) Type() string {
+	return "jaccard"
+}
+
+func (j JaccardProvider) New(a []float32) Distancer {
+	return &Jaccard{a: a, aa: dotProductImplementation(a, a)}
+}
+
+// CompressionProxy returns l2-squared, which is the hamming distance on binary
+// fingerprints and thus ranks candidates similar to jaccard.
+func (j JaccardProvider) CompressionProxy() Provider {
+	return NewL2SquaredProvider()
+}
+
+func (j JaccardProvider) Step(x, y []float32) float32 {
+	panic("Not implemented")
+}
+
+func (j JaccardProvider) Wrap(x float32) float32 {
+	panic("Not implemented")
+}
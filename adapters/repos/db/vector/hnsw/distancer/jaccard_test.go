@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jaccardOfSets(a, b []float32) float32 {
+	var intersection, union float32
+	for i := range a {
+		if a[i] == 1 && b[i] == 1 {
+			intersection++
+		}
+		if a[i] == 1 || b[i] == 1 {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return 1 - intersection/union
+}
+
+func TestJaccardDistancer(t *testing.T) {
+	t.Run("identical fingerprints", func(t *testing.T) {
+		vec1 := []float32{1, 0, 1, 1}
+		vec2 := []float32{1, 0, 1, 1}
+
+		dist, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+
+		control, err := NewJaccardProvider().SingleDist(vec1, vec2)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("disjoint fingerprints", func(t *testing.T) {
+		vec1 := []float32{1, 1, 0, 0}
+		vec2 := []float32{0, 0, 1, 1}
+
+		dist, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		assert.Equal(t, float32(1), dist)
+	})
+
+	t.Run("overlapping fingerprints", func(t *testing.T) {
+		vec1 := []float32{1, 1, 1, 0, 0}
+		vec2 := []float32{0, 1, 1, 1, 0}
+		// intersection 2, union 4
+		expectedDistance := float32(0.5)
+
+		dist, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+
+		control, err := NewJaccardProvider().SingleDist(vec1, vec2)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("empty fingerprints", func(t *testing.T) {
+		dist, err := NewJaccardProvider().SingleDist([]float32{0, 0}, []float32{0, 0})
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("matches set based jaccard on random fingerprints", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		// long enough to hit the SIMD paths of the dot product
+		for _, dims := range []int{3, 64, 167, 1024} {
+			for i := 0; i < 20; i++ {
+				a, b := make([]float32, dims), make([]float32, dims)
+				for j := range a {
+					a[j] = float32(r.Intn(2))
+					b[j] = float32(r.Intn(2))
+				}
+				dist, err := NewJaccardProvider().New(a).Distance(b)
+				require.Nil(t, err)
+				assert.InDelta(t, jaccardOfSets(a, b), dist, 1e-5)
+			}
+		}
+	})
+
+	t.Run("vector length mismatch", func(t *testing.T) {
+		_, err := NewJaccardProvider().SingleDist([]float32{1, 0}, []float32{1})
+		assert.ErrorIs(t, err, ErrVectorLength)
+		_, err = NewJaccardProvider().New([]float32{1, 0}).Distance([]float32{1})
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+}
+
+func TestForCompression(t *testing.T) {
+	assert.Equal(t, "l2-squared", ForCompression(NewJaccardProvider()).Type())
+	assert.Equal(t, "dot", ForCompression(NewWeightedDotProductProvider([]float32{1})).Type())
+	assert.Equal(t, "cosine-dot", ForCompression(NewCosineDistanceProvider()).Type())
+
+	assert.True(t, RequiresRescoring(NewJaccardProvider()))
+	assert.True(t, RequiresRescoring(NewWeightedDotProductProvider([]float32{1})))
+	assert.False(t, RequiresRescoring(NewL2SquaredProvider()))
+}
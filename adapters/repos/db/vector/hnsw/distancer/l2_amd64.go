@@ -22,4 +22,7 @@ func init() {
 	} else if cpu.X86.HasAVX2 {
 		l2SquaredImpl = asm.L2AVX256
 	}
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		l2Squared4Implementation = asm.L2Squared4
+	}
 }
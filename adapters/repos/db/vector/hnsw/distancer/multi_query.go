@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import "github.com/pkg/errors"
+
+// dotProduct4Implementation writes the products of x with each of y0 to y3
+// into out. The default scores the queries one by one and works on every
+// architecture. An init function overwrites it on amd64 with a kernel which
+// loads x once for all four queries if AVX2 and FMA are present.
+var dotProduct4Implementation = func(x, y0, y1, y2, y3 []float32, out *[4]float32) {
+	out[0] = dotProductImplementation(x, y0)
+	out[1] = dotProductImplementation(x, y1)
+	out[2] = dotProductImplementation(x, y2)
+	out[3] = dotProductImplementation(x, y3)
+}
+
+// l2Squared4Implementation is the squared euclidean counterpart of
+// dotProduct4Implementation.
+var l2Squared4Implementation = func(x, y0, y1, y2, y3 []float32, out *[4]float32) {
+	out[0] = l2SquaredImpl(x, y0)
+	out[1] = l2SquaredImpl(x, y1)
+	out[2] = l2SquaredImpl(x, y2)
+	out[3] = l2SquaredImpl(x, y3)
+}
+
+// MultiQueryDist writes the distance between candidate and each of the
+// queries into out, which must be at least as long as queries. Dot product,
+// cosine and l2-squared distances are evaluated four queries at a time by a
+// kernel which reads the candidate once per four queries. Other distances
+// fall back to provider.SingleDist per query.
+func MultiQueryDist(provider Provider, queries [][]float32, candidate []float32, out []float32) error {
+	var (
+		single func(a, b []float32) float32
+		multi  func(x, y0, y1, y2, y3 []float32, out *[4]float32)
+	)
+	switch provider.(type) {
+	case DotProductProvider, CosineDistanceProvider:
+		single, multi = dotProductImplementation, dotProduct4Implementation
+	case L2SquaredProvider:
+		single, multi = l2SquaredImpl, l2Squared4Implementation
+	default:
+		for i, query := range queries {
+			dist, err := provider.SingleDist(query, candidate)
+			if err != nil {
+				return err
+			}
+			out[i] = dist
+		}
+		return nil
+	}
+
+	for _, query := range queries {
+		if len(query) != len(candidate) {
+			return errors.Wrapf(ErrVectorLength, "%d vs %d", len(query), len(candidate))
+		}
+	}
+
+	out = out[:len(queries)]
+	i := 0
+	for ; i+4 <= len(queries); i += 4 {
+		multi(candidate, queries[i], queries[i+1], queries[i+2], queries[i+3], (*[4]float32)(out[i:i+4]))
+	}
+	for ; i < len(queries); i++ {
+		out[i] = single(candidate, queries[i])
+	}
+
+	// the kernels return products, which are turned into distances here
+	switch provider.(type) {
+	case DotProductProvider:
+		for i := range out {
+			out[i] = -out[i]
+		}
+	case CosineDistanceProvider:
+		for i := range out {
+			out[i] = max(1-out[i], 0)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiQueryDist(t *testing.T) {
+	queries := [][]float32{
+		{1, 0, 0},
+		{0, 1, 0},
+		{3, 4, 0},
+	}
+	candidate := []float32{1, 1, 0}
+
+	for _, provider := range []Provider{NewL2SquaredProvider(), NewDotProductProvider(), NewCosineDistanceProvider()} {
+		t.Run(provider.Type(), func(t *testing.T) {
+			out := make([]float32, len(queries))
+			require.NoError(t, MultiQueryDist(provider, queries, candidate, out))
+			for i, query := range queries {
+				expected, err := provider.SingleDist(query, candidate)
+				require.NoError(t, err)
+				assert.InDelta(t, expected, out[i], 1e-6)
+			}
+		})
+	}
+
+	t.Run("random vectors", func(t *testing.T) {
+		r := getRandomSeed()
+		providers := []Provider{
+			NewL2SquaredProvider(), NewDotProductProvider(), NewCosineDistanceProvider(), NewManhattanProvider(),
+		}
+		// dimensions and query counts cover the blocks and tails of the kernels
+		for _, dims := range []int{1, 7, 8, 37, 128, 1536} {
+			for _, n := range []int{1, 3, 4, 9} {
+				queries := make([][]float32, n)
+				for i := range queries {
+					queries[i] = randomVector(r, dims)
+				}
+				candidate := randomVector(r, dims)
+
+				for _, provider := range providers {
+					t.Run(fmt.Sprintf("%s %d dims %d queries", provider.Type(), dims, n), func(t *testing.T) {
+						out := make([]float32, n)
+						require.NoError(t, MultiQueryDist(provider, queries, candidate, out))
+						for i, query := range queries {
+							expected, err := provider.SingleDist(query, candidate)
+							require.NoError(t, err)
+							assert.InDelta(t, expected, out[i], 1e-3*float64(dims))
+						}
+					})
+				}
+			}
+		}
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		out := make([]float32, 1)
+		err := MultiQueryDist(NewL2SquaredProvider(), [][]float32{{1, 2}}, candidate, out)
+		assert.Error(t, err)
+	})
+}
+
+func randomVector(r interface{ Float32() float32 }, dims int) []float32 {
+	vec := make([]float32, dims)
+	for i := range vec {
+		vec[i] = r.Float32() - 0.5
+	}
+	return vec
+}
+
+func BenchmarkMultiQueryDist(b *testing.B) {
+	r := getRandomSeed()
+	for _, dims := range []int{128, 768, 1536} {
+		queries := make([][]float32, 32)
+		for i := range queries {
+			queries[i] = randomVector(r, dims)
+		}
+		candidate := randomVector(r, dims)
+		out := make([]float32, len(queries))
+		provider := NewDotProductProvider()
+
+		b.Run(fmt.Sprintf("%d dimensions/multi query", dims), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				MultiQueryDist(provider, queries, candidate, out)
+			}
+		})
+		b.Run(fmt.Sprintf("%d dimensions/single dist", dims), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i, query := range queries {
+					out[i], _ = provider.SingleDist(query, candidate)
+				}
+			}
+		})
+	}
+}
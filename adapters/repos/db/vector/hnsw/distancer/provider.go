@@ -22,3 +22,25 @@ type Provider interface {
 type Distancer interface {
 	Distance(vec []float32) (float32, error)
 }
+
+// CompressionProxyProvider is implemented by distances that can't be
+// evaluated on quantized vectors. Quantizers use the proxy distance to select
+// candidates, which are then rescored with the exact distance.
+type CompressionProxyProvider interface {
+	CompressionProxy() Provider
+}
+
+// ForCompression returns the distance a quantizer should use for p.
+func ForCompression(p Provider) Provider {
+	if proxied, ok := p.(CompressionProxyProvider); ok {
+		return proxied.CompressionProxy()
+	}
+	return p
+}
+
+// RequiresRescoring is true if results on quantized vectors are only
+// approximations of p and always have to be rescored.
+func RequiresRescoring(p Provider) bool {
+	_, ok := p.(CompressionProxyProvider)
+	return ok
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedDotProductDistancer(t *testing.T) {
+	t.Run("weights are applied per dimension", func(t *testing.T) {
+		provider := NewWeightedDotProductProvider([]float32{1, 0.5, 0})
+		vec1 := []float32{1, 2, 3}
+		vec2 := []float32{4, 5, 6}
+		// 1*1*4 + 0.5*2*5 + 0*3*6 = 9
+		expectedDistance := float32(-9)
+
+		dist, err := provider.New(vec1).Distance(vec2)
+		require.Nil(t, err)
+
+		control, err := provider.SingleDist(vec1, vec2)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("unit weights equal the dot product", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		dims := 300
+		weights := make([]float32, dims)
+		for i := range weights {
+			weights[i] = 1
+		}
+		a, b := make([]float32, dims), make([]float32, dims)
+		for i := range a {
+			a[i], b[i] = r.Float32(), r.Float32()
+		}
+
+		dist, err := NewWeightedDotProductProvider(weights).New(a).Distance(b)
+		require.Nil(t, err)
+		control, err := NewDotProductProvider().SingleDist(a, b)
+		require.Nil(t, err)
+		assert.InDelta(t, control, dist, 1e-3)
+	})
+
+	t.Run("vectors must match the weights", func(t *testing.T) {
+		provider := NewWeightedDotProductProvider([]float32{1, 1, 1})
+
+		_, err := provider.SingleDist([]float32{1, 2}, []float32{3, 4})
+		assert.ErrorIs(t, err, ErrVectorLength)
+		_, err = provider.New([]float32{1, 2}).Distance([]float32{3, 4})
+		assert.ErrorIs(t, err, ErrVectorLength)
+		_, err = provider.New([]float32{1, 2, 3}).Distance([]float32{3, 4})
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+}
@@ -114,7 +114,7 @@ func (h *hnsw) flatSearch(ctx context.Context, queryVector []float32, k, limit i
 	beforeRescore := time.Now()
 	if h.shouldRescore() && !h.multivector.Load() {
 		compressorDistancer, fn := h.compressor.NewDistancer(queryVector)
-		if err := h.rescore(ctx, results, k, compressorDistancer); err != nil {
+		if err := h.rescore(ctx, results, k, h.rescoringDistancer(queryVector, compressorDistancer)); err != nil {
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
 			took := time.Since(beforeRescore)
 			helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", took)
@@ -115,7 +115,10 @@ type hnsw struct {
 	trackRQOnce                       sync.Once
 	dims                              atomic.Int32
 
-	cache               cache.Cache[float32]
+	cache cache.Cache[float32]
+	// cacheOpts are used for the vector cache and the caches of compressed
+	// vectors
+	cacheOpts           []cache.Option
 	waitForCachePrefill bool
 	cachePrefilled      atomic.Bool
 
@@ -205,6 +208,8 @@ type hnsw struct {
 	multivector       atomic.Bool
 	muvera            atomic.Bool
 	muveraEncoder     *multivector.MuveraEncoder
+	plaid             atomic.Bool
+	plaidIndex        *multivector.PlaidIndex
 	docIDVectors      map[uint64][]uint64
 	vecIDcounter      uint64
 	maxDocID          uint64
@@ -296,6 +301,17 @@ func New(cfg Config, uc ent.UserConfig,
 	normalizeOnRead := cfg.DistanceProvider.Type() == "cosine-dot"
 
 	var vectorCache cache.Cache[float32]
+	var cacheOpts []cache.Option
+	if cfg.VectorCacheAdmission {
+		cacheOpts = append(cacheOpts, cache.WithAdmissionPolicy())
+		if uc.Multivector.Enabled && !uc.Multivector.MuveraConfig.Enabled {
+			cfg.Logger.WithFields(logrus.Fields{
+				"action":   "hnsw_vector_cache_admission",
+				"index_id": cfg.ID,
+			}).Warn("vector cache admission is not supported for multi vector indexes, " +
+				"the cache is cleared entirely once full")
+		}
+	}
 
 	var muveraEncoder *multivector.MuveraEncoder
 	if uc.Multivector.Enabled && !uc.Multivector.MuveraConfig.Enabled {
@@ -317,11 +333,11 @@ func New(cfg Config, uc ent.UserConfig,
 			}
 			vectorCache = cache.NewShardedFloat32LockCache(
 				muveraVectorForID, cfg.MultiVectorForIDThunk, uc.VectorCacheMaxObjects, 1, cfg.Logger,
-				normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
+				normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker, cacheOpts...)
 
 		} else {
 			vectorCache = cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, cfg.MultiVectorForIDThunk, uc.VectorCacheMaxObjects, 1, cfg.Logger,
-				normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
+				normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker, cacheOpts...)
 		}
 	}
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
@@ -342,6 +358,7 @@ func New(cfg Config, uc ent.UserConfig,
 		snapshotOnStartup:     cfg.SnapshotOnStartup,
 		nodes:                 make([]*vertex, cache.InitialSize),
 		cache:                 vectorCache,
+		cacheOpts:             cacheOpts,
 		waitForCachePrefill:   cfg.WaitForCachePrefill,
 		cachePrefilled:        atomic.Bool{}, // Will be set appropriately in init()
 		vectorForID:           vectorCache.Get,
@@ -413,7 +430,7 @@ func New(cfg Config, uc ent.UserConfig,
 		} else {
 			index.compressor, err = compressionhelpers.NewBQCompressor(
 				index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
-				cfg.MakeBucketOptions, cfg.AllocChecker, index.getTargetVector())
+				cfg.MakeBucketOptions, cfg.AllocChecker, index.getTargetVector(), cacheOpts...)
 		}
 		if err != nil {
 			return nil, err
@@ -439,6 +456,11 @@ func New(cfg Config, uc ent.UserConfig,
 				return nil, errors.Wrapf(err, "Create or load bucket (multivector store)")
 			}
 		}
+		if uc.Multivector.PlaidConfig.Enabled {
+			if err := index.initPlaid(cfg, uc.Multivector.PlaidConfig); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if err := index.init(cfg); err != nil {
@@ -456,6 +478,35 @@ func New(cfg Config, uc ent.UserConfig,
 	return index, nil
 }
 
+func (h *hnsw) initPlaid(cfg Config, plaidConfig ent.PlaidConfig) error {
+	if err := h.store.CreateOrLoadBucket(context.Background(),
+		multivector.PlaidDocsBucket(cfg.ID),
+		cfg.MakeBucketOptions(lsmkv.StrategyReplace)...,
+	); err != nil {
+		return errors.Wrapf(err, "Create or load bucket (plaid documents)")
+	}
+	if err := h.store.CreateOrLoadBucket(context.Background(),
+		multivector.PlaidPostingsBucket(cfg.ID),
+		cfg.MakeBucketOptions(lsmkv.StrategyRoaringSet)...,
+	); err != nil {
+		return errors.Wrapf(err, "Create or load bucket (plaid postings)")
+	}
+
+	plaidIndex, err := multivector.NewPlaidIndex(plaidConfig,
+		h.store.Bucket(multivector.PlaidDocsBucket(cfg.ID)),
+		h.store.Bucket(multivector.PlaidPostingsBucket(cfg.ID)),
+		h.multiDistancerProvider, h.logger)
+	if err != nil {
+		return errors.Wrap(err, "init plaid index")
+	}
+	h.plaidIndex = plaidIndex
+	h.plaid.Store(true)
+	if dims := plaidIndex.Dimensions(); dims > 0 {
+		h.dims.Store(int32(dims))
+	}
+	return nil
+}
+
 func (h *hnsw) getTargetVector() string {
 	if name, found := strings.CutPrefix(h.id, fmt.Sprintf("%s_", helpers.VectorsBucketLSM)); found {
 		return name
@@ -752,6 +803,12 @@ func (h *hnsw) Drop(ctx context.Context, keepFiles bool) error {
 		return errors.Wrap(err, "commit log drop")
 	}
 
+	if !keepFiles {
+		if err := h.removeHotSet(); err != nil {
+			return errors.Wrap(err, "remove vector cache hot set")
+		}
+	}
+
 	return nil
 }
 
@@ -766,6 +823,9 @@ func (h *hnsw) Shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "hnsw shutdown")
 	}
 
+	if err := h.persistHotSet(); err != nil {
+		h.logHotSetError("hnsw_vector_cache_persist_hot_set", err)
+	}
 	if h.compressed.Load() {
 		err := h.compressor.Drop()
 		if err != nil {
@@ -790,6 +850,9 @@ func (h *hnsw) Entrypoint() uint64 {
 }
 
 func (h *hnsw) ContainsDoc(docID uint64) bool {
+	if h.plaid.Load() {
+		return h.plaidIndex.ContainsDoc(docID)
+	}
 	if h.Multivector() && !h.muvera.Load() {
 		h.RLock()
 		vecIds, exists := h.docIDVectors[docID]
@@ -807,6 +870,10 @@ func (h *hnsw) ContainsDoc(docID uint64) bool {
 }
 
 func (h *hnsw) Iterate(fn func(docID uint64) bool) {
+	if h.plaid.Load() {
+		h.plaidIndex.Iterate(fn)
+		return
+	}
 	if h.Multivector() && !h.muvera.Load() {
 		h.iterateMulti(fn)
 		return
@@ -113,7 +113,7 @@ func (h *hnsw) checkAndCompress() error {
 			if singleVector {
 				h.compressor, err = compressionhelpers.NewRQCompressor(
 					h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker, h.makeBucketOptions,
-					int(h.rqConfig.Bits), int(h.dims.Load()), h.getTargetVector())
+					int(h.rqConfig.Bits), int(h.dims.Load()), h.getTargetVector(), h.cacheOpts...)
 			} else {
 				h.compressor, err = compressionhelpers.NewRQMultiCompressor(
 					h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker, h.makeBucketOptions,
@@ -263,6 +263,10 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 		return errors.Errorf("addMultiBatch called with empty lists")
 	}
 
+	if h.plaid.Load() {
+		return h.addMultiBatchPlaid(ctx, docIDs, vectors)
+	}
+
 	if h.muvera.Load() {
 		h.trackMuveraOnce.Do(func() {
 			h.muveraEncoder.InitEncoder(len(vectors[0][0]))
@@ -462,6 +466,7 @@ func (h *hnsw) addOne(ctx context.Context, vector []float32, node *vertex) error
 	if singleVector {
 		if h.compressed.Load() {
 			h.compressor.Preload(nodeId, vector)
+			h.compressor.TrackQuantizationError(vector)
 		} else {
 			h.cache.Preload(nodeId, vector)
 		}
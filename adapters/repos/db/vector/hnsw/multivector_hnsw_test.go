@@ -20,8 +20,10 @@ import (
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
@@ -581,3 +583,82 @@ func TestEmptyMuvera(t *testing.T) {
 		require.Equal(t, []uint64{}, ids)
 	})
 }
+
+func TestPlaidHnsw(t *testing.T) {
+	ctx := context.Background()
+	k := 10
+
+	// a training limit of 3 trains the codebook with the first document, 1000
+	// keeps every document uncompressed
+	for _, trainingLimit := range []int{3, 1000} {
+		t.Run(fmt.Sprintf("training limit %d", trainingLimit), func(t *testing.T) {
+			vectorIndex, err := New(Config{
+				RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+				ID:                    "plaid",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewDotProductProvider(),
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					return []float32{0}, errors.New("can not use VectorForIDThunk with multivector")
+				},
+				MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+					return multiVectors[id], nil
+				},
+				TempMultiVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([][]float32, error) {
+					return multiVectors[id], nil
+				},
+				MakeBucketOptions: lsmkv.MakeNoopBucketOptions,
+				AllocChecker:      memwatch.NewDummyMonitor(),
+				GetViewThunk:      func() common.BucketView { return &multivectorNoopBucketView{} },
+			}, ent.UserConfig{
+				VectorCacheMaxObjects: 1e12,
+				MaxConnections:        8,
+				EFConstruction:        64,
+				EF:                    64,
+				Multivector: ent.MultivectorConfig{
+					Enabled: true,
+					PlaidConfig: ent.PlaidConfig{
+						Enabled:       true,
+						Centroids:     2,
+						NProbe:        2,
+						ResidualBits:  4,
+						TrainingLimit: trainingLimit,
+						RescoreLimit:  10,
+					},
+				},
+			}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+
+			for i, vec := range multiVectors {
+				require.Nil(t, vectorIndex.AddMulti(ctx, uint64(i), vec))
+			}
+			assert.Equal(t, trainingLimit == 3, vectorIndex.plaidIndex.Trained())
+			assert.True(t, vectorIndex.ContainsDoc(1))
+
+			for i, query := range multiQueries {
+				ids, _, err := vectorIndex.SearchByMultiVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				require.Equal(t, expectedResults[i], ids)
+			}
+
+			require.Nil(t, vectorIndex.DeleteMulti(1))
+			assert.False(t, vectorIndex.ContainsDoc(1))
+			for i, query := range multiQueries {
+				ids, _, err := vectorIndex.SearchByMultiVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				require.Equal(t, [][]uint64{{0, 2}, {0, 2}}[i], ids)
+			}
+
+			require.Nil(t, vectorIndex.AddMulti(ctx, 1, multiVectors[1]))
+			for i, query := range multiQueries {
+				ids, _, err := vectorIndex.SearchByMultiVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				require.Equal(t, expectedResults[i], ids)
+			}
+
+			allow := helpers.NewAllowList(0, 1)
+			ids, _, err := vectorIndex.SearchByMultiVector(ctx, multiQueries[0], k, allow)
+			require.Nil(t, err)
+			require.Equal(t, []uint64{1, 0}, ids)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+// The plaid multivector mode does not use the graph at all. Token vectors are
+// kept in the plaid index and only the final exact MaxSim rescoring is shared
+// with the muvera mode.
+
+func (h *hnsw) addMultiBatchPlaid(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	h.trackDimensionsOnce.Do(func() {
+		if h.dims.Load() == 0 {
+			h.dims.Store(int32(len(vectors[0][0])))
+		}
+	})
+
+	for i, docID := range docIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(vectors[i]) == 0 {
+			return errors.Errorf("insert called with empty multi vector for doc %d", docID)
+		}
+		h.metrics.InsertVector()
+		if err := h.plaidIndex.Add(docID, h.normalizeVecs(vectors[i])); err != nil {
+			return errors.Wrapf(err, "add doc %d to plaid index", docID)
+		}
+	}
+	return nil
+}
+
+func (h *hnsw) searchByMultiVectorPlaid(ctx context.Context, vectors [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if len(vectors) == 0 {
+		return nil, nil, errors.New("multi vector array is empty")
+	}
+	vectors = h.normalizeVecs(vectors)
+
+	candidates, err := h.plaidIndex.Candidates(ctx, vectors, k, allowList)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "plaid candidates")
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "plaid_candidates", len(candidates))
+
+	candidateSet := make(map[uint64]struct{}, len(candidates))
+	for _, docID := range candidates {
+		candidateSet[docID] = struct{}{}
+	}
+	return h.computeLateInteraction(vectors, k, candidateSet)
+}
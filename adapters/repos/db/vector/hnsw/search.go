@@ -91,11 +91,67 @@ func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 	return h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
 }
 
+// SearchByVectorWithEF behaves like SearchByVector, but uses the given ef
+// instead of the configured one. This allows evaluating other settings
+// without changing the configuration of a live index.
+func (h *hnsw) SearchByVectorWithEF(ctx context.Context, vector []float32,
+	k int, ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	ef = max(ef, k)
+	vector = h.normalizeVec(vector)
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		return h.flatSearch(ctx, vector, k, ef, allowList)
+	}
+	return h.knnSearchByVector(ctx, vector, k, ef, allowList)
+}
+
+// SearchByVectorWithEFAndRescore behaves like SearchByVectorWithEF, but also
+// overrides the number of candidates that are rescored. See
+// RescoreLimitTunable for the indexes which honor the limit.
+func (h *hnsw) SearchByVectorWithEFAndRescore(ctx context.Context, vector []float32,
+	k int, ef int, rescoreLimit int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	ctx = context.WithValue(ctx, rescoreLimitKey{}, rescoreLimit)
+	return h.SearchByVectorWithEF(ctx, vector, k, ef, allowList)
+}
+
+// RescoreLimitTunable returns true if the index rescores a configurable
+// number of candidates, i.e. it is compressed with SQ or RQ and rescoring is
+// enabled.
+func (h *hnsw) RescoreLimitTunable() bool {
+	return h.shouldRescore() && !h.multivector.Load() && (h.sqConfig.Enabled || h.rqConfig.Enabled)
+}
+
+type rescoreLimitKey struct{}
+
+// rescoreLimit returns the number of candidates to rescore, if the
+// compression limits it. A limit set on ctx takes precedence over the config.
+func (h *hnsw) rescoreLimit(ctx context.Context) (int, bool) {
+	if limit, ok := ctx.Value(rescoreLimitKey{}).(int); ok {
+		return limit, true
+	}
+	if h.sqConfig.Enabled {
+		return h.sqConfig.RescoreLimit, true
+	}
+	if h.rqConfig.Enabled {
+		return h.rqConfig.RescoreLimit, true
+	}
+	return 0, false
+}
+
 func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	if !h.multivector.Load() {
 		return nil, nil, errors.New("multivector search is not enabled")
 	}
 
+	if h.plaid.Load() {
+		return h.searchByMultiVectorPlaid(ctx, vectors, k, allowList)
+	}
+
 	if h.muvera.Load() {
 		// this happens only if hnsw is empty so we need to initialize muvera encoder
 		if err := h.initMuveraEncoder(vectors); err != nil {
@@ -181,6 +237,10 @@ func (h *hnsw) initMuveraEncoder(vectors [][]float32) error {
 
 func (h *hnsw) shouldRescore() bool {
 	if h.compressed.Load() {
+		// quantized distances are only a proxy for these metrics
+		if distancer.RequiresRescoring(h.distancerProvider) {
+			return !h.doNotRescore
+		}
 		if (h.sqConfig.Enabled && h.sqConfig.RescoreLimit == 0) || (h.rqConfig.Enabled && h.rqConfig.RescoreLimit == 0) {
 			return false
 		}
@@ -188,6 +248,29 @@ func (h *hnsw) shouldRescore() bool {
 	return h.compressed.Load() && !h.doNotRescore
 }
 
+// exactRescoreDistancer rescores with the exact distance for metrics that the
+// compressor only approximates with a proxy distance.
+type exactRescoreDistancer struct {
+	compressionhelpers.CompressorDistancer
+	exact distancer.Distancer
+}
+
+func (d exactRescoreDistancer) DistanceToFloat(vec []float32) (float32, error) {
+	return d.exact.Distance(vec)
+}
+
+func (h *hnsw) rescoringDistancer(query []float32,
+	compressorDistancer compressionhelpers.CompressorDistancer,
+) compressionhelpers.CompressorDistancer {
+	if !distancer.RequiresRescoring(h.distancerProvider) {
+		return compressorDistancer
+	}
+	return exactRescoreDistancer{
+		CompressorDistancer: compressorDistancer,
+		exact:               h.distancerProvider.New(query),
+	}
+}
+
 func (h *hnsw) cacheSize() int64 {
 	var size int64
 	if h.compressed.Load() {
@@ -885,7 +968,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 
 	beforeRescore := time.Now()
 	if h.shouldRescore() && !h.multivector.Load() {
-		if err := h.rescore(ctx, res, k, compressorDistancer); err != nil {
+		if err := h.rescore(ctx, res, k, h.rescoringDistancer(searchVec, compressorDistancer)); err != nil {
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "knn_search_rescore")
 			took := time.Since(beforeRescore)
 			helpers.AnnotateSlowQueryLog(ctx, "knn_search_rescore_took", took)
@@ -969,7 +1052,7 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 	vecIDs := h.docIDVectors[docID]
 	h.RUnlock()
 	var docVecs [][]float32
-	if h.compressed.Load() {
+	if h.compressed.Load() || h.plaid.Load() {
 		slice := h.pools.tempVectors.Get(int(h.dims.Load()))
 		var err error
 		docVecs, err = h.TempMultiVectorForIDThunk(context.Background(), docID, slice)
@@ -1058,13 +1141,8 @@ func (h *hnsw) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVe
 }
 
 func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int, compressorDistancer compressionhelpers.CompressorDistancer) error {
-	if h.sqConfig.Enabled && h.sqConfig.RescoreLimit >= k {
-		for res.Len() > h.sqConfig.RescoreLimit {
-			res.Pop()
-		}
-	}
-	if h.rqConfig.Enabled && h.rqConfig.RescoreLimit >= k {
-		for res.Len() > h.rqConfig.RescoreLimit {
+	if limit, ok := h.rescoreLimit(ctx); ok && limit >= k {
+		for res.Len() > limit {
 			res.Pop()
 		}
 	}
@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SearchByVectorBatch runs one search per query with a shared allow list and
+// returns the results in query order. If the allow list is small enough for
+// a flat search, each candidate is loaded once and scored against all
+// queries. Graph searches visit different nodes for every query, so they run
+// concurrently instead.
+func (h *hnsw) SearchByVectorBatch(ctx context.Context, vectors [][]float32,
+	k int, allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if h.multivector.Load() {
+		return nil, nil, errors.New("batch search is not supported for multivector indexes")
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	queries := make([][]float32, len(vectors))
+	for i, vector := range vectors {
+		queries[i] = h.normalizeVec(vector)
+	}
+
+	ef := h.searchTimeEF(k)
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	flat := allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", flat)
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_batch_queries", len(queries))
+
+	if flat {
+		return h.flatSearchBatch(ctx, queries, k, ef, allowList)
+	}
+
+	ids := make([][]uint64, len(queries))
+	dists := make([][]float32, len(queries))
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range queries {
+		i := i
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			var err error
+			ids[i], dists[i], err = h.knnSearchByVector(ctx, queries[i], k, ef, allowList)
+			if err != nil {
+				return fmt.Errorf("query %d: %w", i, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	return ids, dists, nil
+}
+
+// flatSearchBatch is the multi-query counterpart of flatSearch. The
+// candidates are split across workers and every worker scores each of its
+// candidates against all queries, so a vector is only read once no matter how
+// many queries there are. Compressed indexes keep limit candidates per query
+// and rescore them afterwards, just like flatSearch.
+func (h *hnsw) flatSearchBatch(ctx context.Context, queries [][]float32, k, limit int,
+	allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if !h.shouldRescore() {
+		limit = k
+	}
+
+	h.RLock()
+	nodeSize := uint64(len(h.nodes))
+	h.RUnlock()
+
+	var multiDistancer compressionhelpers.MultiQueryDistancer
+	if h.compressed.Load() {
+		distancer, returnFn := h.compressor.NewMultiQueryDistancer(queries)
+		defer returnFn()
+		multiDistancer = distancer
+	}
+
+	aggregateMu := &sync.Mutex{}
+	results := make([]*priorityqueue.Queue[any], len(queries))
+	for i := range results {
+		results[i] = priorityqueue.NewMax[any](limit)
+	}
+
+	beforeIter := time.Now()
+	candidates := allowList.Slice()
+
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	for workerID := 0; workerID < h.flatSearchConcurrency; workerID++ {
+		workerID := workerID
+		eg.Go(func() error {
+			localResults := make([]*priorityqueue.Queue[any], len(queries))
+			for i := range localResults {
+				localResults[i] = priorityqueue.NewMax[any](limit)
+			}
+			dists := make([]float32, len(queries))
+
+			var e storobj.ErrNotFound
+			for idPos := workerID; idPos < len(candidates); idPos += h.flatSearchConcurrency {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				candidate := candidates[idPos]
+				if candidate >= nodeSize {
+					continue
+				}
+
+				h.shardedNodeLocks.RLock(candidate)
+				c := h.nodes[candidate]
+				h.shardedNodeLocks.RUnlock(candidate)
+
+				if c == nil || h.hasTombstone(candidate) {
+					continue
+				}
+
+				err := h.distsToNode(ctx, multiDistancer, candidate, queries, dists)
+				if errors.As(err, &e) {
+					h.handleDeletedNode(e.DocID, "flatSearchBatch")
+					continue
+				}
+				if errors.Is(err, errNoVector) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+
+				for i, dist := range dists {
+					addResult(localResults[i], candidate, dist, limit)
+				}
+			}
+
+			aggregateMu.Lock()
+			defer aggregateMu.Unlock()
+			for i, local := range localResults {
+				for local.Len() > 0 {
+					res := local.Pop()
+					addResult(results[i], res.ID, res.Dist, limit)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "flat_search_iteration_took", time.Since(beforeIter))
+
+	if h.shouldRescore() {
+		beforeRescore := time.Now()
+		for i, query := range queries {
+			compressorDistancer, returnFn := h.compressor.NewDistancer(query)
+			err := h.rescore(ctx, results[i], k, h.rescoringDistancer(query, compressorDistancer))
+			returnFn()
+			if err != nil {
+				helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
+				return nil, nil, fmt.Errorf("flat search: query %d: %w", i, err)
+			}
+		}
+		helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", time.Since(beforeRescore))
+	}
+
+	ids := make([][]uint64, len(queries))
+	dists := make([][]float32, len(queries))
+	for q, res := range results {
+		ids[q] = make([]uint64, res.Len())
+		dists[q] = make([]float32, res.Len())
+		// results are ordered in reverse
+		for i := res.Len() - 1; i >= 0; i-- {
+			item := res.Pop()
+			ids[q][i] = item.ID
+			dists[q][i] = item.Dist
+		}
+	}
+
+	return ids, dists, nil
+}
+
+var errNoVector = errors.New("no vector")
+
+// distsToNode writes the distance between the node and each query into dists.
+// Uncompressed vectors without data are reported as errNoVector so the caller
+// can skip them.
+func (h *hnsw) distsToNode(ctx context.Context, multiDistancer compressionhelpers.MultiQueryDistancer,
+	node uint64, queries [][]float32, dists []float32,
+) error {
+	if h.compressed.Load() {
+		return multiDistancer.DistancesToNode(node, dists)
+	}
+
+	vec, err := h.vectorForID(ctx, node)
+	if err != nil {
+		return errors.Wrapf(err, "could not get vector of object at docID %d", node)
+	}
+	if len(vec) == 0 {
+		return errNoVector
+	}
+
+	return distancer.MultiQueryDist(h.distancerProvider, queries, vec, dists)
+}
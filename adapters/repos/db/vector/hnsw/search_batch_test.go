@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(300, 5, 32)
+	index := createEmptyHnswIndexForTests(t, func(ctx context.Context, id uint64) ([]float32, error) {
+		return vectors[int(id)], nil
+	})
+	for i, vec := range vectors {
+		require.NoError(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	t.Run("graph search matches single-query results", func(t *testing.T) {
+		ids, dists, err := index.SearchByVectorBatch(ctx, queries, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, ids, len(queries))
+		require.Len(t, dists, len(queries))
+
+		for i, query := range queries {
+			expectedIDs, expectedDists, err := index.SearchByVector(ctx, query, 10, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expectedIDs, ids[i])
+			assert.InDeltaSlice(t, expectedDists, dists[i], 1e-5)
+		}
+	})
+
+	t.Run("flat search matches single-query results", func(t *testing.T) {
+		atomic.StoreInt64(&index.flatSearchCutoff, 1000)
+		defer atomic.StoreInt64(&index.flatSearchCutoff, 0)
+
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < 300; i += 7 {
+			allow.Insert(i)
+		}
+
+		ids, dists, err := index.SearchByVectorBatch(ctx, queries, 10, allow)
+		require.NoError(t, err)
+		require.Len(t, ids, len(queries))
+
+		for i, query := range queries {
+			expectedIDs, expectedDists, err := index.SearchByVector(ctx, query, 10, allow)
+			require.NoError(t, err)
+			assert.Equal(t, expectedIDs, ids[i])
+			assert.InDeltaSlice(t, expectedDists, dists[i], 1e-5)
+			for _, id := range ids[i] {
+				assert.True(t, allow.Contains(id))
+			}
+		}
+	})
+}
+
+func TestSearchByVectorBatchCompressed(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(300, 6, 32)
+	logger, _ := test.NewNullLogger()
+
+	sqConfig := func(rescoreLimit int) ent.UserConfig {
+		return ent.UserConfig{
+			MaxConnections:        16,
+			EFConstruction:        64,
+			EF:                    64,
+			VectorCacheMaxObjects: 10e12,
+			PQ:                    ent.PQConfig{TrainingLimit: len(vectors)},
+			SQ:                    ent.SQConfig{Enabled: true, TrainingLimit: len(vectors), RescoreLimit: rescoreLimit},
+		}
+	}
+
+	for name, uc := range map[string]ent.UserConfig{
+		"pq":            userConfig(8, 16, 16, 64, 64, len(vectors)),
+		"sq":            sqConfig(20),
+		"sq no rescore": sqConfig(0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			provider := distancer.NewL2SquaredProvider()
+			index, err := New(indexConfig(provider.Type(), t.TempDir(), logger, vectors, provider),
+				uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.NoError(t, err)
+			defer index.Shutdown(context.Background())
+
+			for i, vec := range vectors {
+				require.NoError(t, index.Add(ctx, uint64(i), vec))
+			}
+			require.NoError(t, index.compress(uc))
+			atomic.StoreInt64(&index.flatSearchCutoff, 1000)
+
+			allow := helpers.NewAllowList()
+			for i := uint64(0); i < 300; i += 3 {
+				allow.Insert(i)
+			}
+
+			ids, dists, err := index.SearchByVectorBatch(ctx, queries, 10, allow)
+			require.NoError(t, err)
+			require.Len(t, ids, len(queries))
+
+			for i, query := range queries {
+				expectedIDs, expectedDists, err := index.SearchByVector(ctx, query, 10, allow)
+				require.NoError(t, err)
+				assert.Equal(t, expectedIDs, ids[i])
+				assert.InDeltaSlice(t, expectedDists, dists[i], 1e-5)
+			}
+		})
+	}
+}
@@ -105,6 +105,10 @@ func (h *hnsw) restoreFromDisk(cl CommitLogger) error {
 		return errors.Wrap(err, "load commit logger state")
 	}
 
+	if err := h.recoverRetrainedCompression(state); err != nil {
+		return errors.Wrap(err, "recover retrained compression")
+	}
+
 	h.cachePrefilled.Store(state == nil)
 
 	if state == nil {
@@ -162,6 +166,7 @@ func (h *hnsw) restoreFromDisk(cl CommitLogger) error {
 						h.makeBucketOptions,
 						h.allocChecker,
 						h.getTargetVector(),
+						h.cacheOpts...,
 					)
 				} else {
 					h.compressor, err = compressionhelpers.RestoreHNSWPQMultiCompressor(
@@ -196,6 +201,7 @@ func (h *hnsw) restoreFromDisk(cl CommitLogger) error {
 					h.makeBucketOptions,
 					h.allocChecker,
 					h.getTargetVector(),
+					h.cacheOpts...,
 				)
 			} else {
 				h.compressor, err = compressionhelpers.RestoreHNSWSQMultiCompressor(
@@ -285,6 +291,7 @@ func (h *hnsw) restoreRotationalQuantization(data *compression.RQData) error {
 				h.allocChecker,
 				h.makeBucketOptions,
 				h.getTargetVector(),
+				h.cacheOpts...,
 			)
 		})
 	} else {
@@ -330,6 +337,7 @@ func (h *hnsw) restoreBinaryRotationalQuantization(data *compression.BRQData) er
 				h.allocChecker,
 				h.makeBucketOptions,
 				h.getTargetVector(),
+				h.cacheOpts...,
 			)
 		})
 	} else {
@@ -503,14 +511,22 @@ func (h *hnsw) prefillCache(ctx context.Context) {
 		}).Debug("context.WithTimeout")
 
 		var err error
+		hotSet, hsErr := h.loadHotSet()
+		if hsErr != nil {
+			h.logHotSetError("hnsw_vector_cache_load_hot_set", hsErr)
+		}
 		if h.compressed.Load() {
+			// all compressed vectors are prefilled, the seeded frequencies make
+			// the hot set survive once the cache needs to evict
 			if !h.multivector.Load() || h.muvera.Load() {
 				h.compressor.PrefillCache(ctx)
 			} else {
 				h.compressor.PrefillMultiCache(ctx, h.docIDVectors)
 			}
 		} else {
-			err = newVectorCachePrefiller(h.cache, h, h.logger).Prefill(context.Background(), limit)
+			err = newVectorCachePrefiller(h.cache, h, h.logger).
+				withHotSet(hotSet).
+				Prefill(context.Background(), limit)
 		}
 
 		if err != nil {
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+)
+
+// The hot set is a list of the most frequently accessed vector ids together
+// with their estimated access frequency. It is written on shutdown so that
+// the next startup can warm the vector cache with the previous working set
+// rather than in plain id order.
+//
+// Layout (little endian):
+//
+//	magic [4]byte "HSET" | version uint8 | count uint32 | count * (id uint64, frequency uint32)
+const (
+	hotSetMagic     = "HSET"
+	hotSetVersion   = uint8(1)
+	hotSetEntrySize = 8 + 4
+)
+
+func (h *hnsw) hotSetPath() string {
+	return filepath.Join(h.rootPath, fmt.Sprintf("%s.hnsw.hotset", h.id))
+}
+
+// frequencyTracker returns the cache which tracks access frequencies and its
+// max size. For compressed indexes this is the cache of compressed vectors.
+func (h *hnsw) frequencyTracker() (cache.FrequencyTracker, int, bool) {
+	if h.compressed.Load() {
+		tracker, ok := h.compressor.(cache.FrequencyTracker)
+		if !ok {
+			return nil, 0, false
+		}
+		return tracker, int(h.compressor.GetCacheMaxSize()), true
+	}
+	tracker, ok := h.cache.(cache.FrequencyTracker)
+	if !ok {
+		return nil, 0, false
+	}
+	return tracker, int(h.cache.CopyMaxSize()), true
+}
+
+// persistHotSet writes the current hot set of the vector cache, if the cache
+// tracks access frequencies. Compressed indexes prefill all compressed vectors
+// on startup, their hot set is only written if not all of them fit in the
+// cache.
+func (h *hnsw) persistHotSet() error {
+	tracker, limit, ok := h.frequencyTracker()
+	if !ok {
+		return nil
+	}
+	if h.compressed.Load() {
+		h.RLock()
+		allFit := limit >= len(h.nodes)
+		h.RUnlock()
+		if allFit {
+			return nil
+		}
+	}
+
+	entries := tracker.HotSet(limit)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return writeHotSet(h.hotSetPath(), entries)
+}
+
+// loadHotSet reads a previously persisted hot set and seeds the access
+// frequencies of the cache with it. A missing file is not an error, it simply
+// yields an empty hot set.
+func (h *hnsw) loadHotSet() ([]cache.HotEntry, error) {
+	tracker, _, ok := h.frequencyTracker()
+	if !ok {
+		return nil, nil
+	}
+
+	entries, err := readHotSet(h.hotSetPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	tracker.SeedFrequencies(entries)
+	return entries, nil
+}
+
+func (h *hnsw) removeHotSet() error {
+	if err := os.Remove(h.hotSetPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func writeHotSet(path string, entries []cache.HotEntry) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("create hot set file: %w", err)
+	}
+
+	w := bufio.NewWriter(f)
+	header := make([]byte, len(hotSetMagic)+1+4)
+	copy(header, hotSetMagic)
+	header[len(hotSetMagic)] = hotSetVersion
+	binary.LittleEndian.PutUint32(header[len(hotSetMagic)+1:], uint32(len(entries)))
+	if _, err := w.Write(header); err != nil {
+		f.Close()
+		return fmt.Errorf("write hot set header: %w", err)
+	}
+
+	buf := make([]byte, hotSetEntrySize)
+	for _, e := range entries {
+		binary.LittleEndian.PutUint64(buf[0:8], e.ID)
+		binary.LittleEndian.PutUint32(buf[8:12], e.Frequency)
+		if _, err := w.Write(buf); err != nil {
+			f.Close()
+			return fmt.Errorf("write hot set entry: %w", err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("flush hot set file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync hot set file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close hot set file: %w", err)
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func readHotSet(path string) ([]cache.HotEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	header := make([]byte, len(hotSetMagic)+1+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("read hot set header: %w", err)
+	}
+	if string(header[:len(hotSetMagic)]) != hotSetMagic {
+		return nil, fmt.Errorf("invalid hot set file %q: bad magic", path)
+	}
+	if v := header[len(hotSetMagic)]; v != hotSetVersion {
+		return nil, fmt.Errorf("unsupported hot set version %d", v)
+	}
+
+	count := binary.LittleEndian.Uint32(header[len(hotSetMagic)+1:])
+	entries := make([]cache.HotEntry, 0, count)
+	buf := make([]byte, hotSetEntrySize)
+	for i := uint32(0); i < count; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, fmt.Errorf("read hot set entry %d: %w", i, err)
+		}
+		entries = append(entries, cache.HotEntry{
+			ID:        binary.LittleEndian.Uint64(buf[0:8]),
+			Frequency: binary.LittleEndian.Uint32(buf[8:12]),
+		})
+	}
+
+	return entries, nil
+}
+
+func (h *hnsw) logHotSetError(action string, err error) {
+	h.logger.WithFields(logrus.Fields{
+		"action":   action,
+		"index_id": h.id,
+	}).WithError(err).Warn("vector cache hot set")
+}
@@ -23,6 +23,10 @@ type vectorCachePrefiller[T any] struct {
 	cache  cache.Cache[T]
 	index  *hnsw
 	logger logrus.FieldLogger
+
+	// hotSet, if set, is loaded before the level-based prefill so that the
+	// previous working set is restored first
+	hotSet []cache.HotEntry
 }
 
 func newVectorCachePrefiller[T any](cache cache.Cache[T], index *hnsw,
@@ -35,8 +39,22 @@ func newVectorCachePrefiller[T any](cache cache.Cache[T], index *hnsw,
 	}
 }
 
+func (pf *vectorCachePrefiller[T]) withHotSet(hotSet []cache.HotEntry) *vectorCachePrefiller[T] {
+	pf.hotSet = hotSet
+	return pf
+}
+
 func (pf *vectorCachePrefiller[T]) Prefill(ctx context.Context, limit int) error {
 	before := time.Now()
+	// loading a vector into the cache is not an access, the frequencies of the
+	// hot set are seeded already
+	ctx = cache.WithoutAccessTracking(ctx)
+	if len(pf.hotSet) > 0 {
+		if err := pf.prefillHotSet(ctx, limit); err != nil {
+			return err
+		}
+	}
+
 	for level := pf.maxLevel(); level >= 0; level-- {
 		ok, err := pf.prefillLevel(ctx, level, limit)
 		if err != nil {
@@ -48,7 +66,7 @@ func (pf *vectorCachePrefiller[T]) Prefill(ctx context.Context, limit int) error
 		}
 	}
 
-	pf.logTotal(int(pf.cache.Len()), limit, before)
+	pf.logTotal(int(pf.cache.CountVectors()), limit, before)
 	return nil
 }
 
@@ -64,7 +82,7 @@ func (pf *vectorCachePrefiller[T]) prefillLevel(ctx context.Context,
 	pf.index.RUnlock()
 
 	for i := 0; i < nodesLen; i++ {
-		if int(pf.cache.Len()) >= limit {
+		if int(pf.cache.CountVectors()) >= limit {
 			break
 		}
 
@@ -94,6 +112,51 @@ func (pf *vectorCachePrefiller[T]) prefillLevel(ctx context.Context,
 	return true, nil
 }
 
+// prefillHotSet loads the ids of the persisted hot set, hottest first. Ids
+// which no longer exist in the graph or have been deleted are skipped.
+func (pf *vectorCachePrefiller[T]) prefillHotSet(ctx context.Context, limit int) error {
+	before := time.Now()
+	warmed := 0
+
+	pf.index.RLock()
+	nodesLen := len(pf.index.nodes)
+	pf.index.RUnlock()
+
+	for _, entry := range pf.hotSet {
+		if int(pf.cache.CountVectors()) >= limit {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if entry.ID >= uint64(nodesLen) {
+			continue
+		}
+
+		pf.index.shardedNodeLocks.RLock(entry.ID)
+		node := pf.index.nodes[entry.ID]
+		pf.index.shardedNodeLocks.RUnlock(entry.ID)
+
+		if node == nil || pf.index.hasTombstone(entry.ID) {
+			continue
+		}
+
+		pf.cache.Get(ctx, entry.ID)
+		warmed++
+	}
+
+	pf.logger.WithFields(logrus.Fields{
+		"action":   "hnsw_vector_cache_prefill_hot_set",
+		"hot_set":  len(pf.hotSet),
+		"count":    warmed,
+		"took":     time.Since(before),
+		"index_id": pf.index.id,
+	}).Info("restored hot set in vector cache")
+	return nil
+}
+
 func (pf *vectorCachePrefiller[T]) logLevel(level, count int, before time.Time) {
 	pf.logger.WithFields(logrus.Fields{
 		"action":     "hnsw_vector_cache_prefill_level",
@@ -13,11 +13,20 @@ package hnsw
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	vcache "github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func TestVectorCachePrefilling(t *testing.T) {
@@ -73,6 +82,141 @@ func TestVectorCachePrefilling(t *testing.T) {
 	})
 }
 
+func TestVectorCachePrefillingWithHotSet(t *testing.T) {
+	cache := newFakeCache()
+	index := &hnsw{
+		nodes:               generateDummyVertices(100),
+		currentMaximumLayer: 3,
+		shardedNodeLocks:    common.NewDefaultShardedRWLocks(),
+		tombstones:          map[uint64]struct{}{},
+		tombstoneLock:       &sync.RWMutex{},
+	}
+	index.nodes[7] = nil
+	index.tombstones[8] = struct{}{}
+
+	logger, _ := test.NewNullLogger()
+
+	hotSet := []vcache.HotEntry{
+		{ID: 42, Frequency: 100},
+		{ID: 7, Frequency: 90},   // no longer in the graph
+		{ID: 8, Frequency: 80},   // deleted
+		{ID: 500, Frequency: 70}, // beyond the graph
+		{ID: 43, Frequency: 60},
+		{ID: 44, Frequency: 50},
+	}
+	pf := newVectorCachePrefiller[float32](cache, index, logger).withHotSet(hotSet)
+
+	t.Run("hot set is restored before the upper layers", func(t *testing.T) {
+		cache.Reset()
+		pf.Prefill(context.Background(), 4)
+		assert.Equal(t, map[uint64]struct{}{
+			// hot set
+			42: {},
+			43: {},
+			44: {},
+
+			// layer 3
+			0: {},
+		}, cache.store)
+	})
+
+	t.Run("limit smaller than the hot set", func(t *testing.T) {
+		cache.Reset()
+		pf.Prefill(context.Background(), 2)
+		assert.Equal(t, map[uint64]struct{}{
+			42: {},
+			43: {},
+		}, cache.store)
+	})
+}
+
+func TestVectorCacheHotSetPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.hnsw.hotset")
+	entries := []vcache.HotEntry{
+		{ID: 3, Frequency: 255},
+		{ID: 1, Frequency: 17},
+		{ID: 1 << 40, Frequency: 1},
+	}
+
+	require.NoError(t, writeHotSet(path, entries))
+	read, err := readHotSet(path)
+	require.NoError(t, err)
+	assert.Equal(t, entries, read)
+
+	t.Run("corrupt file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("nope, not a hot set"), 0o644))
+		_, err := readHotSet(path)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := readHotSet(filepath.Join(t.TempDir(), "missing"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestVectorCacheHotSetWarmup(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(200, 0, 8)
+
+	for _, tc := range []struct {
+		name string
+		bq   bool
+	}{
+		{name: "uncompressed"},
+		{name: "bq compressed", bq: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := testinghelpers.NewDummyStore(t)
+			cfg := indexConfig("hotset", t.TempDir(), logger, vectors, distancer.NewL2SquaredProvider())
+			cfg.VectorCacheAdmission = true
+			cfg.WaitForCachePrefill = true
+			uc := ent.UserConfig{
+				MaxConnections:        16,
+				EFConstruction:        32,
+				EF:                    32,
+				VectorCacheMaxObjects: 50,
+				BQ:                    ent.BQConfig{Enabled: tc.bq},
+			}
+
+			index, err := New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), store)
+			require.NoError(t, err)
+			for i, vec := range vectors {
+				require.NoError(t, index.Add(ctx, uint64(i), vec))
+			}
+			require.Equal(t, tc.bq, index.compressed.Load())
+
+			tracker, limit, ok := index.frequencyTracker()
+			require.True(t, ok)
+			require.Equal(t, 50, limit)
+			// building the graph accesses the vectors
+			persisted := tracker.HotSet(limit)
+			require.NotEmpty(t, persisted)
+			require.NoError(t, index.Flush())
+			require.NoError(t, index.Shutdown(ctx))
+			require.FileExists(t, index.hotSetPath())
+			store.FlushMemtables(ctx)
+
+			index, err = New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), store)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = index.Shutdown(context.Background()) })
+			index.PostStartup(ctx)
+
+			tracker, _, ok = index.frequencyTracker()
+			require.True(t, ok)
+			restored := map[uint64]uint32{}
+			for _, e := range tracker.HotSet(limit) {
+				restored[e.ID] = e.Frequency
+			}
+			// warming up the cache must not count as accesses
+			for _, e := range persisted {
+				assert.Equal(t, e.Frequency, restored[e.ID], "frequency of %d", e.ID)
+			}
+		})
+	}
+}
+
 func newFakeCache() *fakeCache {
 	return &fakeCache{
 		store: map[uint64]struct{}{},
@@ -153,7 +297,7 @@ func (f *fakeCache) Len() int32 {
 }
 
 func (f *fakeCache) CountVectors() int64 {
-	panic("not implemented")
+	return int64(len(f.store))
 }
 
 func (f *fakeCache) GetKeys(id uint64) (uint64, uint64) {
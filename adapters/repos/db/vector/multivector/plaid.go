@@ -0,0 +1,692 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/sroar"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/kmeans"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	plaidEntryRaw     byte = 0
+	plaidEntryEncoded byte = 1
+)
+
+var plaidCodebookKey = []byte("plaid_codebook")
+
+// PlaidDocsBucket holds one entry per document: the raw token vectors while
+// the codebook is not trained yet, the centroid ids and quantized residuals
+// afterwards. The trained codebook is stored in the same bucket.
+func PlaidDocsBucket(id string) string {
+	return id + "_plaid_docs"
+}
+
+// PlaidPostingsBucket maps every centroid to the documents that have at least
+// one token assigned to it.
+func PlaidPostingsBucket(id string) string {
+	return id + "_plaid_postings"
+}
+
+// PlaidIndex is a token-level late-interaction index in the spirit of PLAID.
+// Token vectors are compressed to a k-means centroid plus a scalar quantized
+// residual. A query probes the closest centroids of each query token, ranks the
+// documents found in their posting lists by the MaxSim over the decompressed
+// tokens and hands the best candidates to the caller for an exact MaxSim on the
+// original vectors.
+//
+// Until TrainingLimit tokens have been added, the codebook can not be trained
+// and documents are kept uncompressed. Searches then consider every document.
+type PlaidIndex struct {
+	sync.RWMutex
+
+	docs              *lsmkv.Bucket
+	postings          *lsmkv.Bucket
+	distancerProvider distancer.Provider
+	logger            logrus.FieldLogger
+
+	centroids     int
+	residualBits  int
+	trainingLimit int
+	nprobe        atomic.Int64
+	rescoreLimit  atomic.Int64
+
+	dims          int
+	codebook      *plaidCodebook
+	pendingTokens int
+}
+
+type plaidCodebook struct {
+	centroids    [][]float32
+	residualMin  []float32
+	residualStep []float32
+	bits         int
+}
+
+func NewPlaidIndex(config ent.PlaidConfig, docs, postings *lsmkv.Bucket,
+	distancerProvider distancer.Provider, logger logrus.FieldLogger,
+) (*PlaidIndex, error) {
+	p := &PlaidIndex{
+		docs:              docs,
+		postings:          postings,
+		distancerProvider: distancerProvider,
+		logger:            logger,
+		centroids:         config.Centroids,
+		residualBits:      config.ResidualBits,
+		trainingLimit:     config.TrainingLimit,
+	}
+	p.UpdateSearchConfig(config)
+
+	raw, err := docs.Get(plaidCodebookKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "load plaid codebook")
+	}
+	if raw != nil {
+		codebook, err := decodePlaidCodebook(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode plaid codebook")
+		}
+		p.codebook = codebook
+		p.dims = len(codebook.residualMin)
+		return p, nil
+	}
+
+	// not trained yet, restore the number of buffered tokens so that training
+	// kicks in at the same point as without the restart
+	err = p.iterateRaw(func(docID uint64, tokens [][]float32) error {
+		p.pendingTokens += len(tokens)
+		if p.dims == 0 && len(tokens) > 0 {
+			p.dims = len(tokens[0])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "count buffered plaid tokens")
+	}
+	return p, nil
+}
+
+// UpdateSearchConfig applies the mutable search-time parameters.
+func (p *PlaidIndex) UpdateSearchConfig(config ent.PlaidConfig) {
+	p.nprobe.Store(int64(config.NProbe))
+	p.rescoreLimit.Store(int64(config.RescoreLimit))
+}
+
+func (p *PlaidIndex) Dimensions() int {
+	p.RLock()
+	defer p.RUnlock()
+	return p.dims
+}
+
+func (p *PlaidIndex) Trained() bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.codebook != nil
+}
+
+func (p *PlaidIndex) ContainsDoc(docID uint64) bool {
+	entry, err := p.docs.Get(plaidDocKey(docID))
+	return err == nil && entry != nil
+}
+
+// Iterate calls fn for every indexed document until fn returns false.
+func (p *PlaidIndex) Iterate(fn func(docID uint64) bool) {
+	c := p.docs.Cursor()
+	defer c.Close()
+
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if len(k) != 8 {
+			continue
+		}
+		if !fn(binary.BigEndian.Uint64(k)) {
+			return
+		}
+	}
+}
+
+func (p *PlaidIndex) Add(docID uint64, tokens [][]float32) error {
+	if len(tokens) == 0 {
+		return fmt.Errorf("plaid: document %d has no token vectors", docID)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.dims == 0 {
+		p.dims = len(tokens[0])
+	}
+	for i := range tokens {
+		if len(tokens[i]) != p.dims {
+			return fmt.Errorf("plaid: token %d of document %d has %d dimensions, expected %d",
+				i, docID, len(tokens[i]), p.dims)
+		}
+	}
+
+	if err := p.deleteLocked(docID); err != nil {
+		return err
+	}
+
+	if p.codebook != nil {
+		return p.putEncodedLocked(docID, tokens)
+	}
+
+	if err := p.docs.Put(plaidDocKey(docID), encodePlaidRaw(tokens)); err != nil {
+		return errors.Wrap(err, "put raw plaid document")
+	}
+	p.pendingTokens += len(tokens)
+	if p.pendingTokens >= p.trainingLimit {
+		return p.trainLocked()
+	}
+	return nil
+}
+
+func (p *PlaidIndex) Delete(docIDs ...uint64) error {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, docID := range docIDs {
+		if err := p.deleteLocked(docID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *PlaidIndex) deleteLocked(docID uint64) error {
+	key := plaidDocKey(docID)
+	entry, err := p.docs.Get(key)
+	if err != nil {
+		return errors.Wrapf(err, "get plaid document %d", docID)
+	}
+	if entry == nil {
+		return nil
+	}
+
+	switch entry[0] {
+	case plaidEntryRaw:
+		tokens, err := decodePlaidRaw(entry)
+		if err != nil {
+			return err
+		}
+		p.pendingTokens -= len(tokens)
+	case plaidEntryEncoded:
+		codes, err := p.codebook.centroidIDs(entry)
+		if err != nil {
+			return err
+		}
+		for _, c := range uniqueCentroids(codes) {
+			if err := p.postings.RoaringSetRemoveOne(plaidCentroidKey(c), docID); err != nil {
+				return errors.Wrapf(err, "remove document %d from plaid centroid %d", docID, c)
+			}
+		}
+	}
+
+	return p.docs.Delete(key)
+}
+
+func (p *PlaidIndex) putEncodedLocked(docID uint64, tokens [][]float32) error {
+	entry, codes := p.codebook.encode(tokens)
+	if err := p.docs.Put(plaidDocKey(docID), entry); err != nil {
+		return errors.Wrap(err, "put encoded plaid document")
+	}
+	for _, c := range uniqueCentroids(codes) {
+		if err := p.postings.RoaringSetAddOne(plaidCentroidKey(c), docID); err != nil {
+			return errors.Wrapf(err, "add document %d to plaid centroid %d", docID, c)
+		}
+	}
+	return nil
+}
+
+// trainLocked fits the centroids and residual quantizer on the buffered raw
+// documents and re-encodes all of them.
+func (p *PlaidIndex) trainLocked() error {
+	docIDs := []uint64{}
+	docTokens := [][][]float32{}
+	data := make([][]float32, 0, p.pendingTokens)
+	err := p.iterateRaw(func(docID uint64, tokens [][]float32) error {
+		docIDs = append(docIDs, docID)
+		docTokens = append(docTokens, tokens)
+		data = append(data, tokens...)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "collect plaid training data")
+	}
+
+	k := min(p.centroids, len(data))
+	km := kmeans.New(k, p.dims, 0)
+	if err := km.Fit(data); err != nil {
+		return errors.Wrap(err, "fit plaid centroids")
+	}
+	codebook := newPlaidCodebook(km.Centers, data, p.residualBits)
+
+	if err := p.docs.Put(plaidCodebookKey, codebook.marshal()); err != nil {
+		return errors.Wrap(err, "persist plaid codebook")
+	}
+	p.codebook = codebook
+
+	for i, docID := range docIDs {
+		if err := p.putEncodedLocked(docID, docTokens[i]); err != nil {
+			return err
+		}
+	}
+	p.pendingTokens = 0
+
+	p.logger.WithFields(logrus.Fields{
+		"action":    "plaid_train",
+		"centroids": k,
+		"tokens":    len(data),
+		"documents": len(docIDs),
+	}).Info("trained plaid codebook")
+	return nil
+}
+
+func (p *PlaidIndex) iterateRaw(fn func(docID uint64, tokens [][]float32) error) error {
+	c := p.docs.Cursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v) == 0 || v[0] != plaidEntryRaw {
+			continue
+		}
+		tokens, err := decodePlaidRaw(v)
+		if err != nil {
+			return err
+		}
+		if err := fn(binary.BigEndian.Uint64(k), tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Candidates returns the documents that should be rescored with an exact
+// MaxSim for the given query. At most max(k, rescoreLimit) ids are returned.
+func (p *PlaidIndex) Candidates(ctx context.Context, query [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, error) {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.codebook == nil {
+		ids := []uint64{}
+		err := p.iterateRaw(func(docID uint64, _ [][]float32) error {
+			if allowList == nil || allowList.Contains(docID) {
+				ids = append(ids, docID)
+			}
+			return ctx.Err()
+		})
+		return ids, err
+	}
+
+	candidates, err := p.probe(query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := max(k, int(p.rescoreLimit.Load()))
+	heap := priorityqueue.NewMax[any](limit)
+	distancers := make([]distancer.Distancer, len(query))
+	for i := range query {
+		distancers[i] = p.distancerProvider.New(query[i])
+	}
+
+	for _, docID := range candidates.ToArray() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if allowList != nil && !allowList.Contains(docID) {
+			continue
+		}
+		entry, err := p.docs.Get(plaidDocKey(docID))
+		if err != nil {
+			return nil, errors.Wrapf(err, "get plaid document %d", docID)
+		}
+		if entry == nil || entry[0] != plaidEntryEncoded {
+			continue
+		}
+		tokens, err := p.codebook.decode(entry)
+		if err != nil {
+			return nil, err
+		}
+		dist, err := maxSimDistance(distancers, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if heap.Len() < limit || dist < heap.Top().Dist {
+			heap.Insert(docID, dist)
+			if heap.Len() > limit {
+				heap.Pop()
+			}
+		}
+	}
+
+	ids := make([]uint64, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		ids[i] = heap.Pop().ID
+	}
+	return ids, nil
+}
+
+// probe returns the union of the posting lists of the nprobe closest
+// centroids of every query token.
+func (p *PlaidIndex) probe(query [][]float32) (*sroar.Bitmap, error) {
+	nprobe := min(int(p.nprobe.Load()), len(p.codebook.centroids))
+	probed := map[uint16]struct{}{}
+	for _, q := range query {
+		d := p.distancerProvider.New(q)
+		closest := priorityqueue.NewMax[any](nprobe)
+		for c, centroid := range p.codebook.centroids {
+			dist, err := d.Distance(centroid)
+			if err != nil {
+				return nil, errors.Wrap(err, "distance to plaid centroid")
+			}
+			closest.Insert(uint64(c), dist)
+			if closest.Len() > nprobe {
+				closest.Pop()
+			}
+		}
+		for closest.Len() > 0 {
+			probed[uint16(closest.Pop().ID)] = struct{}{}
+		}
+	}
+
+	candidates := sroar.NewBitmap()
+	for c := range probed {
+		bm, release, err := p.postings.RoaringSetGet(plaidCentroidKey(c))
+		if err != nil {
+			return nil, errors.Wrapf(err, "get plaid posting list of centroid %d", c)
+		}
+		candidates.Or(bm)
+		release()
+	}
+	return candidates, nil
+}
+
+func maxSimDistance(query []distancer.Distancer, doc [][]float32) (float32, error) {
+	sum := float32(0)
+	for _, d := range query {
+		best := float32(math.MaxFloat32)
+		for _, token := range doc {
+			dist, err := d.Distance(token)
+			if err != nil {
+				return 0, err
+			}
+			if dist < best {
+				best = dist
+			}
+		}
+		sum += best
+	}
+	return sum, nil
+}
+
+func newPlaidCodebook(centroids [][]float32, data [][]float32, bits int) *plaidCodebook {
+	dims := len(centroids[0])
+	cb := &plaidCodebook{
+		centroids:    centroids,
+		residualMin:  make([]float32, dims),
+		residualStep: make([]float32, dims),
+		bits:         bits,
+	}
+
+	residualMax := make([]float32, dims)
+	for d := range dims {
+		cb.residualMin[d] = math.MaxFloat32
+		residualMax[d] = -math.MaxFloat32
+	}
+	for _, x := range data {
+		c := cb.nearest(x)
+		for d := range dims {
+			r := x[d] - centroids[c][d]
+			cb.residualMin[d] = min(cb.residualMin[d], r)
+			residualMax[d] = max(residualMax[d], r)
+		}
+	}
+
+	levels := float32(int(1)<<bits - 1)
+	for d := range dims {
+		cb.residualStep[d] = (residualMax[d] - cb.residualMin[d]) / levels
+	}
+	return cb
+}
+
+// nearest assigns with the squared euclidean distance, the metric the
+// centroids were fitted with.
+func (cb *plaidCodebook) nearest(x []float32) uint16 {
+	best, bestDist := 0, float32(math.MaxFloat32)
+	for c, centroid := range cb.centroids {
+		dist := float32(0)
+		for d := range x {
+			diff := x[d] - centroid[d]
+			dist += diff * diff
+		}
+		if dist < bestDist {
+			best, bestDist = c, dist
+		}
+	}
+	return uint16(best)
+}
+
+func (cb *plaidCodebook) residualBytes() int {
+	return (len(cb.residualMin)*cb.bits + 7) / 8
+}
+
+// encode layout: kind (1) | token count (4) | per token: centroid (2) and the
+// packed residual codes.
+func (cb *plaidCodebook) encode(tokens [][]float32) ([]byte, []uint16) {
+	dims := len(cb.residualMin)
+	levels := int(1)<<cb.bits - 1
+	tokenSize := 2 + cb.residualBytes()
+	out := make([]byte, 5+len(tokens)*tokenSize)
+	out[0] = plaidEntryEncoded
+	binary.LittleEndian.PutUint32(out[1:5], uint32(len(tokens)))
+
+	codes := make([]uint16, len(tokens))
+	for i, x := range tokens {
+		c := cb.nearest(x)
+		codes[i] = c
+		pos := 5 + i*tokenSize
+		binary.LittleEndian.PutUint16(out[pos:pos+2], c)
+		packed := out[pos+2 : pos+tokenSize]
+		for d := range dims {
+			q := 0
+			if cb.residualStep[d] > 0 {
+				r := x[d] - cb.centroids[c][d]
+				q = int(math.Round(float64((r - cb.residualMin[d]) / cb.residualStep[d])))
+				q = max(0, min(levels, q))
+			}
+			bit := d * cb.bits
+			packed[bit/8] |= byte(q) << (bit % 8)
+		}
+	}
+	return out, codes
+}
+
+func (cb *plaidCodebook) decode(entry []byte) ([][]float32, error) {
+	n, tokenSize, err := cb.checkEncoded(entry)
+	if err != nil {
+		return nil, err
+	}
+	dims := len(cb.residualMin)
+	mask := byte(int(1)<<cb.bits - 1)
+	tokens := make([][]float32, n)
+	for i := range tokens {
+		pos := 5 + i*tokenSize
+		c := binary.LittleEndian.Uint16(entry[pos : pos+2])
+		if int(c) >= len(cb.centroids) {
+			return nil, fmt.Errorf("plaid: centroid %d out of range", c)
+		}
+		packed := entry[pos+2 : pos+tokenSize]
+		token := make([]float32, dims)
+		for d := range dims {
+			bit := d * cb.bits
+			q := (packed[bit/8] >> (bit % 8)) & mask
+			token[d] = cb.centroids[c][d] + cb.residualMin[d] + float32(q)*cb.residualStep[d]
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+func (cb *plaidCodebook) centroidIDs(entry []byte) ([]uint16, error) {
+	n, tokenSize, err := cb.checkEncoded(entry)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]uint16, n)
+	for i := range codes {
+		pos := 5 + i*tokenSize
+		codes[i] = binary.LittleEndian.Uint16(entry[pos : pos+2])
+	}
+	return codes, nil
+}
+
+func (cb *plaidCodebook) checkEncoded(entry []byte) (int, int, error) {
+	if len(entry) < 5 || entry[0] != plaidEntryEncoded {
+		return 0, 0, fmt.Errorf("plaid: not an encoded document entry")
+	}
+	n := int(binary.LittleEndian.Uint32(entry[1:5]))
+	tokenSize := 2 + cb.residualBytes()
+	if len(entry) != 5+n*tokenSize {
+		return 0, 0, fmt.Errorf("plaid: encoded entry has %d bytes, expected %d", len(entry), 5+n*tokenSize)
+	}
+	return n, tokenSize, nil
+}
+
+// marshal layout: centroid count (4) | dims (4) | bits (1) | centroids |
+// residual min | residual step, all float32 little endian.
+func (cb *plaidCodebook) marshal() []byte {
+	k, dims := len(cb.centroids), len(cb.residualMin)
+	out := make([]byte, 9+4*(k*dims+2*dims))
+	binary.LittleEndian.PutUint32(out[0:4], uint32(k))
+	binary.LittleEndian.PutUint32(out[4:8], uint32(dims))
+	out[8] = byte(cb.bits)
+	pos := 9
+	put := func(v []float32) {
+		for _, f := range v {
+			binary.LittleEndian.PutUint32(out[pos:pos+4], math.Float32bits(f))
+			pos += 4
+		}
+	}
+	for _, c := range cb.centroids {
+		put(c)
+	}
+	put(cb.residualMin)
+	put(cb.residualStep)
+	return out
+}
+
+func decodePlaidCodebook(in []byte) (*plaidCodebook, error) {
+	if len(in) < 9 {
+		return nil, fmt.Errorf("plaid codebook too short: %d bytes", len(in))
+	}
+	k := int(binary.LittleEndian.Uint32(in[0:4]))
+	dims := int(binary.LittleEndian.Uint32(in[4:8]))
+	if len(in) != 9+4*(k*dims+2*dims) {
+		return nil, fmt.Errorf("plaid codebook has %d bytes, expected %d", len(in), 9+4*(k*dims+2*dims))
+	}
+	pos := 9
+	read := func() []float32 {
+		v := make([]float32, dims)
+		for d := range v {
+			v[d] = math.Float32frombits(binary.LittleEndian.Uint32(in[pos : pos+4]))
+			pos += 4
+		}
+		return v
+	}
+	cb := &plaidCodebook{centroids: make([][]float32, k), bits: int(in[8])}
+	for c := range cb.centroids {
+		cb.centroids[c] = read()
+	}
+	cb.residualMin = read()
+	cb.residualStep = read()
+	return cb, nil
+}
+
+// encodePlaidRaw layout: kind (1) | token count (4) | dims (4) | float32
+// values little endian.
+func encodePlaidRaw(tokens [][]float32) []byte {
+	dims := len(tokens[0])
+	out := make([]byte, 9+4*len(tokens)*dims)
+	out[0] = plaidEntryRaw
+	binary.LittleEndian.PutUint32(out[1:5], uint32(len(tokens)))
+	binary.LittleEndian.PutUint32(out[5:9], uint32(dims))
+	pos := 9
+	for _, token := range tokens {
+		for _, f := range token {
+			binary.LittleEndian.PutUint32(out[pos:pos+4], math.Float32bits(f))
+			pos += 4
+		}
+	}
+	return out
+}
+
+func decodePlaidRaw(in []byte) ([][]float32, error) {
+	if len(in) < 9 || in[0] != plaidEntryRaw {
+		return nil, fmt.Errorf("plaid: not a raw document entry")
+	}
+	n := int(binary.LittleEndian.Uint32(in[1:5]))
+	dims := int(binary.LittleEndian.Uint32(in[5:9]))
+	if len(in) != 9+4*n*dims {
+		return nil, fmt.Errorf("plaid: raw entry has %d bytes, expected %d", len(in), 9+4*n*dims)
+	}
+	tokens := make([][]float32, n)
+	pos := 9
+	for i := range tokens {
+		tokens[i] = make([]float32, dims)
+		for d := range tokens[i] {
+			tokens[i][d] = math.Float32frombits(binary.LittleEndian.Uint32(in[pos : pos+4]))
+			pos += 4
+		}
+	}
+	return tokens, nil
+}
+
+func uniqueCentroids(codes []uint16) []uint16 {
+	seen := make(map[uint16]struct{}, len(codes))
+	out := make([]uint16, 0, len(codes))
+	for _, c := range codes {
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		out = append(out, c)
+	}
+	return out
+}
+
+func plaidDocKey(docID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	return key
+}
+
+func plaidCentroidKey(c uint16) []byte {
+	key := make([]byte, 2)
+	binary.BigEndian.PutUint16(key, c)
+	return key
+}
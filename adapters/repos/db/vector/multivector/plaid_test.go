@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func randomTokens(r *rand.Rand, n, dims int) [][]float32 {
+	tokens := make([][]float32, n)
+	for i := range tokens {
+		tokens[i] = make([]float32, dims)
+		for d := range tokens[i] {
+			tokens[i][d] = r.Float32()*2 - 1
+		}
+		tokens[i] = distancer.Normalize(tokens[i])
+	}
+	return tokens
+}
+
+func TestPlaidCodebook(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	data := randomTokens(r, 500, 16)
+	centroids := data[:8]
+
+	for _, bits := range []int{1, 2, 4, 8} {
+		cb := newPlaidCodebook(centroids, data, bits)
+
+		entry, codes := cb.encode(data[:20])
+		require.Len(t, codes, 20)
+		decoded, err := cb.decode(entry)
+		require.Nil(t, err)
+		require.Len(t, decoded, 20)
+
+		ids, err := cb.centroidIDs(entry)
+		require.Nil(t, err)
+		assert.Equal(t, codes, ids)
+
+		for i := range decoded {
+			for d := range decoded[i] {
+				// rounding to the closest level is off by at most half a step
+				assert.InDelta(t, data[i][d], decoded[i][d], float64(cb.residualStep[d])/2+1e-5)
+			}
+		}
+
+		restored, err := decodePlaidCodebook(cb.marshal())
+		require.Nil(t, err)
+		assert.Equal(t, cb, restored)
+	}
+}
+
+func TestPlaidIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+	require.Nil(t, store.CreateOrLoadBucket(ctx, PlaidDocsBucket("test"), lsmkv.WithStrategy(lsmkv.StrategyReplace)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, PlaidPostingsBucket("test"), lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+
+	config := ent.PlaidConfig{
+		Enabled:       true,
+		Centroids:     32,
+		NProbe:        8,
+		ResidualBits:  8,
+		TrainingLimit: 500,
+		RescoreLimit:  50,
+	}
+	newIndex := func() *PlaidIndex {
+		p, err := NewPlaidIndex(config, store.Bucket(PlaidDocsBucket("test")),
+			store.Bucket(PlaidPostingsBucket("test")), distancer.NewDotProductProvider(), logger)
+		require.Nil(t, err)
+		return p
+	}
+
+	r := rand.New(rand.NewPCG(3, 4))
+	docs := make([][][]float32, 200)
+	for i := range docs {
+		docs[i] = randomTokens(r, 8, 32)
+	}
+
+	p := newIndex()
+	for i := range docs {
+		require.Nil(t, p.Add(uint64(i), docs[i]))
+		if i == 10 {
+			assert.False(t, p.Trained())
+		}
+	}
+	require.True(t, p.Trained())
+	assert.Equal(t, 32, p.Dimensions())
+
+	t.Run("candidates contain the exact top k", func(t *testing.T) {
+		k := 10
+		var found, total int
+		for q := 0; q < 10; q++ {
+			query := randomTokens(r, 4, 32)
+			truth := bruteForceMaxSim(t, query, docs, k)
+			candidates, err := p.Candidates(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.LessOrEqual(t, len(candidates), config.RescoreLimit)
+
+			set := map[uint64]struct{}{}
+			for _, id := range candidates {
+				set[id] = struct{}{}
+			}
+			for _, id := range truth {
+				if _, ok := set[id]; ok {
+					found++
+				}
+				total++
+			}
+		}
+		assert.GreaterOrEqual(t, float64(found)/float64(total), 0.9)
+	})
+
+	t.Run("allow list and delete", func(t *testing.T) {
+		query := docs[7][:2]
+		candidates, err := p.Candidates(ctx, query, 5, helpers.NewAllowList(3, 7, 9))
+		require.Nil(t, err)
+		assert.Subset(t, []uint64{3, 7, 9}, candidates)
+		assert.Contains(t, candidates, uint64(7))
+
+		require.Nil(t, p.Delete(7))
+		assert.False(t, p.ContainsDoc(7))
+		candidates, err = p.Candidates(ctx, query, 5, helpers.NewAllowList(3, 7, 9))
+		require.Nil(t, err)
+		assert.NotContains(t, candidates, uint64(7))
+	})
+
+	t.Run("codebook survives a restart", func(t *testing.T) {
+		restarted := newIndex()
+		assert.True(t, restarted.Trained())
+		assert.Equal(t, p.codebook, restarted.codebook)
+
+		count := 0
+		restarted.Iterate(func(docID uint64) bool {
+			count++
+			return true
+		})
+		assert.Equal(t, len(docs)-1, count)
+	})
+}
+
+func bruteForceMaxSim(t *testing.T, query [][]float32, docs [][][]float32, k int) []uint64 {
+	distancers := make([]distancer.Distancer, len(query))
+	for i := range query {
+		distancers[i] = distancer.NewDotProductProvider().New(query[i])
+	}
+	type scored struct {
+		id   uint64
+		dist float32
+	}
+	all := make([]scored, len(docs))
+	for i := range docs {
+		dist, err := maxSimDistance(distancers, docs[i])
+		require.Nil(t, err)
+		all[i] = scored{uint64(i), dist}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].dist < all[j].dist })
+	ids := make([]uint64, k)
+	for i := range ids {
+		ids[i] = all[i].id
+	}
+	return ids
+}
@@ -73,6 +73,10 @@ func (i *Index) SearchByVector(ctx context.Context, vector []float32, k int, all
 	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
 }
 
+func (i *Index) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error) {
+	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
+}
+
 func (i *Index) SearchByMultiVector(ctx context.Context, vector [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
 }
@@ -28,6 +28,9 @@ type VectorIndex interface {
 	AddBatch(ctx context.Context, ids []uint64, vector [][]float32) error
 	Delete(id ...uint64) error
 	SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	// SearchByVectorBatch runs one search per vector with the same allow list
+	// and returns the results in the order of the vectors.
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error)
 	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
 	UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error
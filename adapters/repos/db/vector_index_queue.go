@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/dto"
@@ -52,6 +54,9 @@ type VectorIndexQueue struct {
 	batchSize int
 
 	vectorIndex VectorIndex
+	// optionally compares a sample of live queries against a brute-force
+	// search, nil if recall sampling is disabled.
+	recall *recallSampler
 }
 
 func NewVectorIndexQueue(
@@ -78,6 +83,10 @@ func NewVectorIndexQueue(
 
 	viq.metrics = NewVectorIndexQueueMetrics(logger, shard.promMetrics, shard.index.Config.ClassName.String(), shard.Name(), targetVector)
 
+	if err := viq.initRecallSampler(logger, targetVector); err != nil {
+		return nil, errors.Wrap(err, "failed to init recall sampler")
+	}
+
 	q, err := queue.NewDiskQueue(
 		queue.DiskQueueOptions{
 			ID:        fmt.Sprintf("vector_index_queue_%s_%s", shard.ID(), shard.vectorIndexID(targetVector)),
@@ -243,7 +252,7 @@ func (iq *VectorIndexQueue) Flush() error {
 }
 
 func (iq *VectorIndexQueue) BeforeSchedule() (skip bool) {
-	return iq.checkCompressionSettings()
+	return iq.checkCompressionSettings() || iq.checkDowngrade()
 }
 
 // Flush the vector index after a batch is processed.
@@ -288,6 +297,73 @@ func (iq *VectorIndexQueue) checkCompressionSettings() (skip bool) {
 	return false
 }
 
+type downgradableIndexer interface {
+	ShouldDowngrade() bool
+	Downgrade(callback func()) error
+}
+
+// converts a dynamic index back to flat once it shrunk below its
+// downgrade threshold
+func (iq *VectorIndexQueue) checkDowngrade() (skip bool) {
+	di, ok := iq.vectorIndex.(downgradableIndexer)
+	if !ok || !di.ShouldDowngrade() {
+		return false
+	}
+
+	iq.scheduler.PauseQueue(iq.DiskQueue.ID())
+
+	err := di.Downgrade(func() {
+		iq.scheduler.ResumeQueue(iq.DiskQueue.ID())
+	})
+	if err != nil {
+		iq.DiskQueue.Logger.WithError(err).Error("failed to downgrade vector index")
+	}
+
+	return true
+}
+
+func (iq *VectorIndexQueue) initRecallSampler(logger logrus.FieldLogger, targetVector string) error {
+	cfg := iq.shard.index.Config.RecallSampling
+	if cfg.Rate <= 0 {
+		return nil
+	}
+
+	vectorIndexConfig := iq.shard.index.GetVectorIndexConfig(targetVector)
+	if vectorIndexConfig == nil {
+		return nil
+	}
+	distProv, err := distanceProviderFor(vectorIndexConfig)
+	if err != nil {
+		return err
+	}
+
+	metrics, err := newRecallSamplerMetrics(iq.shard.promMetrics, iq.shard.index.Config.ClassName.String(), targetVector)
+	if err != nil {
+		return err
+	}
+
+	iq.recall = newRecallSampler(logger, cfg, metrics, distProv)
+	iq.recall.index = func() VectorIndex { return iq.vectorIndex }
+	iq.recall.getView = iq.shard.GetObjectsBucketView
+	iq.recall.vectorForID = func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error) {
+		return iq.shard.readVectorByIndexIDIntoSliceWithView(ctx, id, container, targetVector, view)
+	}
+	return nil
+}
+
+// SampleRecall hands the results of a vector search to the recall sampler,
+// which may decide to compare them against a brute-force search in the
+// background. It is a no-op if recall sampling is disabled.
+func (iq *VectorIndexQueue) SampleRecall(query []float32, k int, allow helpers.AllowList,
+	ids []uint64, dists []float32,
+) {
+	if iq == nil || iq.recall == nil {
+		return
+	}
+
+	iq.recall.Sample(query, k, allow, ids, dists)
+}
+
 // ResetWith resets the queue with the given vector index.
 // The queue must be paused before calling this method.
 func (iq *VectorIndexQueue) ResetWith(vidx VectorIndex) {
@@ -0,0 +1,367 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	entcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// recallSampler re-runs a fraction of the live queries of a vector index
+// against an exact brute-force scan of the same shard and records the
+// observed recall@k and distance error.
+//
+// The sampler is disabled unless RECALL_SAMPLING_RATE is set to a value in
+// (0, 1]. The scan reads the uncompressed vectors from the objects bucket, so
+// the ground truth is independent of the index type and its compression.
+type recallSampler struct {
+	logger  logrus.FieldLogger
+	rate    float64
+	timeout time.Duration
+	slots   chan struct{}
+	metrics *recallSamplerMetrics
+
+	distancer distancer.Provider
+	// index returns the vector index currently served by the queue. It is a
+	// function because the queue may be reset with a different index.
+	index func() VectorIndex
+	// vectorForID returns the uncompressed vector stored for a doc id.
+	vectorForID func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error)
+	getView     func() common.BucketView
+}
+
+func newRecallSampler(logger logrus.FieldLogger, cfg config.RecallSampling,
+	metrics *recallSamplerMetrics, distProv distancer.Provider,
+) *recallSampler {
+	if cfg.Rate <= 0 || distProv == nil {
+		return nil
+	}
+
+	return &recallSampler{
+		logger:    logger.WithField("action", "recall_sampling"),
+		rate:      cfg.Rate,
+		timeout:   cfg.Timeout,
+		slots:     make(chan struct{}, cfg.Concurrency),
+		metrics:   metrics,
+		distancer: distProv,
+	}
+}
+
+// Sample decides whether the query should be sampled and if so, computes the
+// ground truth in the background. The allow list is copied, the caller may
+// release it as soon as Sample returns.
+func (r *recallSampler) Sample(query []float32, k int, allow helpers.AllowList,
+	ids []uint64, dists []float32,
+) {
+	if r == nil || k <= 0 || len(query) == 0 {
+		return
+	}
+
+	if rand.Float64() >= r.rate {
+		return
+	}
+
+	select {
+	case r.slots <- struct{}{}:
+	default:
+		r.metrics.dropped()
+		return
+	}
+
+	query = append([]float32(nil), query...)
+	ids = append([]uint64(nil), ids...)
+	dists = append([]float32(nil), dists...)
+	if allow != nil {
+		allow = allow.DeepCopy()
+	}
+
+	enterrors.GoWrapper(func() {
+		defer func() { <-r.slots }()
+		if allow != nil {
+			defer allow.Close()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		defer cancel()
+
+		if err := r.compare(ctx, query, k, allow, ids, dists); err != nil {
+			r.logger.WithError(err).Debug("recall sample failed")
+			r.metrics.failed()
+		}
+	}, r.logger)
+}
+
+func (r *recallSampler) compare(ctx context.Context, query []float32, k int,
+	allow helpers.AllowList, ids []uint64, dists []float32,
+) error {
+	start := time.Now()
+
+	exactIDs, exactDists, err := r.groundTruth(ctx, query, k, allow)
+	if err != nil {
+		return err
+	}
+
+	r.metrics.observe(recallAtK(ids, exactIDs, k), distanceError(dists, exactDists), time.Since(start))
+	return nil
+}
+
+// groundTruth returns the exact k nearest neighbors of query among the
+// documents currently present in the index, ordered by ascending distance.
+func (r *recallSampler) groundTruth(ctx context.Context, query []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	view := r.getView()
+	defer view.ReleaseView()
+
+	ids, dists, err := bruteForceSearch(ctx, r.index(), r.distancer, r.vectorForID, view,
+		[][]float32{query}, k, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ids[0], dists[0], nil
+}
+
+// bruteForceSearch scans all documents of the index once, reading their
+// uncompressed vectors through vectorForID, and returns the exact k nearest
+// neighbors of each query ordered by ascending distance. Documents that can
+// no longer be read, e.g. because they were deleted concurrently, are skipped.
+func bruteForceSearch(ctx context.Context, idx VectorIndex, distProv distancer.Provider,
+	vectorForID func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error),
+	view common.BucketView, queries [][]float32, k int, allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if len(queries) == 0 {
+		return nil, nil, nil
+	}
+
+	normalize := distProv.Type() == entcommon.DistanceCosine
+	dists := make([]distancer.Distancer, len(queries))
+	heaps := make([]*priorityqueue.Queue[any], len(queries))
+	for i, query := range queries {
+		if normalize {
+			query = distancer.Normalize(query)
+		}
+		dists[i] = distProv.New(query)
+		heaps[i] = priorityqueue.NewMax[any](k)
+	}
+	dims := len(queries[0])
+	container := &common.VectorSlice{Buff8: make([]byte, 8)}
+
+	var iterErr error
+	idx.Iterate(func(id uint64) bool {
+		if ctx.Err() != nil {
+			iterErr = ctx.Err()
+			return false
+		}
+		if allow != nil && !allow.Contains(id) {
+			return true
+		}
+
+		vec, err := vectorForID(ctx, id, container, view)
+		if err != nil || len(vec) != dims {
+			return true
+		}
+		if normalize {
+			vec = distancer.Normalize(vec)
+		}
+
+		for i, dist := range dists {
+			d, err := dist.Distance(vec)
+			if err != nil {
+				iterErr = err
+				return false
+			}
+
+			heap := heaps[i]
+			if heap.Len() < k || heap.Top().Dist > d {
+				heap.Insert(id, d)
+				if heap.Len() > k {
+					heap.Pop()
+				}
+			}
+		}
+		return true
+	})
+	if iterErr != nil {
+		return nil, nil, errors.Wrap(iterErr, "brute-force scan")
+	}
+
+	resIDs := make([][]uint64, len(queries))
+	resDists := make([][]float32, len(queries))
+	for i, heap := range heaps {
+		resIDs[i] = make([]uint64, heap.Len())
+		resDists[i] = make([]float32, heap.Len())
+		for j := len(resIDs[i]) - 1; j >= 0; j-- {
+			item := heap.Pop()
+			resIDs[i][j] = item.ID
+			resDists[i][j] = item.Dist
+		}
+	}
+
+	return resIDs, resDists, nil
+}
+
+// recallAtK returns the share of the exact top-k results that were also
+// returned by the approximate search. If the shard holds fewer than k
+// matching documents, the denominator shrinks accordingly.
+func recallAtK(approx, exact []uint64, k int) float64 {
+	if len(exact) > k {
+		exact = exact[:k]
+	}
+	if len(exact) == 0 {
+		return 1
+	}
+	if len(approx) > k {
+		approx = approx[:k]
+	}
+
+	found := make(map[uint64]struct{}, len(approx))
+	for _, id := range approx {
+		found[id] = struct{}{}
+	}
+
+	hits := 0
+	for _, id := range exact {
+		if _, ok := found[id]; ok {
+			hits++
+		}
+	}
+
+	return float64(hits) / float64(len(exact))
+}
+
+// distanceError returns the mean absolute difference between the distances
+// of the approximate and the exact results at the same rank. Missing ranks in
+// the approximate results are not counted, they are already reflected in the
+// recall.
+func distanceError(approx, exact []float32) float64 {
+	n := min(len(approx), len(exact))
+	if n == 0 {
+		return 0
+	}
+
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += math.Abs(float64(approx[i]) - float64(exact[i]))
+	}
+
+	return sum / float64(n)
+}
+
+type recallSamplerMetrics struct {
+	recall        prometheus.Observer
+	distanceError prometheus.Observer
+	duration      prometheus.Observer
+	droppedCount  prometheus.Counter
+	failedCount   prometheus.Counter
+}
+
+var recallBuckets = []float64{0.5, 0.6, 0.7, 0.8, 0.85, 0.9, 0.925, 0.95, 0.97, 0.98, 0.99, 0.995, 1}
+
+func newRecallSamplerMetrics(prom *monitoring.PrometheusMetrics, className, targetVector string) (*recallSamplerMetrics, error) {
+	if prom == nil {
+		return nil, nil
+	}
+
+	if prom.Group {
+		className = "n/a"
+		targetVector = "n/a"
+	}
+
+	labels := []string{"class_name", "target_vector"}
+
+	recall, _, err := monitoring.EnsureRegisteredMetric(prom.Registerer,
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_sampled_recall",
+			Help:    "Recall@k of sampled vector queries compared to an exact brute-force search",
+			Buckets: recallBuckets,
+		}, labels))
+	if err != nil {
+		return nil, errors.Wrap(err, "register vector_index_sampled_recall")
+	}
+
+	distErr, _, err := monitoring.EnsureRegisteredMetric(prom.Registerer,
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_sampled_distance_error",
+			Help:    "Mean absolute distance difference per rank of sampled vector queries compared to an exact brute-force search",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 9),
+		}, labels))
+	if err != nil {
+		return nil, errors.Wrap(err, "register vector_index_sampled_distance_error")
+	}
+
+	duration, _, err := monitoring.EnsureRegisteredMetric(prom.Registerer,
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "vector_index_sampled_ground_truth_duration_seconds",
+			Help:    "Duration of the brute-force scans used to compute the ground truth of sampled queries",
+			Buckets: monitoring.LatencyBuckets,
+		}, labels))
+	if err != nil {
+		return nil, errors.Wrap(err, "register vector_index_sampled_ground_truth_duration_seconds")
+	}
+
+	outcomes, _, err := monitoring.EnsureRegisteredMetric(prom.Registerer,
+		prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_sampled_queries_skipped_total",
+			Help: "Number of sampled vector queries that were not evaluated, either because all sampling slots were busy or because the scan failed",
+		}, append(labels, "reason")))
+	if err != nil {
+		return nil, errors.Wrap(err, "register vector_index_sampled_queries_skipped_total")
+	}
+
+	values := prometheus.Labels{"class_name": className, "target_vector": targetVector}
+	return &recallSamplerMetrics{
+		recall:        recall.With(values),
+		distanceError: distErr.With(values),
+		duration:      duration.With(values),
+		droppedCount:  outcomes.WithLabelValues(className, targetVector, "busy"),
+		failedCount:   outcomes.WithLabelValues(className, targetVector, "failed"),
+	}, nil
+}
+
+func (m *recallSamplerMetrics) observe(recall, distErr float64, took time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.recall.Observe(recall)
+	m.distanceError.Observe(distErr)
+	m.duration.Observe(took.Seconds())
+}
+
+func (m *recallSamplerMetrics) dropped() {
+	if m == nil {
+		return
+	}
+	m.droppedCount.Inc()
+}
+
+func (m *recallSamplerMetrics) failed() {
+	if m == nil {
+		return
+	}
+	m.failedCount.Inc()
+}
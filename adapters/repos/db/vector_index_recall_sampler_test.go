@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type noopBucketView struct{}
+
+func (noopBucketView) ReleaseView() {}
+
+func TestRecallAtK(t *testing.T) {
+	tests := []struct {
+		name     string
+		approx   []uint64
+		exact    []uint64
+		k        int
+		expected float64
+	}{
+		{name: "perfect", approx: []uint64{1, 2, 3}, exact: []uint64{3, 2, 1}, k: 3, expected: 1},
+		{name: "partial", approx: []uint64{1, 2, 4, 5}, exact: []uint64{1, 2, 3, 6}, k: 4, expected: 0.5},
+		{name: "none", approx: []uint64{7, 8}, exact: []uint64{1, 2}, k: 2, expected: 0},
+		{name: "fewer documents than k", approx: []uint64{1}, exact: []uint64{1}, k: 10, expected: 1},
+		{name: "empty ground truth", approx: nil, exact: nil, k: 10, expected: 1},
+		{name: "results beyond k are ignored", approx: []uint64{9, 1, 2}, exact: []uint64{1, 2, 9}, k: 2, expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, recallAtK(tt.approx, tt.exact, tt.k), 1e-9)
+		})
+	}
+}
+
+func TestDistanceError(t *testing.T) {
+	assert.Equal(t, float64(0), distanceError(nil, []float32{1}))
+	assert.InDelta(t, 0, distanceError([]float32{0.1, 0.2}, []float32{0.1, 0.2}), 1e-6)
+	assert.InDelta(t, 0.15, distanceError([]float32{0.2, 0.4}, []float32{0.1, 0.2}), 1e-6)
+	// missing ranks are not part of the error
+	assert.InDelta(t, 0.1, distanceError([]float32{0.2}, []float32{0.1, 0.2, 0.3}), 1e-6)
+}
+
+func TestRecallSamplerGroundTruth(t *testing.T) {
+	vectors := map[uint64][]float32{
+		0: {0, 0},
+		1: {1, 0},
+		2: {2, 0},
+		3: {3, 0},
+		4: {4, 0},
+	}
+
+	newSampler := func(t *testing.T) *recallSampler {
+		logger, _ := test.NewNullLogger()
+		r := newRecallSampler(logger, config.RecallSampling{Rate: 1, Concurrency: 1},
+			nil, distancer.NewL2SquaredProvider())
+		require.NotNil(t, r)
+
+		idx := NewMockVectorIndex(t)
+		idx.EXPECT().Iterate(mock.Anything).Run(func(fn func(uint64) bool) {
+			for id := uint64(0); id < 6; id++ {
+				if !fn(id) {
+					return
+				}
+			}
+		})
+		r.index = func() VectorIndex { return idx }
+		r.getView = func() common.BucketView { return noopBucketView{} }
+		r.vectorForID = func(ctx context.Context, id uint64, container *common.VectorSlice, view common.BucketView) ([]float32, error) {
+			vec, ok := vectors[id]
+			if !ok {
+				return nil, storobj.NewErrNotFoundf(id, "deleted")
+			}
+			return vec, nil
+		}
+		return r
+	}
+
+	t.Run("unfiltered", func(t *testing.T) {
+		ids, dists, err := newSampler(t).groundTruth(context.Background(), []float32{3.1, 0}, 3, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{3, 4, 2}, ids)
+		assert.InDeltaSlice(t, []float32{0.01, 0.81, 1.21}, dists, 1e-4)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		ids, _, err := newSampler(t).groundTruth(context.Background(), []float32{3.1, 0}, 2,
+			helpers.NewAllowList(0, 1, 5))
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 0}, ids)
+	})
+}
+
+func TestRecallSamplerDisabled(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	r := newRecallSampler(logger, config.RecallSampling{}, nil, distancer.NewL2SquaredProvider())
+	assert.Nil(t, r)
+	// sampling on a disabled sampler must not panic
+	r.Sample([]float32{1}, 1, nil, nil, nil)
+}
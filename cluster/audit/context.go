@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type principalKey struct{}
+
+func (principalKey) String() string {
+	return "audit_principal"
+}
+
+// ContextWithPrincipal attaches the principal issuing a command to ctx so that
+// it can be recorded in the audit log once the command is applied.
+func ContextWithPrincipal(ctx context.Context, principal *models.Principal) context.Context {
+	if principal == nil || principal.Username == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, principalKey{}, principal.Username)
+}
+
+// PrincipalFromContext returns the principal attached by ContextWithPrincipal or an empty string
+func PrincipalFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	p, _ := ctx.Value(principalKey{}).(string)
+	return p
+}
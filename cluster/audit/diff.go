@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Diff returns the field level changes between before and after. Both values are
+// compared in their JSON representation; lists whose items all carry a "name" are
+// matched by name so that reordering properties or tenants is not reported.
+// A nil side reports the other value as a whole.
+func Diff(before, after any) ([]Change, error) {
+	b, err := toGeneric(before)
+	if err != nil {
+		return nil, fmt.Errorf("before: %w", err)
+	}
+	a, err := toGeneric(after)
+	if err != nil {
+		return nil, fmt.Errorf("after: %w", err)
+	}
+
+	var changes []Change
+	diff("", b, a, &changes)
+	return changes, nil
+}
+
+func toGeneric(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	var raw []byte
+	switch v := v.(type) {
+	case json.RawMessage:
+		raw = v
+	default:
+		var err error
+		if raw, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	var res any
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func diff(path string, before, after any, changes *[]Change) {
+	if before == nil || after == nil {
+		if before != nil || after != nil {
+			*changes = append(*changes, Change{Path: path, Before: before, After: after})
+		}
+		return
+	}
+
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			for _, k := range unionKeys(b, a) {
+				diff(join(path, k), b[k], a[k], changes)
+			}
+			return
+		}
+	case []any:
+		if a, ok := after.([]any); ok {
+			bn, bok := byName(b)
+			an, aok := byName(a)
+			if bok && aok {
+				for _, k := range unionKeys(bn, an) {
+					diff(fmt.Sprintf("%s[%s]", path, k), bn[k], an[k], changes)
+				}
+				return
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, Change{Path: path, Before: before, After: after})
+	}
+}
+
+// byName indexes list items by their "name" field, it reports false if any item has none
+func byName(items []any) (map[string]any, bool) {
+	res := make(map[string]any, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		res[name] = m
+	}
+	return res, true
+}
+
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDiff(t *testing.T) {
+	before := &models.Class{
+		Class:       "Article",
+		Description: "old",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "body", DataType: []string{"text"}},
+		},
+	}
+	after := &models.Class{
+		Class:       "Article",
+		Description: "new",
+		Properties: []*models.Property{
+			{Name: "body", DataType: []string{"text"}},
+			{Name: "title", DataType: []string{"text"}, Description: "the title"},
+			{Name: "author", DataType: []string{"text"}},
+		},
+	}
+
+	changes, err := Diff(before, after)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Change{
+		{Path: "description", Before: "old", After: "new"},
+		{Path: "properties[author]", After: map[string]any{"name": "author", "dataType": []any{"text"}}},
+		{Path: "properties[title].description", After: "the title"},
+	}, changes)
+
+	t.Run("nil side", func(t *testing.T) {
+		changes, err := Diff(nil, map[string]string{"alias": "A"})
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Path: "", After: map[string]any{"alias": "A"}}}, changes)
+
+		var cls *models.Class
+		changes, err = Diff(cls, cls)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("unnamed lists are compared as a whole", func(t *testing.T) {
+		changes, err := Diff(map[string]any{"roles": []string{"a"}}, map[string]any{"roles": []string{"a", "b"}})
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Path: "roles", Before: []any{"a"}, After: []any{"a", "b"}}}, changes)
+	})
+}
+
+func TestPrincipalContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, PrincipalFromContext(ctx))
+	assert.Empty(t, PrincipalFromContext(ContextWithPrincipal(ctx, nil)))
+	assert.Equal(t, "alice", PrincipalFromContext(ContextWithPrincipal(ctx, &models.Principal{Username: "alice"})))
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultMaxEntries is the number of entries kept when no explicit limit is configured
+const DefaultMaxEntries = 1000
+
+// Change is a single field level difference introduced by a command.
+// Path uses dots for nested objects and brackets for named list items,
+// e.g. "properties[title].indexFilterable".
+type Change struct {
+	Path   string `json:"path"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// Entry is one applied command as recorded in the audit log
+type Entry struct {
+	// Index is the raft log index the command was applied at
+	Index uint64 `json:"index"`
+	// TimeUnixMs is the time the leader appended the command to the raft log
+	TimeUnixMs int64 `json:"timeUnixMs"`
+	// Principal is the user who issued the command, empty for internal commands
+	Principal string `json:"principal,omitempty"`
+	// Command is the name of the applied command type
+	Command string `json:"command"`
+	// Class is the collection the command targeted, if any
+	Class   string   `json:"class,omitempty"`
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Config controls how many entries are retained.
+// MaxEntries <= 0 falls back to DefaultMaxEntries, MaxAge <= 0 disables age based pruning.
+type Config struct {
+	MaxEntries int
+	MaxAge     time.Duration
+}
+
+// Filter narrows down the entries returned by Query. Zero values match everything.
+type Filter struct {
+	Class     string
+	Principal string
+	Command   string
+	Since     time.Time
+	Limit     int
+}
+
+// Log is an in-memory, bounded log of applied schema and RBAC commands.
+// It is part of the FSM: entries are appended while applying raft log entries
+// and the log is persisted and restored alongside the other FSM snapshots.
+type Log struct {
+	mu        sync.RWMutex
+	cfg       Config
+	entries   []Entry // sorted by Index ascending
+	lastIndex uint64
+}
+
+func NewLog(cfg Config) *Log {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+	return &Log{cfg: cfg}
+}
+
+// Append records e. Entries at or below the last recorded index are ignored,
+// which makes replaying raft log entries on top of a restored snapshot idempotent.
+func (l *Log) Append(e Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e.Index != 0 && e.Index <= l.lastIndex {
+		return
+	}
+	l.entries = append(l.entries, e)
+	if e.Index > l.lastIndex {
+		l.lastIndex = e.Index
+	}
+	l.pruneWithLock(e.TimeUnixMs)
+}
+
+// pruneWithLock drops entries exceeding the configured retention. Age is measured
+// against the newest entry rather than the wall clock so that all nodes keep the
+// same entries for the same log.
+func (l *Log) pruneWithLock(nowUnixMs int64) {
+	drop := 0
+	if n := len(l.entries); n > l.cfg.MaxEntries {
+		drop = n - l.cfg.MaxEntries
+	}
+	if l.cfg.MaxAge > 0 {
+		cutoff := nowUnixMs - l.cfg.MaxAge.Milliseconds()
+		for drop < len(l.entries) && l.entries[drop].TimeUnixMs < cutoff {
+			drop++
+		}
+	}
+	if drop > 0 {
+		l.entries = append([]Entry(nil), l.entries[drop:]...)
+	}
+}
+
+// Query returns the entries matching f, newest first
+func (l *Log) Query(f Filter) []Entry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	res := make([]Entry, 0)
+	for i := len(l.entries) - 1; i >= 0; i-- {
+		e := l.entries[i]
+		if f.Class != "" && e.Class != f.Class {
+			continue
+		}
+		if f.Principal != "" && e.Principal != f.Principal {
+			continue
+		}
+		if f.Command != "" && e.Command != f.Command {
+			continue
+		}
+		if !f.Since.IsZero() && e.TimeUnixMs < f.Since.UnixMilli() {
+			continue
+		}
+		res = append(res, e)
+		if f.Limit > 0 && len(res) == f.Limit {
+			break
+		}
+	}
+	return res
+}
+
+// LastIndex returns the raft index of the last recorded entry
+func (l *Log) LastIndex() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lastIndex
+}
+
+// Len returns the number of retained entries
+func (l *Log) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.entries)
+}
+
+type snapshot struct {
+	LastIndex uint64  `json:"last_index"`
+	Entries   []Entry `json:"entries"`
+}
+
+// Snapshot implements fsm.Snapshotter
+func (l *Log) Snapshot() ([]byte, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	bytes, err := json.Marshal(&snapshot{LastIndex: l.lastIndex, Entries: l.entries})
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	return bytes, nil
+}
+
+// Restore implements fsm.Snapshotter, it replaces the current entries with the snapshot ones
+func (l *Log) Restore(bytes []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(bytes, &s); err != nil {
+		return fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = s.Entries
+	l.lastIndex = s.LastIndex
+	if n := len(l.entries); n > 0 {
+		l.pruneWithLock(l.entries[n-1].TimeUnixMs)
+	}
+	return nil
+}
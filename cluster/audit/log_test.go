@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogRetention(t *testing.T) {
+	t.Run("max entries", func(t *testing.T) {
+		l := NewLog(Config{MaxEntries: 2})
+		for i := uint64(1); i <= 3; i++ {
+			l.Append(Entry{Index: i, TimeUnixMs: int64(i)})
+		}
+		entries := l.Query(Filter{})
+		require.Len(t, entries, 2)
+		assert.Equal(t, uint64(3), entries[0].Index)
+		assert.Equal(t, uint64(2), entries[1].Index)
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		l := NewLog(Config{MaxAge: time.Minute})
+		l.Append(Entry{Index: 1, TimeUnixMs: 0})
+		l.Append(Entry{Index: 2, TimeUnixMs: time.Minute.Milliseconds()})
+		l.Append(Entry{Index: 3, TimeUnixMs: 90 * time.Second.Milliseconds()})
+		entries := l.Query(Filter{})
+		require.Len(t, entries, 2)
+		assert.Equal(t, uint64(2), entries[1].Index)
+	})
+
+	t.Run("replayed entries are ignored", func(t *testing.T) {
+		l := NewLog(Config{})
+		l.Append(Entry{Index: 5, Command: "add_class"})
+		l.Append(Entry{Index: 5, Command: "add_class"})
+		l.Append(Entry{Index: 4, Command: "add_class"})
+		assert.Equal(t, 1, l.Len())
+		assert.Equal(t, uint64(5), l.LastIndex())
+	})
+}
+
+func TestLogQuery(t *testing.T) {
+	l := NewLog(Config{})
+	l.Append(Entry{Index: 1, TimeUnixMs: 1000, Principal: "alice", Command: "add_class", Class: "A"})
+	l.Append(Entry{Index: 2, TimeUnixMs: 2000, Principal: "bob", Command: "add_class", Class: "B"})
+	l.Append(Entry{Index: 3, TimeUnixMs: 3000, Principal: "alice", Command: "delete_class", Class: "A"})
+
+	indexes := func(entries []Entry) []uint64 {
+		res := make([]uint64, len(entries))
+		for i, e := range entries {
+			res[i] = e.Index
+		}
+		return res
+	}
+
+	assert.Equal(t, []uint64{3, 2, 1}, indexes(l.Query(Filter{})))
+	assert.Equal(t, []uint64{3, 1}, indexes(l.Query(Filter{Class: "A"})))
+	assert.Equal(t, []uint64{2}, indexes(l.Query(Filter{Principal: "bob"})))
+	assert.Equal(t, []uint64{3}, indexes(l.Query(Filter{Command: "delete_class"})))
+	assert.Equal(t, []uint64{3, 2}, indexes(l.Query(Filter{Since: time.UnixMilli(2000)})))
+	assert.Equal(t, []uint64{3}, indexes(l.Query(Filter{Limit: 1})))
+}
+
+func TestLogSnapshotRestore(t *testing.T) {
+	l := NewLog(Config{})
+	l.Append(Entry{Index: 7, TimeUnixMs: 1000, Principal: "alice", Command: "update_class", Class: "A",
+		Changes: []Change{{Path: "description", Before: "x", After: "y"}}})
+
+	b, err := l.Snapshot()
+	require.NoError(t, err)
+
+	restored := NewLog(Config{})
+	restored.Append(Entry{Index: 1, Command: "stale"})
+	require.NoError(t, restored.Restore(b))
+
+	assert.Equal(t, l.Query(Filter{}), restored.Query(Filter{}))
+	assert.Equal(t, uint64(7), restored.LastIndex())
+
+	// entries already covered by the snapshot are not recorded again
+	restored.Append(Entry{Index: 7, Command: "update_class"})
+	assert.Equal(t, 1, restored.Len())
+}
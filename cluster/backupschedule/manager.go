@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backupschedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// Manager holds the backup schedules replicated through raft. Schedules are
+// executed by the backup scheduler on the leader, which records every run and
+// every pruned backup back into the manager.
+type Manager struct {
+	mu        sync.Mutex
+	schedules map[string]*backup.Schedule
+}
+
+func NewManager() *Manager {
+	return &Manager{schedules: make(map[string]*backup.Schedule)}
+}
+
+// Put creates or replaces a schedule. Replacing a schedule keeps its run
+// state and the backups it created so that they stay subject to retention.
+func (m *Manager) Put(c *api.ApplyRequest) error {
+	var r api.PutBackupScheduleRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal put backup schedule request: %w", err)
+	}
+	if err := r.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := clone(&r.Schedule)
+	if old, ok := m.schedules[s.ID]; ok {
+		s.CreatedAt = old.CreatedAt
+		s.LastRunAt = old.LastRunAt
+		s.LastBackupID = old.LastBackupID
+		s.LastError = old.LastError
+		s.Backups = slices.Clone(old.Backups)
+	}
+	m.schedules[s.ID] = s
+	return nil
+}
+
+func (m *Manager) Delete(c *api.ApplyRequest) error {
+	var r api.DeleteBackupScheduleRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal delete backup schedule request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.schedules[r.ID]; !ok {
+		return fmt.Errorf("backup schedule %q does not exist", r.ID)
+	}
+	delete(m.schedules, r.ID)
+	return nil
+}
+
+func (m *Manager) RecordRun(c *api.ApplyRequest) error {
+	var r api.RecordBackupScheduleRunRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal record backup schedule run request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.schedules[r.ID]
+	if !ok {
+		return fmt.Errorf("backup schedule %q does not exist", r.ID)
+	}
+	s.LastRunAt = time.UnixMilli(r.RunAtUnixMillis).UTC()
+	s.LastBackupID = r.BackupID
+	s.LastError = r.Error
+	if r.BackupID != "" && !slices.Contains(s.Backups, r.BackupID) {
+		s.Backups = append(s.Backups, r.BackupID)
+	}
+	return nil
+}
+
+func (m *Manager) RemoveBackups(c *api.ApplyRequest) error {
+	var r api.RemoveBackupScheduleBackupsRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal remove backup schedule backups request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.schedules[r.ID]
+	if !ok {
+		// the schedule has been deleted in the meantime, nothing left to track
+		return nil
+	}
+	s.Backups = slices.DeleteFunc(s.Backups, func(id string) bool {
+		return slices.Contains(r.BackupIDs, id)
+	})
+	return nil
+}
+
+// List returns a copy of all schedules sorted by their ID
+func (m *Manager) List() []backup.Schedule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := make([]backup.Schedule, 0, len(m.schedules))
+	for _, s := range m.schedules {
+		res = append(res, *clone(s))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (m *Manager) QuerySchedules() ([]byte, error) {
+	payload, err := json.Marshal(&api.BackupSchedulesResponse{Schedules: m.List()})
+	if err != nil {
+		return nil, fmt.Errorf("marshal backup schedules: %w", err)
+	}
+	return payload, nil
+}
+
+type snapshot struct {
+	Schedules []backup.Schedule `json:"schedules,omitempty"`
+}
+
+func (m *Manager) Snapshot() ([]byte, error) {
+	bytes, err := json.Marshal(&snapshot{Schedules: m.List()})
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	return bytes, nil
+}
+
+func (m *Manager) Restore(bytes []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(bytes, &s); err != nil {
+		return fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.schedules = make(map[string]*backup.Schedule, len(s.Schedules))
+	for i := range s.Schedules {
+		m.schedules[s.Schedules[i].ID] = &s.Schedules[i]
+	}
+	return nil
+}
+
+func clone(s *backup.Schedule) *backup.Schedule {
+	c := *s
+	c.Include = slices.Clone(s.Include)
+	c.Exclude = slices.Clone(s.Exclude)
+	c.Backups = slices.Clone(s.Backups)
+	return &c
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backupschedule
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func toCmd(t *testing.T, req any) *cmd.ApplyRequest {
+	t.Helper()
+	b, err := json.Marshal(req)
+	require.NoError(t, err)
+	return &cmd.ApplyRequest{SubCommand: b}
+}
+
+func testSchedule(id string) backup.Schedule {
+	return backup.Schedule{
+		ID:        id,
+		Cron:      "0 3 * * *",
+		Backend:   "filesystem",
+		Retention: backup.Retention{KeepLast: 2},
+		CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestManager(t *testing.T) {
+	t.Run("put rejects invalid schedule", func(t *testing.T) {
+		m := NewManager()
+		s := testSchedule("daily")
+		s.Cron = "not a cron"
+		require.Error(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: s})))
+		assert.Empty(t, m.List())
+	})
+
+	t.Run("record run and remove backups", func(t *testing.T) {
+		m := NewManager()
+		require.NoError(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: testSchedule("daily")})))
+
+		runAt := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+		for _, id := range []string{"daily-1", "daily-2", "daily-2"} {
+			require.NoError(t, m.RecordRun(toCmd(t, &cmd.RecordBackupScheduleRunRequest{
+				ID: "daily", BackupID: id, RunAtUnixMillis: runAt.UnixMilli(),
+			})))
+		}
+		require.NoError(t, m.RecordRun(toCmd(t, &cmd.RecordBackupScheduleRunRequest{
+			ID: "daily", RunAtUnixMillis: runAt.UnixMilli(), Error: "backend unavailable",
+		})))
+
+		got := m.List()
+		require.Len(t, got, 1)
+		assert.Equal(t, []string{"daily-1", "daily-2"}, got[0].Backups)
+		assert.Equal(t, runAt, got[0].LastRunAt)
+		assert.Equal(t, "backend unavailable", got[0].LastError)
+
+		require.NoError(t, m.RemoveBackups(toCmd(t, &cmd.RemoveBackupScheduleBackupsRequest{
+			ID: "daily", BackupIDs: []string{"daily-1"},
+		})))
+		assert.Equal(t, []string{"daily-2"}, m.List()[0].Backups)
+
+		require.Error(t, m.RecordRun(toCmd(t, &cmd.RecordBackupScheduleRunRequest{ID: "unknown"})))
+	})
+
+	t.Run("update keeps run state", func(t *testing.T) {
+		m := NewManager()
+		require.NoError(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: testSchedule("daily")})))
+		require.NoError(t, m.RecordRun(toCmd(t, &cmd.RecordBackupScheduleRunRequest{
+			ID: "daily", BackupID: "daily-1", RunAtUnixMillis: time.Now().UnixMilli(),
+		})))
+
+		updated := testSchedule("daily")
+		updated.Cron = "@hourly"
+		updated.CreatedAt = time.Now()
+		require.NoError(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: updated})))
+
+		got := m.List()[0]
+		assert.Equal(t, "@hourly", got.Cron)
+		assert.Equal(t, testSchedule("daily").CreatedAt, got.CreatedAt)
+		assert.Equal(t, []string{"daily-1"}, got.Backups)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := NewManager()
+		require.NoError(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: testSchedule("daily")})))
+		require.NoError(t, m.Delete(toCmd(t, &cmd.DeleteBackupScheduleRequest{ID: "daily"})))
+		require.Error(t, m.Delete(toCmd(t, &cmd.DeleteBackupScheduleRequest{ID: "daily"})))
+		assert.Empty(t, m.List())
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		m := NewManager()
+		for _, id := range []string{"weekly", "daily"} {
+			require.NoError(t, m.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: testSchedule(id)})))
+		}
+		snap, err := m.Snapshot()
+		require.NoError(t, err)
+
+		restored := NewManager()
+		require.NoError(t, restored.Put(toCmd(t, &cmd.PutBackupScheduleRequest{Schedule: testSchedule("stale")})))
+		require.NoError(t, restored.Restore(snap))
+		assert.Equal(t, m.List(), restored.List())
+		assert.Equal(t, "daily", restored.List()[0].ID)
+	})
+}
@@ -35,6 +35,10 @@ type Snapshot struct {
 	ReplicationOps []byte `json:"replication_ops,omitempty"`
 	// DbUsers is the state of dynamic db users that will be used to restore the FSM
 	DbUsers []byte `json:"dbusers,omitempty"`
+	// BackupSchedules are the backup schedules executed by the leader
+	BackupSchedules []byte `json:"backup_schedules,omitempty"`
+	// AuditLog is the schema audit log so that history survives log compaction
+	AuditLog []byte `json:"audit_log,omitempty"`
 }
 
 // Snapshotter is used to snapshot and restore any (FSM) state
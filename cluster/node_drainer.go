@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// nodeDrainer moves the replicas of draining nodes to the other storage
+// nodes using MOVE replication ops, and removes a drained node from raft once
+// it doesn't hold any replica anymore. Only the leader drains nodes.
+type nodeDrainer struct {
+	raft               *Raft
+	interval           time.Duration
+	maxConcurrentMoves int
+	logger             logrus.FieldLogger
+}
+
+func newNodeDrainer(raft *Raft, interval time.Duration, maxConcurrentMoves int, logger logrus.FieldLogger) *nodeDrainer {
+	return &nodeDrainer{
+		raft:               raft,
+		interval:           interval,
+		maxConcurrentMoves: maxConcurrentMoves,
+		logger:             logger.WithField("action", "node_drain"),
+	}
+}
+
+func (d *nodeDrainer) run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if d.raft.store.IsLeader() {
+				d.drainOnce(ctx)
+			}
+		}
+	}
+}
+
+func (d *nodeDrainer) drainOnce(ctx context.Context) {
+	for _, drain := range d.raft.replicationFSM().GetNodeDrains() {
+		if drain.State != api.DRAINING {
+			continue
+		}
+
+		logger := d.logger.WithField("node", drain.Node)
+		lastErr := ""
+		if err := d.drain(ctx, drain.Node); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Warn("drain node")
+			lastErr = err.Error()
+		}
+		if lastErr == drain.Error {
+			continue
+		}
+		if err := d.raft.UpdateNodeDrain(ctx, drain.Node, api.DRAINING, lastErr); err != nil {
+			logger.WithError(err).Warn("update node drain")
+		}
+	}
+}
+
+// drain schedules the next moves of the node's replicas, or removes the node
+// from raft if all of them have been moved
+func (d *nodeDrainer) drain(ctx context.Context, node string) error {
+	shards := d.shardsOnNode(node)
+	inFlight := d.raft.replicationFSM().GetMovesFromNode(node)
+	if len(shards) == 0 && len(inFlight) == 0 {
+		return d.remove(ctx, node)
+	}
+
+	moving := make(map[drainShard]struct{}, len(inFlight))
+	for _, op := range inFlight {
+		moving[drainShard{collection: op.SourceShard.CollectionId, shard: op.SourceShard.ShardId}] = struct{}{}
+	}
+	candidates := d.raft.StorageCandidates()
+	domains := d.raft.nodeSelector.Placement().Domains(candidates)
+	moves, unplaced := planDrainMoves(node, shards, moving, candidates, domains, d.maxConcurrentMoves-len(inFlight))
+
+	var errs error
+	for _, move := range moves {
+		opID := strfmt.UUID(uuid.New().String())
+		if err := d.raft.ReplicationReplicateReplica(ctx, opID, node, move.collection, move.shard, move.target, api.MOVE.String()); err != nil {
+			if errors.Is(err, replication.ErrShardAlreadyReplicating) {
+				continue
+			}
+			errs = errors.Join(errs, fmt.Errorf("move shard %s of collection %s to %s: %w", move.shard, move.collection, move.target, err))
+			continue
+		}
+		d.logger.WithFields(logrus.Fields{
+			"node":        node,
+			"collection":  move.collection,
+			"shard":       move.shard,
+			"target_node": move.target,
+			"op_id":       opID,
+		}).Info("scheduled replica move off draining node")
+	}
+	if unplaced > 0 {
+		errs = errors.Join(errs, fmt.Errorf("no target node found for %d replicas", unplaced))
+	}
+	return errs
+}
+
+// remove removes the drained node from raft. If the leader itself got drained
+// the leadership is transferred first and the new leader removes it.
+func (d *nodeDrainer) remove(ctx context.Context, node string) error {
+	if node == d.raft.store.cfg.NodeID {
+		d.logger.WithField("node", node).Info("transferring leadership away from drained node")
+		if err := d.raft.store.raft.LeadershipTransfer().Error(); err != nil {
+			return fmt.Errorf("transfer leadership: %w", err)
+		}
+		return nil
+	}
+
+	if err := d.raft.Remove(ctx, node); err != nil {
+		return fmt.Errorf("remove from raft: %w", err)
+	}
+	if err := d.raft.UpdateNodeDrain(ctx, node, api.REMOVED, ""); err != nil {
+		return err
+	}
+	d.logger.WithField("node", node).Info("removed drained node from raft")
+	return nil
+}
+
+type drainShard struct {
+	collection string
+	shard      string
+}
+
+type drainMove struct {
+	drainShard
+	target string
+}
+
+// shardsOnNode returns the replicas held by the node together with all
+// replicas of the respective shard
+func (d *nodeDrainer) shardsOnNode(node string) map[drainShard][]string {
+	shards := map[drainShard][]string{}
+	for className, state := range d.raft.SchemaReader().States() {
+		for name, physical := range state.Shards.Physical {
+			if slices.Contains(physical.BelongsToNodes, node) {
+				shards[drainShard{collection: className, shard: name}] = slices.Clone(physical.BelongsToNodes)
+			}
+		}
+	}
+	return shards
+}
+
+// planDrainMoves picks the target nodes of up to limit replicas of the
+// draining node. Shards which are already moving are skipped. Targets are
+// spread across failure domains, and among equal choices the nodes which got
+// the fewest replicas so far are preferred. It also returns the number of
+// replicas for which no target is available.
+func planDrainMoves(node string, shards map[drainShard][]string, moving map[drainShard]struct{},
+	candidates []string, domains map[string]string, limit int,
+) (moves []drainMove, unplaced int) {
+	keys := make([]drainShard, 0, len(shards))
+	for key := range shards {
+		if _, ok := moving[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].collection != keys[j].collection {
+			return keys[i].collection < keys[j].collection
+		}
+		return keys[i].shard < keys[j].shard
+	})
+
+	assigned := map[string]int{}
+	for _, key := range keys {
+		if len(moves) >= limit {
+			break
+		}
+		// the ordering of the candidates is kept among nodes with the same count
+		ordered := slices.Clone(candidates)
+		sort.SliceStable(ordered, func(i, j int) bool { return assigned[ordered[i]] < assigned[ordered[j]] })
+
+		replicas := slices.DeleteFunc(slices.Clone(shards[key]), func(n string) bool { return n == node })
+		picked := cluster.PickSpread(replicas, ordered, 1, domains)
+		if len(picked) == 0 {
+			unplaced++
+			continue
+		}
+		assigned[picked[0]]++
+		moves = append(moves, drainMove{drainShard: key, target: picked[0]})
+	}
+	return moves, unplaced
+}
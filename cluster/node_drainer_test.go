@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanDrainMoves(t *testing.T) {
+	shards := map[drainShard][]string{
+		{"A", "s1"}: {"N1", "N2"},
+		{"A", "s2"}: {"N1", "N3"},
+		{"B", "s1"}: {"N1", "N2", "N3"},
+		{"B", "s2"}: {"N1"},
+	}
+
+	t.Run("spreads moves over least assigned nodes", func(t *testing.T) {
+		moves, unplaced := planDrainMoves("N1", shards, nil, []string{"N2", "N3", "N4"}, nil, 10)
+		assert.Equal(t, 0, unplaced)
+		assert.Equal(t, []drainMove{
+			{drainShard{"A", "s1"}, "N3"},
+			{drainShard{"A", "s2"}, "N2"},
+			{drainShard{"B", "s1"}, "N4"},
+			{drainShard{"B", "s2"}, "N2"},
+		}, moves)
+	})
+
+	t.Run("skips moving shards and respects limit", func(t *testing.T) {
+		moving := map[drainShard]struct{}{{"A", "s1"}: {}}
+		moves, unplaced := planDrainMoves("N1", shards, moving, []string{"N2", "N3", "N4"}, nil, 2)
+		assert.Equal(t, 0, unplaced)
+		assert.Equal(t, []drainMove{
+			{drainShard{"A", "s2"}, "N2"},
+			{drainShard{"B", "s1"}, "N4"},
+		}, moves)
+	})
+
+	t.Run("prefers unused domains", func(t *testing.T) {
+		domains := map[string]string{"N1": "z1", "N2": "z1", "N3": "z2", "N4": "z2"}
+		one := map[drainShard][]string{{"A", "s1"}: {"N1", "N3"}}
+		moves, _ := planDrainMoves("N1", one, nil, []string{"N2", "N4"}, domains, 10)
+		assert.Equal(t, []drainMove{{drainShard{"A", "s1"}, "N2"}}, moves)
+	})
+
+	t.Run("counts unplaced replicas", func(t *testing.T) {
+		moves, unplaced := planDrainMoves("N1", shards, nil, []string{"N2", "N3"}, nil, 10)
+		assert.Equal(t, 1, unplaced)
+		assert.Len(t, moves, 3)
+	})
+}
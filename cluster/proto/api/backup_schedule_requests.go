@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import "github.com/weaviate/weaviate/entities/backup"
+
+type PutBackupScheduleRequest struct {
+	Schedule backup.Schedule
+}
+
+type DeleteBackupScheduleRequest struct {
+	ID string
+}
+
+// RecordBackupScheduleRunRequest records that a schedule triggered a backup.
+// BackupID is empty if the backup could not be started.
+type RecordBackupScheduleRunRequest struct {
+	ID              string
+	BackupID        string
+	RunAtUnixMillis int64
+	Error           string
+}
+
+// RemoveBackupScheduleBackupsRequest stops tracking backups of a schedule
+// which have been deleted from the backend
+type RemoveBackupScheduleBackupsRequest struct {
+	ID        string
+	BackupIDs []string
+}
+
+type BackupSchedulesResponse struct {
+	Schedules []backup.Schedule
+}
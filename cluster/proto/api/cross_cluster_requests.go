@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+type ReplicationUpdateCrossClusterRequest struct {
+	Version int
+
+	// PrimaryIndex is the raft log index of the primary cluster up to which
+	// the schema changes have been applied
+	PrimaryIndex uint64
+}
+
+type ReplicationPromoteCrossClusterRequest struct {
+	Version int
+
+	PromotedAtUnixMs int64
+}
+
+type ReplicationCrossClusterRequest struct{}
+
+type ReplicationCrossClusterResponse struct {
+	PrimaryIndex     uint64
+	Promoted         bool
+	PromotedAtUnixMs int64
+}
+
+// CrossClusterSchemaLog is the part of the schema log of a primary cluster
+// which is shipped to a follower cluster
+type CrossClusterSchemaLog struct {
+	// Index is the raft log index up to which the log has been read, the
+	// follower continues reading from there
+	Index uint64
+	// LastIndex is the last applied raft log index of the primary
+	LastIndex uint64
+	// Entries are the schema changes following the requested index
+	Entries []CrossClusterSchemaEntry
+	// Snapshot is set instead of Entries if the entries following the
+	// requested index have been compacted. It holds the schema of the primary
+	// as of Index.
+	Snapshot *CrossClusterSchemaSnapshot
+}
+
+type CrossClusterSchemaEntry struct {
+	Index uint64
+	// Request is the proto encoded ApplyRequest
+	Request []byte
+}
+
+type CrossClusterSchemaSnapshot struct {
+	Classes []AddClassRequest
+	// Aliases maps the aliases to their collection
+	Aliases map[string]string
+}
@@ -36,6 +36,9 @@ const (
 	ApplyRequest_TYPE_UPDATE_SHARD_STATUS                                        ApplyRequest_Type = 10
 	ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD                                       ApplyRequest_Type = 11
 	ApplyRequest_TYPE_DELETE_REPLICA_FROM_SHARD                                  ApplyRequest_Type = 12
+	ApplyRequest_TYPE_SPLIT_SHARD                                                ApplyRequest_Type = 13
+	ApplyRequest_TYPE_SPLIT_SHARD_READY                                          ApplyRequest_Type = 14
+	ApplyRequest_TYPE_SPLIT_SHARD_ABORT                                          ApplyRequest_Type = 15
 	ApplyRequest_TYPE_ADD_TENANT                                                 ApplyRequest_Type = 16
 	ApplyRequest_TYPE_UPDATE_TENANT                                              ApplyRequest_Type = 17
 	ApplyRequest_TYPE_DELETE_TENANT                                              ApplyRequest_Type = 18
@@ -72,10 +75,21 @@ const (
 	ApplyRequest_TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_COLLECTION_AND_SHARD ApplyRequest_Type = 222
 	ApplyRequest_TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_TARGET_NODE          ApplyRequest_Type = 223
 	ApplyRequest_TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_UUID                 ApplyRequest_Type = 224
+	ApplyRequest_TYPE_REPLICATION_DRAIN_NODE                                     ApplyRequest_Type = 230
+	ApplyRequest_TYPE_REPLICATION_UPDATE_NODE_DRAIN                              ApplyRequest_Type = 231
+	ApplyRequest_TYPE_REPLICATION_CANCEL_NODE_DRAIN                              ApplyRequest_Type = 232
+	ApplyRequest_TYPE_REPLICATION_UPDATE_CROSS_CLUSTER                           ApplyRequest_Type = 233
+	ApplyRequest_TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER                          ApplyRequest_Type = 234
+	ApplyRequest_TYPE_REPLICATION_SET_QUERY_NODE                                 ApplyRequest_Type = 235
+	ApplyRequest_TYPE_REPLICATION_SET_REBALANCER_PAUSED                          ApplyRequest_Type = 236
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_ADD                                       ApplyRequest_Type = 300
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL                                    ApplyRequest_Type = 301
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED                     ApplyRequest_Type = 302
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CLEAN_UP                                  ApplyRequest_Type = 303
+	ApplyRequest_TYPE_BACKUP_SCHEDULE_PUT                                        ApplyRequest_Type = 320
+	ApplyRequest_TYPE_BACKUP_SCHEDULE_DELETE                                     ApplyRequest_Type = 321
+	ApplyRequest_TYPE_BACKUP_SCHEDULE_RECORD_RUN                                 ApplyRequest_Type = 322
+	ApplyRequest_TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS                             ApplyRequest_Type = 323
 )
 
 // Enum value maps for ApplyRequest_Type.
@@ -91,6 +105,9 @@ var (
 		10:  "TYPE_UPDATE_SHARD_STATUS",
 		11:  "TYPE_ADD_REPLICA_TO_SHARD",
 		12:  "TYPE_DELETE_REPLICA_FROM_SHARD",
+		13:  "TYPE_SPLIT_SHARD",
+		14:  "TYPE_SPLIT_SHARD_READY",
+		15:  "TYPE_SPLIT_SHARD_ABORT",
 		16:  "TYPE_ADD_TENANT",
 		17:  "TYPE_UPDATE_TENANT",
 		18:  "TYPE_DELETE_TENANT",
@@ -127,10 +144,21 @@ var (
 		222: "TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_COLLECTION_AND_SHARD",
 		223: "TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_TARGET_NODE",
 		224: "TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_UUID",
+		230: "TYPE_REPLICATION_DRAIN_NODE",
+		231: "TYPE_REPLICATION_UPDATE_NODE_DRAIN",
+		232: "TYPE_REPLICATION_CANCEL_NODE_DRAIN",
+		233: "TYPE_REPLICATION_UPDATE_CROSS_CLUSTER",
+		234: "TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER",
+		235: "TYPE_REPLICATION_SET_QUERY_NODE",
+		236: "TYPE_REPLICATION_SET_REBALANCER_PAUSED",
 		300: "TYPE_DISTRIBUTED_TASK_ADD",
 		301: "TYPE_DISTRIBUTED_TASK_CANCEL",
 		302: "TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED",
 		303: "TYPE_DISTRIBUTED_TASK_CLEAN_UP",
+		320: "TYPE_BACKUP_SCHEDULE_PUT",
+		321: "TYPE_BACKUP_SCHEDULE_DELETE",
+		322: "TYPE_BACKUP_SCHEDULE_RECORD_RUN",
+		323: "TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS",
 	}
 	ApplyRequest_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                                                0,
@@ -143,6 +171,9 @@ var (
 		"TYPE_UPDATE_SHARD_STATUS":                                        10,
 		"TYPE_ADD_REPLICA_TO_SHARD":                                       11,
 		"TYPE_DELETE_REPLICA_FROM_SHARD":                                  12,
+		"TYPE_SPLIT_SHARD":                                                13,
+		"TYPE_SPLIT_SHARD_READY":                                          14,
+		"TYPE_SPLIT_SHARD_ABORT":                                          15,
 		"TYPE_ADD_TENANT":                                                 16,
 		"TYPE_UPDATE_TENANT":                                              17,
 		"TYPE_DELETE_TENANT":                                              18,
@@ -179,10 +210,21 @@ var (
 		"TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_COLLECTION_AND_SHARD": 222,
 		"TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_TARGET_NODE":          223,
 		"TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_UUID":                 224,
+		"TYPE_REPLICATION_DRAIN_NODE":                                     230,
+		"TYPE_REPLICATION_UPDATE_NODE_DRAIN":                              231,
+		"TYPE_REPLICATION_CANCEL_NODE_DRAIN":                              232,
+		"TYPE_REPLICATION_UPDATE_CROSS_CLUSTER":                           233,
+		"TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER":                          234,
+		"TYPE_REPLICATION_SET_QUERY_NODE":                                 235,
+		"TYPE_REPLICATION_SET_REBALANCER_PAUSED":                          236,
 		"TYPE_DISTRIBUTED_TASK_ADD":                                       300,
 		"TYPE_DISTRIBUTED_TASK_CANCEL":                                    301,
 		"TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED":                     302,
 		"TYPE_DISTRIBUTED_TASK_CLEAN_UP":                                  303,
+		"TYPE_BACKUP_SCHEDULE_PUT":                                        320,
+		"TYPE_BACKUP_SCHEDULE_DELETE":                                     321,
+		"TYPE_BACKUP_SCHEDULE_RECORD_RUN":                                 322,
+		"TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS":                             323,
 	}
 )
 
@@ -243,7 +285,10 @@ const (
 	QueryRequest_TYPE_GET_ALL_REPLICATION_DETAILS                     QueryRequest_Type = 206
 	QueryRequest_TYPE_GET_REPLICATION_OPERATION_STATE                 QueryRequest_Type = 207
 	QueryRequest_TYPE_GET_REPLICATION_SCALE_PLAN                      QueryRequest_Type = 208
+	QueryRequest_TYPE_GET_REPLICATION_NODE_DRAIN                      QueryRequest_Type = 209
+	QueryRequest_TYPE_GET_REPLICATION_CROSS_CLUSTER                   QueryRequest_Type = 210
 	QueryRequest_TYPE_DISTRIBUTED_TASK_LIST                           QueryRequest_Type = 300
+	QueryRequest_TYPE_GET_BACKUP_SCHEDULES                            QueryRequest_Type = 320
 )
 
 // Enum value maps for QueryRequest_Type.
@@ -276,7 +321,10 @@ var (
 		206: "TYPE_GET_ALL_REPLICATION_DETAILS",
 		207: "TYPE_GET_REPLICATION_OPERATION_STATE",
 		208: "TYPE_GET_REPLICATION_SCALE_PLAN",
+		209: "TYPE_GET_REPLICATION_NODE_DRAIN",
+		210: "TYPE_GET_REPLICATION_CROSS_CLUSTER",
 		300: "TYPE_DISTRIBUTED_TASK_LIST",
+		320: "TYPE_GET_BACKUP_SCHEDULES",
 	}
 	QueryRequest_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                                     0,
@@ -306,7 +354,10 @@ var (
 		"TYPE_GET_ALL_REPLICATION_DETAILS":                     206,
 		"TYPE_GET_REPLICATION_OPERATION_STATE":                 207,
 		"TYPE_GET_REPLICATION_SCALE_PLAN":                      208,
+		"TYPE_GET_REPLICATION_NODE_DRAIN":                      209,
+		"TYPE_GET_REPLICATION_CROSS_CLUSTER":                   210,
 		"TYPE_DISTRIBUTED_TASK_LIST":                           300,
+		"TYPE_GET_BACKUP_SCHEDULES":                            320,
 	}
 )
 
@@ -724,6 +775,7 @@ type ApplyRequest struct {
 	Class         string                 `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
 	Version       uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	SubCommand    []byte                 `protobuf:"bytes,4,opt,name=sub_command,json=subCommand,proto3" json:"sub_command,omitempty"`
+	Principal     string                 `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -786,6 +838,13 @@ func (x *ApplyRequest) GetSubCommand() []byte {
 	return nil
 }
 
+func (x *ApplyRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
 type ApplyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -938,6 +997,7 @@ type AddTenantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClusterNodes  []string               `protobuf:"bytes,1,rep,name=cluster_nodes,json=clusterNodes,proto3" json:"cluster_nodes,omitempty"`
 	Tenants       []*Tenant              `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	NodeDomains   map[string]string      `protobuf:"bytes,3,rep,name=node_domains,json=nodeDomains,proto3" json:"node_domains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -986,11 +1046,19 @@ func (x *AddTenantsRequest) GetTenants() []*Tenant {
 	return nil
 }
 
+func (x *AddTenantsRequest) GetNodeDomains() map[string]string {
+	if x != nil {
+		return x.NodeDomains
+	}
+	return nil
+}
+
 type UpdateTenantsRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Tenants               []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
 	ClusterNodes          []string               `protobuf:"bytes,2,rep,name=cluster_nodes,json=clusterNodes,proto3" json:"cluster_nodes,omitempty"`
 	ImplicitUpdateRequest bool                   `protobuf:"varint,3,opt,name=implicit_update_request,json=implicitUpdateRequest,proto3" json:"implicit_update_request,omitempty"`
+	NodeDomains           map[string]string      `protobuf:"bytes,4,rep,name=node_domains,json=nodeDomains,proto3" json:"node_domains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1046,6 +1114,13 @@ func (x *UpdateTenantsRequest) GetImplicitUpdateRequest() bool {
 	return false
 }
 
+func (x *UpdateTenantsRequest) GetNodeDomains() map[string]string {
+	if x != nil {
+		return x.NodeDomains
+	}
+	return nil
+}
+
 type TenantsProcess struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Op            TenantsProcess_Op      `protobuf:"varint,1,opt,name=op,proto3,enum=weaviate.internal.cluster.TenantsProcess_Op" json:"op,omitempty"`
@@ -1760,13 +1835,14 @@ const file_api_message_proto_rawDesc = "" +
 	"\x11NotifyPeerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x14\n" +
-	"\x12NotifyPeerResponse\"\x97\x0f\n" +
+	"\x12NotifyPeerResponse\"\xb4\x13\n" +
 	"\fApplyRequest\x12@\n" +
 	"\x04type\x18\x01 \x01(\x0e2,.weaviate.internal.cluster.ApplyRequest.TypeR\x04type\x12\x14\n" +
 	"\x05class\x18\x02 \x01(\tR\x05class\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\x04R\aversion\x12\x1f\n" +
 	"\vsub_command\x18\x04 \x01(\fR\n" +
-	"subCommand\"\xf3\r\n" +
+	"subCommand\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\tR\tprincipal\"\xf2\x11\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eTYPE_ADD_CLASS\x10\x01\x12\x15\n" +
@@ -1778,7 +1854,10 @@ const file_api_message_proto_rawDesc = "" +
 	"\x18TYPE_UPDATE_SHARD_STATUS\x10\n" +
 	"\x12\x1d\n" +
 	"\x19TYPE_ADD_REPLICA_TO_SHARD\x10\v\x12\"\n" +
-	"\x1eTYPE_DELETE_REPLICA_FROM_SHARD\x10\f\x12\x13\n" +
+	"\x1eTYPE_DELETE_REPLICA_FROM_SHARD\x10\f\x12\x14\n" +
+	"\x10TYPE_SPLIT_SHARD\x10\r\x12\x1a\n" +
+	"\x16TYPE_SPLIT_SHARD_READY\x10\x0e\x12\x1a\n" +
+	"\x16TYPE_SPLIT_SHARD_ABORT\x10\x0f\x12\x13\n" +
 	"\x0fTYPE_ADD_TENANT\x10\x10\x12\x16\n" +
 	"\x12TYPE_UPDATE_TENANT\x10\x11\x12\x16\n" +
 	"\x12TYPE_DELETE_TENANT\x10\x12\x12\x17\n" +
@@ -1814,18 +1893,29 @@ const file_api_message_proto_rawDesc = "" +
 	"5TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_COLLECTION\x10\xdd\x01\x12D\n" +
 	"?TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_COLLECTION_AND_SHARD\x10\xde\x01\x12;\n" +
 	"6TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_TARGET_NODE\x10\xdf\x01\x124\n" +
-	"/TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_UUID\x10\xe0\x01\x12\x1e\n" +
+	"/TYPE_REPLICATION_REPLICATE_FORCE_DELETE_BY_UUID\x10\xe0\x01\x12 \n" +
+	"\x1bTYPE_REPLICATION_DRAIN_NODE\x10\xe6\x01\x12'\n" +
+	"\"TYPE_REPLICATION_UPDATE_NODE_DRAIN\x10\xe7\x01\x12'\n" +
+	"\"TYPE_REPLICATION_CANCEL_NODE_DRAIN\x10\xe8\x01\x12*\n" +
+	"%TYPE_REPLICATION_UPDATE_CROSS_CLUSTER\x10\xe9\x01\x12+\n" +
+	"&TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER\x10\xea\x01\x12$\n" +
+	"\x1fTYPE_REPLICATION_SET_QUERY_NODE\x10\xeb\x01\x12+\n" +
+	"&TYPE_REPLICATION_SET_REBALANCER_PAUSED\x10\xec\x01\x12\x1e\n" +
 	"\x19TYPE_DISTRIBUTED_TASK_ADD\x10\xac\x02\x12!\n" +
 	"\x1cTYPE_DISTRIBUTED_TASK_CANCEL\x10\xad\x02\x120\n" +
 	"+TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED\x10\xae\x02\x12#\n" +
-	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\"\x04\bc\x10c\"A\n" +
+	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\x12\x1d\n" +
+	"\x18TYPE_BACKUP_SCHEDULE_PUT\x10\xc0\x02\x12 \n" +
+	"\x1bTYPE_BACKUP_SCHEDULE_DELETE\x10\xc1\x02\x12$\n" +
+	"\x1fTYPE_BACKUP_SCHEDULE_RECORD_RUN\x10\xc2\x02\x12(\n" +
+	"#TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS\x10\xc3\x02\"\x04\bc\x10c\"A\n" +
 	"\rApplyResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x16\n" +
-	"\x06leader\x18\x02 \x01(\tR\x06leader\"\x91\b\n" +
+	"\x06leader\x18\x02 \x01(\tR\x06leader\"\x80\t\n" +
 	"\fQueryRequest\x12@\n" +
 	"\x04type\x18\x01 \x01(\x0e2,.weaviate.internal.cluster.QueryRequest.TypeR\x04type\x12\x1f\n" +
 	"\vsub_command\x18\x02 \x01(\fR\n" +
-	"subCommand\"\x9d\a\n" +
+	"subCommand\"\x8c\b\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10TYPE_GET_CLASSES\x10\x01\x12\x13\n" +
@@ -1853,17 +1943,28 @@ const file_api_message_proto_rawDesc = "" +
 	"/TYPE_GET_SHARDING_STATE_BY_COLLECTION_AND_SHARD\x10\xcd\x01\x12%\n" +
 	" TYPE_GET_ALL_REPLICATION_DETAILS\x10\xce\x01\x12)\n" +
 	"$TYPE_GET_REPLICATION_OPERATION_STATE\x10\xcf\x01\x12$\n" +
-	"\x1fTYPE_GET_REPLICATION_SCALE_PLAN\x10\xd0\x01\x12\x1f\n" +
-	"\x1aTYPE_DISTRIBUTED_TASK_LIST\x10\xac\x02\")\n" +
+	"\x1fTYPE_GET_REPLICATION_SCALE_PLAN\x10\xd0\x01\x12$\n" +
+	"\x1fTYPE_GET_REPLICATION_NODE_DRAIN\x10\xd1\x01\x12'\n" +
+	"\"TYPE_GET_REPLICATION_CROSS_CLUSTER\x10\xd2\x01\x12\x1f\n" +
+	"\x1aTYPE_DISTRIBUTED_TASK_LIST\x10\xac\x02\x12\x1e\n" +
+	"\x19TYPE_GET_BACKUP_SCHEDULES\x10\xc0\x02\")\n" +
 	"\rQueryResponse\x12\x18\n" +
-	"\apayload\x18\x01 \x01(\fR\apayload\"u\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\"\x97\x02\n" +
 	"\x11AddTenantsRequest\x12#\n" +
 	"\rcluster_nodes\x18\x01 \x03(\tR\fclusterNodes\x12;\n" +
-	"\atenants\x18\x02 \x03(\v2!.weaviate.internal.cluster.TenantR\atenants\"\xb0\x01\n" +
+	"\atenants\x18\x02 \x03(\v2!.weaviate.internal.cluster.TenantR\atenants\x12`\n" +
+	"\fnode_domains\x18\x03 \x03(\v2=.weaviate.internal.cluster.AddTenantsRequest.NodeDomainsEntryR\vnodeDomains\x1a>\n" +
+	"\x10NodeDomainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd5\x02\n" +
 	"\x14UpdateTenantsRequest\x12;\n" +
 	"\atenants\x18\x01 \x03(\v2!.weaviate.internal.cluster.TenantR\atenants\x12#\n" +
 	"\rcluster_nodes\x18\x02 \x03(\tR\fclusterNodes\x126\n" +
-	"\x17implicit_update_request\x18\x03 \x01(\bR\x15implicitUpdateRequest\"\xcc\x01\n" +
+	"\x17implicit_update_request\x18\x03 \x01(\bR\x15implicitUpdateRequest\x12c\n" +
+	"\fnode_domains\x18\x04 \x03(\v2@.weaviate.internal.cluster.UpdateTenantsRequest.NodeDomainsEntryR\vnodeDomains\x1a>\n" +
+	"\x10NodeDomainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcc\x01\n" +
 	"\x0eTenantsProcess\x12<\n" +
 	"\x02op\x18\x01 \x01(\x0e2,.weaviate.internal.cluster.TenantsProcess.OpR\x02op\x129\n" +
 	"\x06tenant\x18\x02 \x01(\v2!.weaviate.internal.cluster.TenantR\x06tenant\"A\n" +
@@ -1948,7 +2049,7 @@ func file_api_message_proto_rawDescGZIP() []byte {
 }
 
 var file_api_message_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_message_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_api_message_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_message_proto_goTypes = []any{
 	(ApplyRequest_Type)(0),                             // 0: weaviate.internal.cluster.ApplyRequest.Type
 	(QueryRequest_Type)(0),                             // 1: weaviate.internal.cluster.QueryRequest.Type
@@ -1978,31 +2079,35 @@ var file_api_message_proto_goTypes = []any{
 	(*CreateAliasRequest)(nil),                         // 25: weaviate.internal.cluster.CreateAliasRequest
 	(*ReplaceAliasRequest)(nil),                        // 26: weaviate.internal.cluster.ReplaceAliasRequest
 	(*DeleteAliasRequest)(nil),                         // 27: weaviate.internal.cluster.DeleteAliasRequest
+	nil,                                                // 28: weaviate.internal.cluster.AddTenantsRequest.NodeDomainsEntry
+	nil,                                                // 29: weaviate.internal.cluster.UpdateTenantsRequest.NodeDomainsEntry
 }
 var file_api_message_proto_depIdxs = []int32{
 	0,  // 0: weaviate.internal.cluster.ApplyRequest.type:type_name -> weaviate.internal.cluster.ApplyRequest.Type
 	1,  // 1: weaviate.internal.cluster.QueryRequest.type:type_name -> weaviate.internal.cluster.QueryRequest.Type
 	19, // 2: weaviate.internal.cluster.AddTenantsRequest.tenants:type_name -> weaviate.internal.cluster.Tenant
-	19, // 3: weaviate.internal.cluster.UpdateTenantsRequest.tenants:type_name -> weaviate.internal.cluster.Tenant
-	2,  // 4: weaviate.internal.cluster.TenantsProcess.op:type_name -> weaviate.internal.cluster.TenantsProcess.Op
-	19, // 5: weaviate.internal.cluster.TenantsProcess.tenant:type_name -> weaviate.internal.cluster.Tenant
-	3,  // 6: weaviate.internal.cluster.TenantProcessRequest.action:type_name -> weaviate.internal.cluster.TenantProcessRequest.Action
-	16, // 7: weaviate.internal.cluster.TenantProcessRequest.tenants_processes:type_name -> weaviate.internal.cluster.TenantsProcess
-	6,  // 8: weaviate.internal.cluster.ClusterService.RemovePeer:input_type -> weaviate.internal.cluster.RemovePeerRequest
-	4,  // 9: weaviate.internal.cluster.ClusterService.JoinPeer:input_type -> weaviate.internal.cluster.JoinPeerRequest
-	8,  // 10: weaviate.internal.cluster.ClusterService.NotifyPeer:input_type -> weaviate.internal.cluster.NotifyPeerRequest
-	10, // 11: weaviate.internal.cluster.ClusterService.Apply:input_type -> weaviate.internal.cluster.ApplyRequest
-	12, // 12: weaviate.internal.cluster.ClusterService.Query:input_type -> weaviate.internal.cluster.QueryRequest
-	7,  // 13: weaviate.internal.cluster.ClusterService.RemovePeer:output_type -> weaviate.internal.cluster.RemovePeerResponse
-	5,  // 14: weaviate.internal.cluster.ClusterService.JoinPeer:output_type -> weaviate.internal.cluster.JoinPeerResponse
-	9,  // 15: weaviate.internal.cluster.ClusterService.NotifyPeer:output_type -> weaviate.internal.cluster.NotifyPeerResponse
-	11, // 16: weaviate.internal.cluster.ClusterService.Apply:output_type -> weaviate.internal.cluster.ApplyResponse
-	13, // 17: weaviate.internal.cluster.ClusterService.Query:output_type -> weaviate.internal.cluster.QueryResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	28, // 3: weaviate.internal.cluster.AddTenantsRequest.node_domains:type_name -> weaviate.internal.cluster.AddTenantsRequest.NodeDomainsEntry
+	19, // 4: weaviate.internal.cluster.UpdateTenantsRequest.tenants:type_name -> weaviate.internal.cluster.Tenant
+	29, // 5: weaviate.internal.cluster.UpdateTenantsRequest.node_domains:type_name -> weaviate.internal.cluster.UpdateTenantsRequest.NodeDomainsEntry
+	2,  // 6: weaviate.internal.cluster.TenantsProcess.op:type_name -> weaviate.internal.cluster.TenantsProcess.Op
+	19, // 7: weaviate.internal.cluster.TenantsProcess.tenant:type_name -> weaviate.internal.cluster.Tenant
+	3,  // 8: weaviate.internal.cluster.TenantProcessRequest.action:type_name -> weaviate.internal.cluster.TenantProcessRequest.Action
+	16, // 9: weaviate.internal.cluster.TenantProcessRequest.tenants_processes:type_name -> weaviate.internal.cluster.TenantsProcess
+	6,  // 10: weaviate.internal.cluster.ClusterService.RemovePeer:input_type -> weaviate.internal.cluster.RemovePeerRequest
+	4,  // 11: weaviate.internal.cluster.ClusterService.JoinPeer:input_type -> weaviate.internal.cluster.JoinPeerRequest
+	8,  // 12: weaviate.internal.cluster.ClusterService.NotifyPeer:input_type -> weaviate.internal.cluster.NotifyPeerRequest
+	10, // 13: weaviate.internal.cluster.ClusterService.Apply:input_type -> weaviate.internal.cluster.ApplyRequest
+	12, // 14: weaviate.internal.cluster.ClusterService.Query:input_type -> weaviate.internal.cluster.QueryRequest
+	7,  // 15: weaviate.internal.cluster.ClusterService.RemovePeer:output_type -> weaviate.internal.cluster.RemovePeerResponse
+	5,  // 16: weaviate.internal.cluster.ClusterService.JoinPeer:output_type -> weaviate.internal.cluster.JoinPeerResponse
+	9,  // 17: weaviate.internal.cluster.ClusterService.NotifyPeer:output_type -> weaviate.internal.cluster.NotifyPeerResponse
+	11, // 18: weaviate.internal.cluster.ClusterService.Apply:output_type -> weaviate.internal.cluster.ApplyResponse
+	13, // 19: weaviate.internal.cluster.ClusterService.Query:output_type -> weaviate.internal.cluster.QueryResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_api_message_proto_init() }
@@ -2017,7 +2122,7 @@ func file_api_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_message_proto_rawDesc), len(file_api_message_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   24,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -54,6 +54,21 @@ type DeleteReplicaFromShard struct {
 	SchemaVersion            uint64
 }
 
+type SplitShardRequest struct {
+	Class, Shard, TargetShard string
+	SchemaVersion             uint64
+}
+
+type SplitShardReadyRequest struct {
+	Class, Shard, Node string
+	SchemaVersion      uint64
+}
+
+type SplitShardAbortRequest struct {
+	Class, Shard  string
+	SchemaVersion uint64
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
@@ -284,3 +284,69 @@ type ReplicationForceDeleteByTargetNodeRequest struct {
 type ReplicationForceDeleteByUuidRequest struct {
 	Uuid strfmt.UUID
 }
+
+type NodeDrainState string
+
+func (s NodeDrainState) String() string {
+	return string(s)
+}
+
+const (
+	// DRAINING nodes don't get new replicas, their replicas get moved away
+	DRAINING NodeDrainState = "DRAINING"
+	// REMOVED nodes have no replicas left and have been removed from raft
+	REMOVED NodeDrainState = "REMOVED"
+)
+
+type ReplicationDrainNodeRequest struct {
+	Version int
+
+	Node            string
+	StartTimeUnixMs int64
+}
+
+type ReplicationUpdateNodeDrainRequest struct {
+	Version int
+
+	Node  string
+	State NodeDrainState
+	// Error is the last error which prevented the drain from progressing
+	Error string
+}
+
+type ReplicationCancelNodeDrainRequest struct {
+	Version int
+
+	Node string
+}
+
+type ReplicationNodeDrainRequest struct {
+	Node string
+}
+
+type ReplicationNodeDrainResponse struct {
+	Node            string
+	State           NodeDrainState
+	StartTimeUnixMs int64
+	Error           string
+
+	// ReplicasRemaining is the number of shard replicas still hosted by the node
+	ReplicasRemaining int
+	// MovesInFlight is the number of replication ops moving replicas away from the node
+	MovesInFlight int
+}
+
+type ReplicationSetQueryNodeRequest struct {
+	Version int
+
+	Node string
+	// QueryOnly registers the node as query node, or removes it if false
+	QueryOnly bool
+}
+
+type ReplicationSetRebalancerPausedRequest struct {
+	Version int
+
+	// Paused stops the rebalancer of the leader from scheduling moves
+	Paused bool
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/raft"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/cluster/audit"
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
@@ -300,6 +301,10 @@ func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, erro
 		))
 	defer t.ObserveDuration()
 
+	if req.Principal == "" {
+		req.Principal = audit.PrincipalFromContext(ctx)
+	}
+
 	var schemaVersion uint64
 	err := backoff.Retry(func() error {
 		var err error
@@ -0,0 +1,21 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import "github.com/weaviate/weaviate/cluster/audit"
+
+// AuditLog returns the schema audit log entries matching f, newest first.
+// The audit log is part of the FSM, every node holds the same entries up to
+// its applied index so it is read locally.
+func (s *Raft) AuditLog(f audit.Filter) []audit.Entry {
+	return s.store.AuditLog(f)
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// PutBackupSchedule creates or replaces a backup schedule
+func (s *Raft) PutBackupSchedule(ctx context.Context, schedule backup.Schedule) error {
+	return s.executeBackupSchedule(ctx, cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_PUT,
+		&cmd.PutBackupScheduleRequest{Schedule: schedule})
+}
+
+func (s *Raft) DeleteBackupSchedule(ctx context.Context, id string) error {
+	return s.executeBackupSchedule(ctx, cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_DELETE,
+		&cmd.DeleteBackupScheduleRequest{ID: id})
+}
+
+// RecordBackupScheduleRun records that the schedule id triggered backupID at runAt.
+// backupID is empty and runErr is set if the backup could not be started.
+func (s *Raft) RecordBackupScheduleRun(ctx context.Context, id, backupID string, runAt time.Time, runErr string) error {
+	return s.executeBackupSchedule(ctx, cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_RECORD_RUN,
+		&cmd.RecordBackupScheduleRunRequest{
+			ID:              id,
+			BackupID:        backupID,
+			RunAtUnixMillis: runAt.UnixMilli(),
+			Error:           runErr,
+		})
+}
+
+// RemoveBackupScheduleBackups stops tracking backups of the schedule id
+func (s *Raft) RemoveBackupScheduleBackups(ctx context.Context, id string, backupIDs []string) error {
+	return s.executeBackupSchedule(ctx, cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS,
+		&cmd.RemoveBackupScheduleBackupsRequest{ID: id, BackupIDs: backupIDs})
+}
+
+func (s *Raft) executeBackupSchedule(ctx context.Context, typ cmd.ApplyRequest_Type, req any) error {
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       typ,
+		SubCommand: subCommand,
+	}
+	if _, err = s.Execute(ctx, command); err != nil {
+		return fmt.Errorf("executing command: %w", err)
+	}
+	return nil
+}
+
+func (s *Raft) BackupSchedules(ctx context.Context) ([]backup.Schedule, error) {
+	command := &cmd.QueryRequest{
+		Type: cmd.QueryRequest_TYPE_GET_BACKUP_SCHEDULES,
+	}
+	queryResp, err := s.Query(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	response := cmd.BackupSchedulesResponse{}
+	if err = json.Unmarshal(queryResp.Payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query result: %w", err)
+	}
+	return response.Schedules, nil
+}
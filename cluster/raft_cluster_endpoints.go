@@ -21,6 +21,7 @@ import (
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -33,7 +34,13 @@ func (s *Raft) LeaderWithID() (string, string) {
 
 // StorageCandidates return the nodes in the raft configuration or memberlist storage nodes
 // based on the current configuration of the cluster if it does have  MetadataVoterOnly nodes.
+// Draining nodes are left out, since they must not get any new replicas, and so
+// are query nodes, which only get replicas copied to them explicitly.
 func (s *Raft) StorageCandidates() []string {
+	return slices.DeleteFunc(s.storageCandidates(), s.isDraining)
+}
+
+func (s *Raft) storageCandidates() []string {
 	if s.store.raft == nil {
 		// get candidates from memberlist
 		return s.nodeSelector.StorageCandidates()
@@ -43,7 +50,7 @@ func (s *Raft) StorageCandidates() []string {
 		existedRaftCandidates []string
 		raftStorageCandidates []string
 		memStorageCandidates  = s.nodeSelector.StorageCandidates()
-		nonStorageCandidates  = s.nodeSelector.NonStorageNodes()
+		nonStorageCandidates  = append(s.nodeSelector.NonStorageNodes(), s.QueryNodes()...)
 	)
 
 	for _, server := range s.store.raft.GetConfiguration().Configuration().Servers {
@@ -218,40 +225,35 @@ func (s *Raft) processShardReplicaRemoval(ctx context.Context, schemaReader sche
 func (s *Raft) addReplacementReplicas(ctx context.Context, className, shardName string, currentReplicas []string, removedNode string, desiredRF int) error {
 	availableNodes := s.StorageCandidates()
 
-	// Build set of available nodes (excluding removed node and existing replicas)
-	availableSet := make(map[string]bool)
+	// Exclude the removed node, existing replicas are skipped by PickSpread
+	candidates := make([]string, 0, len(availableNodes))
 	for _, node := range availableNodes {
 		if node != removedNode {
-			availableSet[node] = true
+			candidates = append(candidates, node)
 		}
 	}
-	for _, replica := range currentReplicas {
-		delete(availableSet, replica)
-	}
 
-	// Always ensure we have at least desiredRF + 1 replicas before deletion
+	// Always ensure we have at least desiredRF + 1 replicas before deletion,
+	// preferring nodes of failure domains which do not hold a replica yet
 	targetCount := desiredRF + 1
-	currentCount := len(currentReplicas)
-
-	for currentCount < targetCount && len(availableSet) > 0 {
-		// Pick first available node
-		var newNode string
-		for node := range availableSet {
-			newNode = node
-			break
-		}
+	domains := s.nodeSelector.Placement().Domains(availableNodes)
+	newNodes := cluster.PickSpread(currentReplicas, candidates, targetCount-len(currentReplicas), domains)
 
+	for _, newNode := range newNodes {
 		if _, err := s.AddReplicaToShard(ctx, className, shardName, newNode); err != nil {
 			return fmt.Errorf("add replica %q to shard %q in collection %q: %w", newNode, shardName, className, err)
 		}
-
-		delete(availableSet, newNode)
-		currentCount++
 	}
 
 	return nil
 }
 
+// StorageCandidateDomains returns the failure domain of each storage
+// candidate, or nil if replicas are not spread across failure domains
+func (s *Raft) StorageCandidateDomains() map[string]string {
+	return s.nodeSelector.Placement().Domains(s.StorageCandidates())
+}
+
 func (s *Raft) Stats() map[string]any {
 	s.log.Debug("membership.stats")
 	return s.store.Stats()
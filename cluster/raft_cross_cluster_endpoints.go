@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// CrossClusterSchemaLog returns the schema changes following the raft log
+// index from, reading at most limit log entries. It is served by a primary
+// cluster to its followers.
+func (s *Raft) CrossClusterSchemaLog(from uint64, limit int) (*api.CrossClusterSchemaLog, error) {
+	return s.store.crossClusterSchemaLog(from, limit)
+}
+
+// CrossClusterSchemaSnapshot returns the whole local schema in the form
+// shipped to followers
+func (s *Raft) CrossClusterSchemaSnapshot() (*api.CrossClusterSchemaSnapshot, error) {
+	log, err := s.store.crossClusterSchemaSnapshot(s.store.lastAppliedIndex.Load())
+	if err != nil {
+		return nil, err
+	}
+	return log.Snapshot, nil
+}
+
+// CrossClusterShardNodes returns the nodes holding each shard of the class
+func (s *Raft) CrossClusterShardNodes(class string) (map[string][]string, error) {
+	nodes := map[string][]string{}
+	err := s.SchemaReader().Read(class, false, func(_ *models.Class, state *sharding.State) error {
+		for name, shard := range state.Physical {
+			nodes[name] = slices.Clone(shard.BelongsToNodes)
+		}
+		return nil
+	})
+	return nodes, err
+}
+
+// UpdateCrossCluster records that the schema changes of the primary cluster
+// have been applied up to the raft log index primaryIndex of the primary
+func (s *Raft) UpdateCrossCluster(ctx context.Context, primaryIndex uint64) error {
+	req := &api.ReplicationUpdateCrossClusterRequest{
+		Version:      api.ReplicationCommandVersionV0,
+		PrimaryIndex: primaryIndex,
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_UPDATE_CROSS_CLUSTER,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		if strings.Contains(err.Error(), replicationTypes.ErrCrossClusterPromoted.Error()) {
+			return replicationTypes.ErrCrossClusterPromoted
+		}
+		return err
+	}
+	return nil
+}
+
+// PromoteCrossCluster stops following the primary cluster for good
+func (s *Raft) PromoteCrossCluster(ctx context.Context) error {
+	req := &api.ReplicationPromoteCrossClusterRequest{
+		Version:          api.ReplicationCommandVersionV0,
+		PromotedAtUnixMs: time.Now().UnixMilli(),
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		if strings.Contains(err.Error(), replicationTypes.ErrCrossClusterPromoted.Error()) {
+			return fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, replicationTypes.ErrCrossClusterPromoted)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) GetCrossCluster(ctx context.Context) (api.ReplicationCrossClusterResponse, error) {
+	subCommand, err := json.Marshal(&api.ReplicationCrossClusterRequest{})
+	if err != nil {
+		return api.ReplicationCrossClusterResponse{}, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.QueryRequest{
+		Type:       api.QueryRequest_TYPE_GET_REPLICATION_CROSS_CLUSTER,
+		SubCommand: subCommand,
+	}
+
+	queryResponse, err := s.Query(ctx, command)
+	if err != nil {
+		return api.ReplicationCrossClusterResponse{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	response := api.ReplicationCrossClusterResponse{}
+	if err := json.Unmarshal(queryResponse.Payload, &response); err != nil {
+		return api.ReplicationCrossClusterResponse{}, fmt.Errorf("failed to unmarshal query response: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/cluster/types"
+)
+
+// DrainNode marks the node as draining. A draining node doesn't get any new
+// shards or tenants, its replicas are moved to the other nodes by the leader
+// and it is removed from raft once it holds no replica anymore.
+func (s *Raft) DrainNode(ctx context.Context, node string) error {
+	if !slices.Contains(s.storageCandidates(), node) {
+		return fmt.Errorf("%w: node %s is not a storage node of the cluster", types.ErrNotFound, node)
+	}
+
+	remaining := slices.DeleteFunc(s.StorageCandidates(), func(n string) bool { return n == node })
+	for className, state := range s.SchemaReader().States() {
+		rf := 1
+		if state.Class.ReplicationConfig != nil && state.Class.ReplicationConfig.Factor > 1 {
+			rf = int(state.Class.ReplicationConfig.Factor)
+		}
+		if rf > len(remaining) {
+			return fmt.Errorf("%w: collection %s has replication factor %d, but only %d storage nodes would remain",
+				replicationTypes.ErrInvalidRequest, className, rf, len(remaining))
+		}
+	}
+
+	req := &api.ReplicationDrainNodeRequest{
+		Version:         api.ReplicationCommandVersionV0,
+		Node:            node,
+		StartTimeUnixMs: time.Now().UnixMilli(),
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_DRAIN_NODE,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) UpdateNodeDrain(ctx context.Context, node string, state api.NodeDrainState, drainErr string) error {
+	req := &api.ReplicationUpdateNodeDrainRequest{
+		Version: api.ReplicationCommandVersionV0,
+		Node:    node,
+		State:   state,
+		Error:   drainErr,
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_UPDATE_NODE_DRAIN,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CancelNodeDrain stops draining the node. Replicas which have already been
+// moved away stay on their new nodes, moves in flight are not cancelled.
+func (s *Raft) CancelNodeDrain(ctx context.Context, node string) error {
+	req := &api.ReplicationCancelNodeDrainRequest{
+		Version: api.ReplicationCommandVersionV0,
+		Node:    node,
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_CANCEL_NODE_DRAIN,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		if strings.Contains(err.Error(), replicationTypes.ErrNodeDrainNotFound.Error()) {
+			return fmt.Errorf("%w: %w", types.ErrNotFound, replicationTypes.ErrNodeDrainNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) GetNodeDrain(ctx context.Context, node string) (api.ReplicationNodeDrainResponse, error) {
+	request := &api.ReplicationNodeDrainRequest{
+		Node: node,
+	}
+	subCommand, err := json.Marshal(request)
+	if err != nil {
+		return api.ReplicationNodeDrainResponse{}, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.QueryRequest{
+		Type:       api.QueryRequest_TYPE_GET_REPLICATION_NODE_DRAIN,
+		SubCommand: subCommand,
+	}
+
+	queryResponse, err := s.Query(ctx, command)
+	if err != nil {
+		if strings.Contains(err.Error(), replicationTypes.ErrNodeDrainNotFound.Error()) {
+			return api.ReplicationNodeDrainResponse{}, fmt.Errorf("%w: %w", types.ErrNotFound, replicationTypes.ErrNodeDrainNotFound)
+		}
+		return api.ReplicationNodeDrainResponse{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	response := api.ReplicationNodeDrainResponse{}
+	if err := json.Unmarshal(queryResponse.Payload, &response); err != nil {
+		return api.ReplicationNodeDrainResponse{}, fmt.Errorf("failed to unmarshal query response: %w", err)
+	}
+	return response, nil
+}
+
+// isDraining returns true if the node must not get any new replicas
+func (s *Raft) isDraining(node string) bool {
+	return s.replicationFSM().IsDraining(node)
+}
+
+func (s *Raft) replicationFSM() *replication.ShardReplicationFSM {
+	return s.store.replicationManager.GetReplicationFSM()
+}
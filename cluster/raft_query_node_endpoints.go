@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// QueryReplica is a shard replica scheduled to be copied to a query node
+type QueryReplica struct {
+	Shard      string      `json:"shard"`
+	SourceNode string      `json:"sourceNode"`
+	OpID       strfmt.UUID `json:"opId"`
+}
+
+// AddQueryReplicas copies the active shards of the collection which the query
+// node doesn't hold yet to it, using COPY replication ops. Once copied, the
+// replicas serve reads at consistency level ONE, but don't count for the
+// write quorums. Shards which are already being replicated are skipped.
+func (s *Raft) AddQueryReplicas(ctx context.Context, collection, node string) ([]QueryReplica, error) {
+	queryNodes := s.QueryNodes()
+	if !slices.Contains(queryNodes, node) {
+		return nil, fmt.Errorf("%w: node %s is not a query node of the cluster", types.ErrNotFound, node)
+	}
+
+	shards := map[string][]string{}
+	if err := s.SchemaReader().Read(collection, false, func(_ *models.Class, state *sharding.State) error {
+		if state == nil {
+			return fmt.Errorf("%w: collection %s", types.ErrNotFound, collection)
+		}
+		for name, physical := range state.Physical {
+			if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+				continue
+			}
+			shards[name] = slices.Clone(physical.BelongsToNodes)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		added []QueryReplica
+		errs  error
+	)
+	for _, shard := range names {
+		replicas := shards[shard]
+		if slices.Contains(replicas, node) {
+			continue
+		}
+		// copy from a storage node, query replicas may lag behind
+		sources := slices.DeleteFunc(replicas, func(n string) bool { return slices.Contains(queryNodes, n) })
+		if len(sources) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("shard %s has no replica on a storage node", shard))
+			continue
+		}
+
+		opID := strfmt.UUID(uuid.New().String())
+		if err := s.ReplicationReplicateReplica(ctx, opID, sources[0], collection, shard, node, api.COPY.String()); err != nil {
+			if errors.Is(err, replication.ErrShardAlreadyReplicating) {
+				continue
+			}
+			errs = errors.Join(errs, fmt.Errorf("copy shard %s to %s: %w", shard, node, err))
+			continue
+		}
+		s.log.WithFields(logrus.Fields{
+			"collection":  collection,
+			"shard":       shard,
+			"source_node": sources[0],
+			"target_node": node,
+			"op_id":       opID,
+		}).Info("scheduled replica copy to query node")
+		added = append(added, QueryReplica{Shard: shard, SourceNode: sources[0], OpID: opID})
+	}
+	return added, errs
+}
+
+// SetQueryNode registers the node as query node in raft, or removes it if
+// queryOnly is false. Query nodes register themselves once they have joined.
+func (s *Raft) SetQueryNode(ctx context.Context, node string, queryOnly bool) error {
+	req := &api.ReplicationSetQueryNodeRequest{
+		Version:   api.ReplicationCommandVersionV0,
+		Node:      node,
+		QueryOnly: queryOnly,
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_SET_QUERY_NODE,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(ctx, command); err != nil {
+		return err
+	}
+	return nil
+}
+
+// QueryNodes returns the query nodes registered in raft, including the ones
+// which are currently down
+func (s *Raft) QueryNodes() []string {
+	return s.replicationFSM().QueryNodes()
+}
+
+func (s *Raft) isQueryNode(node string) bool {
+	return s.replicationFSM().IsQueryNode(node)
+}
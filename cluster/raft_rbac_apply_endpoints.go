@@ -16,20 +16,22 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/weaviate/weaviate/cluster/audit"
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 func (s *Raft) UpdateRolesPermissions(roles map[string][]authorization.Policy) error {
-	return s.upsertRolesPermissions(roles, false)
+	return s.upsertRolesPermissions(context.Background(), roles, false)
 }
 
 func (s *Raft) CreateRolesPermissions(roles map[string][]authorization.Policy) error {
-	return s.upsertRolesPermissions(roles, true)
+	return s.upsertRolesPermissions(context.Background(), roles, true)
 }
 
-func (s *Raft) upsertRolesPermissions(roles map[string][]authorization.Policy, roleCreation bool) error {
+func (s *Raft) upsertRolesPermissions(ctx context.Context, roles map[string][]authorization.Policy, roleCreation bool) error {
 	if len(roles) == 0 {
 		return fmt.Errorf("no roles to create: %w", schema.ErrBadRequest)
 	}
@@ -43,13 +45,17 @@ func (s *Raft) upsertRolesPermissions(roles map[string][]authorization.Policy, r
 		Type:       cmd.ApplyRequest_TYPE_UPSERT_ROLES_PERMISSIONS,
 		SubCommand: subCommand,
 	}
-	if _, err := s.Execute(context.Background(), command); err != nil {
+	if _, err := s.Execute(ctx, command); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (s *Raft) DeleteRoles(names ...string) error {
+	return s.deleteRoles(context.Background(), names...)
+}
+
+func (s *Raft) deleteRoles(ctx context.Context, names ...string) error {
 	if len(names) == 0 {
 		return fmt.Errorf("no roles to delete: %w", schema.ErrBadRequest)
 	}
@@ -62,13 +68,17 @@ func (s *Raft) DeleteRoles(names ...string) error {
 		Type:       cmd.ApplyRequest_TYPE_DELETE_ROLES,
 		SubCommand: subCommand,
 	}
-	if _, err := s.Execute(context.Background(), command); err != nil {
+	if _, err := s.Execute(ctx, command); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (s *Raft) RemovePermissions(role string, permissions []*authorization.Policy) error {
+	return s.removePermissions(context.Background(), role, permissions)
+}
+
+func (s *Raft) removePermissions(ctx context.Context, role string, permissions []*authorization.Policy) error {
 	if role == "" {
 		return fmt.Errorf("no roles to remove permissions from: %w", schema.ErrBadRequest)
 	}
@@ -81,13 +91,17 @@ func (s *Raft) RemovePermissions(role string, permissions []*authorization.Polic
 		Type:       cmd.ApplyRequest_TYPE_REMOVE_PERMISSIONS,
 		SubCommand: subCommand,
 	}
-	if _, err := s.Execute(context.Background(), command); err != nil {
+	if _, err := s.Execute(ctx, command); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (s *Raft) AddRolesForUser(user string, roles []string) error {
+	return s.addRolesForUser(context.Background(), user, roles)
+}
+
+func (s *Raft) addRolesForUser(ctx context.Context, user string, roles []string) error {
 	if len(roles) == 0 {
 		return fmt.Errorf("no roles to assign: %w", schema.ErrBadRequest)
 	}
@@ -100,13 +114,17 @@ func (s *Raft) AddRolesForUser(user string, roles []string) error {
 		Type:       cmd.ApplyRequest_TYPE_ADD_ROLES_FOR_USER,
 		SubCommand: subCommand,
 	}
-	if _, err := s.Execute(context.Background(), command); err != nil {
+	if _, err := s.Execute(ctx, command); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (s *Raft) RevokeRolesForUser(user string, roles ...string) error {
+	return s.revokeRolesForUser(context.Background(), user, roles...)
+}
+
+func (s *Raft) revokeRolesForUser(ctx context.Context, user string, roles ...string) error {
 	if len(roles) == 0 {
 		return fmt.Errorf("no roles to revoke: %w", schema.ErrBadRequest)
 	}
@@ -119,8 +137,43 @@ func (s *Raft) RevokeRolesForUser(user string, roles ...string) error {
 		Type:       cmd.ApplyRequest_TYPE_REVOKE_ROLES_FOR_USER,
 		SubCommand: subCommand,
 	}
-	if _, err := s.Execute(context.Background(), command); err != nil {
+	if _, err := s.Execute(ctx, command); err != nil {
 		return err
 	}
 	return nil
 }
+
+// WithPrincipal returns a controller applying RBAC changes on behalf of principal,
+// so that they are attributed to it in the schema audit log
+func (s *Raft) WithPrincipal(principal *models.Principal) authorization.Controller {
+	return &principalRaft{Raft: s, ctx: audit.ContextWithPrincipal(context.Background(), principal)}
+}
+
+type principalRaft struct {
+	*Raft
+	ctx context.Context
+}
+
+func (p *principalRaft) UpdateRolesPermissions(roles map[string][]authorization.Policy) error {
+	return p.upsertRolesPermissions(p.ctx, roles, false)
+}
+
+func (p *principalRaft) CreateRolesPermissions(roles map[string][]authorization.Policy) error {
+	return p.upsertRolesPermissions(p.ctx, roles, true)
+}
+
+func (p *principalRaft) DeleteRoles(names ...string) error {
+	return p.deleteRoles(p.ctx, names...)
+}
+
+func (p *principalRaft) RemovePermissions(role string, permissions []*authorization.Policy) error {
+	return p.removePermissions(p.ctx, role, permissions)
+}
+
+func (p *principalRaft) AddRolesForUser(user string, roles []string) error {
+	return p.addRolesForUser(p.ctx, user, roles)
+}
+
+func (p *principalRaft) RevokeRolesForUser(user string, roles ...string) error {
+	return p.revokeRolesForUser(p.ctx, user, roles...)
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// SetRebalancerPaused pauses or resumes the rebalancer of the whole cluster.
+// The switch is recorded in raft, so that it is honored by whichever node is
+// the leader, including future ones.
+func (s *Raft) SetRebalancerPaused(ctx context.Context, paused bool) error {
+	req := &api.ReplicationSetRebalancerPausedRequest{
+		Version: api.ReplicationCommandVersionV0,
+		Paused:  paused,
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_SET_REBALANCER_PAUSED,
+		SubCommand: subCommand,
+	}
+	version, err := s.Execute(ctx, command)
+	if err != nil {
+		return err
+	}
+	// wait for the local FSM, so that RebalancerPaused reflects the switch
+	return s.WaitForUpdate(ctx, version)
+}
+
+// RebalancerPaused returns true if the rebalancer has been paused through
+// raft
+func (s *Raft) RebalancerPaused() bool {
+	return s.replicationFSM().RebalancerPaused()
+}
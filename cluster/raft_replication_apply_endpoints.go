@@ -16,14 +16,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/replication"
 	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/usecases/cluster"
 )
 
 func (s *Raft) ApplyReplicationScalePlan(ctx context.Context, scalePlan api.ReplicationScalePlan) (opsUUIDs []strfmt.UUID, err error) {
@@ -134,6 +137,18 @@ func (s *Raft) ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID
 	if err := replication.ValidateReplicationReplicateShard(s.SchemaReader(), req); err != nil {
 		return fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, err)
 	}
+	if s.isDraining(targetNode) {
+		return fmt.Errorf("%w: target node %s is draining", replicationTypes.ErrInvalidRequest, targetNode)
+	}
+	if s.isQueryNode(targetNode) {
+		// a query replica doesn't count for the write quorums, moving the
+		// replica of a storage node there would lower the durability
+		if transferType != api.COPY.String() {
+			return fmt.Errorf("%w: replicas can only be copied to query node %s", replicationTypes.ErrInvalidRequest, targetNode)
+		}
+	} else if err := s.checkReplicaPlacement(req); err != nil {
+		return fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, err)
+	}
 
 	subCommand, err := json.Marshal(req)
 	if err != nil {
@@ -149,6 +164,45 @@ func (s *Raft) ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID
 	return nil
 }
 
+// checkReplicaPlacement checks whether a COPY or MOVE reduces the spread of
+// the shard's replicas across failure domains below what the available nodes
+// allow. This is rejected if the placement policy requires the spread, and
+// only logged otherwise.
+func (s *Raft) checkReplicaPlacement(req *api.ReplicationReplicateShardRequest) error {
+	placement := s.nodeSelector.Placement()
+	if !placement.Enabled() {
+		return nil
+	}
+
+	before, err := s.SchemaReader().ShardReplicas(req.SourceCollection, req.SourceShard)
+	if err != nil {
+		return err
+	}
+	after := append(slices.Clone(before), req.TargetNode)
+	if req.TransferType == api.MOVE.String() {
+		after = slices.DeleteFunc(after, func(node string) bool { return node == req.SourceNode })
+	}
+
+	available := s.StorageCandidates()
+	domains := placement.Domains(append(slices.Clone(available), req.TargetNode))
+	if cluster.CheckSpread(before, available, domains) != nil {
+		return nil // the shard already violates the spread, this is reported separately
+	}
+	err = cluster.CheckSpread(after, available, domains)
+	if err == nil {
+		return nil
+	}
+	if placement.Required() {
+		return fmt.Errorf("replica placement policy: %w", err)
+	}
+	s.log.WithFields(logrus.Fields{
+		"collection":  req.SourceCollection,
+		"shard":       req.SourceShard,
+		"target_node": req.TargetNode,
+	}).WithError(err).Warn("replica movement reduces the spread across failure domains")
+	return nil
+}
+
 func (s *Raft) ReplicationUpdateReplicaOpStatus(ctx context.Context, id uint64, state api.ShardReplicationState) error {
 	req := &api.ReplicationUpdateOpStateRequest{
 		Version: api.ReplicationCommandVersionV0,
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/schema"
+)
+
+// SplitShard starts splitting shard of class into a new physical shard named
+// targetShard. The split is committed once every replica of shard reported
+// itself ready through SplitShardReady.
+func (s *Raft) SplitShard(ctx context.Context, class, shard, targetShard string) (uint64, error) {
+	if class == "" || shard == "" || targetShard == "" {
+		return 0, fmt.Errorf("empty class or shard or target shard: %w", schema.ErrBadRequest)
+	}
+	req := cmd.SplitShardRequest{
+		Class:       class,
+		Shard:       shard,
+		TargetShard: targetShard,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_SPLIT_SHARD,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// SplitShardReady records that node finished copying its replica of shard
+// into the split target
+func (s *Raft) SplitShardReady(ctx context.Context, class, shard, node string) (uint64, error) {
+	if class == "" || shard == "" || node == "" {
+		return 0, fmt.Errorf("empty class or shard or node: %w", schema.ErrBadRequest)
+	}
+	req := cmd.SplitShardReadyRequest{
+		Class: class,
+		Shard: shard,
+		Node:  node,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_SPLIT_SHARD_READY,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// SplitShardAbort cancels a split of shard which has not been committed yet
+func (s *Raft) SplitShardAbort(ctx context.Context, class, shard string) (uint64, error) {
+	if class == "" || shard == "" {
+		return 0, fmt.Errorf("empty class or shard: %w", schema.ErrBadRequest)
+	}
+	req := cmd.SplitShardAbortRequest{
+		Class: class,
+		Shard: shard,
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_SPLIT_SHARD_ABORT,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type Config struct {
+	// PrimaryHost is the address of a node of the primary cluster
+	PrimaryHost string
+	// Interval between two sync runs
+	Interval time.Duration
+	// SchemaBatchSize is the maximum of raft log entries read from the
+	// primary per request
+	SchemaBatchSize int
+}
+
+// Primary reads from the primary cluster
+type Primary interface {
+	// SchemaLog returns the schema changes following the raft log index from
+	SchemaLog(ctx context.Context, host string, from uint64, limit int) (*api.CrossClusterSchemaLog, error)
+	// ShardHosts returns the hosts of the primary's nodes holding each shard
+	// of the class
+	ShardHosts(ctx context.Context, host, class string) (map[string][]string, error)
+}
+
+// Schema is the schema of the follower cluster. Changes are applied
+// through raft.
+type Schema interface {
+	IsLeader() bool
+	StorageCandidates() []string
+	StorageCandidateDomains() map[string]string
+	CrossClusterSchemaSnapshot() (*api.CrossClusterSchemaSnapshot, error)
+	Execute(ctx context.Context, req *api.ApplyRequest) (uint64, error)
+	GetCrossCluster(ctx context.Context) (api.ReplicationCrossClusterResponse, error)
+	UpdateCrossCluster(ctx context.Context, primaryIndex uint64) error
+	PromoteCrossCluster(ctx context.Context) error
+}
+
+// Objects pulls the objects of a local shard from the primary
+type Objects interface {
+	PullFromPrimary(ctx context.Context, class, shard string, hosts []string) (pulled, deleted int, err error)
+}
+
+// Status of a follower cluster as seen by this node
+type Status struct {
+	// AppliedIndex is the raft log index of the primary up to which the
+	// schema changes have been applied
+	AppliedIndex uint64
+	// PrimaryIndex is the last raft log index applied by the primary, as
+	// last seen by this node
+	PrimaryIndex uint64
+	Promoted     bool
+	PromotedAt   time.Time
+	// LastSchemaSync is the last time the schema was synced, it is only set
+	// on the leader
+	LastSchemaSync time.Time
+	// LastObjectsInSync is the last time all local shards were found in
+	// sync with the primary
+	LastObjectsInSync time.Time
+	// ObjectsLag is the time since the local shards were last found in sync
+	// with the primary, or since following started if they never were
+	ObjectsLag time.Duration
+}
+
+// SchemaLag is the number of raft log entries of the primary which have not
+// been applied yet
+func (s Status) SchemaLag() uint64 {
+	if s.PrimaryIndex <= s.AppliedIndex {
+		return 0
+	}
+	return s.PrimaryIndex - s.AppliedIndex
+}
+
+// Follower tails a primary cluster. The leader replays the schema changes
+// of the primary's raft log, placing the shards on the follower's nodes.
+// Every node pulls the objects of its shards from the primary's replicas,
+// comparing them with the async replication hashtrees. Following stops for
+// good once the cluster is promoted.
+type Follower struct {
+	cfg      Config
+	nodeName string
+	primary  Primary
+	schema   Schema
+	objects  Objects
+	metrics  *metrics
+	logger   logrus.FieldLogger
+
+	// promoted is set once this node has seen the cluster being promoted
+	promoted atomic.Bool
+
+	mu                sync.Mutex
+	started           time.Time
+	primaryIndex      uint64
+	lastSchemaSync    time.Time
+	lastObjectsInSync time.Time
+}
+
+func New(cfg Config, nodeName string, primary Primary, schema Schema, objects Objects,
+	reg prometheus.Registerer, logger logrus.FieldLogger,
+) *Follower {
+	return &Follower{
+		cfg:      cfg,
+		nodeName: nodeName,
+		primary:  primary,
+		schema:   schema,
+		objects:  objects,
+		metrics:  newMetrics(reg),
+		logger:   logger.WithField("action", "cross_cluster_replication"),
+		started:  time.Now(),
+	}
+}
+
+// Run triggers a sync run right away and then every interval until ctx is
+// done or the cluster is promoted
+func (f *Follower) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := f.RunOnce(ctx)
+		if errors.Is(err, types.ErrCrossClusterPromoted) {
+			f.logger.Info("cluster has been promoted, stop following the primary")
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			f.logger.WithError(err).Error("cross cluster sync run failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckWritable returns an error wrapping types.ErrCrossClusterFollowing
+// unless this node has seen the cluster being promoted. Client writes are
+// rejected until then, as the primary's changes would overwrite them. A nil
+// Follower, as used by clusters not following a primary, is always writable.
+func (f *Follower) CheckWritable() error {
+	if f == nil || f.promoted.Load() {
+		return nil
+	}
+	return fmt.Errorf("%w: primary %s", types.ErrCrossClusterFollowing, f.cfg.PrimaryHost)
+}
+
+// RunOnce syncs the schema, if this node is the leader, and then the
+// objects of the local shards
+func (f *Follower) RunOnce(ctx context.Context) error {
+	state, err := f.schema.GetCrossCluster(ctx)
+	if err != nil {
+		return fmt.Errorf("get cross cluster state: %w", err)
+	}
+	if state.Promoted {
+		f.promoted.Store(true)
+		return types.ErrCrossClusterPromoted
+	}
+
+	var errs []error
+	if f.schema.IsLeader() {
+		if err := f.syncSchema(ctx, state.PrimaryIndex); err != nil {
+			f.metrics.failures.WithLabelValues("schema").Inc()
+			errs = append(errs, fmt.Errorf("sync schema: %w", err))
+		}
+	}
+	if err := f.syncObjects(ctx); err != nil {
+		f.metrics.failures.WithLabelValues("objects").Inc()
+		errs = append(errs, fmt.Errorf("sync objects: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// Promote stops following the primary, the cluster accepts writes of its
+// own afterwards
+func (f *Follower) Promote(ctx context.Context) error {
+	if err := f.schema.PromoteCrossCluster(ctx); err != nil {
+		if errors.Is(err, types.ErrCrossClusterPromoted) {
+			f.promoted.Store(true)
+		}
+		return err
+	}
+	f.promoted.Store(true)
+	f.logger.Info("cluster promoted")
+	return nil
+}
+
+// Status returns the status of the follower cluster
+func (f *Follower) Status(ctx context.Context) (Status, error) {
+	state, err := f.schema.GetCrossCluster(ctx)
+	if err != nil {
+		return Status{}, fmt.Errorf("get cross cluster state: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := Status{
+		AppliedIndex:      state.PrimaryIndex,
+		PrimaryIndex:      max(f.primaryIndex, state.PrimaryIndex),
+		Promoted:          state.Promoted,
+		LastSchemaSync:    f.lastSchemaSync,
+		LastObjectsInSync: f.lastObjectsInSync,
+		ObjectsLag:        f.objectsLag(time.Now()),
+	}
+	if state.Promoted {
+		status.PromotedAt = time.UnixMilli(state.PromotedAtUnixMs)
+	}
+	return status, nil
+}
+
+// syncObjects pulls the differing objects of every local shard which is
+// active. The objects are in sync once a run finds no differences.
+func (f *Follower) syncObjects(ctx context.Context) error {
+	local, err := f.schema.CrossClusterSchemaSnapshot()
+	if err != nil {
+		return fmt.Errorf("read local schema: %w", err)
+	}
+
+	var errs []error
+	pulled, deleted := 0, 0
+	for _, c := range local.Classes {
+		hosts, err := f.primary.ShardHosts(ctx, f.cfg.PrimaryHost, c.Class.Class)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("shard hosts of %s: %w", c.Class.Class, err))
+			continue
+		}
+		for name, shard := range c.State.Physical {
+			if !slices.Contains(shard.BelongsToNodes, f.nodeName) ||
+				shard.ActivityStatus() != models.TenantActivityStatusHOT {
+				continue
+			}
+			if len(hosts[name]) == 0 {
+				continue // not created on the primary yet
+			}
+			p, d, err := f.objects.PullFromPrimary(ctx, c.Class.Class, name, hosts[name])
+			pulled += p
+			deleted += d
+			if err != nil {
+				errs = append(errs, fmt.Errorf("pull shard %s/%s: %w", c.Class.Class, name, err))
+			}
+			if ctx.Err() != nil {
+				return errors.Join(append(errs, ctx.Err())...)
+			}
+		}
+	}
+
+	f.metrics.objectsPulled.Add(float64(pulled))
+	f.metrics.objectsDeleted.Add(float64(deleted))
+
+	now := time.Now()
+	f.mu.Lock()
+	if len(errs) == 0 && pulled+deleted == 0 {
+		f.lastObjectsInSync = now
+	}
+	lag := f.objectsLag(now)
+	f.mu.Unlock()
+	f.metrics.objectsLag.Set(lag.Seconds())
+
+	if pulled+deleted > 0 {
+		f.logger.WithFields(logrus.Fields{
+			"pulled":  pulled,
+			"deleted": deleted,
+		}).Debug("pulled objects from primary")
+	}
+	return errors.Join(errs...)
+}
+
+// objectsLag must be called with f.mu held
+func (f *Follower) objectsLag(now time.Time) time.Duration {
+	if f.lastObjectsInSync.IsZero() {
+		return now.Sub(f.started)
+	}
+	return now.Sub(f.lastObjectsInSync)
+}
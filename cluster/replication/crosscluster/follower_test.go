@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakePrimary struct {
+	logs  map[uint64]*api.CrossClusterSchemaLog // from -> log
+	hosts map[string]map[string][]string        // class -> shard -> hosts
+}
+
+func (f *fakePrimary) SchemaLog(ctx context.Context, host string, from uint64, limit int) (*api.CrossClusterSchemaLog, error) {
+	if log, ok := f.logs[from]; ok {
+		return log, nil
+	}
+	return &api.CrossClusterSchemaLog{Index: from, LastIndex: from}, nil
+}
+
+func (f *fakePrimary) ShardHosts(ctx context.Context, host, class string) (map[string][]string, error) {
+	return f.hosts[class], nil
+}
+
+type fakeSchema struct {
+	leader   bool
+	nodes    []string
+	local    *api.CrossClusterSchemaSnapshot
+	state    api.ReplicationCrossClusterResponse
+	executed []*api.ApplyRequest
+}
+
+func (f *fakeSchema) IsLeader() bool                             { return f.leader }
+func (f *fakeSchema) StorageCandidates() []string                { return f.nodes }
+func (f *fakeSchema) StorageCandidateDomains() map[string]string { return nil }
+
+func (f *fakeSchema) CrossClusterSchemaSnapshot() (*api.CrossClusterSchemaSnapshot, error) {
+	if f.local == nil {
+		return &api.CrossClusterSchemaSnapshot{}, nil
+	}
+	return f.local, nil
+}
+
+func (f *fakeSchema) Execute(ctx context.Context, req *api.ApplyRequest) (uint64, error) {
+	f.executed = append(f.executed, req)
+	return 0, nil
+}
+
+func (f *fakeSchema) GetCrossCluster(ctx context.Context) (api.ReplicationCrossClusterResponse, error) {
+	return f.state, nil
+}
+
+func (f *fakeSchema) UpdateCrossCluster(ctx context.Context, primaryIndex uint64) error {
+	f.state.PrimaryIndex = primaryIndex
+	return nil
+}
+
+func (f *fakeSchema) PromoteCrossCluster(ctx context.Context) error {
+	if f.state.Promoted {
+		return types.ErrCrossClusterPromoted
+	}
+	f.state.Promoted = true
+	f.state.PromotedAtUnixMs = time.Now().UnixMilli()
+	return nil
+}
+
+type fakeObjects struct {
+	pulls map[string]int // shard -> objects left to pull
+	calls []string
+}
+
+func (f *fakeObjects) PullFromPrimary(ctx context.Context, class, shard string, hosts []string) (int, int, error) {
+	f.calls = append(f.calls, class+"/"+shard)
+	n := f.pulls[shard]
+	f.pulls[shard] = 0
+	return n, 0, nil
+}
+
+func newTestFollower(primary *fakePrimary, schema *fakeSchema, objects *fakeObjects) *Follower {
+	logger, _ := test.NewNullLogger()
+	cfg := Config{PrimaryHost: "primary:8300", Interval: time.Second, SchemaBatchSize: 10}
+	return New(cfg, "node1", primary, schema, objects, nil, logger)
+}
+
+func classRequest(t *testing.T, typ api.ApplyRequest_Type, class string, sub any) []byte {
+	b, err := json.Marshal(sub)
+	require.NoError(t, err)
+	req, err := proto.Marshal(&api.ApplyRequest{Type: typ, Class: class, SubCommand: b})
+	require.NoError(t, err)
+	return req
+}
+
+func TestFollowerReplaysSchemaLog(t *testing.T) {
+	state := &sharding.State{Physical: map[string]sharding.Physical{
+		"shardA": {Name: "shardA", BelongsToNodes: []string{"p1", "p2"}},
+		"shardB": {Name: "shardB", BelongsToNodes: []string{"p2", "p3"}},
+	}}
+	class := &models.Class{Class: "Article", ReplicationConfig: &models.ReplicationConfig{Factor: 2}}
+
+	tenants, err := proto.Marshal(&api.AddTenantsRequest{
+		ClusterNodes: []string{"p1", "p2"},
+		Tenants:      []*api.Tenant{{Name: "t1", Status: models.TenantActivityStatusHOT}},
+	})
+	require.NoError(t, err)
+	tenantsReq, err := proto.Marshal(&api.ApplyRequest{Type: api.ApplyRequest_TYPE_ADD_TENANT, Class: "Mt", SubCommand: tenants})
+	require.NoError(t, err)
+
+	primary := &fakePrimary{logs: map[uint64]*api.CrossClusterSchemaLog{
+		4: {Index: 8, LastIndex: 12, Entries: []api.CrossClusterSchemaEntry{
+			{Index: 5, Request: classRequest(t, api.ApplyRequest_TYPE_ADD_CLASS, "Article",
+				api.AddClassRequest{Class: class, State: state})},
+		}},
+		8: {Index: 12, LastIndex: 12, Entries: []api.CrossClusterSchemaEntry{
+			{Index: 10, Request: tenantsReq},
+		}},
+	}}
+	schema := &fakeSchema{leader: true, nodes: []string{"node1"}, state: api.ReplicationCrossClusterResponse{PrimaryIndex: 4}}
+	f := newTestFollower(primary, schema, &fakeObjects{})
+
+	require.NoError(t, f.RunOnce(context.Background()))
+	require.Len(t, schema.executed, 2)
+	assert.Equal(t, uint64(12), schema.state.PrimaryIndex)
+
+	added := api.AddClassRequest{}
+	require.NoError(t, json.Unmarshal(schema.executed[0].SubCommand, &added))
+	assert.Equal(t, int64(1), added.Class.ReplicationConfig.Factor)
+	for _, shard := range added.State.Physical {
+		assert.Equal(t, []string{"node1"}, shard.BelongsToNodes)
+	}
+
+	addedTenants := &api.AddTenantsRequest{}
+	require.NoError(t, proto.Unmarshal(schema.executed[1].SubCommand, addedTenants))
+	assert.Equal(t, []string{"node1"}, addedTenants.ClusterNodes)
+
+	status, err := f.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), status.SchemaLag())
+	assert.False(t, status.LastSchemaSync.IsZero())
+}
+
+func TestFollowerReconcilesSnapshot(t *testing.T) {
+	mt := &models.MultiTenancyConfig{Enabled: true}
+	local := &api.CrossClusterSchemaSnapshot{
+		Classes: []api.AddClassRequest{
+			{Class: &models.Class{Class: "Gone"}, State: &sharding.State{}},
+			{
+				Class: &models.Class{Class: "Mt", MultiTenancyConfig: mt, Properties: []*models.Property{{Name: "title"}}},
+				State: &sharding.State{Physical: map[string]sharding.Physical{
+					"t1": {Name: "t1", Status: models.TenantActivityStatusHOT},
+					"t2": {Name: "t2", Status: models.TenantActivityStatusHOT},
+				}},
+			},
+		},
+		Aliases: map[string]string{"Old": "Gone"},
+	}
+	primary := &fakePrimary{logs: map[uint64]*api.CrossClusterSchemaLog{
+		0: {Index: 20, LastIndex: 20, Snapshot: &api.CrossClusterSchemaSnapshot{
+			Classes: []api.AddClassRequest{
+				{
+					Class: &models.Class{Class: "Mt", MultiTenancyConfig: mt, Properties: []*models.Property{{Name: "title"}, {Name: "body"}}},
+					State: &sharding.State{Physical: map[string]sharding.Physical{
+						"t1": {Name: "t1", Status: models.TenantActivityStatusCOLD},
+						"t3": {Name: "t3", Status: models.TenantActivityStatusHOT},
+					}},
+				},
+				{Class: &models.Class{Class: "New"}, State: &sharding.State{Physical: map[string]sharding.Physical{
+					"s1": {Name: "s1", BelongsToNodes: []string{"p1"}},
+				}}},
+			},
+			Aliases: map[string]string{"Fresh": "New"},
+		}},
+	}}
+	schema := &fakeSchema{leader: true, nodes: []string{"node1", "node2"}, local: local}
+	f := newTestFollower(primary, schema, &fakeObjects{})
+
+	require.NoError(t, f.RunOnce(context.Background()))
+	assert.Equal(t, uint64(20), schema.state.PrimaryIndex)
+
+	var got []string
+	for _, req := range schema.executed {
+		got = append(got, req.Type.String()+" "+req.Class)
+	}
+	assert.Equal(t, []string{
+		"TYPE_DELETE_ALIAS ",
+		"TYPE_DELETE_CLASS Gone",
+		"TYPE_ADD_PROPERTY Mt",
+		"TYPE_DELETE_TENANT Mt",
+		"TYPE_ADD_TENANT Mt",
+		"TYPE_UPDATE_TENANT Mt",
+		"TYPE_ADD_CLASS New",
+		"TYPE_CREATE_ALIAS ",
+	}, got)
+
+	props := api.AddPropertyRequest{}
+	require.NoError(t, json.Unmarshal(schema.executed[2].SubCommand, &props))
+	require.Len(t, props.Properties, 1)
+	assert.Equal(t, "body", props.Properties[0].Name)
+}
+
+func TestFollowerPullsLocalShards(t *testing.T) {
+	local := &api.CrossClusterSchemaSnapshot{Classes: []api.AddClassRequest{{
+		Class: &models.Class{Class: "Article"},
+		State: &sharding.State{Physical: map[string]sharding.Physical{
+			"local":  {Name: "local", BelongsToNodes: []string{"node1"}},
+			"remote": {Name: "remote", BelongsToNodes: []string{"node2"}},
+			"cold":   {Name: "cold", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+		}},
+	}}}
+	primary := &fakePrimary{hosts: map[string]map[string][]string{
+		"Article": {"local": {"p1"}, "remote": {"p2"}, "cold": {"p1"}},
+	}}
+	objects := &fakeObjects{pulls: map[string]int{"local": 5}}
+	f := newTestFollower(primary, &fakeSchema{local: local}, objects)
+
+	require.NoError(t, f.RunOnce(context.Background()))
+	assert.Equal(t, []string{"Article/local"}, objects.calls)
+	status, err := f.Status(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.LastObjectsInSync.IsZero())
+
+	require.NoError(t, f.RunOnce(context.Background()))
+	status, err = f.Status(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.LastObjectsInSync.IsZero())
+}
+
+func TestFollowerStopsOncePromoted(t *testing.T) {
+	schema := &fakeSchema{leader: true}
+	f := newTestFollower(&fakePrimary{}, schema, &fakeObjects{})
+	require.ErrorIs(t, f.CheckWritable(), types.ErrCrossClusterFollowing)
+
+	require.NoError(t, f.Promote(context.Background()))
+	require.NoError(t, f.CheckWritable())
+	require.ErrorIs(t, f.Promote(context.Background()), types.ErrCrossClusterPromoted)
+	require.ErrorIs(t, f.RunOnce(context.Background()), types.ErrCrossClusterPromoted)
+
+	status, err := f.Status(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.Promoted)
+	assert.False(t, status.PromotedAt.IsZero())
+}
+
+func TestFollowerWritableOncePromotedElsewhere(t *testing.T) {
+	schema := &fakeSchema{leader: true}
+	f := newTestFollower(&fakePrimary{}, schema, &fakeObjects{})
+	require.NoError(t, newTestFollower(&fakePrimary{}, schema, &fakeObjects{}).Promote(context.Background()))
+
+	// promoted through another node, this node learns about it on its next run
+	require.ErrorIs(t, f.CheckWritable(), types.ErrCrossClusterFollowing)
+	require.ErrorIs(t, f.RunOnce(context.Background()), types.ErrCrossClusterPromoted)
+	require.NoError(t, f.CheckWritable())
+
+	var notFollowing *Follower
+	require.NoError(t, notFollowing.CheckWritable())
+}
+
+func TestPlace(t *testing.T) {
+	class := &models.Class{Class: "C", ReplicationConfig: &models.ReplicationConfig{Factor: 3}}
+	state := &sharding.State{ReplicationFactor: 3, Physical: map[string]sharding.Physical{
+		"a": {Name: "a", BelongsToNodes: []string{"p1", "p2", "p3"}, LegacyBelongsToNodeForBackwardCompat: "p1"},
+		"b": {Name: "b", BelongsToNodes: []string{"p2", "p3", "p1"}},
+	}}
+
+	require.NoError(t, place(class, state, []string{"n1", "n2"}, nil))
+	assert.Equal(t, int64(2), class.ReplicationConfig.Factor)
+	assert.Equal(t, int64(2), state.ReplicationFactor)
+	assert.Equal(t, []string{"n1", "n2"}, state.Physical["a"].BelongsToNodes)
+	assert.Equal(t, []string{"n2", "n1"}, state.Physical["b"].BelongsToNodes)
+	assert.Empty(t, state.Physical["a"].LegacyBelongsToNodeForBackwardCompat)
+
+	require.Error(t, place(class, state, nil, nil))
+}
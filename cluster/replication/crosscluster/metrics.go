@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type metrics struct {
+	schemaLag      prometheus.Gauge
+	objectsLag     prometheus.Gauge
+	schemaApplied  prometheus.Counter
+	schemaSkipped  prometheus.Counter
+	objectsPulled  prometheus.Counter
+	objectsDeleted prometheus.Counter
+	failures       *prometheus.CounterVec
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	r := promauto.With(reg)
+
+	m := &metrics{
+		schemaLag: r.NewGauge(prometheus.GaugeOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_schema_lag_entries",
+			Help:      "Number of raft log entries of the primary cluster not applied yet",
+		}),
+		objectsLag: r.NewGauge(prometheus.GaugeOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_objects_lag_seconds",
+			Help:      "Time since the local shards were last found in sync with the primary cluster",
+		}),
+		schemaApplied: r.NewCounter(prometheus.CounterOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_schema_changes_applied_total",
+			Help:      "Number of schema changes of the primary cluster applied",
+		}),
+		schemaSkipped: r.NewCounter(prometheus.CounterOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_schema_changes_skipped_total",
+			Help:      "Number of schema changes of the primary cluster which could not be applied",
+		}),
+		objectsPulled: r.NewCounter(prometheus.CounterOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_objects_pulled_total",
+			Help:      "Number of objects pulled from the primary cluster",
+		}),
+		objectsDeleted: r.NewCounter(prometheus.CounterOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_objects_deleted_total",
+			Help:      "Number of objects deleted since the primary cluster does not have them",
+		}),
+		failures: r.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "weaviate",
+			Name:      "cross_cluster_replication_sync_failures_total",
+			Help:      "Number of failed sync runs",
+		}, []string{"kind"}),
+	}
+	return m
+}
@@ -0,0 +1,368 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// syncSchema reads the schema changes of the primary following the raft log
+// index from and applies them, until the primary's last index is reached
+func (f *Follower) syncSchema(ctx context.Context, from uint64) error {
+	for {
+		log, err := f.primary.SchemaLog(ctx, f.cfg.PrimaryHost, from, f.cfg.SchemaBatchSize)
+		if err != nil {
+			return fmt.Errorf("read schema log of primary: %w", err)
+		}
+
+		if log.Snapshot != nil {
+			err = f.reconcile(ctx, log.Snapshot)
+		} else {
+			err = f.replay(ctx, log.Entries)
+		}
+		if err != nil {
+			return err
+		}
+
+		progressed := log.Index > from
+		if progressed {
+			if err := f.schema.UpdateCrossCluster(ctx, log.Index); err != nil {
+				return fmt.Errorf("record applied index %d: %w", log.Index, err)
+			}
+			from = log.Index
+		}
+
+		f.mu.Lock()
+		f.primaryIndex = log.LastIndex
+		f.lastSchemaSync = time.Now()
+		f.mu.Unlock()
+
+		lag := uint64(0)
+		if log.LastIndex > from {
+			lag = log.LastIndex - from
+		}
+		f.metrics.schemaLag.Set(float64(lag))
+
+		if lag == 0 || !progressed {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// replay applies the schema changes shipped by the primary, placing new
+// shards on the follower's nodes
+func (f *Follower) replay(ctx context.Context, entries []api.CrossClusterSchemaEntry) error {
+	for _, e := range entries {
+		req := &api.ApplyRequest{}
+		if err := proto.Unmarshal(e.Request, req); err != nil {
+			return fmt.Errorf("decode schema change %d: %w", e.Index, err)
+		}
+		if err := f.remap(req); err != nil {
+			return fmt.Errorf("remap schema change %d: %w", e.Index, err)
+		}
+		if err := f.apply(ctx, req); err != nil {
+			return fmt.Errorf("apply schema change %d: %w", e.Index, err)
+		}
+	}
+	return nil
+}
+
+// apply executes a schema change. Changes which fail, e.g. since a snapshot
+// already contained them, are skipped, unless this node lost its leadership
+// or ctx is done.
+func (f *Follower) apply(ctx context.Context, req *api.ApplyRequest) error {
+	if _, err := f.schema.Execute(ctx, req); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !f.schema.IsLeader() {
+			return err
+		}
+		f.metrics.schemaSkipped.Inc()
+		f.logger.WithFields(logrus.Fields{
+			"type":  req.Type.String(),
+			"class": req.Class,
+		}).WithError(err).Warn("skip schema change of primary")
+		return nil
+	}
+	f.metrics.schemaApplied.Inc()
+	return nil
+}
+
+// remap adapts the shard placement of a schema change to the follower's
+// nodes
+func (f *Follower) remap(req *api.ApplyRequest) error {
+	candidates := f.schema.StorageCandidates()
+	domains := f.schema.StorageCandidateDomains()
+
+	var (
+		sub any
+		err error
+	)
+	switch req.Type {
+	case api.ApplyRequest_TYPE_ADD_CLASS, api.ApplyRequest_TYPE_RESTORE_CLASS:
+		r := api.AddClassRequest{}
+		if err := json.Unmarshal(req.SubCommand, &r); err != nil {
+			return err
+		}
+		if err := place(r.Class, r.State, candidates, domains); err != nil {
+			return err
+		}
+		sub = r
+	case api.ApplyRequest_TYPE_UPDATE_CLASS:
+		r := api.UpdateClassRequest{}
+		if err := json.Unmarshal(req.SubCommand, &r); err != nil {
+			return err
+		}
+		r.State = nil // the follower keeps its own placement
+		capReplicationFactor(r.Class, len(candidates))
+		sub = r
+	case api.ApplyRequest_TYPE_ADD_TENANT:
+		r := &api.AddTenantsRequest{}
+		if err := proto.Unmarshal(req.SubCommand, r); err != nil {
+			return err
+		}
+		r.ClusterNodes, r.NodeDomains = candidates, domains
+		req.SubCommand, err = proto.Marshal(r)
+		return err
+	case api.ApplyRequest_TYPE_UPDATE_TENANT:
+		r := &api.UpdateTenantsRequest{}
+		if err := proto.Unmarshal(req.SubCommand, r); err != nil {
+			return err
+		}
+		r.ClusterNodes, r.NodeDomains = candidates, domains
+		req.SubCommand, err = proto.Marshal(r)
+		return err
+	default:
+		return nil
+	}
+
+	req.SubCommand, err = json.Marshal(sub)
+	return err
+}
+
+// place moves the shards of a primary's sharding state to the follower's
+// nodes, keeping the number of replicas where possible. The shards are
+// spread over the candidates round robin and over the failure domains.
+func place(class *models.Class, state *sharding.State, candidates []string, domains map[string]string) error {
+	if len(candidates) == 0 {
+		return fmt.Errorf("no storage candidates")
+	}
+	capReplicationFactor(class, len(candidates))
+	if state == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(state.Physical))
+	for name := range state.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		shard := state.Physical[name]
+		n := min(max(len(shard.BelongsToNodes), 1), len(candidates))
+		start := i % len(candidates)
+		rotated := append(append([]string{}, candidates[start:]...), candidates[:start]...)
+
+		shard.BelongsToNodes = cluster.PickSpread(nil, rotated, n, domains)
+		shard.LegacyBelongsToNodeForBackwardCompat = ""
+		shard.Split = nil
+		state.Physical[name] = shard
+	}
+	if state.ReplicationFactor > int64(len(candidates)) {
+		state.ReplicationFactor = int64(len(candidates))
+	}
+	return nil
+}
+
+func capReplicationFactor(class *models.Class, nodes int) {
+	if class != nil && class.ReplicationConfig != nil && class.ReplicationConfig.Factor > int64(nodes) {
+		class.ReplicationConfig.Factor = int64(nodes)
+	}
+}
+
+// reconcile changes the local schema to match the primary's snapshot
+func (f *Follower) reconcile(ctx context.Context, snapshot *api.CrossClusterSchemaSnapshot) error {
+	local, err := f.schema.CrossClusterSchemaSnapshot()
+	if err != nil {
+		return fmt.Errorf("read local schema: %w", err)
+	}
+
+	candidates := f.schema.StorageCandidates()
+	domains := f.schema.StorageCandidateDomains()
+
+	primaryClasses := make(map[string]api.AddClassRequest, len(snapshot.Classes))
+	for _, c := range snapshot.Classes {
+		primaryClasses[c.Class.Class] = c
+	}
+	localClasses := make(map[string]api.AddClassRequest, len(local.Classes))
+	for _, c := range local.Classes {
+		localClasses[c.Class.Class] = c
+	}
+
+	var reqs requests
+	for alias := range local.Aliases {
+		if _, ok := snapshot.Aliases[alias]; !ok {
+			reqs.proto(api.ApplyRequest_TYPE_DELETE_ALIAS, "", &api.DeleteAliasRequest{Alias: alias})
+		}
+	}
+	for _, c := range local.Classes {
+		if _, ok := primaryClasses[c.Class.Class]; !ok {
+			reqs.list = append(reqs.list, &api.ApplyRequest{Type: api.ApplyRequest_TYPE_DELETE_CLASS, Class: c.Class.Class})
+		}
+	}
+	for _, c := range snapshot.Classes {
+		l, ok := localClasses[c.Class.Class]
+		if !ok {
+			if err := place(c.Class, c.State, candidates, domains); err != nil {
+				return err
+			}
+			reqs.json(api.ApplyRequest_TYPE_ADD_CLASS, c.Class.Class, api.AddClassRequest{Class: c.Class, State: c.State})
+			continue
+		}
+		classChanges(&reqs, c, l, candidates, domains)
+	}
+	for alias, class := range snapshot.Aliases {
+		target, ok := local.Aliases[alias]
+		switch {
+		case !ok:
+			reqs.proto(api.ApplyRequest_TYPE_CREATE_ALIAS, "", &api.CreateAliasRequest{Collection: class, Alias: alias})
+		case target != class:
+			reqs.proto(api.ApplyRequest_TYPE_REPLACE_ALIAS, "", &api.ReplaceAliasRequest{Collection: class, Alias: alias})
+		}
+	}
+
+	if reqs.err != nil {
+		return reqs.err
+	}
+	for _, req := range reqs.list {
+		if err := f.apply(ctx, req); err != nil {
+			return fmt.Errorf("apply %s of %q: %w", req.Type, req.Class, err)
+		}
+	}
+	return nil
+}
+
+// classChanges adds the schema changes turning the local class into the
+// primary's
+func classChanges(reqs *requests, primary, local api.AddClassRequest, candidates []string, domains map[string]string) {
+	name := primary.Class.Class
+
+	var props []*models.Property
+	for _, p := range primary.Class.Properties {
+		if _, err := schema.GetPropertyByName(local.Class, p.Name); err != nil {
+			props = append(props, p)
+		}
+	}
+	if len(props) > 0 {
+		reqs.json(api.ApplyRequest_TYPE_ADD_PROPERTY, name, api.AddPropertyRequest{Properties: props})
+	}
+
+	capReplicationFactor(primary.Class, len(candidates))
+	if !equalClassConfig(primary.Class, local.Class) {
+		reqs.json(api.ApplyRequest_TYPE_UPDATE_CLASS, name, api.UpdateClassRequest{Class: primary.Class})
+	}
+
+	if !schema.MultiTenancyEnabled(primary.Class) || primary.State == nil || local.State == nil {
+		return
+	}
+
+	var added, updated []*api.Tenant
+	var deleted []string
+	for tenant, shard := range primary.State.Physical {
+		l, ok := local.State.Physical[tenant]
+		switch {
+		case !ok:
+			added = append(added, &api.Tenant{Name: tenant, Status: shard.ActivityStatus()})
+		case l.ActivityStatus() != shard.ActivityStatus():
+			updated = append(updated, &api.Tenant{Name: tenant, Status: shard.ActivityStatus()})
+		}
+	}
+	for tenant := range local.State.Physical {
+		if _, ok := primary.State.Physical[tenant]; !ok {
+			deleted = append(deleted, tenant)
+		}
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i].Name < added[j].Name })
+	sort.Slice(updated, func(i, j int) bool { return updated[i].Name < updated[j].Name })
+	sort.Strings(deleted)
+
+	if len(deleted) > 0 {
+		reqs.proto(api.ApplyRequest_TYPE_DELETE_TENANT, name, &api.DeleteTenantsRequest{Tenants: deleted})
+	}
+	if len(added) > 0 {
+		reqs.proto(api.ApplyRequest_TYPE_ADD_TENANT, name,
+			&api.AddTenantsRequest{ClusterNodes: candidates, Tenants: added, NodeDomains: domains})
+	}
+	if len(updated) > 0 {
+		reqs.proto(api.ApplyRequest_TYPE_UPDATE_TENANT, name,
+			&api.UpdateTenantsRequest{ClusterNodes: candidates, Tenants: updated, NodeDomains: domains})
+	}
+}
+
+// equalClassConfig compares the classes leaving out their properties
+func equalClassConfig(a, b *models.Class) bool {
+	x, y := *a, *b
+	x.Properties, y.Properties = nil, nil
+	bx, err := json.Marshal(x)
+	if err != nil {
+		return false
+	}
+	by, err := json.Marshal(y)
+	if err != nil {
+		return false
+	}
+	return string(bx) == string(by)
+}
+
+// requests collects schema changes, keeping the first encoding error
+type requests struct {
+	list []*api.ApplyRequest
+	err  error
+}
+
+func (r *requests) json(typ api.ApplyRequest_Type, class string, sub any) {
+	b, err := json.Marshal(sub)
+	r.add(typ, class, b, err)
+}
+
+func (r *requests) proto(typ api.ApplyRequest_Type, class string, sub proto.Message) {
+	b, err := proto.Marshal(sub)
+	r.add(typ, class, b, err)
+}
+
+func (r *requests) add(typ api.ApplyRequest_Type, class string, subCommand []byte, err error) {
+	if err != nil {
+		if r.err == nil {
+			r.err = fmt.Errorf("marshal %s: %w", typ, err)
+		}
+		return
+	}
+	r.list = append(r.list, &api.ApplyRequest{Type: typ, Class: class, SubCommand: subCommand})
+}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -43,7 +44,7 @@ func NewManager(schemaReader schema.SchemaReader, nodeSelector cluster.NodeSelec
 	return &Manager{
 		replicationFSM: replicationFSM,
 		schemaReader:   schemaReader,
-		nodeSelector:   nodeSelector,
+		nodeSelector:   drainAwareNodeSelector{nodeSelector, replicationFSM},
 	}
 }
 
@@ -634,3 +635,126 @@ func (m *Manager) ForceDeleteByUuid(c *cmd.ApplyRequest) error {
 
 	return m.replicationFSM.ForceDeleteByUuid(req.Uuid)
 }
+
+func (m *Manager) DrainNode(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationDrainNodeRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.DrainNode(req)
+}
+
+func (m *Manager) UpdateNodeDrain(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationUpdateNodeDrainRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.UpdateNodeDrain(req)
+}
+
+func (m *Manager) CancelNodeDrain(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationCancelNodeDrainRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.CancelNodeDrain(req)
+}
+
+func (m *Manager) QueryNodeDrain(c *cmd.QueryRequest) ([]byte, error) {
+	subCommand := cmd.ReplicationNodeDrainRequest{}
+	if err := json.Unmarshal(c.SubCommand, &subCommand); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	drain, ok := m.replicationFSM.GetNodeDrain(subCommand.Node)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", types.ErrNodeDrainNotFound, subCommand.Node)
+	}
+
+	replicas := 0
+	for _, state := range m.schemaReader.States() {
+		for _, shard := range state.Shards.Physical {
+			if slices.Contains(shard.BelongsToNodes, drain.Node) {
+				replicas++
+			}
+		}
+	}
+
+	response := cmd.ReplicationNodeDrainResponse{
+		Node:              drain.Node,
+		State:             drain.State,
+		StartTimeUnixMs:   drain.StartTimeUnixMs,
+		Error:             drain.Error,
+		ReplicasRemaining: replicas,
+		MovesInFlight:     len(m.replicationFSM.GetMovesFromNode(drain.Node)),
+	}
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal query response for drain of node %s: %w", drain.Node, err)
+	}
+	return payload, nil
+}
+
+func (m *Manager) UpdateCrossCluster(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationUpdateCrossClusterRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.UpdateCrossCluster(req)
+}
+
+func (m *Manager) PromoteCrossCluster(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationPromoteCrossClusterRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.PromoteCrossCluster(req)
+}
+
+func (m *Manager) SetQueryNode(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationSetQueryNodeRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.SetQueryNode(req)
+}
+
+func (m *Manager) SetRebalancerPaused(c *cmd.ApplyRequest) error {
+	req := &cmd.ReplicationSetRebalancerPausedRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return m.replicationFSM.SetRebalancerPaused(req)
+}
+
+func (m *Manager) QueryCrossCluster(c *cmd.QueryRequest) ([]byte, error) {
+	crossCluster := m.replicationFSM.GetCrossCluster()
+	response := cmd.ReplicationCrossClusterResponse{
+		PrimaryIndex:     crossCluster.PrimaryIndex,
+		Promoted:         crossCluster.Promoted,
+		PromotedAtUnixMs: crossCluster.PromotedAtUnixMs,
+	}
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal query response for cross cluster replication: %w", err)
+	}
+	return payload, nil
+}
+
+// drainAwareNodeSelector hides draining nodes from the storage candidates, so
+// that no new replicas are planned on them
+type drainAwareNodeSelector struct {
+	cluster.NodeSelector
+	replicationFSM *ShardReplicationFSM
+}
+
+func (s drainAwareNodeSelector) StorageCandidates() []string {
+	return slices.DeleteFunc(slices.Clone(s.NodeSelector.StorageCandidates()), s.replicationFSM.IsDraining)
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// ShardID identifies a shard of a collection
+type ShardID struct {
+	Collection string `json:"collection"`
+	Shard      string `json:"shard"`
+}
+
+// ShardLoad is the load a replica of a shard puts on the node holding it
+type ShardLoad struct {
+	ShardID
+	Objects int64   `json:"objects"`
+	Load    float64 `json:"load"`
+}
+
+// NodeLoad is the load of a storage node
+type NodeLoad struct {
+	Node     string  `json:"node"`
+	Objects  int64   `json:"objects"`
+	DiskUsed uint64  `json:"diskUsed,omitempty"`
+	Load     float64 `json:"load"`
+
+	shards []ShardLoad
+}
+
+// Move is a replica movement scheduled as a MOVE replication op
+type Move struct {
+	ShardID
+	SourceNode string  `json:"sourceNode"`
+	TargetNode string  `json:"targetNode"`
+	Load       float64 `json:"load"`
+}
+
+// Plan is the result of a rebalancing run. The loads and the imbalance are
+// the ones before the moves are applied, ImbalanceAfter is the expected
+// imbalance once all moves completed.
+type Plan struct {
+	Metric         string     `json:"metric"`
+	Imbalance      float64    `json:"imbalance"`
+	ImbalanceAfter float64    `json:"imbalanceAfter"`
+	InFlight       int        `json:"inFlight"`
+	Nodes          []NodeLoad `json:"nodes"`
+	Moves          []Move     `json:"moves"`
+	// Reason explains why no moves are planned, if so
+	Reason string `json:"reason,omitempty"`
+}
+
+// planner greedily moves replicas from the most to the least loaded nodes
+// until no node exceeds the mean load by more than the threshold. A shard is
+// moved at most once per plan and never to a node which already holds one of
+// its replicas.
+type planner struct {
+	threshold float64
+	loads     map[string]float64
+	shards    map[string][]ShardLoad
+	replicas  map[ShardID][]string
+	busy      map[ShardID]bool
+	available []string
+	domains   map[string]string
+}
+
+func newPlanner(threshold float64, nodes []NodeLoad, replicas map[ShardID][]string,
+	busy map[ShardID]bool, domains map[string]string,
+) *planner {
+	p := &planner{
+		threshold: threshold,
+		loads:     make(map[string]float64, len(nodes)),
+		shards:    make(map[string][]ShardLoad, len(nodes)),
+		replicas:  make(map[ShardID][]string, len(replicas)),
+		busy:      make(map[ShardID]bool, len(busy)),
+		available: make([]string, 0, len(nodes)),
+		domains:   domains,
+	}
+	for _, node := range nodes {
+		p.loads[node.Node] = node.Load
+		p.shards[node.Node] = slices.Clone(node.shards)
+		p.available = append(p.available, node.Node)
+	}
+	for shard, nodes := range replicas {
+		p.replicas[shard] = slices.Clone(nodes)
+	}
+	for shard := range busy {
+		p.busy[shard] = true
+	}
+	return p
+}
+
+func (p *planner) plan(maxMoves int) []Move {
+	var moves []Move
+	for len(moves) < maxMoves {
+		move, ok := p.next()
+		if !ok {
+			break
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+func (p *planner) next() (Move, bool) {
+	mean := p.mean()
+	if mean <= 0 {
+		return Move{}, false
+	}
+
+	byLoad := slices.Clone(p.available)
+	sort.SliceStable(byLoad, func(i, j int) bool { return p.loads[byLoad[i]] > p.loads[byLoad[j]] })
+
+	for _, source := range byLoad {
+		if (p.loads[source]-mean)/mean <= p.threshold {
+			break
+		}
+		for i := len(byLoad) - 1; i >= 0; i-- {
+			target := byLoad[i]
+			diff := p.loads[source] - p.loads[target]
+			if diff <= 0 {
+				break
+			}
+			if idx := p.pickShard(source, target, diff); idx >= 0 {
+				return p.apply(source, target, idx), true
+			}
+		}
+	}
+	return Move{}, false
+}
+
+// pickShard returns the index of the shard of source whose move to target
+// gets both closest to the mean of their loads, or -1 if no move reduces the
+// difference between them
+func (p *planner) pickShard(source, target string, diff float64) int {
+	best, bestDist := -1, math.MaxFloat64
+	for i, shard := range p.shards[source] {
+		if shard.Load <= 0 || shard.Load >= diff || p.busy[shard.ShardID] {
+			continue
+		}
+		replicas := p.replicas[shard.ShardID]
+		if slices.Contains(replicas, target) || !p.placementAllows(replicas, source, target) {
+			continue
+		}
+		if dist := math.Abs(diff/2 - shard.Load); dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+	return best
+}
+
+// placementAllows reports whether moving a replica from source to target
+// keeps the spread across failure domains, unless it is violated already
+func (p *planner) placementAllows(replicas []string, source, target string) bool {
+	if len(p.domains) == 0 || cluster.CheckSpread(replicas, p.available, p.domains) != nil {
+		return true
+	}
+	after := append(slices.DeleteFunc(slices.Clone(replicas), func(node string) bool { return node == source }), target)
+	return cluster.CheckSpread(after, p.available, p.domains) == nil
+}
+
+func (p *planner) apply(source, target string, idx int) Move {
+	shard := p.shards[source][idx]
+	p.shards[source] = slices.Delete(p.shards[source], idx, idx+1)
+	p.shards[target] = append(p.shards[target], shard)
+	p.loads[source] -= shard.Load
+	p.loads[target] += shard.Load
+	p.busy[shard.ShardID] = true
+
+	replicas := p.replicas[shard.ShardID]
+	for i, node := range replicas {
+		if node == source {
+			replicas[i] = target
+		}
+	}
+
+	return Move{ShardID: shard.ShardID, SourceNode: source, TargetNode: target, Load: shard.Load}
+}
+
+func (p *planner) mean() float64 {
+	if len(p.loads) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, load := range p.loads {
+		sum += load
+	}
+	return sum / float64(len(p.loads))
+}
+
+// imbalance is the largest deviation of a node's load from the mean load,
+// relative to the mean load
+func (p *planner) imbalance() float64 {
+	mean := p.mean()
+	if mean <= 0 {
+		return 0
+	}
+	var out float64
+	for _, load := range p.loads {
+		out = max(out, math.Abs(load-mean)/mean)
+	}
+	return out
+}
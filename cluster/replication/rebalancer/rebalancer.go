@@ -0,0 +1,309 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+const (
+	// MetricObjects balances the number of objects held by each node
+	MetricObjects = "objects"
+	// MetricDisk balances the disk usage of each node. The disk usage of a
+	// shard is estimated from its share of the objects held by its node.
+	MetricDisk = "disk"
+)
+
+type Config struct {
+	// Interval between two rebalancing runs
+	Interval time.Duration
+	// ImbalanceThreshold is the deviation of a node's load from the mean
+	// load, relative to the mean load, which is tolerated
+	ImbalanceThreshold float64
+	// MaxConcurrentMoves limits the number of replication ops which may be in
+	// flight. Runs only schedule moves up to this limit.
+	MaxConcurrentMoves int
+	Metric             string
+	// Paused stops scheduling moves, it can be overridden at runtime. The
+	// rebalancer can also be paused through raft, see Replicator.
+	Paused *runtime.DynamicValue[bool]
+}
+
+// NodeStatusGetter returns the status of the nodes as reported by the nodes
+// API
+type NodeStatusGetter interface {
+	GetNodeStatus(ctx context.Context, className, shardName, verbosity string) ([]*models.NodeStatus, error)
+}
+
+// Replicator schedules the replication ops and knows about the replicas of
+// the shards and the ops which are in flight. RebalancerPaused reports the
+// pause switch recorded in raft, which applies to whichever node leads.
+type Replicator interface {
+	IsLeader() bool
+	RebalancerPaused() bool
+	StorageCandidates() []string
+	StorageCandidateDomains() map[string]string
+	ShardReplicas(class, shard string) ([]string, error)
+	GetAllReplicationDetails(ctx context.Context) ([]api.ReplicationDetailsResponse, error)
+	ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID, sourceNode string, sourceCollection string,
+		sourceShard string, targetNode string, transferType string) error
+}
+
+// NodeInfoGetter returns the disk usage gossiped by the cluster members
+type NodeInfoGetter interface {
+	NodeInfo(node string) (cluster.NodeInfo, bool)
+}
+
+// Rebalancer moves shard replicas from overloaded to underloaded nodes, e.g.
+// to fill a node which joined the cluster. It runs on every node, but only
+// the leader schedules moves. The moves are MOVE replication ops, which are
+// executed by the replication engine of the target node.
+type Rebalancer struct {
+	cfg        Config
+	nodes      NodeStatusGetter
+	replicator Replicator
+	nodeInfo   NodeInfoGetter
+	logger     logrus.FieldLogger
+}
+
+func New(cfg Config, nodes NodeStatusGetter, replicator Replicator, nodeInfo NodeInfoGetter,
+	logger logrus.FieldLogger,
+) *Rebalancer {
+	return &Rebalancer{
+		cfg:        cfg,
+		nodes:      nodes,
+		replicator: replicator,
+		nodeInfo:   nodeInfo,
+		logger:     logger.WithField("action", "rebalancer"),
+	}
+}
+
+// Paused reports whether the scheduling of moves is paused, either through
+// raft or by configuration
+func (r *Rebalancer) Paused() bool {
+	return r.replicator.RebalancerPaused() || (r.cfg.Paused != nil && r.cfg.Paused.Get())
+}
+
+// Run triggers a rebalancing run every interval until ctx is done
+func (r *Rebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				r.logger.WithError(err).Error("rebalancing run failed")
+			}
+		}
+	}
+}
+
+// RunOnce plans a rebalancing and schedules its moves, if this node is the
+// leader and the rebalancer is not paused
+func (r *Rebalancer) RunOnce(ctx context.Context) error {
+	if !r.replicator.IsLeader() || r.Paused() {
+		return nil
+	}
+
+	plan, err := r.Plan(ctx, 0)
+	if err != nil {
+		return err
+	}
+	if len(plan.Moves) == 0 {
+		r.logger.WithFields(logrus.Fields{
+			"imbalance": plan.Imbalance,
+			"in_flight": plan.InFlight,
+			"reason":    plan.Reason,
+		}).Debug("no replicas to move")
+		return nil
+	}
+
+	var errs []error
+	for _, move := range plan.Moves {
+		id := strfmt.UUID(uuid.New().String())
+		if err := r.replicator.ReplicationReplicateReplica(ctx, id, move.SourceNode, move.Collection,
+			move.Shard, move.TargetNode, api.MOVE.String()); err != nil {
+			errs = append(errs, fmt.Errorf("move shard %s/%s from %s to %s: %w",
+				move.Collection, move.Shard, move.SourceNode, move.TargetNode, err))
+			continue
+		}
+		r.logger.WithFields(logrus.Fields{
+			"op_id":       id,
+			"collection":  move.Collection,
+			"shard":       move.Shard,
+			"source_node": move.SourceNode,
+			"target_node": move.TargetNode,
+			"load":        move.Load,
+		}).Info("scheduled replica move")
+	}
+	r.logger.WithFields(logrus.Fields{
+		"imbalance":       plan.Imbalance,
+		"imbalance_after": plan.ImbalanceAfter,
+		"moves":           len(plan.Moves) - len(errs),
+	}).Info("rebalancing run finished")
+	return errors.Join(errs...)
+}
+
+// Plan computes the moves which rebalance the storage nodes without
+// scheduling them. At most limit moves are planned. If limit is not
+// positive, the moves are limited by the replication ops which may still be
+// started without exceeding the maximum of concurrent moves.
+func (r *Rebalancer) Plan(ctx context.Context, limit int) (Plan, error) {
+	plan := Plan{Metric: r.cfg.Metric}
+
+	busy, inFlight, err := r.inFlight(ctx)
+	if err != nil {
+		return plan, err
+	}
+	plan.InFlight = inFlight
+
+	nodes, replicas, err := r.loads(ctx)
+	if err != nil {
+		return plan, err
+	}
+	plan.Nodes = nodes
+
+	p := newPlanner(r.cfg.ImbalanceThreshold, nodes, replicas, busy, r.replicator.StorageCandidateDomains())
+	plan.Imbalance = p.imbalance()
+
+	if limit <= 0 {
+		limit = r.cfg.MaxConcurrentMoves - inFlight
+	}
+	switch {
+	case len(nodes) < 2:
+		plan.Reason = "less than two storage nodes"
+	case plan.Imbalance <= r.cfg.ImbalanceThreshold:
+		plan.Reason = "nodes are balanced"
+	case limit <= 0:
+		plan.Reason = "maximum of concurrent moves in flight"
+	default:
+		plan.Moves = p.plan(limit)
+		if len(plan.Moves) == 0 {
+			plan.Reason = "no replica can be moved without exceeding the load of its target"
+		}
+	}
+	plan.ImbalanceAfter = p.imbalance()
+	return plan, nil
+}
+
+// inFlight returns the shards with replication ops which did not finish yet
+// and the number of those ops
+func (r *Rebalancer) inFlight(ctx context.Context) (map[ShardID]bool, int, error) {
+	ops, err := r.replicator.GetAllReplicationDetails(ctx)
+	if err != nil && !errors.Is(err, types.ErrNotFound) {
+		return nil, 0, fmt.Errorf("list replication ops: %w", err)
+	}
+
+	busy := map[ShardID]bool{}
+	count := 0
+	for _, op := range ops {
+		switch api.ShardReplicationState(op.Status.State) {
+		case api.READY, api.CANCELLED:
+			continue
+		}
+		busy[ShardID{Collection: op.Collection, Shard: op.ShardId}] = true
+		count++
+	}
+	return busy, count, nil
+}
+
+// loads collects the load of the storage nodes from the nodes API. It fails
+// if a storage node is not healthy, since the data of unhealthy nodes should
+// not be moved around.
+func (r *Rebalancer) loads(ctx context.Context) ([]NodeLoad, map[ShardID][]string, error) {
+	statuses, err := r.nodes.GetNodeStatus(ctx, "", "", verbosity.OutputVerbose)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get node status: %w", err)
+	}
+	byName := make(map[string]*models.NodeStatus, len(statuses))
+	for _, status := range statuses {
+		byName[status.Name] = status
+	}
+
+	candidates := r.replicator.StorageCandidates()
+	nodes := make([]NodeLoad, 0, len(candidates))
+	replicas := map[ShardID][]string{}
+	for _, name := range candidates {
+		status, ok := byName[name]
+		if !ok || status.Status == nil || *status.Status != models.NodeStatusStatusHEALTHY {
+			return nil, nil, fmt.Errorf("storage node %s is not healthy", name)
+		}
+
+		node := NodeLoad{Node: name}
+		for _, s := range status.Shards {
+			shard := ShardLoad{ShardID: ShardID{Collection: s.Class, Shard: s.Name}, Objects: s.ObjectCount}
+			if _, ok := replicas[shard.ShardID]; !ok {
+				nodes, err := r.replicator.ShardReplicas(shard.Collection, shard.Shard)
+				if err != nil {
+					return nil, nil, fmt.Errorf("replicas of shard %s/%s: %w", shard.Collection, shard.Shard, err)
+				}
+				replicas[shard.ShardID] = nodes
+			}
+			if !slices.Contains(replicas[shard.ShardID], name) {
+				continue // the replica is about to be removed from this node
+			}
+			node.Objects += shard.Objects
+			node.shards = append(node.shards, shard)
+		}
+		if info, ok := r.nodeInfo.NodeInfo(name); ok && info.DiskUsage.Total >= info.DiskUsage.Available {
+			node.DiskUsed = info.DiskUsage.Total - info.DiskUsage.Available
+		}
+
+		sort.Slice(node.shards, func(i, j int) bool {
+			if node.shards[i].Collection != node.shards[j].Collection {
+				return node.shards[i].Collection < node.shards[j].Collection
+			}
+			return node.shards[i].Shard < node.shards[j].Shard
+		})
+
+		node.Load = float64(node.Objects)
+		if r.cfg.Metric == MetricDisk {
+			node.Load = float64(node.DiskUsed)
+		}
+		for i := range node.shards {
+			node.shards[i].Load = r.shardLoad(node, node.shards[i])
+		}
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes, replicas, nil
+}
+
+func (r *Rebalancer) shardLoad(node NodeLoad, shard ShardLoad) float64 {
+	if r.cfg.Metric != MetricDisk {
+		return float64(shard.Objects)
+	}
+	if node.Objects == 0 {
+		return 0
+	}
+	return float64(node.DiskUsed) * float64(shard.Objects) / float64(node.Objects)
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+type fakeNodes struct {
+	shards    map[string]map[string]int64 // node -> shard -> objects
+	unhealthy string
+}
+
+func (f *fakeNodes) GetNodeStatus(ctx context.Context, className, shardName, verbosity string) ([]*models.NodeStatus, error) {
+	var out []*models.NodeStatus
+	for node, shards := range f.shards {
+		status := models.NodeStatusStatusHEALTHY
+		if node == f.unhealthy {
+			status = models.NodeStatusStatusUNAVAILABLE
+		}
+		ns := &models.NodeStatus{Name: node, Status: &status}
+		for shard, objects := range shards {
+			ns.Shards = append(ns.Shards, &models.NodeShardStatus{Class: "C", Name: shard, ObjectCount: objects})
+		}
+		out = append(out, ns)
+	}
+	return out, nil
+}
+
+type scheduledMove struct {
+	source, shard, target, transferType string
+}
+
+type fakeReplicator struct {
+	notLeader bool
+	paused    bool
+	nodes     []string
+	domains   map[string]string
+	replicas  map[string][]string
+	ops       []api.ReplicationDetailsResponse
+	scheduled []scheduledMove
+}
+
+func (f *fakeReplicator) IsLeader() bool                             { return !f.notLeader }
+func (f *fakeReplicator) RebalancerPaused() bool                     { return f.paused }
+func (f *fakeReplicator) StorageCandidates() []string                { return f.nodes }
+func (f *fakeReplicator) StorageCandidateDomains() map[string]string { return f.domains }
+
+func (f *fakeReplicator) ShardReplicas(class, shard string) ([]string, error) {
+	return f.replicas[shard], nil
+}
+
+func (f *fakeReplicator) GetAllReplicationDetails(ctx context.Context) ([]api.ReplicationDetailsResponse, error) {
+	return f.ops, nil
+}
+
+func (f *fakeReplicator) ReplicationReplicateReplica(ctx context.Context, uuid strfmt.UUID, sourceNode string,
+	sourceCollection string, sourceShard string, targetNode string, transferType string,
+) error {
+	f.scheduled = append(f.scheduled, scheduledMove{sourceNode, sourceShard, targetNode, transferType})
+	return nil
+}
+
+type fakeNodeInfo map[string]uint64 // node -> disk used
+
+func (f fakeNodeInfo) NodeInfo(node string) (cluster.NodeInfo, bool) {
+	used, ok := f[node]
+	return cluster.NodeInfo{DiskUsage: cluster.DiskUsage{Total: 1 << 40, Available: 1<<40 - used}}, ok
+}
+
+// newTestCluster returns a cluster of three nodes where the third node just
+// joined and holds no replica yet
+func newTestCluster() (*fakeNodes, *fakeReplicator) {
+	nodes := &fakeNodes{shards: map[string]map[string]int64{
+		"N1": {"a": 100, "b": 100, "c": 100},
+		"N2": {"d": 100, "e": 100, "f": 100},
+		"N3": {},
+	}}
+	replicator := &fakeReplicator{
+		nodes: []string{"N1", "N2", "N3"},
+		replicas: map[string][]string{
+			"a": {"N1"}, "b": {"N1"}, "c": {"N1"},
+			"d": {"N2"}, "e": {"N2"}, "f": {"N2"},
+		},
+	}
+	return nodes, replicator
+}
+
+func testConfig() Config {
+	return Config{
+		Interval:           time.Minute,
+		ImbalanceThreshold: 0.1,
+		MaxConcurrentMoves: 5,
+		Metric:             MetricObjects,
+		Paused:             runtime.NewDynamicValue(false),
+	}
+}
+
+func TestRebalancerRunOnce(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("fills a new node", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		require.NoError(t, r.RunOnce(context.Background()))
+		assert.Equal(t, []scheduledMove{
+			{"N1", "a", "N3", api.MOVE.String()},
+			{"N2", "d", "N3", api.MOVE.String()},
+		}, replicator.scheduled)
+	})
+
+	t.Run("respects the moves in flight", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		replicator.ops = []api.ReplicationDetailsResponse{
+			{Collection: "C", ShardId: "a", Status: api.ReplicationDetailsState{State: api.HYDRATING.String()}},
+			{Collection: "C", ShardId: "e", Status: api.ReplicationDetailsState{State: api.READY.String()}},
+		}
+		cfg := testConfig()
+		cfg.MaxConcurrentMoves = 2
+		r := New(cfg, nodes, replicator, fakeNodeInfo{}, logger)
+
+		require.NoError(t, r.RunOnce(context.Background()))
+		require.Len(t, replicator.scheduled, 1)
+		assert.NotEqual(t, "a", replicator.scheduled[0].shard)
+		assert.Equal(t, "N3", replicator.scheduled[0].target)
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		cfg := testConfig()
+		r := New(cfg, nodes, replicator, fakeNodeInfo{}, logger)
+
+		replicator.paused = true
+		assert.True(t, r.Paused())
+		require.NoError(t, r.RunOnce(context.Background()))
+		assert.Empty(t, replicator.scheduled)
+
+		replicator.paused = false
+		require.NoError(t, cfg.Paused.SetValue(true))
+		assert.True(t, r.Paused())
+		require.NoError(t, r.RunOnce(context.Background()))
+		assert.Empty(t, replicator.scheduled)
+	})
+
+	t.Run("not the leader", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		replicator.notLeader = true
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		require.NoError(t, r.RunOnce(context.Background()))
+		assert.Empty(t, replicator.scheduled)
+	})
+
+	t.Run("unhealthy node", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		nodes.unhealthy = "N2"
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		require.ErrorContains(t, r.RunOnce(context.Background()), "N2 is not healthy")
+		assert.Empty(t, replicator.scheduled)
+	})
+}
+
+func TestRebalancerPlan(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("balanced", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		nodes.shards["N3"] = map[string]int64{"g": 100, "h": 100, "i": 100}
+		replicator.replicas["g"] = []string{"N3"}
+		replicator.replicas["h"] = []string{"N3"}
+		replicator.replicas["i"] = []string{"N3"}
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		plan, err := r.Plan(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Moves)
+		assert.Equal(t, "nodes are balanced", plan.Reason)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		plan, err := r.Plan(context.Background(), 1)
+		require.NoError(t, err)
+		require.Len(t, plan.Moves, 1)
+		assert.Equal(t, 1.0, plan.Imbalance)
+		assert.Equal(t, 0.5, plan.ImbalanceAfter)
+	})
+
+	t.Run("replicas are not moved to nodes holding the shard", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		nodes.shards = map[string]map[string]int64{
+			"N1": {"a": 300},
+			"N2": {"a": 300},
+			"N3": {"b": 10},
+		}
+		replicator.replicas = map[string][]string{"a": {"N1", "N2"}, "b": {"N3"}}
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		plan, err := r.Plan(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Moves, "moving a replica of a would only shift the imbalance")
+	})
+
+	t.Run("placement", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		nodes.shards = map[string]map[string]int64{
+			"N1": {"a": 100, "b": 100},
+			"N2": {"a": 100, "c": 100},
+			"N3": {"b": 100, "c": 100},
+			"N4": {},
+		}
+		replicator.nodes = []string{"N1", "N2", "N3", "N4"}
+		replicator.replicas = map[string][]string{"a": {"N1", "N2"}, "b": {"N1", "N3"}, "c": {"N2", "N3"}}
+		// moving a replica of b or c to N4 would put both replicas in zone z2
+		replicator.domains = map[string]string{"N1": "z1", "N2": "z1", "N3": "z2", "N4": "z2"}
+		r := New(testConfig(), nodes, replicator, fakeNodeInfo{}, logger)
+
+		plan, err := r.Plan(context.Background(), 0)
+		require.NoError(t, err)
+		for _, move := range plan.Moves {
+			assert.NotEqual(t, "N3", move.SourceNode)
+			after := []string{move.TargetNode}
+			for _, node := range replicator.replicas[move.Shard] {
+				if node != move.SourceNode {
+					after = append(after, node)
+				}
+			}
+			assert.NoError(t, cluster.CheckSpread(after, replicator.nodes, replicator.domains), move.Shard)
+		}
+		require.NotEmpty(t, plan.Moves)
+	})
+
+	t.Run("disk metric", func(t *testing.T) {
+		nodes, replicator := newTestCluster()
+		nodes.shards["N3"] = map[string]int64{"g": 100, "h": 100, "i": 100}
+		replicator.replicas["g"] = []string{"N3"}
+		replicator.replicas["h"] = []string{"N3"}
+		replicator.replicas["i"] = []string{"N3"}
+		cfg := testConfig()
+		cfg.Metric = MetricDisk
+		// the objects of N1 are much larger
+		r := New(cfg, nodes, replicator, fakeNodeInfo{"N1": 9000, "N2": 3000, "N3": 3000}, logger)
+
+		plan, err := r.Plan(context.Background(), 0)
+		require.NoError(t, err)
+		require.NotEmpty(t, plan.Moves)
+		assert.Equal(t, "N1", plan.Moves[0].SourceNode)
+		assert.Equal(t, float64(3000), plan.Moves[0].Load)
+	})
+}
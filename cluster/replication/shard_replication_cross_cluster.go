@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+// CrossCluster is the progress of a cluster following a primary cluster. The
+// schema changes of the primary are applied up to PrimaryIndex. Once the
+// follower is promoted it stops following the primary for good.
+type CrossCluster struct {
+	PrimaryIndex     uint64
+	Promoted         bool
+	PromotedAtUnixMs int64
+}
+
+func (s *ShardReplicationFSM) UpdateCrossCluster(c *api.ReplicationUpdateCrossClusterRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	if s.crossCluster.Promoted {
+		return types.ErrCrossClusterPromoted
+	}
+	s.crossCluster.PrimaryIndex = c.PrimaryIndex
+	return nil
+}
+
+func (s *ShardReplicationFSM) PromoteCrossCluster(c *api.ReplicationPromoteCrossClusterRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	if s.crossCluster.Promoted {
+		return types.ErrCrossClusterPromoted
+	}
+	s.crossCluster.Promoted = true
+	s.crossCluster.PromotedAtUnixMs = c.PromotedAtUnixMs
+	return nil
+}
+
+func (s *ShardReplicationFSM) GetCrossCluster() CrossCluster {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	return s.crossCluster
+}
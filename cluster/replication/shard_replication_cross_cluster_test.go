@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+func TestShardReplicationFSM_CrossCluster(t *testing.T) {
+	fsm := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	assert.Equal(t, CrossCluster{}, fsm.GetCrossCluster())
+
+	require.NoError(t, fsm.UpdateCrossCluster(&api.ReplicationUpdateCrossClusterRequest{PrimaryIndex: 42}))
+	assert.Equal(t, CrossCluster{PrimaryIndex: 42}, fsm.GetCrossCluster())
+
+	snapshot, err := fsm.Snapshot()
+	require.NoError(t, err)
+	restored := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, restored.Restore(snapshot))
+	assert.Equal(t, fsm.GetCrossCluster(), restored.GetCrossCluster())
+
+	require.NoError(t, fsm.PromoteCrossCluster(&api.ReplicationPromoteCrossClusterRequest{PromotedAtUnixMs: 7}))
+	assert.Equal(t, CrossCluster{PrimaryIndex: 42, Promoted: true, PromotedAtUnixMs: 7}, fsm.GetCrossCluster())
+
+	// a promoted cluster does not follow the primary anymore
+	require.ErrorIs(t, fsm.UpdateCrossCluster(&api.ReplicationUpdateCrossClusterRequest{PrimaryIndex: 43}), types.ErrCrossClusterPromoted)
+	require.ErrorIs(t, fsm.PromoteCrossCluster(&api.ReplicationPromoteCrossClusterRequest{PromotedAtUnixMs: 8}), types.ErrCrossClusterPromoted)
+	assert.Equal(t, int64(7), fsm.GetCrossCluster().PromotedAtUnixMs)
+}
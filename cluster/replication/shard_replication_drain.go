@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+// NodeDrain is the drain of a node which is about to leave the cluster. The
+// replicas of a draining node get moved to other nodes, and no new replicas
+// are placed on it. Once it holds no replicas anymore it is removed from raft.
+type NodeDrain struct {
+	Node            string
+	State           api.NodeDrainState
+	StartTimeUnixMs int64
+	// Error is the last error which prevented the drain from progressing
+	Error string
+}
+
+func (s *ShardReplicationFSM) DrainNode(c *api.ReplicationDrainNodeRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	if drain, ok := s.drains[c.Node]; ok && drain.State == api.DRAINING {
+		return nil
+	}
+	s.drains[c.Node] = NodeDrain{
+		Node:            c.Node,
+		State:           api.DRAINING,
+		StartTimeUnixMs: c.StartTimeUnixMs,
+	}
+	return nil
+}
+
+func (s *ShardReplicationFSM) UpdateNodeDrain(c *api.ReplicationUpdateNodeDrainRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	drain, ok := s.drains[c.Node]
+	if !ok || drain.State != api.DRAINING {
+		return fmt.Errorf("%w: %s", types.ErrNodeDrainNotFound, c.Node)
+	}
+	drain.State = c.State
+	drain.Error = c.Error
+	s.drains[c.Node] = drain
+	return nil
+}
+
+// CancelNodeDrain forgets the drain of the node. A draining node accepts new
+// replicas again, replicas which have already been moved stay where they are.
+func (s *ShardReplicationFSM) CancelNodeDrain(c *api.ReplicationCancelNodeDrainRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	if _, ok := s.drains[c.Node]; !ok {
+		return fmt.Errorf("%w: %s", types.ErrNodeDrainNotFound, c.Node)
+	}
+	delete(s.drains, c.Node)
+	return nil
+}
+
+func (s *ShardReplicationFSM) GetNodeDrain(node string) (NodeDrain, bool) {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	drain, ok := s.drains[node]
+	return drain, ok
+}
+
+// GetNodeDrains returns all drains sorted by node name
+func (s *ShardReplicationFSM) GetNodeDrains() []NodeDrain {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	drains := make([]NodeDrain, 0, len(s.drains))
+	for _, drain := range s.drains {
+		drains = append(drains, drain)
+	}
+	sort.Slice(drains, func(i, j int) bool { return drains[i].Node < drains[j].Node })
+	return drains
+}
+
+// IsDraining returns true if the node must not get any new replicas
+func (s *ShardReplicationFSM) IsDraining(node string) bool {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	drain, ok := s.drains[node]
+	return ok && drain.State == api.DRAINING
+}
+
+// GetMovesFromNode returns the MOVE ops with the node as source which are
+// neither READY nor CANCELLED
+func (s *ShardReplicationFSM) GetMovesFromNode(node string) []ShardReplicationOp {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	var moves []ShardReplicationOp
+	for _, op := range s.opsBySource[node] {
+		if op.TransferType != api.MOVE {
+			continue
+		}
+		status, ok := s.statusById[op.ID]
+		if !ok {
+			continue
+		}
+		if state := status.GetCurrentState(); state == api.READY || state == api.CANCELLED {
+			continue
+		}
+		moves = append(moves, op)
+	}
+	return moves
+}
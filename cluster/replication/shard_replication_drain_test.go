@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+func TestShardReplicationFSM_NodeDrain(t *testing.T) {
+	fsm := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+
+	require.ErrorIs(t, fsm.UpdateNodeDrain(&api.ReplicationUpdateNodeDrainRequest{Node: "N1", State: api.REMOVED}), types.ErrNodeDrainNotFound)
+	require.ErrorIs(t, fsm.CancelNodeDrain(&api.ReplicationCancelNodeDrainRequest{Node: "N1"}), types.ErrNodeDrainNotFound)
+
+	require.NoError(t, fsm.DrainNode(&api.ReplicationDrainNodeRequest{Node: "N2", StartTimeUnixMs: 2}))
+	require.NoError(t, fsm.DrainNode(&api.ReplicationDrainNodeRequest{Node: "N1", StartTimeUnixMs: 1}))
+	// draining again keeps the original start time
+	require.NoError(t, fsm.DrainNode(&api.ReplicationDrainNodeRequest{Node: "N1", StartTimeUnixMs: 5}))
+	assert.True(t, fsm.IsDraining("N1"))
+	assert.False(t, fsm.IsDraining("N3"))
+
+	require.NoError(t, fsm.UpdateNodeDrain(&api.ReplicationUpdateNodeDrainRequest{Node: "N1", State: api.DRAINING, Error: "boom"}))
+	drain, ok := fsm.GetNodeDrain("N1")
+	require.True(t, ok)
+	assert.Equal(t, NodeDrain{Node: "N1", State: api.DRAINING, StartTimeUnixMs: 1, Error: "boom"}, drain)
+
+	snapshot, err := fsm.Snapshot()
+	require.NoError(t, err)
+	restored := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, restored.Restore(snapshot))
+	assert.Equal(t, fsm.GetNodeDrains(), restored.GetNodeDrains())
+	assert.Equal(t, []string{"N1", "N2"}, []string{restored.GetNodeDrains()[0].Node, restored.GetNodeDrains()[1].Node})
+
+	require.NoError(t, fsm.UpdateNodeDrain(&api.ReplicationUpdateNodeDrainRequest{Node: "N2", State: api.REMOVED}))
+	assert.False(t, fsm.IsDraining("N2"))
+	require.ErrorIs(t, fsm.UpdateNodeDrain(&api.ReplicationUpdateNodeDrainRequest{Node: "N2", State: api.DRAINING}), types.ErrNodeDrainNotFound)
+
+	require.NoError(t, fsm.CancelNodeDrain(&api.ReplicationCancelNodeDrainRequest{Node: "N1"}))
+	_, ok = fsm.GetNodeDrain("N1")
+	assert.False(t, ok)
+}
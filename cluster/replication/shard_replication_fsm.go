@@ -79,6 +79,14 @@ type ShardReplicationFSM struct {
 	opsById map[uint64]ShardReplicationOp
 	// opsStatus stores op -> opStatus
 	statusById map[uint64]ShardReplicationOpStatus
+	// drains stores the drain (if any) of each node
+	drains map[string]NodeDrain
+	// crossCluster stores the progress of the follower mode, see CrossCluster
+	crossCluster CrossCluster
+	// queryNodes stores the nodes registered as query nodes
+	queryNodes map[string]struct{}
+	// rebalancerPaused stops the rebalancer from scheduling moves
+	rebalancerPaused bool
 
 	opsByStateGauge *prometheus.GaugeVec
 }
@@ -94,6 +102,8 @@ func NewShardReplicationFSM(reg prometheus.Registerer) *ShardReplicationFSM {
 		opsBySourceFQDN:         make(map[shardFQDN][]ShardReplicationOp),
 		opsById:                 make(map[uint64]ShardReplicationOp),
 		statusById:              make(map[uint64]ShardReplicationOpStatus),
+		drains:                  make(map[string]NodeDrain),
+		queryNodes:              make(map[string]struct{}),
 	}
 
 	fsm.opsByStateGauge = promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
@@ -106,7 +116,11 @@ func NewShardReplicationFSM(reg prometheus.Registerer) *ShardReplicationFSM {
 }
 
 type snapshot struct {
-	Ops map[ShardReplicationOp]ShardReplicationOpStatus
+	Ops              map[ShardReplicationOp]ShardReplicationOpStatus
+	Drains           map[string]NodeDrain `json:",omitempty"`
+	CrossCluster     *CrossCluster        `json:",omitempty"`
+	QueryNodes       []string             `json:",omitempty"`
+	RebalancerPaused bool                 `json:",omitempty"`
 }
 
 func (s *ShardReplicationFSM) Snapshot() ([]byte, error) {
@@ -120,9 +134,26 @@ func (s *ShardReplicationFSM) Snapshot() ([]byte, error) {
 		}
 		ops[op] = status
 	}
+	var drains map[string]NodeDrain
+	if len(s.drains) > 0 {
+		drains = maps.Clone(s.drains)
+	}
+	var crossCluster *CrossCluster
+	if s.crossCluster != (CrossCluster{}) {
+		cc := s.crossCluster
+		crossCluster = &cc
+	}
+	queryNodes := s.sortedQueryNodes()
+	rebalancerPaused := s.rebalancerPaused
 	s.opsLock.RUnlock()
 
-	return json.Marshal(&snapshot{Ops: ops})
+	return json.Marshal(&snapshot{
+		Ops:              ops,
+		Drains:           drains,
+		CrossCluster:     crossCluster,
+		QueryNodes:       queryNodes,
+		RebalancerPaused: rebalancerPaused,
+	})
 }
 
 func (s *ShardReplicationFSM) Restore(bytes []byte) error {
@@ -141,6 +172,16 @@ func (s *ShardReplicationFSM) Restore(bytes []byte) error {
 			return err
 		}
 	}
+	for node, drain := range snap.Drains {
+		s.drains[node] = drain
+	}
+	if snap.CrossCluster != nil {
+		s.crossCluster = *snap.CrossCluster
+	}
+	for _, node := range snap.QueryNodes {
+		s.queryNodes[node] = struct{}{}
+	}
+	s.rebalancerPaused = snap.RebalancerPaused
 
 	return nil
 }
@@ -159,6 +200,10 @@ func (s *ShardReplicationFSM) resetState() {
 	maps.Clear(s.opsBySourceFQDN)
 	maps.Clear(s.opsById)
 	maps.Clear(s.statusById)
+	maps.Clear(s.drains)
+	s.crossCluster = CrossCluster{}
+	maps.Clear(s.queryNodes)
+	s.rebalancerPaused = false
 
 	s.opsByStateGauge.Reset()
 }
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// SetQueryNode registers or removes a query node. Query nodes are recorded in
+// raft rather than taken from the memberlist, so that they are still known
+// while they are down and their replicas keep being excluded from the quorums.
+func (s *ShardReplicationFSM) SetQueryNode(c *api.ReplicationSetQueryNodeRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	if c.QueryOnly {
+		s.queryNodes[c.Node] = struct{}{}
+	} else {
+		delete(s.queryNodes, c.Node)
+	}
+	return nil
+}
+
+// IsQueryNode returns true if the node is registered as query node
+func (s *ShardReplicationFSM) IsQueryNode(node string) bool {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	_, ok := s.queryNodes[node]
+	return ok
+}
+
+// QueryNodes returns the registered query nodes sorted by name
+func (s *ShardReplicationFSM) QueryNodes() []string {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	return s.sortedQueryNodes()
+}
+
+// sortedQueryNodes expects the caller to hold opsLock
+func (s *ShardReplicationFSM) sortedQueryNodes() []string {
+	if len(s.queryNodes) == 0 {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(s.queryNodes))
+}
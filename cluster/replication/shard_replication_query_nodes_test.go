@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func TestShardReplicationFSM_QueryNodes(t *testing.T) {
+	fsm := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	assert.Empty(t, fsm.QueryNodes())
+
+	require.NoError(t, fsm.SetQueryNode(&api.ReplicationSetQueryNodeRequest{Node: "Q2", QueryOnly: true}))
+	require.NoError(t, fsm.SetQueryNode(&api.ReplicationSetQueryNodeRequest{Node: "Q1", QueryOnly: true}))
+	require.NoError(t, fsm.SetQueryNode(&api.ReplicationSetQueryNodeRequest{Node: "Q1", QueryOnly: true}))
+	assert.True(t, fsm.IsQueryNode("Q1"))
+	assert.False(t, fsm.IsQueryNode("N1"))
+	assert.Equal(t, []string{"Q1", "Q2"}, fsm.QueryNodes())
+
+	snapshot, err := fsm.Snapshot()
+	require.NoError(t, err)
+	restored := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, restored.Restore(snapshot))
+	assert.Equal(t, []string{"Q1", "Q2"}, restored.QueryNodes())
+
+	require.NoError(t, fsm.SetQueryNode(&api.ReplicationSetQueryNodeRequest{Node: "Q2", QueryOnly: false}))
+	assert.False(t, fsm.IsQueryNode("Q2"))
+	assert.Equal(t, []string{"Q1"}, fsm.QueryNodes())
+}
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import "github.com/weaviate/weaviate/cluster/proto/api"
+
+// SetRebalancerPaused pauses or resumes the rebalancer. The switch is
+// recorded in raft, since only the leader schedules moves and the leader may
+// change while the rebalancer is paused.
+func (s *ShardReplicationFSM) SetRebalancerPaused(c *api.ReplicationSetRebalancerPausedRequest) error {
+	s.opsLock.Lock()
+	defer s.opsLock.Unlock()
+
+	s.rebalancerPaused = c.Paused
+	return nil
+}
+
+// RebalancerPaused returns true if the rebalancer must not schedule moves
+func (s *ShardReplicationFSM) RebalancerPaused() bool {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+
+	return s.rebalancerPaused
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func TestShardReplicationFSM_RebalancerPaused(t *testing.T) {
+	fsm := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	assert.False(t, fsm.RebalancerPaused())
+
+	require.NoError(t, fsm.SetRebalancerPaused(&api.ReplicationSetRebalancerPausedRequest{Paused: true}))
+	assert.True(t, fsm.RebalancerPaused())
+
+	snapshot, err := fsm.Snapshot()
+	require.NoError(t, err)
+	restored := NewShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, restored.Restore(snapshot))
+	assert.True(t, restored.RebalancerPaused())
+
+	require.NoError(t, fsm.SetRebalancerPaused(&api.ReplicationSetRebalancerPausedRequest{Paused: false}))
+	assert.False(t, fsm.RebalancerPaused())
+
+	snapshot, err = fsm.Snapshot()
+	require.NoError(t, err)
+	require.NoError(t, restored.Restore(snapshot))
+	assert.False(t, restored.RebalancerPaused())
+}
@@ -18,6 +18,9 @@ var (
 	ErrCancellationImpossible       = errors.New("cancellation impossible")
 	ErrDeletionImpossible           = errors.New("deletion impossible")
 	ErrReplicationOperationNotFound = errors.New("replication operation not found")
+	ErrNodeDrainNotFound            = errors.New("node drain not found")
+	ErrCrossClusterPromoted         = errors.New("cluster has been promoted")
+	ErrCrossClusterFollowing        = errors.New("cluster follows a primary cluster and accepts writes only once it has been promoted")
 	// ErrNotFound is a custom error that is used to indicate that a resource was not found.
 	// We use it to return a specific error code from the RPC layer to ensure we don't retry an operation
 	// returning an error indicating that the resource was not found.
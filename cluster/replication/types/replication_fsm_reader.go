@@ -19,3 +19,18 @@ type ReplicationFSMReader interface {
 	// It returns a tuple of (writeReplicas, additionalWriteReplicas)
 	FilterOneShardReplicasWrite(collection string, shard string, shardReplicasLocation []string) ([]string, []string)
 }
+
+// QueryNodeLister is implemented by the replication FSM readers which know
+// about the query nodes registered in raft
+type QueryNodeLister interface {
+	QueryNodes() []string
+}
+
+// QueryNodes returns the query nodes known to r, or none if it isn't aware
+// of them
+func QueryNodes(r ReplicationFSMReader) []string {
+	if l, ok := r.(QueryNodeLister); ok {
+		return l.QueryNodes()
+	}
+	return nil
+}
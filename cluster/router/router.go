@@ -22,6 +22,7 @@ package router
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
 	"github.com/weaviate/weaviate/cluster/router/types"
@@ -145,6 +146,46 @@ func sort(replicas []types.Replica, preferredNodeName string) []types.Replica {
 	return append(orderedReplicas, otherReplicas...)
 }
 
+// splitQueryReplicas moves the replicas on query nodes to the additional write
+// replicas: they get the writes, but don't count for the consistency level.
+func splitQueryReplicas(write, additional, queryNodes []string) ([]string, []string) {
+	if len(queryNodes) == 0 {
+		return write, additional
+	}
+	storage := make([]string, 0, len(write))
+	for _, node := range write {
+		if slices.Contains(queryNodes, node) {
+			if !slices.Contains(additional, node) {
+				additional = append(additional, node)
+			}
+			continue
+		}
+		storage = append(storage, node)
+	}
+	return storage, additional
+}
+
+// readsFromQueryReplicas reports whether reads at the given consistency level
+// may be served by query replicas. Since writes don't wait for them, they can
+// lag behind and only answer reads which need a single replica.
+func readsFromQueryReplicas(cl types.ConsistencyLevel) bool {
+	return cl != types.ConsistencyLevelQuorum && cl != types.ConsistencyLevelAll
+}
+
+// withoutQueryReplicas removes the replicas on query nodes from the replica set.
+func withoutQueryReplicas(set types.ReadReplicaSet, queryNodes []string) types.ReadReplicaSet {
+	if len(queryNodes) == 0 {
+		return set
+	}
+	replicas := make([]types.Replica, 0, len(set.Replicas))
+	for _, replica := range set.Replicas {
+		if !slices.Contains(queryNodes, replica.NodeName) {
+			replicas = append(replicas, replica)
+		}
+	}
+	return types.ReadReplicaSet{Replicas: replicas}
+}
+
 // preferredNode determines the preferred node for replica ordering by selecting
 // the direct candidate if specified, otherwise falling back to the local node.
 func preferredNode(directCandidate string, localNodeName string) string {
@@ -319,6 +360,7 @@ func (r *singleTenantRouter) writeReplicasForShard(collection, tenant, shard str
 	}
 
 	writeNodeNames, additionalWriteNodeNames := r.replicationFSMReader.FilterOneShardReplicasWrite(collection, shard, replicas)
+	writeNodeNames, additionalWriteNodeNames = splitQueryReplicas(writeNodeNames, additionalWriteNodeNames, replicationTypes.QueryNodes(r.replicationFSMReader))
 
 	write = buildReplicas(writeNodeNames, shard, r.nodeSelector.NodeHostname)
 	additional = buildReplicas(additionalWriteNodeNames, shard, r.nodeSelector.NodeHostname)
@@ -341,6 +383,10 @@ func (r *singleTenantRouter) buildReadRoutingPlan(params types.RoutingPlanBuildO
 		return types.ReadRoutingPlan{}, err
 	}
 
+	if !readsFromQueryReplicas(params.ConsistencyLevel) {
+		readReplicas = withoutQueryReplicas(readReplicas, replicationTypes.QueryNodes(r.replicationFSMReader))
+	}
+
 	if len(readReplicas.Replicas) == 0 {
 		return types.ReadRoutingPlan{}, fmt.Errorf("no read replica found")
 	}
@@ -518,6 +564,7 @@ func (r *multiTenantRouter) getWriteReplicasLocation(collection string, tenant,
 	}
 
 	writeNodeNames, additionalWriteNodeNames := r.replicationFSMReader.FilterOneShardReplicasWrite(collection, shard, replicas)
+	writeNodeNames, additionalWriteNodeNames = splitQueryReplicas(writeNodeNames, additionalWriteNodeNames, replicationTypes.QueryNodes(r.replicationFSMReader))
 	writeReplicas := buildReplicas(writeNodeNames, shard, r.nodeSelector.NodeHostname)
 	additionalWriteReplicas := buildReplicas(additionalWriteNodeNames, shard, r.nodeSelector.NodeHostname)
 
@@ -593,6 +640,10 @@ func (r *multiTenantRouter) buildReadRoutingPlan(params types.RoutingPlanBuildOp
 		return types.ReadRoutingPlan{}, err
 	}
 
+	if !readsFromQueryReplicas(params.ConsistencyLevel) {
+		readReplicas = withoutQueryReplicas(readReplicas, replicationTypes.QueryNodes(r.replicationFSMReader))
+	}
+
 	if len(readReplicas.Replicas) == 0 {
 		return types.ReadRoutingPlan{}, fmt.Errorf("no read replica found")
 	}
@@ -2131,3 +2131,59 @@ func TestSingleTenantRouter_BuildReadRoutingPlan_AllShards(t *testing.T) {
 	require.True(t, shardNames["shard1"], "should have replica from shard1")
 	require.True(t, shardNames["shard2"], "should have replica from shard2")
 }
+
+func TestSingleTenantRouter_QueryReplicas(t *testing.T) {
+	mockSchemaGetter := schema.NewMockSchemaGetter(t)
+	mockSchemaReader := schema.NewMockSchemaReader(t)
+	mockReplicationFSM := replicationTypes.NewMockReplicationFSMReader(t)
+	mockNodeSelector := mocks.NewMockNodeSelector("node1", "node2", "node3")
+
+	state := createShardingStateWithShards([]string{"shard1"})
+	mockSchemaReader.EXPECT().Shards(mock.Anything).Return(state.AllPhysicalShards(), nil).Maybe()
+
+	replicas := []string{"node1", "node2", "node3"}
+	mockSchemaReader.EXPECT().ShardReplicas("TestClass", "shard1").Return(replicas, nil).Maybe()
+	mockReplicationFSM.EXPECT().FilterOneShardReplicasRead("TestClass", "shard1", replicas).Return(replicas).Maybe()
+	mockReplicationFSM.EXPECT().FilterOneShardReplicasWrite("TestClass", "shard1", replicas).Return(replicas, nil).Maybe()
+
+	r := router.NewBuilder(
+		"TestClass",
+		false,
+		mockNodeSelector,
+		mockSchemaGetter,
+		mockSchemaReader,
+		queryNodesFSMReader{mockReplicationFSM, []string{"node3"}},
+	).Build()
+
+	t.Run("writes don't count query replicas", func(t *testing.T) {
+		plan, err := r.BuildWriteRoutingPlan(types.RoutingPlanBuildOptions{Shard: "shard1", ConsistencyLevel: types.ConsistencyLevelAll})
+		require.NoError(t, err)
+		require.Equal(t, []string{"node1", "node2"}, plan.ReplicaSet.NodeNames())
+		require.Equal(t, []string{"node3"}, plan.ReplicaSet.AdditionalNodeNames())
+		require.Equal(t, 2, plan.IntConsistencyLevel)
+	})
+
+	t.Run("reads at ONE include query replicas", func(t *testing.T) {
+		plan, err := r.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{Shard: "shard1", ConsistencyLevel: types.ConsistencyLevelOne})
+		require.NoError(t, err)
+		require.ElementsMatch(t, replicas, plan.ReplicaSet.NodeNames())
+	})
+
+	t.Run("reads at QUORUM skip query replicas", func(t *testing.T) {
+		plan, err := r.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{Shard: "shard1", ConsistencyLevel: types.ConsistencyLevelQuorum})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"node1", "node2"}, plan.ReplicaSet.NodeNames())
+		require.Equal(t, 2, plan.IntConsistencyLevel)
+	})
+}
+
+// queryNodesFSMReader adds the query nodes registered in raft to a replication
+// FSM reader
+type queryNodesFSMReader struct {
+	replicationTypes.ReplicationFSMReader
+	queryNodes []string
+}
+
+func (r queryNodesFSMReader) QueryNodes() []string {
+	return r.queryNodes
+}
@@ -468,6 +468,82 @@ func (s *SchemaManager) DeleteReplicaFromShard(cmd *command.ApplyRequest, schema
 	)
 }
 
+func (s *SchemaManager) SplitShard(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.SplitShardRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.splitShard(cmd.Class, cmd.Version, req.Shard, req.TargetShard) },
+			updateStore: func() error {
+				if s.isLocalShardReplica(req.Class, req.Shard) {
+					return s.db.StartShardSplit(req.Class, req.Shard)
+				}
+				return nil
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) SplitShardReady(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.SplitShardReadyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	var committed *sharding.ShardSplit
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() (err error) {
+				committed, err = s.schema.splitShardReady(cmd.Class, cmd.Version, req.Shard, req.Node)
+				return err
+			},
+			updateStore: func() error {
+				if committed != nil && s.isLocalShardReplica(req.Class, req.Shard) {
+					return s.db.CommitShardSplit(req.Class, req.Shard, committed.Target)
+				}
+				return nil
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) SplitShardAbort(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.SplitShardAbortRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	var aborted *sharding.ShardSplit
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() (err error) {
+				aborted, err = s.schema.splitShardAbort(cmd.Class, cmd.Version, req.Shard)
+				return err
+			},
+			updateStore: func() error {
+				if s.isLocalShardReplica(req.Class, req.Shard) {
+					return s.db.AbortShardSplit(req.Class, req.Shard, aborted.Target)
+				}
+				return nil
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) isLocalShardReplica(class, shard string) bool {
+	replicas, _, err := s.schema.ShardReplicas(class, shard)
+	return err == nil && slices.Contains(replicas, s.schema.nodeID)
+}
+
 func (s *SchemaManager) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.AddTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	entSchema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 type (
@@ -197,6 +198,50 @@ func (m *metaClass) DeleteReplicaFromShard(v uint64, shard string, replica strin
 	return nil
 }
 
+func (m *metaClass) StartShardSplit(v uint64, shard, target string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.Sharding.StartSplit(shard, target); err != nil {
+		return err
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+// MarkShardSplitReady returns the split if it got committed by this call. On
+// commit the sharding config of the class is updated to the new shard count.
+func (m *metaClass) MarkShardSplitReady(v uint64, shard, node string) (*sharding.ShardSplit, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	split, err := m.Sharding.MarkSplitReady(shard, node)
+	if err != nil {
+		return nil, err
+	}
+	if split != nil {
+		if cfg, ok := m.Class.ShardingConfig.(shardingConfig.Config); ok {
+			cfg.DesiredCount = m.Sharding.Config.DesiredCount
+			cfg.ActualCount = m.Sharding.Config.ActualCount
+			m.Class.ShardingConfig = cfg
+		}
+	}
+	m.ClassVersion = v
+	return split, nil
+}
+
+func (m *metaClass) AbortShardSplit(v uint64, shard string) (*sharding.ShardSplit, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	split, err := m.Sharding.AbortSplit(shard)
+	if err != nil {
+		return nil, err
+	}
+	m.ClassVersion = v
+	return split, nil
+}
+
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
@@ -246,7 +291,7 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 		names[i] = tenant.Name
 	}
 	// First determine the partition based on the node *present at the time of the log entry being created*
-	partitions, err := m.Sharding.GetPartitions(req.ClusterNodes, names, replFactor)
+	partitions, err := m.Sharding.GetPartitionsWithDomains(req.ClusterNodes, names, replFactor, req.NodeDomains)
 	if err != nil {
 		return nil, fmt.Errorf("get partitions: %w", err)
 	}
@@ -606,7 +651,7 @@ func (m *metaClass) unfreeze(nodeID string, i int, req *command.UpdateTenantsReq
 	name := req.Tenants[i].Name
 	process := m.shardProcess(name, command.TenantProcessRequest_ACTION_UNFREEZING)
 
-	partitions, err := m.Sharding.GetPartitions(req.ClusterNodes, []string{name}, m.Class.ReplicationConfig.Factor)
+	partitions, err := m.Sharding.GetPartitionsWithDomains(req.ClusterNodes, []string{name}, m.Class.ReplicationConfig.Factor, req.NodeDomains)
 	if err != nil {
 		req.Tenants[i] = nil
 		return fmt.Errorf("get partitions: %w", err)
@@ -474,6 +474,36 @@ func (s *schema) deleteReplicaFromShard(class string, v uint64, shard string, re
 	return meta.DeleteReplicaFromShard(v, shard, replica)
 }
 
+func (s *schema) splitShard(class string, v uint64, shard, target string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.unsafeResolveClass(class)
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.StartShardSplit(v, shard, target)
+}
+
+func (s *schema) splitShardReady(class string, v uint64, shard, node string) (*sharding.ShardSplit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.unsafeResolveClass(class)
+	if meta == nil {
+		return nil, ErrClassNotFound
+	}
+	return meta.MarkShardSplitReady(v, shard, node)
+}
+
+func (s *schema) splitShardAbort(class string, v uint64, shard string) (*sharding.ShardSplit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.unsafeResolveClass(class)
+	if meta == nil {
+		return nil, ErrClassNotFound
+	}
+	return meta.AbortShardSplit(v, shard)
+}
+
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 
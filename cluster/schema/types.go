@@ -39,6 +39,13 @@ type Indexer interface {
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
 	AddReplicaToShard(class, shard, targetNode string) error
 	DeleteReplicaFromShard(class, shard, targetNode string) error
+	// StartShardSplit starts preparing the pending split of a local shard
+	StartShardSplit(class, shard string) error
+	// CommitShardSplit makes the split target of a local shard serve its
+	// moved token range and removes the moved objects from the source
+	CommitShardSplit(class, shard, target string) error
+	// AbortShardSplit discards the split target of a local shard
+	AbortShardSplit(class, shard, target string) error
 	LoadShard(class, shard string)     // is a no-op
 	ShutdownShard(class, shard string) // is a no-op
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
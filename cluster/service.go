@@ -41,6 +41,8 @@ const (
 	replicationEngineShutdownTimeout = 20 * time.Second
 	replicationOperationTimeout      = 24 * time.Hour
 	catchUpInterval                  = 5 * time.Second
+	nodeDrainInterval                = 10 * time.Second
+	nodeDrainMaxConcurrentMoves      = 2
 )
 
 // Service class serves as the primary entry point for the Raft layer, managing and coordinating
@@ -49,6 +51,7 @@ type Service struct {
 	*Raft
 
 	replicationEngine *replication.ShardReplicationEngine
+	nodeDrainer       *nodeDrainer
 	raftAddr          string
 	config            *Config
 
@@ -105,6 +108,7 @@ func New(cfg Config, authZController authorization.Controller, snapshotter fsm.S
 	return &Service{
 		Raft:               raft,
 		replicationEngine:  replicationEngine,
+		nodeDrainer:        newNodeDrainer(raft, nodeDrainInterval, nodeDrainMaxConcurrentMoves, cfg.Logger),
 		raftAddr:           fmt.Sprintf("%s:%d", cfg.Host, cfg.RaftPort),
 		config:             &cfg,
 		rpcClient:          client,
@@ -117,10 +121,6 @@ func New(cfg Config, authZController authorization.Controller, snapshotter fsm.S
 }
 
 func (c *Service) onFSMCaughtUp(ctx context.Context) {
-	if !c.config.ReplicaMovementEnabled {
-		return
-	}
-
 	ticker := time.NewTicker(catchUpInterval)
 	defer ticker.Stop()
 	for {
@@ -129,6 +129,10 @@ func (c *Service) onFSMCaughtUp(ctx context.Context) {
 			return
 		case <-ticker.C:
 			if c.Raft.store.FSMHasCaughtUp() {
+				c.syncQueryNode(ctx)
+				if !c.config.ReplicaMovementEnabled {
+					return
+				}
 				c.logger.Infof("Metadata FSM reported caught up, starting replication engine")
 				engineCtx, engineCancel := context.WithCancel(ctx)
 				c.cancelReplicationEngine = engineCancel
@@ -140,12 +144,27 @@ func (c *Service) onFSMCaughtUp(ctx context.Context) {
 						}
 					}
 				}, c.logger)
+				enterrors.GoWrapper(func() {
+					c.nodeDrainer.run(engineCtx)
+				}, c.logger)
 				return
 			}
 		}
 	}
 }
 
+// syncQueryNode records in raft whether this node is a query node, so that
+// the other nodes keep excluding its replicas from the quorums while it is down
+func (c *Service) syncQueryNode(ctx context.Context) {
+	if c.Raft.isQueryNode(c.config.NodeID) == c.config.QueryOnly {
+		return
+	}
+	if err := c.Raft.SetQueryNode(ctx, c.config.NodeID, c.config.QueryOnly); err != nil {
+		c.logger.WithError(err).WithField("query_only", c.config.QueryOnly).
+			Error("failed to register query node in raft")
+	}
+}
+
 // Open internal RPC service to handle node communication,
 // bootstrap the Raft node, and restore the database state
 func (c *Service) Open(ctx context.Context, db schema.Indexer) error {
@@ -252,3 +271,7 @@ func (c *Service) LeaderWithID() (string, string) {
 func (c *Service) StorageCandidates() []string {
 	return c.Raft.StorageCandidates()
 }
+
+func (c *Service) StorageCandidateDomains() map[string]string {
+	return c.Raft.StorageCandidateDomains()
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
 
+	"github.com/weaviate/weaviate/cluster/audit"
+	"github.com/weaviate/weaviate/cluster/backupschedule"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/dynusers"
 	"github.com/weaviate/weaviate/cluster/fsm"
@@ -136,6 +138,8 @@ type Config struct {
 	NodeSelector cluster.NodeSelector
 	Logger       *logrus.Logger
 	Voter        bool
+	// QueryOnly registers this node as query node in raft once it has caught up
+	QueryOnly bool
 
 	// MetadataOnlyVoters configures the voters to store metadata exclusively, without storing any other data
 	MetadataOnlyVoters bool
@@ -186,6 +190,9 @@ type Config struct {
 	// DrainSleep is the time the node will wait for the cluster to process any ongoing
 	// operations before shutting down.
 	DrainSleep time.Duration
+
+	// AuditLog controls the retention of the schema audit log
+	AuditLog audit.Config
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
@@ -240,6 +247,12 @@ type Store struct {
 	// distributedTaskManager is responsible for applying/querying the distributed task FSM used to handle distributed tasks.
 	distributedTasksManager *distributedtask.Manager
 
+	// backupSchedulesManager is responsible for applying/querying the backup schedules executed by the leader
+	backupSchedulesManager *backupschedule.Manager
+
+	// auditLog records who changed the schema, aliases or RBAC, when and how
+	auditLog *audit.Log
+
 	// lastAppliedIndexToDB represents the index of the last applied command when the store is opened.
 	lastAppliedIndexToDB atomic.Uint64
 	// lastAppliedIndex index of latest update to the store
@@ -334,7 +347,9 @@ func NewFSM(cfg Config, authZController authorization.Controller, snapshotter fs
 			Clock:            clockwork.NewRealClock(),
 			CompletedTaskTTL: cfg.DistributedTasks.CompletedTaskTTL,
 		}),
-		metrics: newStoreMetrics(cfg.NodeID, reg),
+		backupSchedulesManager: backupschedule.NewManager(),
+		auditLog:               audit.NewLog(cfg.AuditLog),
+		metrics:                newStoreMetrics(cfg.NodeID, reg),
 	}
 }
 
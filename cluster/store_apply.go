@@ -171,6 +171,7 @@ func (st *Store) Apply(l *raft.Log) any {
 		"cmd_schema_only": schemaOnly,
 	}).Debug("server.apply")
 
+	auditBefore := st.auditClassState(l, &cmd)
 	f := func() {}
 
 	switch cmd.Type {
@@ -245,6 +246,18 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.schemaManager.DeleteReplicaFromShard(&cmd, schemaOnly)
 		}
+	case api.ApplyRequest_TYPE_SPLIT_SHARD:
+		f = func() {
+			ret.Error = st.schemaManager.SplitShard(&cmd, schemaOnly)
+		}
+	case api.ApplyRequest_TYPE_SPLIT_SHARD_READY:
+		f = func() {
+			ret.Error = st.schemaManager.SplitShardReady(&cmd, schemaOnly)
+		}
+	case api.ApplyRequest_TYPE_SPLIT_SHARD_ABORT:
+		f = func() {
+			ret.Error = st.schemaManager.SplitShardAbort(&cmd, schemaOnly)
+		}
 
 	case api.ApplyRequest_TYPE_ADD_TENANT:
 		f = func() {
@@ -384,6 +397,34 @@ func (st *Store) Apply(l *raft.Log) any {
 		f = func() {
 			ret.Error = st.replicationManager.ForceDeleteByUuid(&cmd)
 		}
+	case api.ApplyRequest_TYPE_REPLICATION_DRAIN_NODE:
+		f = func() {
+			ret.Error = st.replicationManager.DrainNode(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_UPDATE_NODE_DRAIN:
+		f = func() {
+			ret.Error = st.replicationManager.UpdateNodeDrain(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_CANCEL_NODE_DRAIN:
+		f = func() {
+			ret.Error = st.replicationManager.CancelNodeDrain(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_UPDATE_CROSS_CLUSTER:
+		f = func() {
+			ret.Error = st.replicationManager.UpdateCrossCluster(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_PROMOTE_CROSS_CLUSTER:
+		f = func() {
+			ret.Error = st.replicationManager.PromoteCrossCluster(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_SET_QUERY_NODE:
+		f = func() {
+			ret.Error = st.replicationManager.SetQueryNode(&cmd)
+		}
+	case api.ApplyRequest_TYPE_REPLICATION_SET_REBALANCER_PAUSED:
+		f = func() {
+			ret.Error = st.replicationManager.SetRebalancerPaused(&cmd)
+		}
 
 	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_ADD:
 		f = func() {
@@ -402,6 +443,23 @@ func (st *Store) Apply(l *raft.Log) any {
 			ret.Error = st.distributedTasksManager.CleanUpTask(&cmd)
 		}
 
+	case api.ApplyRequest_TYPE_BACKUP_SCHEDULE_PUT:
+		f = func() {
+			ret.Error = st.backupSchedulesManager.Put(&cmd)
+		}
+	case api.ApplyRequest_TYPE_BACKUP_SCHEDULE_DELETE:
+		f = func() {
+			ret.Error = st.backupSchedulesManager.Delete(&cmd)
+		}
+	case api.ApplyRequest_TYPE_BACKUP_SCHEDULE_RECORD_RUN:
+		f = func() {
+			ret.Error = st.backupSchedulesManager.RecordRun(&cmd)
+		}
+	case api.ApplyRequest_TYPE_BACKUP_SCHEDULE_REMOVE_BACKUPS:
+		f = func() {
+			ret.Error = st.backupSchedulesManager.RemoveBackups(&cmd)
+		}
+
 	default:
 		// This could occur when a new command has been introduced in a later app version
 		// At this point, we need to panic so that the app undergo an upgrade during restart
@@ -424,6 +482,10 @@ func (st *Store) Apply(l *raft.Log) any {
 	enterrors.GoWrapper(g, st.log)
 	wg.Wait()
 
+	if ret.Error == nil {
+		st.recordAudit(l, &cmd, auditBefore)
+	}
+
 	return ret
 }
 
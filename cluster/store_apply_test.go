@@ -12,15 +12,20 @@
 package cluster
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/raft"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/weaviate/weaviate/cluster/audit"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	clusterschema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
@@ -534,3 +539,48 @@ func setupApplyTest(t *testing.T) (MockStore, *raft.Log) {
 
 	return mockStore, log
 }
+
+func TestStore_Apply_AuditLog(t *testing.T) {
+	ms, _ := setupApplyTest(t)
+	ms.parser.On("ParseClass", mock.Anything).Return(nil)
+	ms.indexer.On("AddClass", mock.Anything).Return(nil)
+	ms.indexer.On("UpdateClass", mock.Anything).Return(nil)
+	ms.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"T1": {Name: "T1", BelongsToNodes: []string{"Node-1"}}}}
+	added := &models.Class{Class: "TestClass", Description: "before"}
+	updated := &models.Class{Class: "TestClass", Description: "after"}
+	ms.parser.On("ParseClassUpdate", mock.Anything, mock.Anything).Return(updated, nil)
+
+	apply := func(index uint64, principal string, cmdType api.ApplyRequest_Type, sub any) {
+		subCommand, err := json.Marshal(sub)
+		require.NoError(t, err)
+		data, err := proto.Marshal(&api.ApplyRequest{Type: cmdType, Class: "TestClass", SubCommand: subCommand, Principal: principal})
+		require.NoError(t, err)
+		resp := ms.store.Apply(&raft.Log{
+			Index: index, Type: raft.LogCommand, Data: data,
+			AppendedAt: time.UnixMilli(int64(index) * 1000),
+		}).(Response)
+		require.NoError(t, resp.Error)
+	}
+	apply(1, "alice", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{Class: added, State: ss})
+	apply(2, "bob", api.ApplyRequest_TYPE_UPDATE_CLASS, api.UpdateClassRequest{Class: updated, State: ss})
+	// replayed entries are only recorded once
+	apply(2, "bob", api.ApplyRequest_TYPE_UPDATE_CLASS, api.UpdateClassRequest{Class: updated, State: ss})
+
+	entries := ms.store.AuditLog(audit.Filter{})
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, uint64(2), entries[0].Index)
+	assert.Equal(t, "bob", entries[0].Principal)
+	assert.Equal(t, "update_class", entries[0].Command)
+	assert.Equal(t, int64(2000), entries[0].TimeUnixMs)
+	assert.Contains(t, entries[0].Changes, audit.Change{Path: "description", Before: "before", After: "after"})
+
+	assert.Equal(t, "alice", entries[1].Principal)
+	assert.Equal(t, "add_class", entries[1].Command)
+	require.Len(t, entries[1].Changes, 1)
+	assert.Nil(t, entries[1].Changes[0].Before)
+
+	assert.Len(t, ms.store.AuditLog(audit.Filter{Principal: "alice"}), 1)
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/raft"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/weaviate/weaviate/cluster/audit"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+type auditScope int
+
+const (
+	auditNone auditScope = iota
+	// auditClass commands are recorded as the diff of the class before and after apply
+	auditClass
+	// auditAdded and auditRemoved commands are recorded from their payload
+	auditAdded
+	auditRemoved
+)
+
+func auditScopeOf(t api.ApplyRequest_Type) auditScope {
+	switch t {
+	case api.ApplyRequest_TYPE_ADD_CLASS,
+		api.ApplyRequest_TYPE_UPDATE_CLASS,
+		api.ApplyRequest_TYPE_DELETE_CLASS,
+		api.ApplyRequest_TYPE_RESTORE_CLASS,
+		api.ApplyRequest_TYPE_ADD_PROPERTY,
+		api.ApplyRequest_TYPE_UPDATE_PROPERTY:
+		return auditClass
+	case api.ApplyRequest_TYPE_ADD_TENANT,
+		api.ApplyRequest_TYPE_UPDATE_TENANT,
+		api.ApplyRequest_TYPE_CREATE_ALIAS,
+		api.ApplyRequest_TYPE_REPLACE_ALIAS,
+		api.ApplyRequest_TYPE_UPSERT_ROLES_PERMISSIONS,
+		api.ApplyRequest_TYPE_ADD_ROLES_FOR_USER:
+		return auditAdded
+	case api.ApplyRequest_TYPE_DELETE_TENANT,
+		api.ApplyRequest_TYPE_DELETE_ALIAS,
+		api.ApplyRequest_TYPE_DELETE_ROLES,
+		api.ApplyRequest_TYPE_REMOVE_PERMISSIONS,
+		api.ApplyRequest_TYPE_REVOKE_ROLES_FOR_USER:
+		return auditRemoved
+	default:
+		return auditNone
+	}
+}
+
+// auditCommandName returns the name under which a command type is recorded, e.g. "add_class"
+func auditCommandName(t api.ApplyRequest_Type) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "TYPE_"))
+}
+
+// auditClassState returns the JSON representation of the class targeted by cmd, nil
+// if the command is not class scoped or the class does not exist. The class is
+// marshalled right away as the schema only hands out shallow copies.
+func (st *Store) auditClassState(l *raft.Log, cmd *api.ApplyRequest) json.RawMessage {
+	if auditScopeOf(cmd.Type) != auditClass || l.Index <= st.auditLog.LastIndex() {
+		return nil
+	}
+	class := st.schemaManager.NewSchemaReader().ReadOnlyVersionedClass(cmd.Class).Class
+	if class == nil {
+		return nil
+	}
+	b, err := json.Marshal(class)
+	if err != nil {
+		st.log.WithError(err).WithField("class", cmd.Class).Warn("audit log: marshal class")
+		return nil
+	}
+	return b
+}
+
+// recordAudit appends the successfully applied command cmd to the audit log.
+// before is the class state returned by auditClassState prior to applying cmd.
+func (st *Store) recordAudit(l *raft.Log, cmd *api.ApplyRequest, before json.RawMessage) {
+	scope := auditScopeOf(cmd.Type)
+	if scope == auditNone || l.Index <= st.auditLog.LastIndex() {
+		return
+	}
+
+	var b, a any
+	switch scope {
+	case auditClass:
+		if before != nil {
+			b = before
+		}
+		if after := st.auditClassState(l, cmd); after != nil {
+			a = after
+		}
+	case auditAdded:
+		a = st.auditPayload(cmd)
+	case auditRemoved:
+		b = st.auditPayload(cmd)
+	}
+
+	changes, err := audit.Diff(b, a)
+	if err != nil {
+		st.log.WithFields(logrus.Fields{
+			"log_index": l.Index,
+			"cmd_type":  cmd.Type.String(),
+		}).WithError(err).Warn("audit log: diff command")
+	}
+
+	entry := audit.Entry{
+		Index:     l.Index,
+		Principal: cmd.Principal,
+		Command:   auditCommandName(cmd.Type),
+		Class:     cmd.Class,
+		Changes:   changes,
+	}
+	if !l.AppendedAt.IsZero() {
+		entry.TimeUnixMs = l.AppendedAt.UnixMilli()
+	}
+	st.auditLog.Append(entry)
+}
+
+// auditPayload decodes the sub command of cmd into a JSON value, tenant commands
+// are protobuf encoded while all other audited commands are JSON encoded
+func (st *Store) auditPayload(cmd *api.ApplyRequest) any {
+	var msg proto.Message
+	switch cmd.Type {
+	case api.ApplyRequest_TYPE_ADD_TENANT:
+		msg = &api.AddTenantsRequest{}
+	case api.ApplyRequest_TYPE_UPDATE_TENANT:
+		msg = &api.UpdateTenantsRequest{}
+	case api.ApplyRequest_TYPE_DELETE_TENANT:
+		msg = &api.DeleteTenantsRequest{}
+	default:
+		if !json.Valid(cmd.SubCommand) {
+			return nil
+		}
+		return json.RawMessage(cmd.SubCommand)
+	}
+
+	if err := proto.Unmarshal(cmd.SubCommand, msg); err != nil {
+		st.log.WithError(err).WithField("cmd_type", cmd.Type.String()).Warn("audit log: decode command")
+		return nil
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		st.log.WithError(err).WithField("cmd_type", cmd.Type.String()).Warn("audit log: encode command")
+		return nil
+	}
+	return json.RawMessage(b)
+}
+
+// AuditLog returns the entries of the schema audit log matching f, newest first
+func (st *Store) AuditLog(f audit.Filter) []audit.Entry {
+	return st.auditLog.Query(f)
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/raft"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// crossClusterSchemaTypes are the schema changes shipped to a follower
+// cluster. Changes to the topology of the primary (shard status, replica
+// placement, splits) are left out, the follower places the shards on its own
+// nodes.
+var crossClusterSchemaTypes = map[api.ApplyRequest_Type]struct{}{
+	api.ApplyRequest_TYPE_ADD_CLASS:       {},
+	api.ApplyRequest_TYPE_UPDATE_CLASS:    {},
+	api.ApplyRequest_TYPE_DELETE_CLASS:    {},
+	api.ApplyRequest_TYPE_RESTORE_CLASS:   {},
+	api.ApplyRequest_TYPE_ADD_PROPERTY:    {},
+	api.ApplyRequest_TYPE_UPDATE_PROPERTY: {},
+	api.ApplyRequest_TYPE_ADD_TENANT:      {},
+	api.ApplyRequest_TYPE_UPDATE_TENANT:   {},
+	api.ApplyRequest_TYPE_DELETE_TENANT:   {},
+	api.ApplyRequest_TYPE_CREATE_ALIAS:    {},
+	api.ApplyRequest_TYPE_REPLACE_ALIAS:   {},
+	api.ApplyRequest_TYPE_DELETE_ALIAS:    {},
+}
+
+// crossClusterSchemaLog reads at most limit entries of the raft log following
+// index from and returns the schema changes among them. If these entries
+// have been compacted already, the whole schema is returned instead.
+func (st *Store) crossClusterSchemaLog(from uint64, limit int) (*api.CrossClusterSchemaLog, error) {
+	last := st.lastAppliedIndex.Load()
+	res := &api.CrossClusterSchemaLog{Index: from, LastIndex: last}
+	if from >= last {
+		return res, nil
+	}
+
+	first, err := st.logStore.FirstIndex()
+	if err != nil {
+		return nil, fmt.Errorf("read first log index: %w", err)
+	}
+	if from == 0 || from+1 < first {
+		return st.crossClusterSchemaSnapshot(last)
+	}
+
+	for i := from + 1; i <= last && i <= from+uint64(limit); i++ {
+		var l raft.Log
+		if err := st.logStore.GetLog(i, &l); err != nil {
+			if errors.Is(err, raft.ErrLogNotFound) {
+				// compacted in the meantime
+				return st.crossClusterSchemaSnapshot(last)
+			}
+			return nil, fmt.Errorf("read log %d: %w", i, err)
+		}
+		res.Index = i
+		if l.Type != raft.LogCommand {
+			continue
+		}
+		cmd := api.ApplyRequest{}
+		if err := proto.Unmarshal(l.Data, &cmd); err != nil {
+			return nil, fmt.Errorf("decode log %d: %w", i, err)
+		}
+		if _, ok := crossClusterSchemaTypes[cmd.Type]; ok {
+			res.Entries = append(res.Entries, api.CrossClusterSchemaEntry{Index: i, Request: l.Data})
+		}
+	}
+	return res, nil
+}
+
+// crossClusterSchemaSnapshot returns the whole schema. The schema may already
+// contain changes following index last, the follower applies them twice.
+func (st *Store) crossClusterSchemaSnapshot(last uint64) (*api.CrossClusterSchemaLog, error) {
+	reader := st.SchemaReader()
+	schema := reader.ReadOnlySchema()
+
+	snapshot := &api.CrossClusterSchemaSnapshot{
+		Classes: make([]api.AddClassRequest, 0, len(schema.Classes)),
+		Aliases: reader.Aliases(),
+	}
+	for _, class := range schema.Classes {
+		err := reader.Read(class.Class, false, func(class *models.Class, state *sharding.State) error {
+			cls := *class
+			cls.Properties = slices.Clone(class.Properties)
+			ss := state.DeepCopy()
+			snapshot.Classes = append(snapshot.Classes, api.AddClassRequest{Class: &cls, State: &ss})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("read class %s: %w", class.Class, err)
+		}
+	}
+	sort.Slice(snapshot.Classes, func(i, j int) bool {
+		return snapshot.Classes[i].Class.Class < snapshot.Classes[j].Class.Class
+	})
+	return &api.CrossClusterSchemaLog{Index: last, LastIndex: last, Snapshot: snapshot}, nil
+}
@@ -146,6 +146,16 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 		if err != nil {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not get replication scale plan: %w", err)
 		}
+	case cmd.QueryRequest_TYPE_GET_REPLICATION_NODE_DRAIN:
+		payload, err = st.replicationManager.QueryNodeDrain(req)
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get node drain: %w", err)
+		}
+	case cmd.QueryRequest_TYPE_GET_REPLICATION_CROSS_CLUSTER:
+		payload, err = st.replicationManager.QueryCrossCluster(req)
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get cross cluster replication: %w", err)
+		}
 	case cmd.QueryRequest_TYPE_GET_ALL_REPLICATION_DETAILS:
 		payload, err = st.replicationManager.GetAllReplicationDetails(req)
 		if err != nil {
@@ -156,6 +166,11 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 		if err != nil {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not get distributed task list: %w", err)
 		}
+	case cmd.QueryRequest_TYPE_GET_BACKUP_SCHEDULES:
+		payload, err = st.backupSchedulesManager.QuerySchedules()
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get backup schedules: %w", err)
+		}
 	case cmd.QueryRequest_TYPE_GET_REPLICATION_OPERATION_STATE:
 		payload, err = st.replicationManager.GetReplicationOperationState(req)
 		if err != nil {
@@ -58,6 +58,16 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		return fmt.Errorf("replication snapshot: %w", err)
 	}
 
+	backupSchedulesSnapshot, err := s.backupSchedulesManager.Snapshot()
+	if err != nil {
+		return fmt.Errorf("backup schedules snapshot: %w", err)
+	}
+
+	auditSnapshot, err := s.auditLog.Snapshot()
+	if err != nil {
+		return fmt.Errorf("audit log snapshot: %w", err)
+	}
+
 	snap := fsm.Snapshot{
 		NodeID:           s.cfg.NodeID,
 		SnapshotID:       sink.ID(),
@@ -67,6 +77,8 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		DbUsers:          dbUserSnapshot,
 		DistributedTasks: tasksSnapshot,
 		ReplicationOps:   replicationSnapshot,
+		BackupSchedules:  backupSchedulesSnapshot,
+		AuditLog:         auditSnapshot,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
@@ -168,6 +180,20 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}
 
+		if snap.BackupSchedules != nil {
+			if err := st.backupSchedulesManager.Restore(snap.BackupSchedules); err != nil {
+				st.log.WithError(err).Error("restoring backup schedules from snapshot")
+				return fmt.Errorf("restore backup schedules from snapshot: %w", err)
+			}
+		}
+
+		if snap.AuditLog != nil {
+			if err := st.auditLog.Restore(snap.AuditLog); err != nil {
+				st.log.WithError(err).Error("restoring audit log from snapshot")
+				return fmt.Errorf("restore audit log from snapshot: %w", err)
+			}
+		}
+
 		if st.cfg.MetadataOnlyVoters {
 			return nil
 		}
@@ -101,7 +101,7 @@ func (s *service) Usage(ctx context.Context, exactObjectCount bool) (*types.Repo
 
 	// Get backup usage from all enabled backup backends
 	for _, backend := range s.backups.EnabledBackupBackends() {
-		backups, err := backend.AllBackups(ctx)
+		backups, err := backend.AllBackups(ctx, "", "")
 		if err != nil {
 			s.logger.WithError(err).WithFields(logrus.Fields{"backend": backend}).Error("failed to get backups from backend")
 			return nil, err
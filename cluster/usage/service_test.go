@@ -326,7 +326,7 @@ func TestService_Usage_WithBackups(t *testing.T) {
 			},
 		},
 	}
-	mockBackupBackend.EXPECT().AllBackups(ctx).Return(backups, nil)
+	mockBackupBackend.EXPECT().AllBackups(ctx, "", "").Return(backups, nil)
 
 	mockBackupProvider := backupusecase.NewMockBackupBackendProvider(t)
 	mockBackupProvider.EXPECT().EnabledBackupBackends().Return([]modulecapabilities.BackupBackend{mockBackupBackend})
@@ -410,7 +410,7 @@ func TestService_Usage_WithBackups_3node_cluster(t *testing.T) {
 			},
 		},
 	}
-	mockBackupBackend.EXPECT().AllBackups(ctx).Return(backups, nil)
+	mockBackupBackend.EXPECT().AllBackups(ctx, "", "").Return(backups, nil)
 
 	mockBackupProvider := backupusecase.NewMockBackupBackendProvider(t)
 	mockBackupProvider.EXPECT().EnabledBackupBackends().Return([]modulecapabilities.BackupBackend{mockBackupBackend})
@@ -498,7 +498,7 @@ func TestService_Usage_BackupError(t *testing.T) {
 	repo := createTestDb(t, mockSchema, shardingState, nil, nodeName)
 
 	mockBackupBackend := modulecapabilities.NewMockBackupBackend(t)
-	mockBackupBackend.EXPECT().AllBackups(ctx).Return(nil, assert.AnError)
+	mockBackupBackend.EXPECT().AllBackups(ctx, "", "").Return(nil, assert.AnError)
 
 	mockBackupProvider := backupusecase.NewMockBackupBackendProvider(t)
 	mockBackupProvider.EXPECT().EnabledBackupBackends().Return([]modulecapabilities.BackupBackend{mockBackupBackend})
@@ -47,6 +47,7 @@ type DistributedBackupDescriptor struct {
 	Error                   string                     `json:"error"`
 	PreCompressionSizeBytes int64                      `json:"preCompressionSizeBytes"` // Size of this node's backup in bytes before compression
 	CompressionType         CompressionType            `json:"compressionType"`
+	BaseBackupID            string                     `json:"baseBackupId,omitempty"` // Base of an incremental backup
 }
 
 // Len returns how many nodes exist in d
@@ -236,6 +237,30 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// FileRefs locates each file of the shard, either in a chunk of this backup
+	// or in a chunk of an earlier backup in case of an incremental backup
+	FileRefs map[string]FileRef `json:"fileRefs,omitempty"`
+}
+
+// FileRef locates a file within a chain of incremental backups
+type FileRef struct {
+	// BackupID is the ID of the backup holding the file.
+	// It is empty if the file is held by the backup the descriptor belongs to.
+	BackupID string `json:"backupId,omitempty"`
+	Chunk    int32  `json:"chunk"`
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"modTime"` // unix nanoseconds
+}
+
+// Locate returns where file is stored given the ID of the backup s belongs to.
+// It returns false if s does not reference file.
+func (s *ShardDescriptor) Locate(file, backupID string) (FileRef, bool) {
+	ref, ok := s.FileRefs[file]
+	if ok && ref.BackupID == "" {
+		ref.BackupID = backupID
+	}
+	return ref, ok
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -289,6 +314,14 @@ func (f *FileList) PopFront() string {
 	return file
 }
 
+// Remaining returns the files which have not been popped yet
+func (f *FileList) Remaining() []string {
+	if f == nil || f.Len() == 0 {
+		return nil
+	}
+	return f.Files[f.start:]
+}
+
 // Peek returns the first file without removing it
 func (f *FileList) Peek() string {
 	if f == nil || f.Len() == 0 {
@@ -297,6 +330,12 @@ func (f *FileList) Peek() string {
 	return f.Files[f.start]
 }
 
+// ChunkRef identifies a chunk of a class held by a specific backup
+type ChunkRef struct {
+	BackupID string
+	Chunk    int32
+}
+
 // ClassDescriptor contains everything needed to completely restore a class
 type ClassDescriptor struct {
 	Name          string             `json:"name"` // DB class name, also selected by user
@@ -314,6 +353,24 @@ type ClassDescriptor struct {
 	PreCompressionSizeBytes int64              `json:"preCompressionSizeBytes"` // Size of this class's backup in bytes before compression
 }
 
+// ReusedFiles groups files held by earlier backups by the chunk containing them
+func (c *ClassDescriptor) ReusedFiles() map[ChunkRef][]string {
+	var result map[ChunkRef][]string
+	for _, shard := range c.Shards {
+		for file, ref := range shard.FileRefs {
+			if ref.BackupID == "" {
+				continue
+			}
+			if result == nil {
+				result = make(map[ChunkRef][]string)
+			}
+			key := ChunkRef{BackupID: ref.BackupID, Chunk: ref.Chunk}
+			result[key] = append(result[key], file)
+		}
+	}
+	return result
+}
+
 type CompressionType string
 
 const (
@@ -336,6 +393,7 @@ type BackupDescriptor struct {
 	Error                   string            `json:"error"`
 	PreCompressionSizeBytes int64             `json:"preCompressionSizeBytes"` // Size of this node's backup in bytes before compression
 	CompressionType         *CompressionType  `json:"compressionType,omitempty"`
+	BaseBackupID            string            `json:"baseBackupId,omitempty"` // Base of an incremental backup
 }
 
 // List all existing classes in d
@@ -483,6 +541,7 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		ServerVersion:           d.ServerVersion,
 		Error:                   d.Error,
 		PreCompressionSizeBytes: d.PreCompressionSizeBytes, // Copy pre-compression size
+		BaseBackupID:            d.BaseBackupID,
 	}
 	if node != "" && len(cs) > 0 {
 		result.Nodes = map[string]*NodeDescriptor{node: {Classes: cs}}
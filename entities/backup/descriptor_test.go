@@ -473,3 +473,55 @@ func TestShardDescriptorClear(t *testing.T) {
 	s.ClearTemporary()
 	assert.Equal(t, want, s)
 }
+
+func TestShardDescriptorLocate(t *testing.T) {
+	s := ShardDescriptor{
+		Name: "shard",
+		FileRefs: map[string]FileRef{
+			"lsm/objects/segment-1.db": {Chunk: 2, Size: 10, ModTime: 1},
+			"lsm/objects/segment-2.db": {BackupID: "first", Chunk: 1, Size: 20, ModTime: 2},
+		},
+	}
+
+	ref, ok := s.Locate("lsm/objects/segment-1.db", "second")
+	assert.True(t, ok)
+	assert.Equal(t, FileRef{BackupID: "second", Chunk: 2, Size: 10, ModTime: 1}, ref)
+
+	ref, ok = s.Locate("lsm/objects/segment-2.db", "second")
+	assert.True(t, ok)
+	assert.Equal(t, FileRef{BackupID: "first", Chunk: 1, Size: 20, ModTime: 2}, ref)
+
+	_, ok = s.Locate("lsm/objects/segment-3.db", "second")
+	assert.False(t, ok)
+}
+
+func TestClassDescriptorReusedFiles(t *testing.T) {
+	c := ClassDescriptor{
+		Name: "Class",
+		Shards: []*ShardDescriptor{
+			{
+				Name: "s1",
+				FileRefs: map[string]FileRef{
+					"s1/segment-1.db":    {BackupID: "first", Chunk: 1},
+					"s1/segment-1.bloom": {BackupID: "first", Chunk: 1},
+					"s1/segment-2.wal":   {Chunk: 3},
+				},
+			},
+			{
+				Name: "s2",
+				FileRefs: map[string]FileRef{
+					"s2/segment-1.db": {BackupID: "second", Chunk: 1},
+					"s2/segment-2.db": {BackupID: "first", Chunk: 2},
+				},
+			},
+		},
+	}
+
+	got := c.ReusedFiles()
+	assert.Len(t, got, 3)
+	assert.ElementsMatch(t, []string{"s1/segment-1.db", "s1/segment-1.bloom"}, got[ChunkRef{"first", 1}])
+	assert.Equal(t, []string{"s2/segment-2.db"}, got[ChunkRef{"first", 2}])
+	assert.Equal(t, []string{"s2/segment-1.db"}, got[ChunkRef{"second", 1}])
+
+	assert.Nil(t, (&ClassDescriptor{Shards: []*ShardDescriptor{{Name: "s1"}}}).ReusedFiles())
+}
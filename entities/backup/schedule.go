@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	cron "github.com/netresearch/go-cron"
+)
+
+// scheduleIDFormat is the format of the time suffix of backups created by a schedule
+const scheduleIDFormat = "20060102150405"
+
+var regExpScheduleID = regexp.MustCompile("^[a-z0-9_-]+$")
+
+// Schedule creates backups periodically and prunes the backups it created
+// according to its retention rules
+type Schedule struct {
+	ID string `json:"id"`
+	// Cron is a standard crontab expression (minute, hour, day of month, month
+	// and day of week) or a descriptor such as "@daily". It is evaluated in UTC
+	// unless prefixed with a time zone, e.g. "CRON_TZ=Europe/Berlin 0 3 * * *".
+	Cron             string    `json:"cron"`
+	Backend          string    `json:"backend"`
+	Include          []string  `json:"include,omitempty"`
+	Exclude          []string  `json:"exclude,omitempty"`
+	Bucket           string    `json:"bucket,omitempty"`
+	Path             string    `json:"path,omitempty"`
+	CompressionLevel string    `json:"compressionLevel,omitempty"`
+	CPUPercentage    int       `json:"cpuPercentage,omitempty"`
+	Retention        Retention `json:"retention"`
+	CreatedAt        time.Time `json:"createdAt"`
+
+	// LastRunAt is the last time the schedule triggered a backup
+	LastRunAt    time.Time `json:"lastRunAt,omitempty"`
+	LastBackupID string    `json:"lastBackupId,omitempty"`
+	LastError    string    `json:"lastError,omitempty"`
+	// Backups lists the IDs of the backups created by the schedule which have not been deleted yet
+	Backups []string `json:"backups,omitempty"`
+}
+
+// Retention decides which backups of a schedule are kept. A backup is kept
+// if any rule selects it. All backups are kept if no rule is set.
+type Retention struct {
+	// KeepLast keeps the N most recent successful backups
+	KeepLast int `json:"keepLast,omitempty"`
+	// KeepDaily keeps the most recent successful backup of each of the last N days having one
+	KeepDaily int `json:"keepDaily,omitempty"`
+	// KeepWeekly keeps the most recent successful backup of each of the last N ISO weeks having one
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+}
+
+// IsZero reports whether no retention rule is set
+func (r Retention) IsZero() bool {
+	return r.KeepLast == 0 && r.KeepDaily == 0 && r.KeepWeekly == 0
+}
+
+// ScheduledBackup is a backup created by a schedule as seen by its retention
+type ScheduledBackup struct {
+	ID     string
+	Time   time.Time
+	Status Status
+}
+
+// Expired returns the IDs of backups which are no longer retained.
+// Backups in progress are always kept, failed and cancelled ones always expire.
+func (r Retention) Expired(backups []ScheduledBackup) []string {
+	if r.IsZero() {
+		return nil
+	}
+	var expired []string
+	succeeded := make([]ScheduledBackup, 0, len(backups))
+	for _, b := range backups {
+		switch b.Status {
+		case Success:
+			succeeded = append(succeeded, b)
+		case Failed, Cancelled:
+			expired = append(expired, b.ID)
+		default:
+		}
+	}
+	sort.SliceStable(succeeded, func(i, j int) bool {
+		return succeeded[i].Time.After(succeeded[j].Time)
+	})
+
+	var (
+		days  = make(map[string]struct{}, r.KeepDaily)
+		weeks = make(map[string]struct{}, r.KeepWeekly)
+	)
+	for i, b := range succeeded {
+		keep := i < r.KeepLast
+		t := b.Time.UTC()
+		if day := t.Format(time.DateOnly); len(days) < r.KeepDaily {
+			if _, ok := days[day]; !ok {
+				days[day] = struct{}{}
+				keep = true
+			}
+		}
+		year, week := t.ISOWeek()
+		if key := fmt.Sprintf("%d-%d", year, week); len(weeks) < r.KeepWeekly {
+			if _, ok := weeks[key]; !ok {
+				weeks[key] = struct{}{}
+				keep = true
+			}
+		}
+		if !keep {
+			expired = append(expired, b.ID)
+		}
+	}
+	return expired
+}
+
+// Validate checks the definition of the schedule, it ignores its run state
+func (s *Schedule) Validate() error {
+	if !regExpScheduleID.MatchString(s.ID) {
+		return fmt.Errorf("invalid schedule id: '%v' allowed characters are lowercase, 0-9, _, -", s.ID)
+	}
+	if _, err := parseCron(s.Cron); err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", s.Cron, err)
+	}
+	if s.Backend == "" {
+		return errors.New("backend is required")
+	}
+	if len(s.Include) > 0 && len(s.Exclude) > 0 {
+		return errors.New("'include' and 'exclude' cannot both contain values")
+	}
+	if s.CPUPercentage < 0 || s.CPUPercentage > 80 {
+		return fmt.Errorf("cpu percentage must be between 1 and 80, got %d", s.CPUPercentage)
+	}
+	if r := s.Retention; r.KeepLast < 0 || r.KeepDaily < 0 || r.KeepWeekly < 0 {
+		return errors.New("retention rules must not be negative")
+	}
+	return nil
+}
+
+// Next returns the first activation of the schedule after its last run,
+// or after its creation if it has never run
+func (s *Schedule) Next() (time.Time, error) {
+	sched, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse cron expression %q: %w", s.Cron, err)
+	}
+	from := s.CreatedAt
+	if s.LastRunAt.After(from) {
+		from = s.LastRunAt
+	}
+	return sched.Next(from).UTC(), nil
+}
+
+// parseCron parses expr in UTC, the time zone of the leader must not matter
+func parseCron(expr string) (cron.Schedule, error) {
+	if !strings.HasPrefix(expr, "TZ=") && !strings.HasPrefix(expr, "CRON_TZ=") {
+		expr = "CRON_TZ=UTC " + expr
+	}
+	return cron.ParseStandard(expr)
+}
+
+// BackupID returns the ID of the backup created by the schedule at t
+func (s *Schedule) BackupID(t time.Time) string {
+	return fmt.Sprintf("%s-%s", s.ID, t.UTC().Format(scheduleIDFormat))
+}
+
+// BackupTime returns the time at which the schedule created the backup id
+func (s *Schedule) BackupTime(id string) (time.Time, bool) {
+	prefix := s.ID + "-"
+	if len(id) != len(prefix)+len(scheduleIDFormat) || id[:len(prefix)] != prefix {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(scheduleIDFormat, id[len(prefix):])
+	return t, err == nil
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	valid := func() Schedule {
+		return Schedule{ID: "nightly", Cron: "0 3 * * *", Backend: "s3"}
+	}
+	require.NoError(t, (&Schedule{ID: "hourly", Cron: "@hourly", Backend: "gcs"}).Validate())
+	require.NoError(t, (&Schedule{ID: "tz", Cron: "CRON_TZ=Europe/Berlin 0 3 * * *", Backend: "gcs"}).Validate())
+
+	for name, mutate := range map[string]func(s *Schedule){
+		"InvalidID":       func(s *Schedule) { s.ID = "Nightly!" },
+		"InvalidCron":     func(s *Schedule) { s.Cron = "every night" },
+		"NoBackend":       func(s *Schedule) { s.Backend = "" },
+		"IncludeExclude":  func(s *Schedule) { s.Include, s.Exclude = []string{"A"}, []string{"B"} },
+		"CPUPercentage":   func(s *Schedule) { s.CPUPercentage = 90 },
+		"NegativeKeepDay": func(s *Schedule) { s.Retention.KeepDaily = -1 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := valid()
+			require.NoError(t, s.Validate())
+			mutate(&s)
+			require.Error(t, s.Validate())
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	created := time.Date(2026, 3, 10, 12, 30, 0, 0, time.UTC)
+	s := Schedule{ID: "nightly", Cron: "0 3 * * *", CreatedAt: created}
+
+	next, err := s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 11, 3, 0, 0, 0, time.UTC), next)
+
+	s.LastRunAt = next
+	next, err = s.Next()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 12, 3, 0, 0, 0, time.UTC), next)
+}
+
+func TestScheduleBackupID(t *testing.T) {
+	s := Schedule{ID: "nightly"}
+	at := time.Date(2026, 3, 11, 3, 0, 5, 0, time.UTC)
+
+	id := s.BackupID(at)
+	assert.Equal(t, "nightly-20260311030005", id)
+
+	got, ok := s.BackupTime(id)
+	assert.True(t, ok)
+	assert.Equal(t, at, got)
+
+	for _, id := range []string{"weekly-20260311030005", "nightly-2026", "nightly-2026031103000x"} {
+		_, ok := s.BackupTime(id)
+		assert.False(t, ok, id)
+	}
+}
+
+func TestRetentionExpired(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.UTC) }
+	// 2026-03-02 is a Monday
+	backups := []ScheduledBackup{
+		{ID: "b1", Time: day(2, 3), Status: Success},
+		{ID: "b2", Time: day(3, 3), Status: Success},
+		{ID: "b3", Time: day(9, 3), Status: Success},
+		{ID: "b4", Time: day(9, 15), Status: Success},
+		{ID: "b5", Time: day(10, 3), Status: Failed},
+		{ID: "b6", Time: day(10, 15), Status: Success},
+		{ID: "b7", Time: day(11, 3), Status: Transferring},
+	}
+
+	tests := []struct {
+		name      string
+		retention Retention
+		expired   []string
+	}{
+		{name: "NoRules", retention: Retention{}},
+		{name: "KeepLast", retention: Retention{KeepLast: 2}, expired: []string{"b5", "b3", "b2", "b1"}},
+		{name: "KeepDaily", retention: Retention{KeepDaily: 2}, expired: []string{"b5", "b3", "b2", "b1"}},
+		{name: "KeepWeekly", retention: Retention{KeepWeekly: 2}, expired: []string{"b5", "b4", "b3", "b1"}},
+		{name: "Combined", retention: Retention{KeepLast: 1, KeepDaily: 2, KeepWeekly: 2}, expired: []string{"b5", "b3", "b1"}},
+		{name: "KeepMoreThanAvailable", retention: Retention{KeepLast: 10}, expired: []string{"b5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.expired, tt.retention.Expired(backups))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package changelog contains the types of the change data capture stream,
+// which reports every mutation of the objects of a shard.
+package changelog
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+)
+
+type Operation string
+
+const (
+	OperationInsert Operation = "INSERT"
+	OperationUpdate Operation = "UPDATE"
+	OperationDelete Operation = "DELETE"
+)
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// Change is a single mutation of an object recorded in the change log of a
+// shard. Offsets are strictly increasing per shard replica, but are not
+// comparable across replicas.
+type Change struct {
+	Offset    uint64      `json:"offset"`
+	ID        strfmt.UUID `json:"id"`
+	Operation Operation   `json:"operation"`
+	// Timestamp of the mutation in unix millis
+	Timestamp int64 `json:"timestamp"`
+	// Properties hold the JSON encoded properties of the object at the time
+	// the change got read. They are only set if requested and the object
+	// still exists.
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
+
+// Source is the replica of a shard whose change log is read by a stream.
+// Offsets are only meaningful together with their source.
+type Source struct {
+	Shard string `json:"shard"`
+	Node  string `json:"node"`
+}
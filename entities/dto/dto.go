@@ -68,6 +68,21 @@ type GetParams struct {
 	Alias                   string // used only to transfer alias passed in search request, not used for actual search
 }
 
+// BatchVectorSearchParams describe several nearVector queries which share
+// everything but the query vector
+type BatchVectorSearchParams struct {
+	ClassName             string
+	TargetVector          string
+	Vectors               [][]float32
+	Limit                 int
+	Filters               *filters.LocalFilter
+	AdditionalProperties  additional.Properties
+	ReplicationProperties *additional.ReplicationProperties
+	Tenant                string
+	// Properties to load, nil loads all properties
+	Properties []string
+}
+
 type Embedding interface {
 	[]float32 | [][]float32
 }
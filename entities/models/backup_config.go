@@ -49,6 +49,9 @@ type BackupConfig struct {
 	// Name of the endpoint, e.g. s3.amazonaws.com.
 	Endpoint string `json:"Endpoint,omitempty"`
 
+	// ID of an earlier backup on the same backend, bucket and path. When set, immutable LSM segments which are unchanged since the base backup are referenced instead of being uploaded again.
+	IncrementalBaseBackupID string `json:"IncrementalBaseBackupID,omitempty"`
+
 	// Path or key within the bucket.
 	Path string `json:"Path,omitempty"`
 }
@@ -29,6 +29,9 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Allows restoring collections (classes) under a different name. Keys are collection names in the backup and values are the names they are restored as. Aliases of a renamed collection are not restored unless moveAliases is set.
+	ClassMapping map[string]string `json:"classMapping,omitempty"`
+
 	// Custom configuration for the backup restoration process.
 	Config *RestoreConfig `json:"config,omitempty"`
 
@@ -38,11 +41,17 @@ type BackupRestoreRequest struct {
 	// List of collections (classes) to include in the backup restoration process.
 	Include []string `json:"include"`
 
+	// When collections are renamed with classMapping, restore the aliases of the backup pointing to the restored collections. This moves aliases away from existing collections of the original name. By default aliases of renamed collections are skipped.
+	MoveAliases bool `json:"moveAliases,omitempty"`
+
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
 
 	// Allows ovewriting the collection alias if there is a conflict
 	OverwriteAlias bool `json:"overwriteAlias,omitempty"`
+
+	// Allows restoring tenants under a different name. Keys are tenant names in the backup and values are the names they are restored as. Only the listed tenants are restored and exactly one multi-tenant collection must be restored.
+	TenantMapping map[string]string `json:"tenantMapping,omitempty"`
 }
 
 // Validate validates this backup restore request
@@ -258,6 +258,9 @@ func (m *ObjectsGetResponse) UnmarshalBinary(b []byte) error {
 // swagger:model ObjectsGetResponseAO2Result
 type ObjectsGetResponseAO2Result struct {
 
+	// Nodes which acknowledged the write of this object. Only set if the request carries a write concern, see the `X-Weaviate-Write-Acks` header.
+	AcknowledgedReplicas []string `json:"acknowledgedReplicas,omitempty"`
+
 	// errors
 	Errors *ErrorResponse `json:"errors,omitempty"`
 
@@ -29,7 +29,8 @@ type BackupBackend interface {
 	// GetObject giving backupID and key
 	GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error)
 	// AllBackups returns the top level metadata for all attempted backups
-	AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error)
+	// bucketName and bucketPath override the initialised bucketName and bucketPath
+	AllBackups(ctx context.Context, overrideBucket, overridePath string) ([]*backup.DistributedBackupDescriptor, error)
 
 	// WriteToFile writes an object in the specified file with path destPath
 	// The file will be created if it doesn't exist
@@ -51,4 +52,8 @@ type BackupBackend interface {
 	// Allows restores from a different bucket to the designated backup bucket
 	Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r backup.ReadCloserWithError) (int64, error)
 	Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error)
+
+	// DeleteBackup removes all objects stored under backupID, including the metadata of all nodes
+	// bucketName and bucketPath override the initialised bucketName and bucketPath
+	DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error
 }
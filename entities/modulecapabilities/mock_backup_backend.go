@@ -36,9 +36,9 @@ func (_m *MockBackupBackend) EXPECT() *MockBackupBackend_Expecter {
 	return &MockBackupBackend_Expecter{mock: &_m.Mock}
 }
 
-// AllBackups provides a mock function with given fields: ctx
-func (_m *MockBackupBackend) AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error) {
-	ret := _m.Called(ctx)
+// AllBackups provides a mock function with given fields: ctx, overrideBucket, overridePath
+func (_m *MockBackupBackend) AllBackups(ctx context.Context, overrideBucket string, overridePath string) ([]*backup.DistributedBackupDescriptor, error) {
+	ret := _m.Called(ctx, overrideBucket, overridePath)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AllBackups")
@@ -46,19 +46,19 @@ func (_m *MockBackupBackend) AllBackups(ctx context.Context) ([]*backup.Distribu
 
 	var r0 []*backup.DistributedBackupDescriptor
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*backup.DistributedBackupDescriptor, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*backup.DistributedBackupDescriptor, error)); ok {
+		return rf(ctx, overrideBucket, overridePath)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*backup.DistributedBackupDescriptor); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*backup.DistributedBackupDescriptor); ok {
+		r0 = rf(ctx, overrideBucket, overridePath)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*backup.DistributedBackupDescriptor)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, overrideBucket, overridePath)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,13 +73,15 @@ type MockBackupBackend_AllBackups_Call struct {
 
 // AllBackups is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockBackupBackend_Expecter) AllBackups(ctx interface{}) *MockBackupBackend_AllBackups_Call {
-	return &MockBackupBackend_AllBackups_Call{Call: _e.mock.On("AllBackups", ctx)}
+//   - overrideBucket string
+//   - overridePath string
+func (_e *MockBackupBackend_Expecter) AllBackups(ctx interface{}, overrideBucket interface{}, overridePath interface{}) *MockBackupBackend_AllBackups_Call {
+	return &MockBackupBackend_AllBackups_Call{Call: _e.mock.On("AllBackups", ctx, overrideBucket, overridePath)}
 }
 
-func (_c *MockBackupBackend_AllBackups_Call) Run(run func(ctx context.Context)) *MockBackupBackend_AllBackups_Call {
+func (_c *MockBackupBackend_AllBackups_Call) Run(run func(ctx context.Context, overrideBucket string, overridePath string)) *MockBackupBackend_AllBackups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -89,7 +91,56 @@ func (_c *MockBackupBackend_AllBackups_Call) Return(_a0 []*backup.DistributedBac
 	return _c
 }
 
-func (_c *MockBackupBackend_AllBackups_Call) RunAndReturn(run func(context.Context) ([]*backup.DistributedBackupDescriptor, error)) *MockBackupBackend_AllBackups_Call {
+func (_c *MockBackupBackend_AllBackups_Call) RunAndReturn(run func(context.Context, string, string) ([]*backup.DistributedBackupDescriptor, error)) *MockBackupBackend_AllBackups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBackup provides a mock function with given fields: ctx, backupID, overrideBucket, overridePath
+func (_m *MockBackupBackend) DeleteBackup(ctx context.Context, backupID string, overrideBucket string, overridePath string) error {
+	ret := _m.Called(ctx, backupID, overrideBucket, overridePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, backupID, overrideBucket, overridePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBackupBackend_DeleteBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBackup'
+type MockBackupBackend_DeleteBackup_Call struct {
+	*mock.Call
+}
+
+// DeleteBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backupID string
+//   - overrideBucket string
+//   - overridePath string
+func (_e *MockBackupBackend_Expecter) DeleteBackup(ctx interface{}, backupID interface{}, overrideBucket interface{}, overridePath interface{}) *MockBackupBackend_DeleteBackup_Call {
+	return &MockBackupBackend_DeleteBackup_Call{Call: _e.mock.On("DeleteBackup", ctx, backupID, overrideBucket, overridePath)}
+}
+
+func (_c *MockBackupBackend_DeleteBackup_Call) Run(run func(ctx context.Context, backupID string, overrideBucket string, overridePath string)) *MockBackupBackend_DeleteBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockBackupBackend_DeleteBackup_Call) Return(_a0 error) *MockBackupBackend_DeleteBackup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBackupBackend_DeleteBackup_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockBackupBackend_DeleteBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
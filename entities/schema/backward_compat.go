@@ -151,6 +151,7 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeGeoCoordinates),
 		string(DataTypePhoneNumber),
 		string(DataTypeBlob),
+		string(DataTypeSparseVector),
 		string(DataTypeUUID),
 		string(DataTypeUUIDArray),
 		string(DataTypeStringArray),
@@ -180,6 +181,10 @@ func IsBlobDataType(dt []string) bool {
 	return false
 }
 
+func IsSparseVectorDataType(dt []string) bool {
+	return len(dt) == 1 && dt[0] == string(DataTypeSparseVector)
+}
+
 func IsArrayDataType(dt []string) bool {
 	for i := range dt {
 		switch DataType(dt[i]) {
@@ -43,6 +43,10 @@ const (
 	DataTypePhoneNumber DataType = "phoneNumber"
 	// DataTypeBlob represents a base64 encoded data
 	DataTypeBlob DataType = "blob"
+	// DataTypeSparseVector represents a learned sparse vector (e.g. SPLADE),
+	// i.e. pairs of dimension indices and weights. It is stored in a posting
+	// list per dimension and can be queried with nearSparseVector
+	DataTypeSparseVector DataType = "sparseVector"
 	// DataTypeTextArray The data type is a value of type string array
 	DataTypeTextArray DataType = "text[]"
 	// DataTypeIntArray The data type is a value of type int array
@@ -91,7 +95,7 @@ var PrimitiveDataTypes []DataType = []DataType{
 	DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate,
 	DataTypeGeoCoordinates, DataTypePhoneNumber, DataTypeBlob, DataTypeTextArray,
 	DataTypeIntArray, DataTypeNumberArray, DataTypeBooleanArray, DataTypeDateArray,
-	DataTypeUUID, DataTypeUUIDArray,
+	DataTypeUUID, DataTypeUUIDArray, DataTypeSparseVector,
 }
 
 var NestedDataTypes []DataType = []DataType{
@@ -19,6 +19,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 type NearVector struct {
@@ -36,6 +37,30 @@ type KeywordRanking struct {
 	AdditionalExplanations bool     `json:"additionalExplanations"`
 	MinimumOrTokensMatch   int      `json:"minimumOrTokensMatch"`
 	SearchOperator         string   `json:"searchOperator"`
+	// SparseVector is set instead of Query for rankings of Type "sparseVector"
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
+}
+
+// NearSparseVector ranks objects by the dot product of the query with the
+// sparse vector stored in Property.
+type NearSparseVector struct {
+	Property string              `json:"property"`
+	Vector   sparsevector.Vector `json:"vector"`
+}
+
+func (n *NearSparseVector) Validate() error {
+	if n.Property == "" {
+		return fmt.Errorf("nearSparseVector: property must be set")
+	}
+	if n.Vector.Len() == 0 {
+		return fmt.Errorf("nearSparseVector: vector must have at least one non-zero dimension")
+	}
+	v, err := sparsevector.New(n.Vector.Indices, n.Vector.Values)
+	if err != nil {
+		return fmt.Errorf("nearSparseVector: %w", err)
+	}
+	n.Vector = *v
+	return nil
 }
 
 // Indicates whether property should be indexed
@@ -114,6 +139,9 @@ type HybridSearch struct {
 	SearchOperator       string        `json:"searchOperator"`
 	NearTextParams       *NearTextParams
 	NearVectorParams     *NearVector
+	// NearSparseVectorParams replaces BM25 with a learned sparse vector as the
+	// keyword leg of the hybrid search
+	NearSparseVectorParams *NearSparseVector
 }
 
 type NearObject struct {
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package sparsevector contains the representation of learned sparse vectors
+// (such as the ones produced by SPLADE-style models) as they are stored in
+// properties of data type sparseVector and used in nearSparseVector queries.
+package sparsevector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// MaxDimensions limits the number of non-zero dimensions of a single sparse
+// vector. Learned sparse encoders typically produce a few hundred non-zero
+// dimensions, the limit exists to protect the posting lists from abuse.
+const MaxDimensions = 16384
+
+// Vector is a sparse vector represented by the indices of its non-zero
+// dimensions and their respective weights.
+type Vector struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// New creates a validated sparse vector. The input is copied and sorted by
+// dimension index. Validation happens before sorting, as sorting requires
+// indices and values of the same length.
+func New(indices []uint32, values []float32) (*Vector, error) {
+	v := &Vector{
+		Indices: append([]uint32(nil), indices...),
+		Values:  append([]float32(nil), values...),
+	}
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	sort.Sort(byIndex{v})
+	return v, nil
+}
+
+// Validate checks that indices and values match up, that every dimension is
+// only present once and that all weights are finite and positive. Negative
+// weights are rejected as the WAND upper bounds of the posting lists rely on
+// every contribution being non-negative.
+func (v *Vector) Validate() error {
+	if len(v.Indices) != len(v.Values) {
+		return fmt.Errorf("sparse vector has %d indices, but %d values", len(v.Indices), len(v.Values))
+	}
+	if len(v.Indices) > MaxDimensions {
+		return fmt.Errorf("sparse vector has %d non-zero dimensions, the maximum is %d",
+			len(v.Indices), MaxDimensions)
+	}
+
+	seen := make(map[uint32]struct{}, len(v.Indices))
+	for i, dim := range v.Indices {
+		if _, ok := seen[dim]; ok {
+			return fmt.Errorf("sparse vector contains dimension %d more than once", dim)
+		}
+		seen[dim] = struct{}{}
+
+		w := v.Values[i]
+		if math.IsNaN(float64(w)) || math.IsInf(float64(w), 0) {
+			return fmt.Errorf("sparse vector dimension %d has invalid weight %v", dim, w)
+		}
+		if w <= 0 {
+			return fmt.Errorf("sparse vector dimension %d has weight %v, weights must be positive", dim, w)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of non-zero dimensions.
+func (v *Vector) Len() int {
+	return len(v.Indices)
+}
+
+// Dot returns the dot product of two sparse vectors. Both vectors are
+// expected to be sorted by index, which is guaranteed for vectors created
+// through New or Parse.
+func Dot(a, b *Vector) float32 {
+	var sum float32
+	i, j := 0, 0
+	for i < len(a.Indices) && j < len(b.Indices) {
+		switch {
+		case a.Indices[i] == b.Indices[j]:
+			sum += a.Values[i] * b.Values[j]
+			i++
+			j++
+		case a.Indices[i] < b.Indices[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return sum
+}
+
+// Parse converts a property value into a sparse vector. Besides *Vector and
+// Vector, it accepts the generic map representation which is the result of
+// unmarshalling a JSON payload such as {"indices": [1, 7], "values": [0.3, 1.2]}.
+func Parse(in any) (*Vector, error) {
+	switch typed := in.(type) {
+	case *Vector:
+		if typed == nil {
+			return nil, fmt.Errorf("sparse vector is nil")
+		}
+		return New(typed.Indices, typed.Values)
+	case Vector:
+		return New(typed.Indices, typed.Values)
+	case map[string]any:
+		return parseMap(typed)
+	default:
+		return nil, fmt.Errorf("expected sparse vector to be a map with indices and values, got %T", in)
+	}
+}
+
+func parseMap(in map[string]any) (*Vector, error) {
+	// round-tripping through JSON covers all the numeric representations
+	// which the different APIs (REST, GraphQL, gRPC, storage) produce
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("marshal sparse vector: %w", err)
+	}
+
+	var v struct {
+		Indices []uint32  `json:"indices"`
+		Values  []float32 `json:"values"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("sparse vector must contain integer indices and numeric values: %w", err)
+	}
+	if v.Indices == nil || v.Values == nil {
+		return nil, fmt.Errorf("sparse vector must contain both indices and values")
+	}
+	return New(v.Indices, v.Values)
+}
+
+// IsSparseVectorMap reports whether the given map has the shape of a
+// serialized sparse vector.
+func IsSparseVectorMap(in map[string]any) bool {
+	if len(in) != 2 {
+		return false
+	}
+	_, hasIndices := in["indices"]
+	_, hasValues := in["values"]
+	return hasIndices && hasValues
+}
+
+type byIndex struct{ v *Vector }
+
+func (b byIndex) Len() int           { return len(b.v.Indices) }
+func (b byIndex) Less(i, j int) bool { return b.v.Indices[i] < b.v.Indices[j] }
+func (b byIndex) Swap(i, j int) {
+	b.v.Indices[i], b.v.Indices[j] = b.v.Indices[j], b.v.Indices[i]
+	b.v.Values[i], b.v.Values[j] = b.v.Values[j], b.v.Values[i]
+}
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparsevector
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("sorts by index", func(t *testing.T) {
+		v, err := New([]uint32{7, 1, 3}, []float32{0.7, 0.1, 0.3})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{1, 3, 7}, v.Indices)
+		assert.Equal(t, []float32{0.1, 0.3, 0.7}, v.Values)
+	})
+
+	t.Run("does not modify the input", func(t *testing.T) {
+		indices := []uint32{2, 1}
+		_, err := New(indices, []float32{1, 1})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{2, 1}, indices)
+	})
+
+	tests := []struct {
+		name    string
+		indices []uint32
+		values  []float32
+	}{
+		{name: "length mismatch", indices: []uint32{1, 2}, values: []float32{1}},
+		{name: "unsorted length mismatch", indices: []uint32{5, 3, 1, 0}, values: []float32{0.1}},
+		{name: "more values than indices", indices: []uint32{3, 1}, values: []float32{0.1, 0.2, 0.3}},
+		{name: "duplicate dimension", indices: []uint32{1, 1}, values: []float32{1, 2}},
+		{name: "negative weight", indices: []uint32{1}, values: []float32{-0.5}},
+		{name: "zero weight", indices: []uint32{1}, values: []float32{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.indices, tt.values)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	var fromJSON map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"indices": [5, 2], "values": [0.5, 1.5]}`), &fromJSON))
+
+	v, err := Parse(fromJSON)
+	require.NoError(t, err)
+	assert.Equal(t, &Vector{Indices: []uint32{2, 5}, Values: []float32{1.5, 0.5}}, v)
+
+	v, err = Parse(&Vector{Indices: []uint32{1}, Values: []float32{1}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, v.Len())
+
+	_, err = Parse(map[string]any{"indices": []any{1.5}, "values": []any{1.0}})
+	assert.Error(t, err, "fractional index")
+
+	_, err = Parse(map[string]any{"indices": []any{1}})
+	assert.Error(t, err, "values missing")
+
+	_, err = Parse(map[string]any{"indices": []any{1}, "values": []any{1}, "other": 1})
+	assert.Error(t, err, "unknown field")
+
+	_, err = Parse("foo")
+	assert.Error(t, err)
+}
+
+func TestDot(t *testing.T) {
+	a, err := New([]uint32{1, 3, 5}, []float32{1, 2, 3})
+	require.NoError(t, err)
+	b, err := New([]uint32{0, 3, 5, 9}, []float32{4, 0.5, 2, 1})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 7, Dot(a, b), 1e-6)
+	assert.InDelta(t, 7, Dot(b, a), 1e-6)
+	assert.Equal(t, float32(0), Dot(a, &Vector{}))
+}
+
+func TestIsSparseVectorMap(t *testing.T) {
+	assert.True(t, IsSparseVectorMap(map[string]any{"indices": nil, "values": nil}))
+	assert.False(t, IsSparseVectorMap(map[string]any{"indices": nil}))
+	assert.False(t, IsSparseVectorMap(map[string]any{"indices": nil, "values": nil, "x": 1}))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 func enrichSchemaTypes(schema map[string]interface{}, ofNestedProp bool) error {
@@ -92,11 +93,14 @@ func enrichSchemaTypes(schema map[string]interface{}, ofNestedProp bool) error {
 	return nil
 }
 
-// nested properties does not support phone or geo data types
+// nested properties does not support phone, geo or sparse vector data types
 func parseMapProp(input map[string]interface{}, ofNestedProp bool) (interface{}, error) {
 	if !ofNestedProp && isGeoProp(input) {
 		return parseGeoProp(input)
 	}
+	if !ofNestedProp && sparsevector.IsSparseVectorMap(input) {
+		return sparsevector.Parse(input)
+	}
 	if !ofNestedProp && isPhoneProp(input) {
 		return parsePhoneNumber(input)
 	}
@@ -13,6 +13,7 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 
@@ -25,6 +26,13 @@ const (
 	DistanceL2Squared = "l2-squared"
 	DistanceManhattan = "manhattan"
 	DistanceHamming   = "hamming"
+	// DistanceJaccard is the Jaccard distance on binary fingerprints, also
+	// known as Tanimoto. Non-binary vectors use the continuous Tanimoto
+	// extension.
+	DistanceJaccard = "jaccard"
+	// DistanceWeightedDot is the dot product with a weight per dimension, see
+	// the distanceWeights setting.
+	DistanceWeightedDot = "weighted-dot"
 
 	// Set these defaults if the user leaves them blank
 	DefaultVectorCacheMaxObjects = 1e12
@@ -39,6 +47,28 @@ const (
 	NoCompression = "none"
 )
 
+// ValidateDistance checks that the distance metric is known and that
+// distanceWeights are set if, and only if, the metric uses them.
+func ValidateDistance(distance string, weights []float32) error {
+	switch distance {
+	case "", DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+		DistanceHamming, DistanceJaccard:
+		if len(weights) > 0 {
+			return fmt.Errorf("distanceWeights can only be used with distance %q", DistanceWeightedDot)
+		}
+	case DistanceWeightedDot:
+		if len(weights) == 0 {
+			return fmt.Errorf("distance %q requires distanceWeights with one weight per dimension",
+				DistanceWeightedDot)
+		}
+	default:
+		return fmt.Errorf("unrecognized distance metric %q, choose one of [%q, %q, %q, %q, %q, %q, %q]",
+			distance, DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+			DistanceHamming, DistanceJaccard, DistanceWeightedDot)
+	}
+	return nil
+}
+
 // Tries to parse the int value from the map, if it overflows math.MaxInt64, it
 // uses math.MaxInt64 instead. This is to protect from rounding errors from
 // json marshalling where the type may be assumed as float64
@@ -108,3 +138,49 @@ func OptionalStringFromMap(in map[string]interface{}, name string,
 	setFn(asString)
 	return nil
 }
+
+func OptionalFloat32SliceFromMap(in map[string]interface{}, name string,
+	setFn func(v []float32),
+) error {
+	value, ok := in[name]
+	if !ok || value == nil {
+		return nil
+	}
+
+	var values []interface{}
+	switch typed := value.(type) {
+	case []interface{}:
+		values = typed
+	case []float32:
+		setFn(typed)
+		return nil
+	case []float64:
+		values = make([]interface{}, len(typed))
+		for i := range typed {
+			values[i] = typed[i]
+		}
+	default:
+		return fmt.Errorf("%s must be a list of numbers", name)
+	}
+
+	asFloat32 := make([]float32, len(values))
+	for i, v := range values {
+		// depending on whether we get the results from disk or from the REST API,
+		// numbers may be represented slightly differently
+		switch typed := v.(type) {
+		case json.Number:
+			f, err := typed.Float64()
+			if err != nil {
+				return errors.Wrapf(err, "json.Number to float64 for %q", name)
+			}
+			asFloat32[i] = float32(f)
+		case float64:
+			asFloat32[i] = float32(typed)
+		default:
+			return fmt.Errorf("%s must be a list of numbers, got %T", name, v)
+		}
+	}
+
+	setFn(asFloat32)
+	return nil
+}
@@ -18,18 +18,37 @@ import (
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hfresh"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 const (
 	DefaultThreshold = 10_000
+	// DefaultDowngradeThreshold of 0 keeps an upgraded index forever
+	DefaultDowngradeThreshold = 0
+
+	TargetHNSW    = "hnsw"
+	TargetHFresh  = "hfresh"
+	DefaultTarget = TargetHNSW
 )
 
 type UserConfig struct {
-	Distance  string          `json:"distance"`
-	Threshold uint64          `json:"threshold"`
-	HnswUC    hnsw.UserConfig `json:"hnsw"`
-	FlatUC    flat.UserConfig `json:"flat"`
+	Distance string `json:"distance"`
+	// DistanceWeights are the per-dimension weights of the weighted-dot
+	// distance, shared by the hnsw and flat index.
+	DistanceWeights []float32 `json:"distanceWeights,omitempty"`
+	Threshold       uint64    `json:"threshold"`
+	// Target is the index type the flat index is upgraded to once Threshold
+	// is exceeded, either hnsw or hfresh.
+	Target string `json:"target"`
+	// DowngradeThreshold is the number of live vectors below which an
+	// upgraded index is converted back to flat. The gap to Threshold is the
+	// hysteresis which keeps an index hovering around the threshold from
+	// flipping back and forth. 0 disables downgrading.
+	DowngradeThreshold uint64            `json:"downgradeThreshold"`
+	HnswUC             hnsw.UserConfig   `json:"hnsw"`
+	FlatUC             flat.UserConfig   `json:"flat"`
+	HfreshUC           hfresh.UserConfig `json:"hfresh"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -49,9 +68,12 @@ func (u UserConfig) IsMultiVector() bool {
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.Threshold = DefaultThreshold
+	u.DowngradeThreshold = DefaultDowngradeThreshold
+	u.Target = DefaultTarget
 	u.Distance = common.DefaultDistanceMetric
 	u.HnswUC = hnsw.NewDefaultUserConfig()
 	u.FlatUC = flat.NewDefaultUserConfig()
+	u.HfreshUC = hfresh.NewDefaultUserConfig()
 }
 
 func NewDefaultUserConfig() UserConfig {
@@ -81,12 +103,34 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, err
 	}
 
+	if err := common.OptionalFloat32SliceFromMap(asMap, "distanceWeights", func(v []float32) {
+		uc.DistanceWeights = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.ValidateDistance(uc.Distance, uc.DistanceWeights); err != nil {
+		return uc, err
+	}
+
 	if err := common.OptionalIntFromMap(asMap, "threshold", func(v int) {
 		uc.Threshold = uint64(v)
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := common.OptionalIntFromMap(asMap, "downgradeThreshold", func(v int) {
+		uc.DowngradeThreshold = uint64(v)
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "target", func(v string) {
+		uc.Target = v
+	}); err != nil {
+		return uc, err
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig, isMultiVector)
@@ -106,22 +150,60 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 	}
 
 	flatConfig, ok := asMap["flat"]
-	if !ok || flatConfig == nil {
-		return uc, nil
+	if ok && flatConfig != nil {
+		flatUC, err := flat.ParseAndValidateConfig(flatConfig)
+		if err != nil {
+			return uc, err
+		}
+
+		castedFlatUC, ok := flatUC.(flat.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid flat configuration")
+		}
+		uc.FlatUC = castedFlatUC
 	}
 
-	flatUC, err := flat.ParseAndValidateConfig(flatConfig)
-	if err != nil {
-		return uc, err
+	hfreshConfig, ok := asMap["hfresh"]
+	if ok && hfreshConfig != nil {
+		hfreshUC, err := hfresh.ParseAndValidateConfig(hfreshConfig, isMultiVector)
+		if err != nil {
+			return uc, err
+		}
+
+		castedHfreshUC, ok := hfreshUC.(hfresh.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid hfresh configuration")
+		}
+		uc.HfreshUC = castedHfreshUC
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.Distance == common.DistanceJaccard && (u.HnswUC.BQ.Enabled || u.FlatUC.BQ.Enabled) {
+		return fmt.Errorf("bq only keeps the sign and can not be used with distance %q",
+			common.DistanceJaccard)
+	}
+
+	switch u.Target {
+	case TargetHNSW:
+	case TargetHFresh:
+		if u.Distance != common.DistanceCosine && u.Distance != common.DistanceL2Squared {
+			return fmt.Errorf("target %q only supports distance %q or %q, got %q",
+				TargetHFresh, common.DistanceCosine, common.DistanceL2Squared, u.Distance)
+		}
+	default:
+		return fmt.Errorf("unrecognized target %q, must be one of %q or %q",
+			u.Target, TargetHNSW, TargetHFresh)
 	}
 
-	castedFlatUC, ok := flatUC.(flat.UserConfig)
-	if !ok {
-		return uc, fmt.Errorf("invalid flat configuration")
+	if u.DowngradeThreshold > 0 && u.DowngradeThreshold >= u.Threshold {
+		return fmt.Errorf("downgradeThreshold (%d) must be lower than threshold (%d)",
+			u.DowngradeThreshold, u.Threshold)
 	}
-	uc.FlatUC = castedFlatUC
 
-	return uc, nil
+	return nil
 }
 
 func ParseDefaultQuantization(vectorIndexConfig schemaConfig.VectorIndexConfig, compression string) (schemaConfig.VectorIndexConfig, error) {
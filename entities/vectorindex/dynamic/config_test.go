@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hfresh"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -37,6 +38,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				Target:    DefaultTarget,
+				HfreshUC:  hfresh.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -79,6 +82,14 @@ func Test_DynamicUserConfig(t *testing.T) {
 							DProjections: hnsw.DefaultMultivectorDProjections,
 							Repetitions:  hnsw.DefaultMultivectorRepetitions,
 						},
+						PlaidConfig: hnsw.PlaidConfig{
+							Enabled:       hnsw.DefaultMultivectorPlaidEnabled,
+							Centroids:     hnsw.DefaultMultivectorPlaidCentroids,
+							NProbe:        hnsw.DefaultMultivectorPlaidNProbe,
+							ResidualBits:  hnsw.DefaultMultivectorPlaidResidualBits,
+							TrainingLimit: hnsw.DefaultMultivectorPlaidTrainingLimit,
+							RescoreLimit:  hnsw.DefaultMultivectorPlaidRescoreLimit,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -116,6 +127,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: 100,
+				Target:    DefaultTarget,
+				HfreshUC:  hfresh.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -158,6 +171,14 @@ func Test_DynamicUserConfig(t *testing.T) {
 							DProjections: hnsw.DefaultMultivectorDProjections,
 							Repetitions:  hnsw.DefaultMultivectorRepetitions,
 						},
+						PlaidConfig: hnsw.PlaidConfig{
+							Enabled:       hnsw.DefaultMultivectorPlaidEnabled,
+							Centroids:     hnsw.DefaultMultivectorPlaidCentroids,
+							NProbe:        hnsw.DefaultMultivectorPlaidNProbe,
+							ResidualBits:  hnsw.DefaultMultivectorPlaidResidualBits,
+							TrainingLimit: hnsw.DefaultMultivectorPlaidTrainingLimit,
+							RescoreLimit:  hnsw.DefaultMultivectorPlaidRescoreLimit,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -216,6 +237,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				Target:    DefaultTarget,
+				HfreshUC:  hfresh.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: 11,
 					MaxConnections:         12,
@@ -257,6 +280,14 @@ func Test_DynamicUserConfig(t *testing.T) {
 							DProjections: hnsw.DefaultMultivectorDProjections,
 							Repetitions:  hnsw.DefaultMultivectorRepetitions,
 						},
+						PlaidConfig: hnsw.PlaidConfig{
+							Enabled:       hnsw.DefaultMultivectorPlaidEnabled,
+							Centroids:     hnsw.DefaultMultivectorPlaidCentroids,
+							NProbe:        hnsw.DefaultMultivectorPlaidNProbe,
+							ResidualBits:  hnsw.DefaultMultivectorPlaidResidualBits,
+							TrainingLimit: hnsw.DefaultMultivectorPlaidTrainingLimit,
+							RescoreLimit:  hnsw.DefaultMultivectorPlaidRescoreLimit,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -314,6 +345,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				Target:    DefaultTarget,
+				HfreshUC:  hfresh.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -356,6 +389,14 @@ func Test_DynamicUserConfig(t *testing.T) {
 							DProjections: hnsw.DefaultMultivectorDProjections,
 							Repetitions:  hnsw.DefaultMultivectorRepetitions,
 						},
+						PlaidConfig: hnsw.PlaidConfig{
+							Enabled:       hnsw.DefaultMultivectorPlaidEnabled,
+							Centroids:     hnsw.DefaultMultivectorPlaidCentroids,
+							NProbe:        hnsw.DefaultMultivectorPlaidNProbe,
+							ResidualBits:  hnsw.DefaultMultivectorPlaidResidualBits,
+							TrainingLimit: hnsw.DefaultMultivectorPlaidTrainingLimit,
+							RescoreLimit:  hnsw.DefaultMultivectorPlaidRescoreLimit,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -401,6 +442,32 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "unknown target returns error",
+			input: map[string]interface{}{
+				"target": "ivf",
+			},
+			expectErr:    true,
+			expectErrMsg: "unrecognized target",
+		},
+		{
+			name: "hfresh target with unsupported distance returns error",
+			input: map[string]interface{}{
+				"target":   "hfresh",
+				"distance": "dot",
+			},
+			expectErr:    true,
+			expectErrMsg: "only supports distance",
+		},
+		{
+			name: "downgrade threshold not below threshold returns error",
+			input: map[string]interface{}{
+				"threshold":          float64(1000),
+				"downgradeThreshold": float64(1000),
+			},
+			expectErr:    true,
+			expectErrMsg: "downgradeThreshold (1000) must be lower than threshold (1000)",
+		},
 	}
 
 	for _, test := range tests {
@@ -417,3 +484,24 @@ func Test_DynamicUserConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_DynamicUserConfigTargetAndDowngrade(t *testing.T) {
+	cfg, err := ParseAndValidateConfig(map[string]interface{}{
+		"threshold":          float64(1000),
+		"downgradeThreshold": float64(200),
+		"target":             "hfresh",
+		"distance":           "l2-squared",
+		"hfresh": map[string]interface{}{
+			"distance":    "l2-squared",
+			"searchProbe": float64(32),
+		},
+	}, false)
+	require.NoError(t, err)
+
+	uc := cfg.(UserConfig)
+	assert.Equal(t, TargetHFresh, uc.Target)
+	assert.Equal(t, uint64(1000), uc.Threshold)
+	assert.Equal(t, uint64(200), uc.DowngradeThreshold)
+	assert.Equal(t, uint32(32), uc.HfreshUC.SearchProbe)
+	assert.Equal(t, hfresh.DefaultReplicas, int(uc.HfreshUC.Replicas))
+}
@@ -42,6 +42,7 @@ type RQUserConfig struct {
 
 type UserConfig struct {
 	Distance                 string                `json:"distance"`
+	DistanceWeights          []float32             `json:"distanceWeights,omitempty"`
 	VectorCacheMaxObjects    int                   `json:"vectorCacheMaxObjects"`
 	PQ                       CompressionUserConfig `json:"pq"`
 	BQ                       CompressionUserConfig `json:"bq"`
@@ -104,6 +105,16 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalFloat32SliceFromMap(asMap, "distanceWeights", func(v []float32) {
+		uc.DistanceWeights = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.ValidateDistance(uc.Distance, uc.DistanceWeights); err != nil {
+		return uc, err
+	}
+
 	if err := vectorindexcommon.OptionalIntFromMap(asMap, "vectorCacheMaxObjects", func(v int) {
 		uc.VectorCacheMaxObjects = v
 	}); err != nil {
@@ -126,6 +137,11 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if uc.BQ.Enabled && uc.Distance == vectorindexcommon.DistanceJaccard {
+		return uc, fmt.Errorf("bq only keeps the sign and can not be used with distance %q",
+			vectorindexcommon.DistanceJaccard)
+	}
+
 	return uc, nil
 }
 
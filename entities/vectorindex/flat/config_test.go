@@ -12,6 +12,7 @@
 package flat
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -341,6 +342,75 @@ func Test_FlatUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
+		{
+			name: "weighted-dot with weights",
+			input: map[string]interface{}{
+				"distance":        "weighted-dot",
+				"distanceWeights": []interface{}{float64(1), json.Number("0.5"), float64(0)},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DistanceWeightedDot,
+				DistanceWeights:       []float32{1, 0.5, 0},
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         DefaultRQBits,
+				},
+			},
+		},
+		{
+			name: "weighted-dot without weights",
+			input: map[string]interface{}{
+				"distance": "weighted-dot",
+			},
+			expectErr:    true,
+			expectErrMsg: "requires distanceWeights",
+		},
+		{
+			name: "weights without weighted-dot",
+			input: map[string]interface{}{
+				"distance":        "dot",
+				"distanceWeights": []interface{}{float64(1)},
+			},
+			expectErr:    true,
+			expectErrMsg: "distanceWeights can only be used with distance",
+		},
+		{
+			name: "unknown distance",
+			input: map[string]interface{}{
+				"distance": "tanimoto-ish",
+			},
+			expectErr:    true,
+			expectErrMsg: "unrecognized distance metric",
+		},
+		{
+			name: "jaccard with bq",
+			input: map[string]interface{}{
+				"distance": "jaccard",
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "can not be used with distance \"jaccard\"",
+		},
 	}
 
 	for _, test := range tests {
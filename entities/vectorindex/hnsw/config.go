@@ -57,6 +57,7 @@ type UserConfig struct {
 	VectorCacheMaxObjects    int               `json:"vectorCacheMaxObjects"`
 	FlatSearchCutoff         int               `json:"flatSearchCutoff"`
 	Distance                 string            `json:"distance"`
+	DistanceWeights          []float32         `json:"distanceWeights,omitempty"`
 	PQ                       PQConfig          `json:"pq"`
 	BQ                       BQConfig          `json:"bq"`
 	SQ                       SQConfig          `json:"sq"`
@@ -132,6 +133,14 @@ func (u *UserConfig) SetDefaults() {
 			DProjections: DefaultMultivectorDProjections,
 			Repetitions:  DefaultMultivectorRepetitions,
 		},
+		PlaidConfig: PlaidConfig{
+			Enabled:       DefaultMultivectorPlaidEnabled,
+			Centroids:     DefaultMultivectorPlaidCentroids,
+			NProbe:        DefaultMultivectorPlaidNProbe,
+			ResidualBits:  DefaultMultivectorPlaidResidualBits,
+			TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+			RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+		},
 	}
 }
 
@@ -216,6 +225,12 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalFloat32SliceFromMap(asMap, "distanceWeights", func(v []float32) {
+		uc.DistanceWeights = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
@@ -284,6 +299,10 @@ func (u *UserConfig) validate() error {
 		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
 	}
 
+	if err := vectorIndexCommon.ValidateDistance(u.Distance, u.DistanceWeights); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -306,6 +325,11 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
 
+	if u.BQ.Enabled && u.Distance == vectorIndexCommon.DistanceJaccard {
+		return fmt.Errorf("invalid hnsw config: bq only keeps the sign and can not be used with distance %q",
+			vectorIndexCommon.DistanceJaccard)
+	}
+
 	err := ValidateRQConfig(u.RQ)
 	if err != nil {
 		return err
@@ -315,6 +339,19 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: ksim must be less than 10")
 	}
 
+	if u.Multivector.PlaidConfig.Enabled {
+		if !u.Multivector.Enabled {
+			return fmt.Errorf("invalid hnsw config: multivector plaid requires multivector to be enabled")
+		}
+		if enabled > 0 {
+			return fmt.Errorf("invalid hnsw config: multivector plaid compresses the token vectors itself " +
+				"and can not be combined with pq, bq, sq or rq")
+		}
+		if err := validatePlaidConfig(u.Multivector); err != nil {
+			return fmt.Errorf("invalid hnsw config: %w", err)
+		}
+	}
+
 	return nil
 }
 
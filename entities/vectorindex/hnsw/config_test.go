@@ -78,6 +78,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -129,6 +137,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -191,6 +207,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -253,6 +277,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -316,6 +348,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -376,6 +416,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -445,6 +493,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -513,6 +569,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -601,6 +665,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -700,6 +772,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -760,6 +840,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -787,6 +875,33 @@ func Test_UserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "invalid hnsw config: filterStrategy must be either 'sweeping' or 'acorn'",
 		},
+		{
+			name: "with unknown distance",
+			input: map[string]interface{}{
+				"distance": "chestnut",
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: unrecognized distance metric \"chestnut\"",
+		},
+		{
+			name: "with weighted-dot and no weights",
+			input: map[string]interface{}{
+				"distance": "weighted-dot",
+			},
+			expectErr:    true,
+			expectErrMsg: "requires distanceWeights",
+		},
+		{
+			name: "with jaccard and bq",
+			input: map[string]interface{}{
+				"distance": "jaccard",
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: bq only keeps the sign",
+		},
 		{
 			name: "acorn enabled, all defaults",
 			input: map[string]interface{}{
@@ -835,6 +950,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -886,6 +1009,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -947,6 +1078,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -1002,6 +1141,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -1056,6 +1203,14 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
 				},
 			},
 		},
@@ -1111,8 +1266,118 @@ func Test_UserConfig(t *testing.T) {
 						DProjections: DefaultMultivectorDProjections,
 						Repetitions:  DefaultMultivectorRepetitions,
 					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       DefaultMultivectorPlaidEnabled,
+						Centroids:     DefaultMultivectorPlaidCentroids,
+						NProbe:        DefaultMultivectorPlaidNProbe,
+						ResidualBits:  DefaultMultivectorPlaidResidualBits,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
+				},
+			},
+		},
+		{
+			name: "with multivector plaid",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"plaid": map[string]interface{}{
+						"enabled":      true,
+						"centroids":    float64(1024),
+						"nprobe":       float64(8),
+						"residualBits": float64(2),
+					},
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: DefaultMultivectorAggregation,
+					MuveraConfig: MuveraConfig{
+						Enabled:      DefaultMultivectorMuveraEnabled,
+						KSim:         DefaultMultivectorKSim,
+						DProjections: DefaultMultivectorDProjections,
+						Repetitions:  DefaultMultivectorRepetitions,
+					},
+					PlaidConfig: PlaidConfig{
+						Enabled:       true,
+						Centroids:     1024,
+						NProbe:        8,
+						ResidualBits:  2,
+						TrainingLimit: DefaultMultivectorPlaidTrainingLimit,
+						RescoreLimit:  DefaultMultivectorPlaidRescoreLimit,
+					},
+				},
+			},
+		},
+		{
+			name: "with multivector plaid and muvera",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"plaid":   map[string]interface{}{"enabled": true},
+					"muvera":  map[string]interface{}{"enabled": true},
 				},
 			},
+			expectErr:    true,
+			expectErrMsg: "multivector plaid and muvera can not be enabled at the same time",
+		},
+		{
+			name: "with multivector plaid and bq",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{"enabled": true},
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"plaid":   map[string]interface{}{"enabled": true},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "can not be combined with pq, bq, sq or rq",
+		},
+		{
+			name: "with multivector plaid and invalid residual bits",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"plaid":   map[string]interface{}{"enabled": true, "residualBits": float64(3)},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "residualBits must be one of 1, 2, 4 or 8",
 		},
 	}
 
@@ -28,12 +28,20 @@ const (
 	DefaultMultivectorDProjections  = 16
 	DefaultMultivectorRepetitions   = 10
 	DefaultMultivectorAggregation   = "maxSim"
+
+	DefaultMultivectorPlaidEnabled       = false
+	DefaultMultivectorPlaidCentroids     = 256
+	DefaultMultivectorPlaidNProbe        = 4
+	DefaultMultivectorPlaidResidualBits  = 4
+	DefaultMultivectorPlaidTrainingLimit = 20000
+	DefaultMultivectorPlaidRescoreLimit  = 256
 )
 
 // Multivector configuration
 type MultivectorConfig struct {
 	Enabled      bool         `json:"enabled"`
 	MuveraConfig MuveraConfig `json:"muvera"`
+	PlaidConfig  PlaidConfig  `json:"plaid"`
 	Aggregation  string       `json:"aggregation"`
 }
 
@@ -44,6 +52,22 @@ type MuveraConfig struct {
 	Repetitions  int  `json:"repetitions"`
 }
 
+// PlaidConfig configures the token-level late-interaction index. Instead of
+// encoding a document into a single fixed dimensional encoding (MUVERA), every
+// token vector is assigned to one of Centroids k-means centroids and stored as
+// the centroid id plus a residual quantized to ResidualBits per dimension.
+// Queries probe the NProbe closest centroids per query token, rank the
+// candidates with the compressed tokens and rescore the best RescoreLimit
+// documents with an exact MaxSim on the original vectors read from disk.
+type PlaidConfig struct {
+	Enabled       bool `json:"enabled"`
+	Centroids     int  `json:"centroids"`
+	NProbe        int  `json:"nprobe"`
+	ResidualBits  int  `json:"residualBits"`
+	TrainingLimit int  `json:"trainingLimit"`
+	RescoreLimit  int  `json:"rescoreLimit"`
+}
+
 func validAggregation(v string) error {
 	switch v {
 	case MultivectorAggregationMaxSim:
@@ -63,6 +87,36 @@ func ValidateMultivectorConfig(cfg MultivectorConfig) error {
 		return err
 	}
 
+	return validatePlaidConfig(cfg)
+}
+
+func validatePlaidConfig(cfg MultivectorConfig) error {
+	plaid := cfg.PlaidConfig
+	if !plaid.Enabled {
+		return nil
+	}
+	if cfg.MuveraConfig.Enabled {
+		return fmt.Errorf("multivector plaid and muvera can not be enabled at the same time")
+	}
+	if plaid.Centroids < 1 || plaid.Centroids > 65536 {
+		return fmt.Errorf("multivector plaid centroids must be between 1 and 65536, got %d", plaid.Centroids)
+	}
+	if plaid.NProbe < 1 || plaid.NProbe > plaid.Centroids {
+		return fmt.Errorf("multivector plaid nprobe must be between 1 and the number of centroids (%d), got %d",
+			plaid.Centroids, plaid.NProbe)
+	}
+	switch plaid.ResidualBits {
+	case 1, 2, 4, 8:
+	default:
+		return fmt.Errorf("multivector plaid residualBits must be one of 1, 2, 4 or 8, got %d", plaid.ResidualBits)
+	}
+	if plaid.TrainingLimit < plaid.Centroids {
+		return fmt.Errorf("multivector plaid trainingLimit must be at least the number of centroids (%d), got %d",
+			plaid.Centroids, plaid.TrainingLimit)
+	}
+	if plaid.RescoreLimit < 1 {
+		return fmt.Errorf("multivector plaid rescoreLimit must be positive, got %d", plaid.RescoreLimit)
+	}
 	return nil
 }
 
@@ -88,6 +142,10 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
+	if err := parsePlaidMap(multivectorConfigMap, &multivector.PlaidConfig); err != nil {
+		return err
+	}
+
 	muveraValue, ok := multivectorConfigMap["muvera"]
 	if !ok {
 		return nil
@@ -130,3 +188,49 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 
 	return nil
 }
+
+func parsePlaidMap(multivectorConfigMap map[string]interface{}, plaid *PlaidConfig) error {
+	plaidValue, ok := multivectorConfigMap["plaid"]
+	if !ok {
+		return nil
+	}
+
+	plaidMap, ok := plaidValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(plaidMap, "enabled", func(v bool) {
+		plaid.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(plaidMap, "centroids", func(v int) {
+		plaid.Centroids = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(plaidMap, "nprobe", func(v int) {
+		plaid.NProbe = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(plaidMap, "residualBits", func(v int) {
+		plaid.ResidualBits = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(plaidMap, "trainingLimit", func(v int) {
+		plaid.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return common.OptionalIntFromMap(plaidMap, "rescoreLimit", func(v int) {
+		plaid.RescoreLimit = v
+	})
+}
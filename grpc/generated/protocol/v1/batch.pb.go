@@ -549,11 +549,13 @@ func (x *BatchReference) GetTenant() string {
 }
 
 type BatchObjectsReply struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Took          float32                         `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
-	Errors        []*BatchObjectsReply_BatchError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState          `protogen:"open.v1"`
+	Took   float32                         `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Errors []*BatchObjectsReply_BatchError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Nodes which acknowledged the write of each object, set if the request carries a write concern
+	Acknowledgements []*BatchObjectsReply_Acknowledgement `protobuf:"bytes,3,rep,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *BatchObjectsReply) Reset() {
@@ -600,6 +602,13 @@ func (x *BatchObjectsReply) GetErrors() []*BatchObjectsReply_BatchError {
 	return nil
 }
 
+func (x *BatchObjectsReply) GetAcknowledgements() []*BatchObjectsReply_Acknowledgement {
+	if x != nil {
+		return x.Acknowledgements
+	}
+	return nil
+}
+
 type BatchReferencesReply struct {
 	state         protoimpl.MessageState             `protogen:"open.v1"`
 	Took          float32                            `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
@@ -1161,9 +1170,11 @@ type BatchStreamReply_Results_Error struct {
 	//
 	//	*BatchStreamReply_Results_Error_Uuid
 	//	*BatchStreamReply_Results_Error_Beacon
-	Detail        isBatchStreamReply_Results_Error_Detail `protobuf_oneof:"detail"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Detail isBatchStreamReply_Results_Error_Detail `protobuf_oneof:"detail"`
+	// Nodes which acknowledged the write of the object, set if the stream carries a write concern
+	AcknowledgedReplicas []string `protobuf:"bytes,4,rep,name=acknowledged_replicas,json=acknowledgedReplicas,proto3" json:"acknowledged_replicas,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *BatchStreamReply_Results_Error) Reset() {
@@ -1228,6 +1239,13 @@ func (x *BatchStreamReply_Results_Error) GetBeacon() string {
 	return ""
 }
 
+func (x *BatchStreamReply_Results_Error) GetAcknowledgedReplicas() []string {
+	if x != nil {
+		return x.AcknowledgedReplicas
+	}
+	return nil
+}
+
 type isBatchStreamReply_Results_Error_Detail interface {
 	isBatchStreamReply_Results_Error_Detail()
 }
@@ -1250,9 +1268,11 @@ type BatchStreamReply_Results_Success struct {
 	//
 	//	*BatchStreamReply_Results_Success_Uuid
 	//	*BatchStreamReply_Results_Success_Beacon
-	Detail        isBatchStreamReply_Results_Success_Detail `protobuf_oneof:"detail"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Detail isBatchStreamReply_Results_Success_Detail `protobuf_oneof:"detail"`
+	// Nodes which acknowledged the write of the object, set if the stream carries a write concern
+	AcknowledgedReplicas []string `protobuf:"bytes,4,rep,name=acknowledged_replicas,json=acknowledgedReplicas,proto3" json:"acknowledged_replicas,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *BatchStreamReply_Results_Success) Reset() {
@@ -1310,6 +1330,13 @@ func (x *BatchStreamReply_Results_Success) GetBeacon() string {
 	return ""
 }
 
+func (x *BatchStreamReply_Results_Success) GetAcknowledgedReplicas() []string {
+	if x != nil {
+		return x.AcknowledgedReplicas
+	}
+	return nil
+}
+
 type isBatchStreamReply_Results_Success_Detail interface {
 	isBatchStreamReply_Results_Success_Detail()
 }
@@ -1608,6 +1635,58 @@ func (x *BatchObjectsReply_BatchError) GetError() string {
 	return ""
 }
 
+type BatchObjectsReply_Acknowledgement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Replicas      []string               `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchObjectsReply_Acknowledgement) Reset() {
+	*x = BatchObjectsReply_Acknowledgement{}
+	mi := &file_v1_batch_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchObjectsReply_Acknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObjectsReply_Acknowledgement) ProtoMessage() {}
+
+func (x *BatchObjectsReply_Acknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObjectsReply_Acknowledgement.ProtoReflect.Descriptor instead.
+func (*BatchObjectsReply_Acknowledgement) Descriptor() ([]byte, []int) {
+	return file_v1_batch_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *BatchObjectsReply_Acknowledgement) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchObjectsReply_Acknowledgement) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
 type BatchReferencesReply_BatchError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -1618,7 +1697,7 @@ type BatchReferencesReply_BatchError struct {
 
 func (x *BatchReferencesReply_BatchError) Reset() {
 	*x = BatchReferencesReply_BatchError{}
-	mi := &file_v1_batch_proto_msgTypes[25]
+	mi := &file_v1_batch_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1630,7 +1709,7 @@ func (x *BatchReferencesReply_BatchError) String() string {
 func (*BatchReferencesReply_BatchError) ProtoMessage() {}
 
 func (x *BatchReferencesReply_BatchError) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_batch_proto_msgTypes[25]
+	mi := &file_v1_batch_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,7 +1772,7 @@ const file_v1_batch_proto_rawDesc = "" +
 	"\n" +
 	"References\x123\n" +
 	"\x06values\x18\x01 \x03(\v2\x1b.weaviate.v1.BatchReferenceR\x06valuesB\t\n" +
-	"\amessage\"\xe9\a\n" +
+	"\amessage\"\xd4\b\n" +
 	"\x10BatchStreamReply\x12A\n" +
 	"\aresults\x18\x01 \x01(\v2%.weaviate.v1.BatchStreamReply.ResultsH\x00R\aresults\x12Q\n" +
 	"\rshutting_down\x18\x02 \x01(\v2*.weaviate.v1.BatchStreamReply.ShuttingDownH\x00R\fshuttingDown\x12A\n" +
@@ -1712,18 +1791,20 @@ const file_v1_batch_proto_rawDesc = "" +
 	"batch_size\x18\x01 \x01(\x05R\tbatchSize\x1a6\n" +
 	"\x04Acks\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x18\n" +
-	"\abeacons\x18\x02 \x03(\tR\abeacons\x1a\xb9\x02\n" +
+	"\abeacons\x18\x02 \x03(\tR\abeacons\x1a\xa4\x03\n" +
 	"\aResults\x12C\n" +
 	"\x06errors\x18\x01 \x03(\v2+.weaviate.v1.BatchStreamReply.Results.ErrorR\x06errors\x12K\n" +
-	"\tsuccesses\x18\x02 \x03(\v2-.weaviate.v1.BatchStreamReply.Results.SuccessR\tsuccesses\x1aW\n" +
+	"\tsuccesses\x18\x02 \x03(\v2-.weaviate.v1.BatchStreamReply.Results.SuccessR\tsuccesses\x1a\x8c\x01\n" +
 	"\x05Error\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x12\x14\n" +
 	"\x04uuid\x18\x02 \x01(\tH\x00R\x04uuid\x12\x18\n" +
-	"\x06beacon\x18\x03 \x01(\tH\x00R\x06beaconB\b\n" +
-	"\x06detail\x1aC\n" +
+	"\x06beacon\x18\x03 \x01(\tH\x00R\x06beacon\x123\n" +
+	"\x15acknowledged_replicas\x18\x04 \x03(\tR\x14acknowledgedReplicasB\b\n" +
+	"\x06detail\x1ax\n" +
 	"\aSuccess\x12\x14\n" +
 	"\x04uuid\x18\x02 \x01(\tH\x00R\x04uuid\x12\x18\n" +
-	"\x06beacon\x18\x03 \x01(\tH\x00R\x06beaconB\b\n" +
+	"\x06beacon\x18\x03 \x01(\tH\x00R\x06beacon\x123\n" +
+	"\x15acknowledged_replicas\x18\x04 \x03(\tR\x14acknowledgedReplicasB\b\n" +
 	"\x06detailB\t\n" +
 	"\amessageJ\x04\b\x03\x10\x04R\bshutdown\"\xa4\n" +
 	"\n" +
@@ -1766,14 +1847,18 @@ const file_v1_batch_proto_rawDesc = "" +
 	"\rto_collection\x18\x04 \x01(\tH\x00R\ftoCollection\x88\x01\x01\x12\x17\n" +
 	"\ato_uuid\x18\x05 \x01(\tR\x06toUuid\x12\x16\n" +
 	"\x06tenant\x18\x06 \x01(\tR\x06tenantB\x10\n" +
-	"\x0e_to_collection\"\xa4\x01\n" +
+	"\x0e_to_collection\"\xc5\x02\n" +
 	"\x11BatchObjectsReply\x12\x12\n" +
 	"\x04took\x18\x01 \x01(\x02R\x04took\x12A\n" +
-	"\x06errors\x18\x02 \x03(\v2).weaviate.v1.BatchObjectsReply.BatchErrorR\x06errors\x1a8\n" +
+	"\x06errors\x18\x02 \x03(\v2).weaviate.v1.BatchObjectsReply.BatchErrorR\x06errors\x12Z\n" +
+	"\x10acknowledgements\x18\x03 \x03(\v2..weaviate.v1.BatchObjectsReply.AcknowledgementR\x10acknowledgements\x1a8\n" +
 	"\n" +
 	"BatchError\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xaa\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x1aC\n" +
+	"\x0fAcknowledgement\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1a\n" +
+	"\breplicas\x18\x02 \x03(\tR\breplicas\"\xaa\x01\n" +
 	"\x14BatchReferencesReply\x12\x12\n" +
 	"\x04took\x18\x01 \x01(\x02R\x04took\x12D\n" +
 	"\x06errors\x18\x02 \x03(\v2,.weaviate.v1.BatchReferencesReply.BatchErrorR\x06errors\x1a8\n" +
@@ -1795,7 +1880,7 @@ func file_v1_batch_proto_rawDescGZIP() []byte {
 	return file_v1_batch_proto_rawDescData
 }
 
-var file_v1_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_v1_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_v1_batch_proto_goTypes = []any{
 	(*BatchObjectsRequest)(nil),                // 0: weaviate.v1.BatchObjectsRequest
 	(*BatchReferencesRequest)(nil),             // 1: weaviate.v1.BatchReferencesRequest
@@ -1822,22 +1907,23 @@ var file_v1_batch_proto_goTypes = []any{
 	(*BatchObject_SingleTargetRefProps)(nil),   // 22: weaviate.v1.BatchObject.SingleTargetRefProps
 	(*BatchObject_MultiTargetRefProps)(nil),    // 23: weaviate.v1.BatchObject.MultiTargetRefProps
 	(*BatchObjectsReply_BatchError)(nil),       // 24: weaviate.v1.BatchObjectsReply.BatchError
-	(*BatchReferencesReply_BatchError)(nil),    // 25: weaviate.v1.BatchReferencesReply.BatchError
-	(ConsistencyLevel)(0),                      // 26: weaviate.v1.ConsistencyLevel
-	(*Vectors)(nil),                            // 27: weaviate.v1.Vectors
-	(*structpb.Struct)(nil),                    // 28: google.protobuf.Struct
-	(*NumberArrayProperties)(nil),              // 29: weaviate.v1.NumberArrayProperties
-	(*IntArrayProperties)(nil),                 // 30: weaviate.v1.IntArrayProperties
-	(*TextArrayProperties)(nil),                // 31: weaviate.v1.TextArrayProperties
-	(*BooleanArrayProperties)(nil),             // 32: weaviate.v1.BooleanArrayProperties
-	(*ObjectProperties)(nil),                   // 33: weaviate.v1.ObjectProperties
-	(*ObjectArrayProperties)(nil),              // 34: weaviate.v1.ObjectArrayProperties
+	(*BatchObjectsReply_Acknowledgement)(nil),  // 25: weaviate.v1.BatchObjectsReply.Acknowledgement
+	(*BatchReferencesReply_BatchError)(nil),    // 26: weaviate.v1.BatchReferencesReply.BatchError
+	(ConsistencyLevel)(0),                      // 27: weaviate.v1.ConsistencyLevel
+	(*Vectors)(nil),                            // 28: weaviate.v1.Vectors
+	(*structpb.Struct)(nil),                    // 29: google.protobuf.Struct
+	(*NumberArrayProperties)(nil),              // 30: weaviate.v1.NumberArrayProperties
+	(*IntArrayProperties)(nil),                 // 31: weaviate.v1.IntArrayProperties
+	(*TextArrayProperties)(nil),                // 32: weaviate.v1.TextArrayProperties
+	(*BooleanArrayProperties)(nil),             // 33: weaviate.v1.BooleanArrayProperties
+	(*ObjectProperties)(nil),                   // 34: weaviate.v1.ObjectProperties
+	(*ObjectArrayProperties)(nil),              // 35: weaviate.v1.ObjectArrayProperties
 }
 var file_v1_batch_proto_depIdxs = []int32{
 	4,  // 0: weaviate.v1.BatchObjectsRequest.objects:type_name -> weaviate.v1.BatchObject
-	26, // 1: weaviate.v1.BatchObjectsRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	27, // 1: weaviate.v1.BatchObjectsRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
 	5,  // 2: weaviate.v1.BatchReferencesRequest.references:type_name -> weaviate.v1.BatchReference
-	26, // 3: weaviate.v1.BatchReferencesRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	27, // 3: weaviate.v1.BatchReferencesRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
 	8,  // 4: weaviate.v1.BatchStreamRequest.start:type_name -> weaviate.v1.BatchStreamRequest.Start
 	10, // 5: weaviate.v1.BatchStreamRequest.data:type_name -> weaviate.v1.BatchStreamRequest.Data
 	9,  // 6: weaviate.v1.BatchStreamRequest.stop:type_name -> weaviate.v1.BatchStreamRequest.Stop
@@ -1848,30 +1934,31 @@ var file_v1_batch_proto_depIdxs = []int32{
 	17, // 11: weaviate.v1.BatchStreamReply.acks:type_name -> weaviate.v1.BatchStreamReply.Acks
 	15, // 12: weaviate.v1.BatchStreamReply.out_of_memory:type_name -> weaviate.v1.BatchStreamReply.OutOfMemory
 	21, // 13: weaviate.v1.BatchObject.properties:type_name -> weaviate.v1.BatchObject.Properties
-	27, // 14: weaviate.v1.BatchObject.vectors:type_name -> weaviate.v1.Vectors
+	28, // 14: weaviate.v1.BatchObject.vectors:type_name -> weaviate.v1.Vectors
 	24, // 15: weaviate.v1.BatchObjectsReply.errors:type_name -> weaviate.v1.BatchObjectsReply.BatchError
-	25, // 16: weaviate.v1.BatchReferencesReply.errors:type_name -> weaviate.v1.BatchReferencesReply.BatchError
-	26, // 17: weaviate.v1.BatchStreamRequest.Start.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
-	11, // 18: weaviate.v1.BatchStreamRequest.Data.objects:type_name -> weaviate.v1.BatchStreamRequest.Data.Objects
-	12, // 19: weaviate.v1.BatchStreamRequest.Data.references:type_name -> weaviate.v1.BatchStreamRequest.Data.References
-	4,  // 20: weaviate.v1.BatchStreamRequest.Data.Objects.values:type_name -> weaviate.v1.BatchObject
-	5,  // 21: weaviate.v1.BatchStreamRequest.Data.References.values:type_name -> weaviate.v1.BatchReference
-	19, // 22: weaviate.v1.BatchStreamReply.Results.errors:type_name -> weaviate.v1.BatchStreamReply.Results.Error
-	20, // 23: weaviate.v1.BatchStreamReply.Results.successes:type_name -> weaviate.v1.BatchStreamReply.Results.Success
-	28, // 24: weaviate.v1.BatchObject.Properties.non_ref_properties:type_name -> google.protobuf.Struct
-	22, // 25: weaviate.v1.BatchObject.Properties.single_target_ref_props:type_name -> weaviate.v1.BatchObject.SingleTargetRefProps
-	23, // 26: weaviate.v1.BatchObject.Properties.multi_target_ref_props:type_name -> weaviate.v1.BatchObject.MultiTargetRefProps
-	29, // 27: weaviate.v1.BatchObject.Properties.number_array_properties:type_name -> weaviate.v1.NumberArrayProperties
-	30, // 28: weaviate.v1.BatchObject.Properties.int_array_properties:type_name -> weaviate.v1.IntArrayProperties
-	31, // 29: weaviate.v1.BatchObject.Properties.text_array_properties:type_name -> weaviate.v1.TextArrayProperties
-	32, // 30: weaviate.v1.BatchObject.Properties.boolean_array_properties:type_name -> weaviate.v1.BooleanArrayProperties
-	33, // 31: weaviate.v1.BatchObject.Properties.object_properties:type_name -> weaviate.v1.ObjectProperties
-	34, // 32: weaviate.v1.BatchObject.Properties.object_array_properties:type_name -> weaviate.v1.ObjectArrayProperties
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	25, // 16: weaviate.v1.BatchObjectsReply.acknowledgements:type_name -> weaviate.v1.BatchObjectsReply.Acknowledgement
+	26, // 17: weaviate.v1.BatchReferencesReply.errors:type_name -> weaviate.v1.BatchReferencesReply.BatchError
+	27, // 18: weaviate.v1.BatchStreamRequest.Start.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	11, // 19: weaviate.v1.BatchStreamRequest.Data.objects:type_name -> weaviate.v1.BatchStreamRequest.Data.Objects
+	12, // 20: weaviate.v1.BatchStreamRequest.Data.references:type_name -> weaviate.v1.BatchStreamRequest.Data.References
+	4,  // 21: weaviate.v1.BatchStreamRequest.Data.Objects.values:type_name -> weaviate.v1.BatchObject
+	5,  // 22: weaviate.v1.BatchStreamRequest.Data.References.values:type_name -> weaviate.v1.BatchReference
+	19, // 23: weaviate.v1.BatchStreamReply.Results.errors:type_name -> weaviate.v1.BatchStreamReply.Results.Error
+	20, // 24: weaviate.v1.BatchStreamReply.Results.successes:type_name -> weaviate.v1.BatchStreamReply.Results.Success
+	29, // 25: weaviate.v1.BatchObject.Properties.non_ref_properties:type_name -> google.protobuf.Struct
+	22, // 26: weaviate.v1.BatchObject.Properties.single_target_ref_props:type_name -> weaviate.v1.BatchObject.SingleTargetRefProps
+	23, // 27: weaviate.v1.BatchObject.Properties.multi_target_ref_props:type_name -> weaviate.v1.BatchObject.MultiTargetRefProps
+	30, // 28: weaviate.v1.BatchObject.Properties.number_array_properties:type_name -> weaviate.v1.NumberArrayProperties
+	31, // 29: weaviate.v1.BatchObject.Properties.int_array_properties:type_name -> weaviate.v1.IntArrayProperties
+	32, // 30: weaviate.v1.BatchObject.Properties.text_array_properties:type_name -> weaviate.v1.TextArrayProperties
+	33, // 31: weaviate.v1.BatchObject.Properties.boolean_array_properties:type_name -> weaviate.v1.BooleanArrayProperties
+	34, // 32: weaviate.v1.BatchObject.Properties.object_properties:type_name -> weaviate.v1.ObjectProperties
+	35, // 33: weaviate.v1.BatchObject.Properties.object_array_properties:type_name -> weaviate.v1.ObjectArrayProperties
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_v1_batch_proto_init() }
@@ -1911,7 +1998,7 @@ func file_v1_batch_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_batch_proto_rawDesc), len(file_v1_batch_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
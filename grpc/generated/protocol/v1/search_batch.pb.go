@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchBatchRequest runs many nearVector queries with the same filters
+// against one collection. The filters are resolved once per shard and shared
+// by all queries.
+type SearchBatchRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Collection       string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant           *string                `protobuf:"bytes,2,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	ConsistencyLevel *ConsistencyLevel      `protobuf:"varint,3,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviate.v1.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	Filters          *Filters               `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	TargetVector     *string                `protobuf:"bytes,5,opt,name=target_vector,json=targetVector,proto3,oneof" json:"target_vector,omitempty"` // empty for the default vector
+	Limit            uint32                 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                                        // per query
+	Queries          []*SearchBatchQuery    `protobuf:"bytes,7,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchBatchRequest) Reset() {
+	*x = SearchBatchRequest{}
+	mi := &file_v1_search_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBatchRequest) ProtoMessage() {}
+
+func (x *SearchBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBatchRequest.ProtoReflect.Descriptor instead.
+func (*SearchBatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_search_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchBatchRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SearchBatchRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *SearchBatchRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+func (x *SearchBatchRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SearchBatchRequest) GetTargetVector() string {
+	if x != nil && x.TargetVector != nil {
+		return *x.TargetVector
+	}
+	return ""
+}
+
+func (x *SearchBatchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchBatchRequest) GetQueries() []*SearchBatchQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type SearchBatchQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VectorBytes   []byte                 `protobuf:"bytes,1,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"` // little endian float32
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBatchQuery) Reset() {
+	*x = SearchBatchQuery{}
+	mi := &file_v1_search_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBatchQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBatchQuery) ProtoMessage() {}
+
+func (x *SearchBatchQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBatchQuery.ProtoReflect.Descriptor instead.
+func (*SearchBatchQuery) Descriptor() ([]byte, []int) {
+	return file_v1_search_batch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchBatchQuery) GetVectorBytes() []byte {
+	if x != nil {
+		return x.VectorBytes
+	}
+	return nil
+}
+
+type SearchBatchReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Took          float32                `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Results       []*SearchBatchResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // one per query, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBatchReply) Reset() {
+	*x = SearchBatchReply{}
+	mi := &file_v1_search_batch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBatchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBatchReply) ProtoMessage() {}
+
+func (x *SearchBatchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_batch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBatchReply.ProtoReflect.Descriptor instead.
+func (*SearchBatchReply) Descriptor() ([]byte, []int) {
+	return file_v1_search_batch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchBatchReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *SearchBatchReply) GetResults() []*SearchBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SearchBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchBatchHit      `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBatchResult) Reset() {
+	*x = SearchBatchResult{}
+	mi := &file_v1_search_batch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBatchResult) ProtoMessage() {}
+
+func (x *SearchBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_batch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBatchResult.ProtoReflect.Descriptor instead.
+func (*SearchBatchResult) Descriptor() ([]byte, []int) {
+	return file_v1_search_batch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchBatchResult) GetHits() []*SearchBatchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type SearchBatchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Distance      float32                `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBatchHit) Reset() {
+	*x = SearchBatchHit{}
+	mi := &file_v1_search_batch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBatchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBatchHit) ProtoMessage() {}
+
+func (x *SearchBatchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_batch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBatchHit.ProtoReflect.Descriptor instead.
+func (*SearchBatchHit) Descriptor() ([]byte, []int) {
+	return file_v1_search_batch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchBatchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchBatchHit) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+var File_v1_search_batch_proto protoreflect.FileDescriptor
+
+const file_v1_search_batch_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/search_batch.proto\x12\vweaviate.v1\x1a\rv1/base.proto\"\xfe\x02\n" +
+	"\x12SearchBatchRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12\x1b\n" +
+	"\x06tenant\x18\x02 \x01(\tH\x00R\x06tenant\x88\x01\x01\x12O\n" +
+	"\x11consistency_level\x18\x03 \x01(\x0e2\x1d.weaviate.v1.ConsistencyLevelH\x01R\x10consistencyLevel\x88\x01\x01\x12.\n" +
+	"\afilters\x18\x04 \x01(\v2\x14.weaviate.v1.FiltersR\afilters\x12(\n" +
+	"\rtarget_vector\x18\x05 \x01(\tH\x02R\ftargetVector\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\rR\x05limit\x127\n" +
+	"\aqueries\x18\a \x03(\v2\x1d.weaviate.v1.SearchBatchQueryR\aqueriesB\t\n" +
+	"\a_tenantB\x14\n" +
+	"\x12_consistency_levelB\x10\n" +
+	"\x0e_target_vector\"5\n" +
+	"\x10SearchBatchQuery\x12!\n" +
+	"\fvector_bytes\x18\x01 \x01(\fR\vvectorBytes\"`\n" +
+	"\x10SearchBatchReply\x12\x12\n" +
+	"\x04took\x18\x01 \x01(\x02R\x04took\x128\n" +
+	"\aresults\x18\x02 \x03(\v2\x1e.weaviate.v1.SearchBatchResultR\aresults\"D\n" +
+	"\x11SearchBatchResult\x12/\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1b.weaviate.v1.SearchBatchHitR\x04hits\"<\n" +
+	"\x0eSearchBatchHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x02R\bdistanceBu\n" +
+	"#io.weaviate.client.grpc.protocol.v1B\x18WeaviateProtoSearchBatchZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
+
+var (
+	file_v1_search_batch_proto_rawDescOnce sync.Once
+	file_v1_search_batch_proto_rawDescData []byte
+)
+
+func file_v1_search_batch_proto_rawDescGZIP() []byte {
+	file_v1_search_batch_proto_rawDescOnce.Do(func() {
+		file_v1_search_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_search_batch_proto_rawDesc), len(file_v1_search_batch_proto_rawDesc)))
+	})
+	return file_v1_search_batch_proto_rawDescData
+}
+
+var file_v1_search_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_search_batch_proto_goTypes = []any{
+	(*SearchBatchRequest)(nil), // 0: weaviate.v1.SearchBatchRequest
+	(*SearchBatchQuery)(nil),   // 1: weaviate.v1.SearchBatchQuery
+	(*SearchBatchReply)(nil),   // 2: weaviate.v1.SearchBatchReply
+	(*SearchBatchResult)(nil),  // 3: weaviate.v1.SearchBatchResult
+	(*SearchBatchHit)(nil),     // 4: weaviate.v1.SearchBatchHit
+	(ConsistencyLevel)(0),      // 5: weaviate.v1.ConsistencyLevel
+	(*Filters)(nil),            // 6: weaviate.v1.Filters
+}
+var file_v1_search_batch_proto_depIdxs = []int32{
+	5, // 0: weaviate.v1.SearchBatchRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	6, // 1: weaviate.v1.SearchBatchRequest.filters:type_name -> weaviate.v1.Filters
+	1, // 2: weaviate.v1.SearchBatchRequest.queries:type_name -> weaviate.v1.SearchBatchQuery
+	3, // 3: weaviate.v1.SearchBatchReply.results:type_name -> weaviate.v1.SearchBatchResult
+	4, // 4: weaviate.v1.SearchBatchResult.hits:type_name -> weaviate.v1.SearchBatchHit
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_search_batch_proto_init() }
+func file_v1_search_batch_proto_init() {
+	if File_v1_search_batch_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	file_v1_search_batch_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_search_batch_proto_rawDesc), len(file_v1_search_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_search_batch_proto_goTypes,
+		DependencyIndexes: file_v1_search_batch_proto_depIdxs,
+		MessageInfos:      file_v1_search_batch_proto_msgTypes,
+	}.Build()
+	File_v1_search_batch_proto = out.File
+	file_v1_search_batch_proto_goTypes = nil
+	file_v1_search_batch_proto_depIdxs = nil
+}
@@ -21,7 +21,7 @@ var File_v1_weaviate_proto protoreflect.FileDescriptor
 
 const file_v1_weaviate_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\xbc\x04\n" +
+	"\x11v1/weaviate.proto\x12\vweaviate.v1\x1a\x12v1/aggregate.proto\x1a\x0ev1/batch.proto\x1a\x15v1/batch_delete.proto\x1a\x16v1/change_stream.proto\x1a\x15v1/search_batch.proto\x1a\x13v1/search_get.proto\x1a\x10v1/tenants.proto2\xdd\x05\n" +
 	"\bWeaviate\x12@\n" +
 	"\x06Search\x12\x1a.weaviate.v1.SearchRequest\x1a\x18.weaviate.v1.SearchReply\"\x00\x12R\n" +
 	"\fBatchObjects\x12 .weaviate.v1.BatchObjectsRequest\x1a\x1e.weaviate.v1.BatchObjectsReply\"\x00\x12[\n" +
@@ -30,7 +30,9 @@ const file_v1_weaviate_proto_rawDesc = "" +
 	"\n" +
 	"TenantsGet\x12\x1e.weaviate.v1.TenantsGetRequest\x1a\x1c.weaviate.v1.TenantsGetReply\"\x00\x12I\n" +
 	"\tAggregate\x12\x1d.weaviate.v1.AggregateRequest\x1a\x1b.weaviate.v1.AggregateReply\"\x00\x12S\n" +
-	"\vBatchStream\x12\x1f.weaviate.v1.BatchStreamRequest\x1a\x1d.weaviate.v1.BatchStreamReply\"\x00(\x010\x01Bj\n" +
+	"\vBatchStream\x12\x1f.weaviate.v1.BatchStreamRequest\x1a\x1d.weaviate.v1.BatchStreamReply\"\x00(\x010\x01\x12O\n" +
+	"\vSearchBatch\x12\x1f.weaviate.v1.SearchBatchRequest\x1a\x1d.weaviate.v1.SearchBatchReply\"\x00\x12N\n" +
+	"\fChangeStream\x12 .weaviate.v1.ChangeStreamRequest\x1a\x18.weaviate.v1.ChangeEvent\"\x000\x01Bj\n" +
 	"#io.weaviate.client.grpc.protocol.v1B\rWeaviateProtoZ4github.com/weaviate/weaviate/grpc/generated;protocolb\x06proto3"
 
 var file_v1_weaviate_proto_goTypes = []any{
@@ -41,13 +43,17 @@ var file_v1_weaviate_proto_goTypes = []any{
 	(*TenantsGetRequest)(nil),      // 4: weaviate.v1.TenantsGetRequest
 	(*AggregateRequest)(nil),       // 5: weaviate.v1.AggregateRequest
 	(*BatchStreamRequest)(nil),     // 6: weaviate.v1.BatchStreamRequest
-	(*SearchReply)(nil),            // 7: weaviate.v1.SearchReply
-	(*BatchObjectsReply)(nil),      // 8: weaviate.v1.BatchObjectsReply
-	(*BatchReferencesReply)(nil),   // 9: weaviate.v1.BatchReferencesReply
-	(*BatchDeleteReply)(nil),       // 10: weaviate.v1.BatchDeleteReply
-	(*TenantsGetReply)(nil),        // 11: weaviate.v1.TenantsGetReply
-	(*AggregateReply)(nil),         // 12: weaviate.v1.AggregateReply
-	(*BatchStreamReply)(nil),       // 13: weaviate.v1.BatchStreamReply
+	(*SearchBatchRequest)(nil),     // 7: weaviate.v1.SearchBatchRequest
+	(*ChangeStreamRequest)(nil),    // 8: weaviate.v1.ChangeStreamRequest
+	(*SearchReply)(nil),            // 9: weaviate.v1.SearchReply
+	(*BatchObjectsReply)(nil),      // 10: weaviate.v1.BatchObjectsReply
+	(*BatchReferencesReply)(nil),   // 11: weaviate.v1.BatchReferencesReply
+	(*BatchDeleteReply)(nil),       // 12: weaviate.v1.BatchDeleteReply
+	(*TenantsGetReply)(nil),        // 13: weaviate.v1.TenantsGetReply
+	(*AggregateReply)(nil),         // 14: weaviate.v1.AggregateReply
+	(*BatchStreamReply)(nil),       // 15: weaviate.v1.BatchStreamReply
+	(*SearchBatchReply)(nil),       // 16: weaviate.v1.SearchBatchReply
+	(*ChangeEvent)(nil),            // 17: weaviate.v1.ChangeEvent
 }
 var file_v1_weaviate_proto_depIdxs = []int32{
 	0,  // 0: weaviate.v1.Weaviate.Search:input_type -> weaviate.v1.SearchRequest
@@ -57,15 +63,19 @@ var file_v1_weaviate_proto_depIdxs = []int32{
 	4,  // 4: weaviate.v1.Weaviate.TenantsGet:input_type -> weaviate.v1.TenantsGetRequest
 	5,  // 5: weaviate.v1.Weaviate.Aggregate:input_type -> weaviate.v1.AggregateRequest
 	6,  // 6: weaviate.v1.Weaviate.BatchStream:input_type -> weaviate.v1.BatchStreamRequest
-	7,  // 7: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
-	8,  // 8: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
-	9,  // 9: weaviate.v1.Weaviate.BatchReferences:output_type -> weaviate.v1.BatchReferencesReply
-	10, // 10: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
-	11, // 11: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
-	12, // 12: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
-	13, // 13: weaviate.v1.Weaviate.BatchStream:output_type -> weaviate.v1.BatchStreamReply
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	7,  // 7: weaviate.v1.Weaviate.SearchBatch:input_type -> weaviate.v1.SearchBatchRequest
+	8,  // 8: weaviate.v1.Weaviate.ChangeStream:input_type -> weaviate.v1.ChangeStreamRequest
+	9,  // 9: weaviate.v1.Weaviate.Search:output_type -> weaviate.v1.SearchReply
+	10, // 10: weaviate.v1.Weaviate.BatchObjects:output_type -> weaviate.v1.BatchObjectsReply
+	11, // 11: weaviate.v1.Weaviate.BatchReferences:output_type -> weaviate.v1.BatchReferencesReply
+	12, // 12: weaviate.v1.Weaviate.BatchDelete:output_type -> weaviate.v1.BatchDeleteReply
+	13, // 13: weaviate.v1.Weaviate.TenantsGet:output_type -> weaviate.v1.TenantsGetReply
+	14, // 14: weaviate.v1.Weaviate.Aggregate:output_type -> weaviate.v1.AggregateReply
+	15, // 15: weaviate.v1.Weaviate.BatchStream:output_type -> weaviate.v1.BatchStreamReply
+	16, // 16: weaviate.v1.Weaviate.SearchBatch:output_type -> weaviate.v1.SearchBatchReply
+	17, // 17: weaviate.v1.Weaviate.ChangeStream:output_type -> weaviate.v1.ChangeEvent
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -79,6 +89,8 @@ func file_v1_weaviate_proto_init() {
 	file_v1_aggregate_proto_init()
 	file_v1_batch_proto_init()
 	file_v1_batch_delete_proto_init()
+	file_v1_change_stream_proto_init()
+	file_v1_search_batch_proto_init()
 	file_v1_search_get_proto_init()
 	file_v1_tenants_proto_init()
 	type x struct{}
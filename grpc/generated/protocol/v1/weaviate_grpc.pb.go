@@ -23,6 +23,8 @@ const (
 	Weaviate_TenantsGet_FullMethodName      = "/weaviate.v1.Weaviate/TenantsGet"
 	Weaviate_Aggregate_FullMethodName       = "/weaviate.v1.Weaviate/Aggregate"
 	Weaviate_BatchStream_FullMethodName     = "/weaviate.v1.Weaviate/BatchStream"
+	Weaviate_SearchBatch_FullMethodName     = "/weaviate.v1.Weaviate/SearchBatch"
+	Weaviate_ChangeStream_FullMethodName    = "/weaviate.v1.Weaviate/ChangeStream"
 )
 
 // WeaviateClient is the client API for Weaviate service.
@@ -36,6 +38,8 @@ type WeaviateClient interface {
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
 	BatchStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchStreamRequest, BatchStreamReply], error)
+	SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error)
+	ChangeStream(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeEvent], error)
 }
 
 type weaviateClient struct {
@@ -119,6 +123,35 @@ func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOptio
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Weaviate_BatchStreamClient = grpc.BidiStreamingClient[BatchStreamRequest, BatchStreamReply]
 
+func (c *weaviateClient) SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBatchReply)
+	err := c.cc.Invoke(ctx, Weaviate_SearchBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ChangeStream(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], Weaviate_ChangeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ChangeStreamRequest, ChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangeStreamClient = grpc.ServerStreamingClient[ChangeEvent]
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility.
@@ -130,6 +163,8 @@ type WeaviateServer interface {
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
 	BatchStream(grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]) error
+	SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error)
+	ChangeStream(*ChangeStreamRequest, grpc.ServerStreamingServer[ChangeEvent]) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -161,6 +196,12 @@ func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest)
 func (UnimplementedWeaviateServer) BatchStream(grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]) error {
 	return status.Error(codes.Unimplemented, "method BatchStream not implemented")
 }
+func (UnimplementedWeaviateServer) SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchBatch not implemented")
+}
+func (UnimplementedWeaviateServer) ChangeStream(*ChangeStreamRequest, grpc.ServerStreamingServer[ChangeEvent]) error {
+	return status.Error(codes.Unimplemented, "method ChangeStream not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 func (UnimplementedWeaviateServer) testEmbeddedByValue()                  {}
 
@@ -297,6 +338,35 @@ func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) er
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Weaviate_BatchStreamServer = grpc.BidiStreamingServer[BatchStreamRequest, BatchStreamReply]
 
+func _Weaviate_SearchBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).SearchBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_SearchBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).SearchBatch(ctx, req.(*SearchBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ChangeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).ChangeStream(m, &grpc.GenericServerStream[ChangeStreamRequest, ChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Weaviate_ChangeStreamServer = grpc.ServerStreamingServer[ChangeEvent]
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -328,6 +398,10 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Aggregate",
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
+		{
+			MethodName: "SearchBatch",
+			Handler:    _Weaviate_SearchBatch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -336,6 +410,11 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ChangeStream",
+			Handler:       _Weaviate_ChangeStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/weaviate.proto",
 }
@@ -128,10 +128,19 @@ func (g *azureClient) makeObjectName(overridePath string, parts []string) string
 	}
 }
 
-func (a *azureClient) AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (a *azureClient) AllBackups(ctx context.Context, overrideBucket, overridePath string) ([]*backup.DistributedBackupDescriptor, error) {
 	var meta []*backup.DistributedBackupDescriptor
 
-	blobs := a.client.NewListBlobsFlatPager(a.config.Container, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(a.config.BackupPath)})
+	containerName := a.config.Container
+	if overrideBucket != "" {
+		containerName = overrideBucket
+	}
+	prefix := a.config.BackupPath
+	if overridePath != "" {
+		prefix = overridePath
+	}
+
+	blobs := a.client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
 	for {
 		if !blobs.More() {
 			break
@@ -148,7 +157,7 @@ func (a *azureClient) AllBackups(ctx context.Context) ([]*backup.DistributedBack
 				}
 
 				// now we have ubak.GlobalBackupFile
-				contents, err := a.getObject(ctx, a.config.Container, *item.Name)
+				contents, err := a.getObject(ctx, containerName, *item.Name)
 				if err != nil {
 					return nil, fmt.Errorf("get blob item %q: %w", *item.Name, err)
 				}
@@ -243,6 +252,39 @@ func (a *azureClient) Initialize(ctx context.Context, backupID, overrideBucket,
 	return nil
 }
 
+// DeleteBackup removes all blobs stored under backupID
+func (a *azureClient) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	if backupID == "" {
+		// an empty ID would match the objects of all backups
+		return backup.NewErrUnprocessable(errors.New("delete backup: empty backup id"))
+	}
+	containerName := a.config.Container
+	if overrideBucket != "" {
+		containerName = overrideBucket
+	}
+
+	prefix := a.makeObjectName(overridePath, []string{backupID}) + "/"
+	blobs := a.client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+	for blobs.More() {
+		page, err := blobs.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs %s", prefix))
+		}
+		if page.Segment == nil {
+			continue
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, containerName, *item.Name, nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob %s", *item.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) WriteToFile(ctx context.Context, backupID, key, destPath, overrideBucket, overridePath string) error {
 	dir := path.Dir(destPath)
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
@@ -221,6 +221,22 @@ func (m *Module) Read(ctx context.Context, backupID, key, overrideBucket, overri
 	return read, err
 }
 
+// DeleteBackup removes the directory of the backup
+func (m *Module) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	if backupID == "" {
+		// an empty ID would match the objects of all backups
+		return backup.NewErrUnprocessable(errors.New("delete backup: empty backup id"))
+	}
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup %s", backupID))
+	}
+	backupPath := m.HomeDir(backupID, overrideBucket, overridePath)
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup %s", backupPath))
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -52,3 +52,23 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_DeleteBackup(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	for _, id := range []string{"first", "second"} {
+		assert.Nil(t, module.PutObject(ctx, id+"/node1", "backup.json", "", "", []byte("{}")))
+	}
+
+	assert.Nil(t, module.DeleteBackup(ctx, "first", "", ""))
+	_, err := os.Stat(module.HomeDir("first", "", ""))
+	assert.True(t, os.IsNotExist(err))
+	_, err = module.GetObject(ctx, "second/node1", "backup.json", "", "")
+	assert.Nil(t, err)
+
+	assert.NotNil(t, module.DeleteBackup(ctx, "", "", ""))
+	_, err = os.Stat(module.HomeDir("second", "", ""))
+	assert.Nil(t, err)
+}
@@ -80,9 +80,14 @@ func (m *Module) HomeDir(backupID, overrideBucket, overridePath string) string {
 	}
 }
 
-func (m *Module) AllBackups(context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (m *Module) AllBackups(_ context.Context, _, overridePath string) ([]*backup.DistributedBackupDescriptor, error) {
+	backupsPath := m.backupsPath
+	if overridePath != "" {
+		backupsPath = overridePath
+	}
+
 	var meta []*backup.DistributedBackupDescriptor
-	backups, err := os.ReadDir(m.backupsPath)
+	backups, err := os.ReadDir(backupsPath)
 	if err != nil {
 		return nil, fmt.Errorf("open backups path: %w", err)
 	}
@@ -90,7 +95,7 @@ func (m *Module) AllBackups(context.Context) ([]*backup.DistributedBackupDescrip
 		if !bak.IsDir() {
 			continue
 		}
-		backupPath := path.Join(m.backupsPath, bak.Name())
+		backupPath := path.Join(backupsPath, bak.Name())
 		contents, err := os.ReadDir(backupPath)
 		if err != nil {
 			return nil, fmt.Errorf("read backup contents: %w", err)
@@ -140,14 +140,18 @@ func (g *gcsClient) HomeDir(backupID, overrideBucket, overridePath string) strin
 	}
 }
 
-func (g *gcsClient) AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (g *gcsClient) AllBackups(ctx context.Context, overrideBucket, overridePath string) ([]*backup.DistributedBackupDescriptor, error) {
 	var meta []*backup.DistributedBackupDescriptor
-	bucket, err := g.findBucket(ctx, "")
+	bucket, err := g.findBucket(ctx, overrideBucket)
 	if err != nil {
 		return nil, fmt.Errorf("find bucket: %w", err)
 	}
 
-	iter := bucket.Objects(ctx, &storage.Query{Prefix: g.config.BackupPath, MatchGlob: "**/" + ubak.GlobalBackupFile})
+	prefix := g.config.BackupPath
+	if overridePath != "" {
+		prefix = overridePath
+	}
+	iter := bucket.Objects(ctx, &storage.Query{Prefix: prefix, MatchGlob: "**/" + ubak.GlobalBackupFile})
 	for {
 		// Check context before each iteration
 		if err := ctx.Err(); err != nil {
@@ -280,6 +284,33 @@ func (g *gcsClient) Initialize(ctx context.Context, backupID, overrideBucket, ov
 	return nil
 }
 
+// DeleteBackup removes all objects stored under backupID
+func (g *gcsClient) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	if backupID == "" {
+		// an empty ID would match the objects of all backups
+		return backup.NewErrUnprocessable(errors.New("delete backup: empty backup id"))
+	}
+	bucket, err := g.findBucket(ctx, overrideBucket)
+	if err != nil {
+		return errors.Wrap(err, "find bucket")
+	}
+
+	prefix := g.makeObjectName(overridePath, []string{backupID}) + "/"
+	iter := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		next, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects %s", prefix))
+		}
+		if err := bucket.Object(next.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", next.Name))
+		}
+	}
+}
+
 // WriteToFile downloads an object and store its content in destPath
 // The file destPath will be created if it doesn't exit
 func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath, overrideBucket, overridePath string) (err error) {
@@ -113,14 +113,23 @@ func (s *s3Client) HomeDir(backupID, overrideBucket, overridePath string) string
 	return "s3://" + path.Join(remoteBucket, remotePath, s.makeObjectName(backupID))
 }
 
-func (s *s3Client) AllBackups(ctx context.Context,
+func (s *s3Client) AllBackups(ctx context.Context, overrideBucket, overridePath string,
 ) ([]*backup.DistributedBackupDescriptor, error) {
+	bucket := s.config.Bucket
+	if overrideBucket != "" {
+		bucket = overrideBucket
+	}
+	prefix := s.config.BackupPath
+	if overridePath != "" {
+		prefix = overridePath
+	}
+
 	var meta []*backup.DistributedBackupDescriptor
 	objectsInfo := s.client.ListObjects(ctx,
-		s.config.Bucket,
+		bucket,
 		minio.ListObjectsOptions{
 			Recursive: true,
-			Prefix:    s.config.BackupPath,
+			Prefix:    prefix,
 		},
 	)
 
@@ -137,7 +146,7 @@ func (s *s3Client) AllBackups(ctx context.Context,
 
 		// Get the backup object
 		obj, err := s.client.GetObject(ctx,
-			s.config.Bucket, info.Key, minio.GetObjectOptions{})
+			bucket, info.Key, minio.GetObjectOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("get object %q: %w", info.Key, err)
 		}
@@ -383,6 +392,37 @@ func (s *s3Client) Read(ctx context.Context, backupID, key, overrideBucket, over
 	return read, nil
 }
 
+// DeleteBackup removes all objects stored under backupID
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	if backupID == "" {
+		// an empty ID would match the objects of all backups
+		return backup.NewErrUnprocessable(errors.New("delete backup: empty backup id"))
+	}
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete backup: cannot get client")
+	}
+	prefix := s.makeObjectName(backupID) + "/"
+	if overridePath != "" {
+		prefix = path.Join(overridePath, backupID) + "/"
+	}
+
+	bucket := s.config.Bucket
+	if overrideBucket != "" {
+		bucket = overrideBucket
+	}
+
+	objects := client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true, Prefix: prefix})
+	var firstErr error
+	// the channel must be drained for the listing routine to terminate
+	for rErr := range client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		if firstErr == nil {
+			firstErr = backup.NewErrInternal(errors.Wrapf(rErr.Err, "delete object %s:%s", bucket, rErr.ObjectName))
+		}
+	}
+	return firstErr
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
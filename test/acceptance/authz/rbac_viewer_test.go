@@ -57,6 +57,11 @@ func TestAuthzViewerEndpoints(t *testing.T) {
 		{endpoint: "classifications", methods: []string{"POST"}, success: []bool{false}, arrayReq: false},
 		{endpoint: "classifications/id", methods: []string{"GET"}, success: []bool{true}, arrayReq: false},
 		{endpoint: "cluster/statistics", methods: []string{"GET"}, success: []bool{true}, arrayReq: false},
+		{endpoint: "cluster/schema-audit", methods: []string{"GET"}, success: []bool{true}, arrayReq: false},
+		{endpoint: "cluster/rebalancer/plan", methods: []string{"GET"}, success: []bool{true}, arrayReq: false},
+		{endpoint: "cluster/rebalancer/pause", methods: []string{"POST"}, success: []bool{false}, arrayReq: false},
+		{endpoint: "cluster/rebalancer/resume", methods: []string{"POST"}, success: []bool{false}, arrayReq: false},
+		{endpoint: "cluster/replication-verify?collection=RandomClass", methods: []string{"GET"}, success: []bool{true}, arrayReq: false},
 		{endpoint: "graphql", methods: []string{"POST"}, success: []bool{true}, arrayReq: false},
 		{endpoint: "objects", methods: []string{"GET", "POST"}, success: []bool{true, false}, arrayReq: false},
 		{endpoint: "objects/" + UUID1.String(), methods: []string{"GET", "HEAD", "DELETE", "PATCH", "PUT"}, success: []bool{true, true, false, false, false}, arrayReq: false, body: map[string][]byte{"PATCH": []byte(fmt.Sprintf("{\"class\": \"c\", \"id\":%q}", UUID1.String()))}},
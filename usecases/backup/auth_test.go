@@ -35,6 +35,7 @@ import (
 
 func Test_Authorization(t *testing.T) {
 	req := &BackupRequest{ID: "123", Backend: "filesystem"}
+	schedule := backup.Schedule{ID: "daily", Cron: "0 3 * * *", Backend: "filesystem", Include: []string{"ABC"}}
 	type testCase struct {
 		methodName       string
 		additionalArgs   []interface{}
@@ -91,6 +92,26 @@ func Test_Authorization(t *testing.T) {
 			classes:        []string{"ABC"},
 			ignoreAuthZ:    true,
 		},
+		{
+			methodName:       "PutSchedule",
+			additionalArgs:   []interface{}{schedule},
+			expectedVerb:     authorization.CREATE,
+			expectedResource: authorization.Backups("ABC")[0],
+			classes:          []string{"ABC"},
+		},
+		{
+			methodName:       "Schedules",
+			expectedVerb:     authorization.READ,
+			expectedResource: authorization.Backups()[0],
+			classes:          []string{"ABC"},
+		},
+		{
+			methodName:       "DeleteSchedule",
+			additionalArgs:   []interface{}{"daily"},
+			expectedVerb:     authorization.DELETE,
+			expectedResource: authorization.Backups("ABC")[0],
+			classes:          []string{"ABC"},
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -102,7 +123,8 @@ func Test_Authorization(t *testing.T) {
 		for _, method := range allExportedMethods(&Scheduler{}) {
 			switch method {
 			case "OnCommit", "OnAbort", "OnCanCommit",
-				"OnStatus", "CleanupUnfinishedBackups":
+				"OnStatus", "CleanupUnfinishedBackups",
+				"SetScheduleStore", "RunSchedules", "RunSchedulesOnce":
 				continue
 			}
 			assert.Contains(t, testedMethods, method)
@@ -158,7 +180,7 @@ func Test_Authorization(t *testing.T) {
 
 				// AllBackups mock expectation for List method
 				if test.methodName == "List" {
-					modcapabilities.On("AllBackups", mock.Anything).Return([]*backup.DistributedBackupDescriptor{&dd}, nil)
+					modcapabilities.On("AllBackups", mock.Anything, "", "").Return([]*backup.DistributedBackupDescriptor{&dd}, nil)
 				}
 
 				nodeResolver.On("NodeCount").Return(1).Maybe()
@@ -172,6 +194,7 @@ func Test_Authorization(t *testing.T) {
 
 				s := NewScheduler(authorizer, nil, selector, backupProvider, nodeResolver, &fakeSchemaManger{}, logger)
 				require.NotNil(t, s)
+				s.SetScheduleStore(&fakeScheduleStore{schedules: []backup.Schedule{schedule}})
 
 				if !test.ignoreAuthZ {
 					authorizer.On("Authorize", mock.Anything, mock.Anything, test.expectedVerb, test.expectedResource).Return(nil)
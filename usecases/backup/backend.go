@@ -21,6 +21,8 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -144,6 +146,11 @@ func (s *nodeStore) Meta(ctx context.Context, backupID, overrideBucket, override
 	return &result, err
 }
 
+// sibling returns the store of the same node for another backup
+func (s *nodeStore) sibling(backupID string) nodeStore {
+	return nodeStore{objectStore{s.backend, fmt.Sprintf("%s/%s", backupID, path.Base(s.backupId)), s.bucket, s.path}}
+}
+
 // meta marshals and uploads metadata
 func (s *nodeStore) PutMeta(ctx context.Context, desc *backup.BackupDescriptor, overrideBucket, overridePath string) error {
 	return s.putMeta(ctx, BackupFile, overrideBucket, overridePath, desc)
@@ -182,20 +189,26 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	// base is the node descriptor of the backup an incremental backup is based on
+	base *backup.BackupDescriptor
 }
 
 func newUploader(cfg config.Backup, sourcer Sourcer, rbacSourcer fsm.Snapshotter, dynUserSourcer fsm.Snapshotter, backend nodeStore,
 	backupID string, setstatus func(st backup.Status), l logrus.FieldLogger,
 ) *uploader {
 	return &uploader{
-		cfg, sourcer, rbacSourcer, dynUserSourcer, backend,
-		backupID,
-		newZipConfig(Compression{
+		cfg:            cfg,
+		sourcer:        sourcer,
+		rbacSourcer:    rbacSourcer,
+		dynUserSourcer: dynUserSourcer,
+		backend:        backend,
+		backupID:       backupID,
+		zipConfig: newZipConfig(Compression{
 			Level:         GzipDefaultCompression,
 			CPUPercentage: DefaultCPUPercentage,
 		}),
-		setstatus,
-		l,
+		setStatus: setstatus,
+		log:       l,
 	}
 }
 
@@ -204,6 +217,13 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+// withBase makes the upload incremental: files which are unchanged since
+// base was created are referenced instead of being uploaded again
+func (u *uploader) withBase(base *backup.BackupDescriptor) *uploader {
+	u.base = base
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor, overrideBucket, overridePath string) (err error) {
 	u.setStatus(backup.Transferring)
@@ -362,6 +382,7 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 	}
 
 	desc.Chunks = make(map[int32][]string, 1+nShards/2)
+	baseShards := u.baseShards(desc.Name)
 	var (
 		lastChunk = int32(0)
 		nWorker   = u.GoPoolSize
@@ -406,13 +427,15 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 					}
 					for shard := range sender {
 						firstChunk := true
-						filesInShard := shard.CopyFilesInShard()
+						filesInShard := u.filesToUpload(shard, baseShards[shard.Name])
 						for {
 							chunk := atomic.AddInt32(&lastChunk, 1)
+							pending := filesInShard.Remaining()
 							shards, preCompressionSize, err := u.compress(ctx, desc.Name, chunk, shard, filesInShard, firstChunk, overrideBucket, overridePath)
 							if err != nil {
 								return err
 							}
+							setChunk(shard, pending[:len(pending)-filesInShard.Len()], chunk)
 							if m := int32(len(shards)); m > 0 {
 								recvCh <- chuckShards{chunk, shards, preCompressionSize}
 							}
@@ -438,6 +461,84 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 	return desc.PreCompressionSizeBytes, err
 }
 
+// baseShards returns the shards of class held by the base backup
+func (u *uploader) baseShards(class string) map[string]*backup.ShardDescriptor {
+	if u.base == nil {
+		return nil
+	}
+	for _, cdesc := range u.base.Classes {
+		if cdesc.Name != class {
+			continue
+		}
+		shards := make(map[string]*backup.ShardDescriptor, len(cdesc.Shards))
+		for _, shard := range cdesc.Shards {
+			shards[shard.Name] = shard
+		}
+		return shards
+	}
+	return nil
+}
+
+// filesToUpload records size and modification time of the files of shard.
+// Immutable segments which are unchanged since the base backup are
+// referenced and left out of the returned list.
+func (u *uploader) filesToUpload(shard, base *backup.ShardDescriptor) *backup.FileList {
+	sourceDataPath := u.backend.SourceDataPath()
+	files := &backup.FileList{Files: make([]string, 0, len(shard.Files))}
+	shard.FileRefs = make(map[string]backup.FileRef, len(shard.Files))
+	for _, file := range shard.Files {
+		info, err := os.Stat(filepath.Join(sourceDataPath, file))
+		if err != nil {
+			// the zip writer knows how to handle missing files
+			files.Files = append(files.Files, file)
+			continue
+		}
+		ref := backup.FileRef{Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+		if base != nil && isImmutableSegment(file) {
+			if prev, ok := base.Locate(file, u.base.ID); ok && prev.Size == ref.Size && prev.ModTime == ref.ModTime {
+				shard.FileRefs[file] = prev
+				continue
+			}
+		}
+		shard.FileRefs[file] = ref
+		files.Files = append(files.Files, file)
+	}
+	if n := len(shard.Files) - len(files.Files); n > 0 {
+		u.log.WithFields(logrus.Fields{
+			"shard":       shard.Name,
+			"base_backup": u.base.ID,
+			"reused":      n,
+		}).Debug("reuse unchanged segments")
+	}
+	return files
+}
+
+// setChunk records the chunk files have been written to
+func setChunk(shard *backup.ShardDescriptor, files []string, chunk int32) {
+	for _, file := range files {
+		if ref, ok := shard.FileRefs[file]; ok {
+			ref.Chunk = chunk
+			shard.FileRefs[file] = ref
+		}
+	}
+}
+
+// isImmutableSegment reports whether file is an LSM segment or one of its
+// companion files (bloom filters, net additions, ...), which are never
+// modified once written. Write-ahead logs and temporary files are excluded.
+func isImmutableSegment(file string) bool {
+	name := filepath.Base(file)
+	if !strings.HasPrefix(name, "segment-") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".wal", ".tmp":
+		return false
+	default:
+		return true
+	}
+}
+
 type chuckShards struct {
 	chunk              int32
 	shards             []string
@@ -553,6 +654,10 @@ type fileWriter struct {
 	GoPoolSize int
 	migrator   func(classPath string) error
 	logger     logrus.FieldLogger
+	// bases maps earlier backups holding reused files to their compression type
+	bases map[string]backup.CompressionType
+	// renaming restores the class under a new class or tenant name
+	renaming *classRenaming
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
@@ -578,17 +683,28 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
+func (fw *fileWriter) setBases(bases map[string]backup.CompressionType) { fw.bases = bases }
+
+func (fw *fileWriter) setRenaming(r *classRenaming) { fw.renaming = r }
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, overrideBucket, overridePath string, compressionType backup.CompressionType) (err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
 		return nil
 	}
-	classTempDir := path.Join(fw.tempDir, desc.Name)
+	classTempDir := path.Join(fw.tempDir, fw.renaming.class(desc.Name))
 
 	if err := fw.writeTempFiles(ctx, classTempDir, overrideBucket, overridePath, desc, compressionType); err != nil {
 		return fmt.Errorf("get files: %w", err)
 	}
 
+	if fw.renaming.renamesClass() {
+		indexDir := path.Join(classTempDir, strings.ToLower(fw.renaming.to))
+		if err := fw.sourcer.RenameClass(ctx, indexDir, fw.renaming.to); err != nil {
+			return fmt.Errorf("rename class of objects: %w", err)
+		}
+	}
+
 	if fw.migrator != nil {
 		if err := fw.migrator(classTempDir); err != nil {
 			return fmt.Errorf("migrate from pre 1.23: %w", err)
@@ -620,6 +736,9 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 			if err := ctx.Err(); err != nil {
 				return err
 			}
+			if !fw.renaming.keeps(shard.Name) {
+				continue
+			}
 			shard := shard
 			eg.Go(func() error { return fw.writeTempShard(ctx, shard, classTempDir, overrideBucket, overridePath) }, shard.Name)
 		}
@@ -628,14 +747,18 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 
 	// source files are compressed
 	eg.SetLimit(fw.GoPoolSize)
-	for k := range desc.Chunks {
+	for k, shards := range desc.Chunks {
 		// Check for cancellation before processing each chunk
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if !slices.ContainsFunc(shards, fw.renaming.keeps) {
+			continue
+		}
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
 			uz, w := NewUnzip(classTempDir, compressionType)
+			uz.rename(fw.renaming.path)
 
 			enterrors.GoWrapper(func() {
 				fw.backend.Read(ctx, chunk, overrideBucket, overridePath, w)
@@ -644,16 +767,44 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 			return err
 		})
 	}
+
+	// files of an incremental backup which are held by earlier backups
+	for ref, files := range desc.ReusedFiles() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		compressionType, ok := fw.bases[ref.BackupID]
+		if !ok {
+			return fmt.Errorf("base backup %q of class %s has not been resolved", ref.BackupID, desc.Name)
+		}
+		store, chunk := fw.backend.sibling(ref.BackupID), chunkKey(desc.Name, ref.Chunk)
+		eg.Go(func() error {
+			uz, w := NewUnzip(classTempDir, compressionType)
+			uz.only(files)
+			uz.rename(fw.renaming.path)
+
+			enterrors.GoWrapper(func() {
+				store.Read(ctx, chunk, overrideBucket, overridePath, w)
+			}, fw.logger)
+			_, err := uz.ReadChunk()
+			return err
+		})
+	}
 	return eg.Wait()
 }
 
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescriptor, classTempDir, overrideBucket, overridePath string) error {
+	// shards which are not restored have been skipped already
+	tempPath := func(relPath string) string {
+		relPath, _ = fw.renaming.path(relPath)
+		return path.Join(classTempDir, relPath)
+	}
 	for _, key := range sd.Files {
 		// Check for cancellation before processing each file
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		destPath := path.Join(classTempDir, key)
+		destPath := tempPath(key)
 		destDir := path.Dir(destPath)
 		if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 			return fmt.Errorf("create folder %s: %w", destDir, err)
@@ -662,15 +813,15 @@ func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescri
 			return fmt.Errorf("write file %s: %w", destPath, err)
 		}
 	}
-	destPath := path.Join(classTempDir, sd.DocIDCounterPath)
+	destPath := tempPath(sd.DocIDCounterPath)
 	if err := os.WriteFile(destPath, sd.DocIDCounter, os.ModePerm); err != nil {
 		return fmt.Errorf("write counter file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.PropLengthTrackerPath)
+	destPath = tempPath(sd.PropLengthTrackerPath)
 	if err := os.WriteFile(destPath, sd.PropLengthTracker, os.ModePerm); err != nil {
 		return fmt.Errorf("write prop file %s: %w", destPath, err)
 	}
-	destPath = path.Join(classTempDir, sd.ShardVersionPath)
+	destPath = tempPath(sd.ShardVersionPath)
 	if err := os.WriteFile(destPath, sd.Version, os.ModePerm); err != nil {
 		return fmt.Errorf("write version file %s: %w", destPath, err)
 	}
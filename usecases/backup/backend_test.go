@@ -71,3 +71,100 @@ func TestCalculateShardPreCompressionSize(t *testing.T) {
 
 	assert.Equal(t, int64(100+200+300), preCompressionSize)
 }
+
+func TestUploaderFilesToUpload(t *testing.T) {
+	tempDir := t.TempDir()
+	files := []string{
+		"s1/lsm/objects/segment-1.db",
+		"s1/lsm/objects/segment-1.bloom",
+		"s1/lsm/objects/segment-2.db",
+		"s1/lsm/objects/segment-3.wal",
+		"s1/indexcount",
+	}
+	refs := make(map[string]backup.FileRef, len(files))
+	for i, file := range files {
+		path := filepath.Join(tempDir, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, make([]byte, 10*(i+1)), 0o644))
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		refs[file] = backup.FileRef{Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+	}
+
+	mockBackend := modulecapabilities.NewMockBackupBackend(t)
+	mockBackend.EXPECT().SourceDataPath().Return(tempDir)
+	u := &uploader{
+		backend: nodeStore{objectStore: objectStore{backend: mockBackend}},
+		log:     logrus.New(),
+	}
+
+	// the segment is held by the base itself
+	segment1 := refs["s1/lsm/objects/segment-1.db"]
+	segment1.Chunk = 1
+	// the bloom filter has been reused by the base from an earlier backup
+	bloom1 := refs["s1/lsm/objects/segment-1.bloom"]
+	bloom1.BackupID, bloom1.Chunk = "first", 4
+	// the segment has been replaced by a compaction
+	segment2 := refs["s1/lsm/objects/segment-2.db"]
+	segment2.Size++
+	base := &backup.ShardDescriptor{
+		Name: "s1",
+		FileRefs: map[string]backup.FileRef{
+			"s1/lsm/objects/segment-1.db":    segment1,
+			"s1/lsm/objects/segment-1.bloom": bloom1,
+			"s1/lsm/objects/segment-2.db":    segment2,
+			"s1/lsm/objects/segment-3.wal":   refs["s1/lsm/objects/segment-3.wal"],
+			"s1/indexcount":                  refs["s1/indexcount"],
+		},
+	}
+
+	t.Run("Full", func(t *testing.T) {
+		shard := &backup.ShardDescriptor{Name: "s1", Files: files}
+		got := u.filesToUpload(shard, nil)
+		assert.Equal(t, files, got.Remaining())
+		assert.Equal(t, refs, shard.FileRefs)
+
+		setChunk(shard, files[:2], 7)
+		assert.Equal(t, int32(7), shard.FileRefs[files[0]].Chunk)
+		assert.Equal(t, int32(7), shard.FileRefs[files[1]].Chunk)
+		assert.Equal(t, int32(0), shard.FileRefs[files[2]].Chunk)
+	})
+
+	t.Run("Incremental", func(t *testing.T) {
+		u.base = &backup.BackupDescriptor{ID: "base"}
+		defer func() { u.base = nil }()
+
+		shard := &backup.ShardDescriptor{Name: "s1", Files: files}
+		got := u.filesToUpload(shard, base)
+		assert.Equal(t, []string{
+			"s1/lsm/objects/segment-2.db",
+			"s1/lsm/objects/segment-3.wal",
+			"s1/indexcount",
+		}, got.Remaining())
+
+		want := map[string]backup.FileRef{
+			"s1/lsm/objects/segment-1.db":    {BackupID: "base", Chunk: 1, Size: segment1.Size, ModTime: segment1.ModTime},
+			"s1/lsm/objects/segment-1.bloom": bloom1,
+			"s1/lsm/objects/segment-2.db":    refs["s1/lsm/objects/segment-2.db"],
+			"s1/lsm/objects/segment-3.wal":   refs["s1/lsm/objects/segment-3.wal"],
+			"s1/indexcount":                  refs["s1/indexcount"],
+		}
+		assert.Equal(t, want, shard.FileRefs)
+	})
+}
+
+func TestIsImmutableSegment(t *testing.T) {
+	for file, want := range map[string]bool{
+		"s1/lsm/objects/segment-1.db":                  true,
+		"s1/lsm/objects/segment-1.bloom":               true,
+		"s1/lsm/objects/segment-1.secondary.0.bloom":   true,
+		"s1/lsm/objects/segment-1.cna":                 true,
+		"s1/lsm/objects/segment-1.wal":                 false,
+		"s1/lsm/objects/segment-1_2.db.tmp":            false,
+		"s1/main.hnsw.commitlog.d/1700000000":          false,
+		"s1/indexcount":                                false,
+		"s1/lsm/property_name_searchable/segment-7.db": true,
+	} {
+		assert.Equal(t, want, isImmutableSegment(file), file)
+	}
+}
@@ -81,6 +81,28 @@ func (b *backupper) OnStatus(ctx context.Context, req *StatusRequest) (reqState,
 	}, nil
 }
 
+// baseDescriptor returns the descriptor of this node in the base of an incremental backup.
+// It returns nil if the node was not part of the base backup, in which case all files are uploaded.
+func (b *backupper) baseDescriptor(ctx context.Context, store nodeStore, req *Request) (*backup.BackupDescriptor, error) {
+	baseStore := store.sibling(req.BaseBackupID)
+	base, err := baseStore.Meta(ctx, req.BaseBackupID, req.Bucket, req.Path, false)
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			b.logger.WithFields(logrus.Fields{
+				"action":      "create_backup",
+				"backup_id":   req.ID,
+				"base_backup": req.BaseBackupID,
+			}).Info("node not part of base backup, all files will be uploaded")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get base backup %q: %w", req.BaseBackupID, err)
+	}
+	if base.Status != string(backup.Success) {
+		return nil, fmt.Errorf("base backup %q has status %q, expected %q", req.BaseBackupID, base.Status, backup.Success)
+	}
+	return base, nil
+}
+
 // backup checks if the node is ready to back up (can commit phase)
 //
 // Moreover it starts a goroutine in the background which waits for the
@@ -128,6 +150,7 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Version:         Version,
 			ServerVersion:   config.ServerVersion,
 			CompressionType: &compressionType,
+			BaseBackupID:    req.BaseBackupID,
 		}
 
 		// the coordinator might want to abort the backup
@@ -135,6 +158,16 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		ctx := b.withCancellation(context.Background(), id, done, b.logger)
 		defer close(done)
 
+		if req.BaseBackupID != "" {
+			base, err := b.baseDescriptor(ctx, store, req)
+			if err != nil {
+				b.logger.WithField("action", "create_backup").Error(err)
+				b.lastAsyncError = err
+				return
+			}
+			provider.withBase(base)
+		}
+
 		logFields := logrus.Fields{"action": "create_backup", "backup_id": req.ID, "override_bucket": req.Bucket, "override_path": req.Path}
 		if err := provider.all(ctx, req.Classes, &result, req.Bucket, req.Path); err != nil {
 			b.logger.WithFields(logFields).Error(err)
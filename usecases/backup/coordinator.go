@@ -187,6 +187,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		ServerVersion:   config.ServerVersion,
 		Leader:          leader,
 		CompressionType: compressionType,
+		BaseBackupID:    req.BaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -427,6 +428,11 @@ func (c *coordinator) restoreClasses(
 		if hasReqClasses && !slices.Contains(req.Classes, cls.Name) {
 			continue
 		}
+		if err := newClassRenaming(cls.Name, req.ClassMapping, req.TenantMapping).apply(&cls, req.MoveAliases); err != nil {
+			c.descriptor.Error = fmt.Sprintf("restore class %q: %v", cls.Name, err)
+			restoreErrors = append(restoreErrors, fmt.Sprintf("%q: %v", cls.Name, err))
+			continue
+		}
 		if err := c.schema.RestoreClass(ctx, &cls, req.NodeMapping, req.RestoreOverwriteAlias); err != nil {
 			c.descriptor.Error = fmt.Sprintf("restore class %q: %v", cls.Name, err)
 			restoreErrors = append(restoreErrors, fmt.Sprintf("%q: %v", cls.Name, err))
@@ -509,9 +515,12 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 				Classes:           gr.Classes,
 				Duration:          _BookingPeriod,
 				NodeMapping:       c.descriptor.NodeMapping,
+				ClassMapping:      req.ClassMapping,
+				TenantMapping:     req.TenantMapping,
 				Compression:       req.Compression,
 				Bucket:            req.Bucket,
 				Path:              req.Path,
+				BaseBackupID:      req.BaseBackupID,
 				UserRestoreOption: req.UserRestoreOption,
 				RbacRestoreOption: req.RbacRestoreOption,
 			}
@@ -72,6 +72,11 @@ func (s *fakeSourcer) BackupDescriptors(ctx context.Context, bakid string, class
 	return args.Get(0).(<-chan backup.ClassDescriptor)
 }
 
+func (s *fakeSourcer) RenameClass(ctx context.Context, indexDir, class string) error {
+	args := s.Called(ctx, indexDir, class)
+	return args.Error(0)
+}
+
 type fakeBackend struct {
 	mock.Mock
 	sync.RWMutex
@@ -97,10 +102,10 @@ func (fb *fakeBackend) HomeDir(backupID, overrideBucket, overridePath string) st
 	return args.String(0)
 }
 
-func (fb *fakeBackend) AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (fb *fakeBackend) AllBackups(ctx context.Context, overrideBucket, overridePath string) ([]*backup.DistributedBackupDescriptor, error) {
 	fb.RLock()
 	defer fb.RUnlock()
-	args := fb.Called(ctx)
+	args := fb.Called(ctx, overrideBucket, overridePath)
 	if args.Get(0) != nil {
 		return args.Get(0).([]*backup.DistributedBackupDescriptor), args.Error(1)
 	}
@@ -201,6 +206,13 @@ func (fb *fakeBackend) Read(ctx context.Context, backupID, key, overrideBucket,
 	return 0, args.Error(1)
 }
 
+func (fb *fakeBackend) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r backup.ReadCloserWithError) (int64, error) {
 	fb.Lock()
 	defer fb.Unlock()
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
 
 	"github.com/weaviate/weaviate/cluster/fsm"
@@ -117,6 +118,27 @@ type Compression struct {
 	CPUPercentage int
 }
 
+// ParseCompressionLevel maps the compression level of the REST API to a CompressionLevel,
+// the level defaults to GzipDefaultCompression
+func ParseCompressionLevel(l string) CompressionLevel {
+	switch l {
+	case models.BackupConfigCompressionLevelBestSpeed:
+		return GzipBestSpeed
+	case models.BackupConfigCompressionLevelBestCompression:
+		return GzipBestCompression
+	case models.BackupConfigCompressionLevelZstdBestSpeed:
+		return ZstdBestSpeed
+	case models.BackupConfigCompressionLevelZstdDefaultCompression:
+		return ZstdDefaultCompression
+	case models.BackupConfigCompressionLevelZstdBestCompression:
+		return ZstdBestCompression
+	case models.BackupConfigCompressionLevelNoCompression:
+		return NoCompression
+	default:
+		return GzipDefaultCompression
+	}
+}
+
 // BackupRequest a transition request from API to Backend.
 type BackupRequest struct {
 	// Compression is the compression configuration.
@@ -138,12 +160,29 @@ type BackupRequest struct {
 	// No effect if the map is empty
 	NodeMapping map[string]string
 
+	// ClassMapping is a map of class name replacement where key is the class name in the backup
+	// and value is the name under which the class is restored. No effect if the map is empty
+	ClassMapping map[string]string
+
+	// TenantMapping is a map of tenant name replacement where key is the tenant name in the backup
+	// and value is the name under which the tenant is restored. If the map is not empty, only a single
+	// class may be restored and only the tenants it contains are restored
+	TenantMapping map[string]string
+
+	// MoveAliases restores the aliases of a class restored under a new name with ClassMapping.
+	// They are skipped otherwise, as they would be moved away from the existing class
+	MoveAliases bool
+
 	// Override bucket (optional) - replaces environement variable for one call
 	Bucket string
 
 	// Override path (optional) - replaces environement variable for one call
 	Path string
 
+	// BaseBackupID (optional) makes the backup incremental: immutable LSM segments
+	// which are unchanged since the base backup are referenced instead of uploaded
+	BaseBackupID string
+
 	RbacRestoreOption string
 	UserRestoreOption string
 }
@@ -26,10 +26,13 @@ type fakeSchemaManger struct {
 	nodeName        string
 	// Track NodeMapping passed to RestoreClass for testing
 	lastNodeMapping map[string]string
+	// Track names of the classes passed to RestoreClass for testing
+	restoredClasses []string
 }
 
 func (f *fakeSchemaManger) RestoreClass(ctx context.Context, desc *backup.ClassDescriptor, nodeMapping map[string]string, overwriteAlias bool) error {
 	f.lastNodeMapping = nodeMapping
+	f.restoredClasses = append(f.restoredClasses, desc.Name)
 	return f.errRestoreClass
 }
 
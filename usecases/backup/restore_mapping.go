@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// classRenaming restores a class of a backup under a new name. If tenants is
+// not empty, only the tenants it contains are restored, each one under the
+// tenant name it is mapped to.
+//
+// A nil *classRenaming restores the class as it is.
+type classRenaming struct {
+	from    string
+	to      string
+	tenants map[string]string
+}
+
+// newClassRenaming returns how class is renamed by classMapping and
+// tenantMapping, or nil if class is restored under its original name
+func newClassRenaming(class string, classMapping, tenantMapping map[string]string) *classRenaming {
+	to, ok := classMapping[class]
+	if !ok {
+		to = class
+	}
+	if to == class && len(tenantMapping) == 0 {
+		return nil
+	}
+	return &classRenaming{from: class, to: to, tenants: tenantMapping}
+}
+
+// class returns the name under which class is restored
+func (r *classRenaming) class(class string) string {
+	if r == nil {
+		return class
+	}
+	return r.to
+}
+
+// renamesClass reports whether the class is restored under a new name
+func (r *classRenaming) renamesClass() bool {
+	return r != nil && r.to != r.from
+}
+
+// keeps reports whether shard is restored
+func (r *classRenaming) keeps(shard string) bool {
+	if r == nil || len(r.tenants) == 0 {
+		return true
+	}
+	_, ok := r.tenants[shard]
+	return ok
+}
+
+// path rewrites the path of a file relative to the data directory,
+// which starts with the index directory followed by the shard directory.
+// It returns false if the file belongs to a shard which is not restored.
+func (r *classRenaming) path(p string) (string, bool) {
+	if r == nil {
+		return p, true
+	}
+	parts := strings.SplitN(p, "/", 3)
+	if parts[0] != strings.ToLower(r.from) {
+		return p, true
+	}
+	parts[0] = strings.ToLower(r.to)
+	if len(parts) > 1 && len(r.tenants) > 0 {
+		tenant, ok := r.tenants[parts[1]]
+		if !ok {
+			return "", false
+		}
+		parts[1] = tenant
+	}
+	return strings.Join(parts, "/"), true
+}
+
+// apply rewrites the schema, sharding state, aliases and shards of d
+// so that d is restored under its new class and tenant names.
+// Aliases of a renamed class are dropped unless moveAliases is set.
+func (r *classRenaming) apply(d *backup.ClassDescriptor, moveAliases bool) error {
+	if r == nil {
+		return nil
+	}
+
+	class := &models.Class{}
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	if len(r.tenants) > 0 && !schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("tenant mapping requires a multi-tenant collection, but %s is not", r.from)
+	}
+	class.Class = r.to
+	schemaJSON, err := json.Marshal(class)
+	if err != nil {
+		return fmt.Errorf("marshal class schema: %w", err)
+	}
+
+	var shardingStateJSON []byte
+	if d.ShardingState != nil {
+		var ss sharding.State
+		if err := json.Unmarshal(d.ShardingState, &ss); err != nil {
+			return fmt.Errorf("unmarshal sharding state: %w", err)
+		}
+		ss.IndexID = r.to
+		if len(r.tenants) > 0 {
+			physical := make(map[string]sharding.Physical, len(r.tenants))
+			for from, to := range r.tenants {
+				shard, ok := ss.Physical[from]
+				if !ok {
+					return fmt.Errorf("tenant %s doesn't exist in collection %s of the backup", from, r.from)
+				}
+				shard.Name = to
+				physical[to] = shard
+			}
+			ss.Physical = physical
+		}
+		if shardingStateJSON, err = json.Marshal(&ss); err != nil {
+			return fmt.Errorf("marshal sharding state: %w", err)
+		}
+	}
+
+	// aliases of a renamed class still point to the existing class of the
+	// old name, restoring them would move them to the copy
+	aliasesJSON, aliasesIncluded := d.Aliases, d.AliasesIncluded
+	if r.renamesClass() && !moveAliases {
+		aliasesJSON, aliasesIncluded = nil, false
+	} else if d.AliasesIncluded {
+		aliases := make([]*models.Alias, 0)
+		if err := json.Unmarshal(d.Aliases, &aliases); err != nil {
+			return fmt.Errorf("unmarshal aliases: %w", err)
+		}
+		for _, alias := range aliases {
+			alias.Class = r.to
+		}
+		if aliasesJSON, err = json.Marshal(aliases); err != nil {
+			return fmt.Errorf("marshal aliases: %w", err)
+		}
+	}
+
+	shards := make([]*backup.ShardDescriptor, 0, len(d.Shards))
+	for _, shard := range d.Shards {
+		if !r.keeps(shard.Name) {
+			continue
+		}
+		renamed := *shard
+		if to, ok := r.tenants[shard.Name]; ok {
+			renamed.Name = to
+		}
+		shards = append(shards, &renamed)
+	}
+
+	d.Name = r.to
+	d.Schema = schemaJSON
+	d.ShardingState = shardingStateJSON
+	d.Aliases = aliasesJSON
+	d.AliasesIncluded = aliasesIncluded
+	d.Shards = shards
+	return nil
+}
+
+// validateRestoreMapping checks that classMapping renames restored classes
+// to new and distinct class names, and that tenantMapping is only used when
+// a single class is restored. It returns classMapping with normalized names.
+func validateRestoreMapping(classes, existing []string,
+	classMapping, tenantMapping map[string]string,
+) (map[string]string, error) {
+	mapping := make(map[string]string, len(classMapping))
+	targets := make(map[string]string, len(classes))
+	for from, to := range classMapping {
+		from = schema.UppercaseClassName(from)
+		if !slices.Contains(classes, from) {
+			return nil, fmt.Errorf("class mapping: class %s is not restored from the backup", from)
+		}
+		name, err := schema.ValidateClassName(schema.UppercaseClassName(to))
+		if err != nil {
+			return nil, fmt.Errorf("class mapping: %w", err)
+		}
+		mapping[from] = string(name)
+	}
+	for _, cls := range classes {
+		to := cls
+		if name, ok := mapping[cls]; ok {
+			to = name
+		}
+		if other, ok := targets[strings.ToLower(to)]; ok {
+			return nil, fmt.Errorf("class mapping: classes %s and %s would both be restored as %s", other, cls, to)
+		}
+		targets[strings.ToLower(to)] = cls
+		if to != cls && slices.ContainsFunc(existing, func(c string) bool { return strings.EqualFold(c, to) }) {
+			return nil, fmt.Errorf("class mapping: class %s already exists", to)
+		}
+	}
+
+	if len(tenantMapping) == 0 {
+		return mapping, nil
+	}
+	if len(classes) != 1 {
+		return nil, fmt.Errorf("tenant mapping requires restoring a single class, got %v", classes)
+	}
+	tenants := make(map[string]struct{}, len(tenantMapping))
+	for from, to := range tenantMapping {
+		if err := schema.ValidateTenantName(from); err != nil {
+			return nil, fmt.Errorf("tenant mapping: %w", err)
+		}
+		if err := schema.ValidateTenantName(to); err != nil {
+			return nil, fmt.Errorf("tenant mapping: %w", err)
+		}
+		if _, ok := tenants[to]; ok {
+			return nil, fmt.Errorf("tenant mapping: several tenants would be restored as %s", to)
+		}
+		tenants[to] = struct{}{}
+	}
+	return mapping, nil
+}
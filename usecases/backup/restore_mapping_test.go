@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestClassRenaming(t *testing.T) {
+	t.Run("NoMapping", func(t *testing.T) {
+		r := newClassRenaming("Article", map[string]string{"Other": "OtherCopy"}, nil)
+		assert.Nil(t, r)
+		assert.Equal(t, "Article", r.class("Article"))
+		assert.True(t, r.keeps("shard1"))
+		p, ok := r.path("article/shard1/indexcount")
+		assert.True(t, ok)
+		assert.Equal(t, "article/shard1/indexcount", p)
+		assert.NoError(t, r.apply(&backup.ClassDescriptor{Name: "Article"}, false))
+	})
+
+	t.Run("Path", func(t *testing.T) {
+		r := newClassRenaming("Article", map[string]string{"Article": "ArticleCopy"}, nil)
+		p, ok := r.path("article/shard1/lsm/objects/segment-1.db")
+		assert.True(t, ok)
+		assert.Equal(t, "articlecopy/shard1/lsm/objects/segment-1.db", p)
+
+		r = newClassRenaming("Article", nil, map[string]string{"t1": "t1-copy"})
+		assert.Equal(t, "Article", r.class("Article"))
+		p, ok = r.path("article/t1/indexcount")
+		assert.True(t, ok)
+		assert.Equal(t, "article/t1-copy/indexcount", p)
+		_, ok = r.path("article/t2/indexcount")
+		assert.False(t, ok)
+		assert.True(t, r.keeps("t1"))
+		assert.False(t, r.keeps("t2"))
+	})
+
+	newDescriptor := func(t *testing.T, mt bool, shards ...string) backup.ClassDescriptor {
+		class := &models.Class{Class: "Article", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: mt}}
+		ss := sharding.State{IndexID: "Article", Physical: map[string]sharding.Physical{}, PartitioningEnabled: mt}
+		d := backup.ClassDescriptor{Name: "Article", AliasesIncluded: true}
+		for _, shard := range shards {
+			ss.Physical[shard] = sharding.Physical{Name: shard, BelongsToNodes: []string{"node1"}}
+			d.Shards = append(d.Shards, &backup.ShardDescriptor{Name: shard, Node: "node1"})
+		}
+		var err error
+		d.Schema, err = json.Marshal(class)
+		require.NoError(t, err)
+		d.ShardingState, err = json.Marshal(&ss)
+		require.NoError(t, err)
+		d.Aliases, err = json.Marshal([]*models.Alias{{Alias: "Latest", Class: "Article"}})
+		require.NoError(t, err)
+		return d
+	}
+
+	t.Run("ApplyClassMapping", func(t *testing.T) {
+		d := newDescriptor(t, false, "shard1")
+		r := newClassRenaming("Article", map[string]string{"Article": "ArticleCopy"}, nil)
+		require.NoError(t, r.apply(&d, false))
+
+		assert.Equal(t, "ArticleCopy", d.Name)
+		var class models.Class
+		require.NoError(t, json.Unmarshal(d.Schema, &class))
+		assert.Equal(t, "ArticleCopy", class.Class)
+		var ss sharding.State
+		require.NoError(t, json.Unmarshal(d.ShardingState, &ss))
+		assert.Equal(t, "ArticleCopy", ss.IndexID)
+		assert.Contains(t, ss.Physical, "shard1")
+		assert.False(t, d.AliasesIncluded, "aliases of the existing class must not be moved")
+		assert.Nil(t, d.Aliases)
+		require.Len(t, d.Shards, 1)
+		assert.Equal(t, "shard1", d.Shards[0].Name)
+	})
+
+	t.Run("ApplyClassMappingMovingAliases", func(t *testing.T) {
+		d := newDescriptor(t, false, "shard1")
+		r := newClassRenaming("Article", map[string]string{"Article": "ArticleCopy"}, nil)
+		require.NoError(t, r.apply(&d, true))
+
+		assert.True(t, d.AliasesIncluded)
+		var aliases []*models.Alias
+		require.NoError(t, json.Unmarshal(d.Aliases, &aliases))
+		assert.Equal(t, []*models.Alias{{Alias: "Latest", Class: "ArticleCopy"}}, aliases)
+	})
+
+	t.Run("ApplyTenantMapping", func(t *testing.T) {
+		d := newDescriptor(t, true, "t1", "t2")
+		original := d.Shards[0]
+		r := newClassRenaming("Article", nil, map[string]string{"t2": "t2-copy"})
+		require.NoError(t, r.apply(&d, false))
+
+		assert.Equal(t, "Article", d.Name)
+		var ss sharding.State
+		require.NoError(t, json.Unmarshal(d.ShardingState, &ss))
+		assert.Equal(t, map[string]sharding.Physical{
+			"t2-copy": {Name: "t2-copy", BelongsToNodes: []string{"node1"}},
+		}, ss.Physical)
+		require.Len(t, d.Shards, 1)
+		assert.Equal(t, "t2-copy", d.Shards[0].Name)
+		assert.Equal(t, "t1", original.Name, "shards of the backup descriptor must not be modified")
+	})
+
+	t.Run("UnknownTenant", func(t *testing.T) {
+		d := newDescriptor(t, true, "t1")
+		r := newClassRenaming("Article", nil, map[string]string{"t3": "t3-copy"})
+		assert.ErrorContains(t, r.apply(&d, false), "tenant t3 doesn't exist")
+	})
+
+	t.Run("TenantMappingWithoutMultiTenancy", func(t *testing.T) {
+		d := newDescriptor(t, false, "shard1")
+		r := newClassRenaming("Article", nil, map[string]string{"shard1": "copy"})
+		assert.ErrorContains(t, r.apply(&d, false), "requires a multi-tenant collection")
+	})
+}
+
+func TestValidateRestoreMapping(t *testing.T) {
+	classes := []string{"Article", "Author"}
+	existing := []string{"Article", "Author", "Book"}
+
+	t.Run("Valid", func(t *testing.T) {
+		mapping, err := validateRestoreMapping(classes, existing,
+			map[string]string{"article": "articleCopy", "Author": "Author"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"Article": "ArticleCopy", "Author": "Author"}, mapping)
+	})
+
+	for _, tc := range []struct {
+		name          string
+		classMapping  map[string]string
+		tenantMapping map[string]string
+		err           string
+	}{
+		{
+			name:         "ClassNotRestored",
+			classMapping: map[string]string{"Book": "BookCopy"},
+			err:          "class Book is not restored",
+		},
+		{
+			name:         "InvalidTarget",
+			classMapping: map[string]string{"Article": "Article Copy"},
+			err:          "not a valid class name",
+		},
+		{
+			name:         "TargetExists",
+			classMapping: map[string]string{"Article": "Book"},
+			err:          "class Book already exists",
+		},
+		{
+			name:         "TargetCollides",
+			classMapping: map[string]string{"Article": "Copy", "Author": "Copy"},
+			err:          "would both be restored as Copy",
+		},
+		{
+			name:          "TenantMappingWithSeveralClasses",
+			tenantMapping: map[string]string{"t1": "t2"},
+			err:           "requires restoring a single class",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validateRestoreMapping(classes, existing, tc.classMapping, tc.tenantMapping)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	t.Run("TenantMapping", func(t *testing.T) {
+		_, err := validateRestoreMapping([]string{"Article"}, existing, nil, map[string]string{"t1": "t1-copy"})
+		assert.NoError(t, err)
+
+		_, err = validateRestoreMapping([]string{"Article"}, existing, nil, map[string]string{"t1": "copy", "t2": "copy"})
+		assert.ErrorContains(t, err, "several tenants would be restored as copy")
+
+		_, err = validateRestoreMapping([]string{"Article"}, existing, nil, map[string]string{"t1": "not valid"})
+		assert.ErrorContains(t, err, "not a valid tenant name")
+	})
+}
@@ -131,7 +131,8 @@ func (r *restorer) restore(
 		overrideBucket := req.Bucket
 		overridePath := req.Path
 
-		err = r.restoreAll(ctx, desc, req.CPUPercentage, store, overrideBucket, overridePath, req.RbacRestoreOption, req.UserRestoreOption)
+		err = r.restoreAll(ctx, desc, req.CPUPercentage, store, overrideBucket, overridePath,
+			req.RbacRestoreOption, req.UserRestoreOption, req.ClassMapping, req.TenantMapping)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -149,6 +150,7 @@ func (r *restorer) restore(
 func (r *restorer) restoreAll(ctx context.Context,
 	desc *backup.BackupDescriptor, cpuPercentage int,
 	store nodeStore, overrideBucket, overridePath, rbacRestoreOption, usersRestoreOption string,
+	classMapping, tenantMapping map[string]string,
 ) error {
 	compressionType := desc.GetCompressionType()
 	compressed := desc.Version > version1
@@ -182,13 +184,19 @@ func (r *restorer) restoreAll(ctx context.Context,
 		}
 	}
 
+	bases, err := r.resolveBases(ctx, desc, store, overrideBucket, overridePath)
+	if err != nil {
+		return err
+	}
+
 	for _, cdesc := range desc.Classes {
 		// Check for cancellation before each class restore
 		if err := ctx.Err(); err != nil {
 			r.lastOp.set(backup.Cancelled)
 			return fmt.Errorf("restore cancelled: %w", err)
 		}
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressionType, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
+		renaming := newClassRenaming(cdesc.Name, classMapping, tenantMapping)
+		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressionType, compressed, cpuPercentage, store, bases, renaming, overrideBucket, overridePath); err != nil {
 			if errors.Is(err, context.Canceled) {
 				r.lastOp.set(backup.Cancelled)
 				return fmt.Errorf("restore cancelled: %w", err)
@@ -197,12 +205,38 @@ func (r *restorer) restoreAll(ctx context.Context,
 		}
 		r.logger.WithField("action", "restore").
 			WithField("backup_id", desc.ID).
-			WithField("class", cdesc.Name).Info("successfully restored")
+			WithField("class", cdesc.Name).
+			WithField("restored_as", renaming.class(cdesc.Name)).Info("successfully restored")
 	}
 
 	return nil
 }
 
+// resolveBases returns the compression type of every earlier backup holding
+// files reused by the incremental backup desc
+func (r *restorer) resolveBases(ctx context.Context, desc *backup.BackupDescriptor,
+	store nodeStore, overrideBucket, overridePath string,
+) (map[string]backup.CompressionType, error) {
+	var bases map[string]backup.CompressionType
+	for _, cdesc := range desc.Classes {
+		for ref := range cdesc.ReusedFiles() {
+			if _, ok := bases[ref.BackupID]; ok {
+				continue
+			}
+			baseStore := store.sibling(ref.BackupID)
+			meta, err := baseStore.Meta(ctx, ref.BackupID, overrideBucket, overridePath, false)
+			if err != nil {
+				return nil, fmt.Errorf("resolve base backup %q: %w", ref.BackupID, err)
+			}
+			if bases == nil {
+				bases = make(map[string]backup.CompressionType)
+			}
+			bases[ref.BackupID] = meta.GetCompressionType()
+		}
+	}
+	return bases, nil
+}
+
 func getType(myvar interface{}) string {
 	if t := reflect.TypeOf(myvar); t.Kind() == reflect.Ptr {
 		return "*" + t.Elem().Name()
@@ -214,7 +248,7 @@ func getType(myvar interface{}) string {
 func (r *restorer) restoreOne(ctx context.Context,
 	desc *backup.ClassDescriptor, serverVersion string, compressionType backup.CompressionType,
 	compressed bool, cpuPercentage int, store nodeStore,
-	overrideBucket, overridePath string,
+	bases map[string]backup.CompressionType, renaming *classRenaming, overrideBucket, overridePath string,
 ) (err error) {
 	classLabel := desc.Name
 	if monitoring.GetMetrics().Group {
@@ -228,9 +262,14 @@ func (r *restorer) restoreOne(ctx context.Context,
 
 	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
 		WithPoolPercentage(cpuPercentage)
+	fw.setBases(bases)
+	fw.setRenaming(renaming)
 
 	// Pre-v1.23 versions store files in a flat format
 	if serverVersion < "1.23" {
+		if renaming != nil {
+			return fmt.Errorf("restoring under a new class or tenant name requires a backup created with v1.23 or later, got %s", serverVersion)
+		}
 		f, err := hfsMigrator(desc, r.node, serverVersion)
 		if err != nil {
 			return fmt.Errorf("migrate to pre 1.23: %w", err)
@@ -52,6 +52,7 @@ type Scheduler struct {
 	backupper  *coordinator
 	restorer   *coordinator
 	backends   BackupBackendProvider
+	schedules  ScheduleStore
 }
 
 // NewScheduler creates a new scheduler with two coordinators
@@ -84,7 +85,7 @@ func NewScheduler(
 
 func (s *Scheduler) CleanupUnfinishedBackups(ctx context.Context) {
 	for _, backend := range s.backends.EnabledBackupBackends() {
-		backups, err := backend.AllBackups(ctx)
+		backups, err := backend.AllBackups(ctx, "", "")
 		if err != nil {
 			s.logger.
 				WithField("action", "cleanup_unfinished_backups").
@@ -126,6 +127,15 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		logOperation(s.logger, "try_backup", req.ID, req.Backend, begin, err)
 	}(time.Now())
 
+	return s.backup(ctx, req, func(classes []string) error {
+		return s.authorizer.Authorize(ctx, pr, authorization.CREATE, authorization.Backups(classes...)...)
+	})
+}
+
+// backup starts the backup once authorize accepted the classes it resolved to
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest, authorize func(classes []string) error,
+) (*models.BackupCreateResponse, error) {
+
 	store, err := coordBackend(s.backends, req.Backend, req.ID, req.Bucket, req.Path)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -137,7 +147,7 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
-	if err := s.authorizer.Authorize(ctx, pr, authorization.CREATE, authorization.Backups(classes...)...); err != nil {
+	if err := authorize(classes); err != nil {
 		return nil, err
 	}
 
@@ -145,13 +155,14 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:      OpCreate,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Classes:     classes,
-		Compression: req.Compression,
-		Bucket:      req.Bucket,
-		Path:        req.Path,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		Compression:  req.Compression,
+		Bucket:       req.Bucket,
+		Path:         req.Path,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -191,7 +202,15 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
-	if err := s.authorizer.Authorize(ctx, pr, authorization.CREATE, authorization.Backups(meta.Classes()...)...); err != nil {
+	// restoring a class under a new name requires the same permissions on the new name
+	restored := meta.Classes()
+	classes := slices.Clone(restored)
+	for _, cls := range restored {
+		if to, ok := req.ClassMapping[cls]; ok && to != cls {
+			classes = append(classes, to)
+		}
+	}
+	if err := s.authorizer.Authorize(ctx, pr, authorization.CREATE, authorization.Backups(classes...)...); err != nil {
 		return nil, err
 	}
 
@@ -199,6 +218,15 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	if err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	for _, cls := range schema {
+		if !slices.Contains(restored, cls.Name) {
+			continue
+		}
+		// cls is a copy, renaming it only checks that the mapping applies
+		if err := newClassRenaming(cls.Name, req.ClassMapping, req.TenantMapping).apply(&cls, req.MoveAliases); err != nil {
+			return nil, backup.NewErrUnprocessable(fmt.Errorf("restore class %s: %w", cls.Name, err))
+		}
+	}
 	status := string(backup.Started)
 	data := &models.BackupRestoreResponse{
 		Backend: req.Backend,
@@ -210,6 +238,9 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	rReq := Request{
 		Method:                OpRestore,
 		NodeMapping:           req.NodeMapping,
+		ClassMapping:          req.ClassMapping,
+		TenantMapping:         req.TenantMapping,
+		MoveAliases:           req.MoveAliases,
 		ID:                    req.ID,
 		Backend:               req.Backend,
 		Compression:           req.Compression,
@@ -429,7 +460,7 @@ func (s *Scheduler) List(ctx context.Context, principal *models.Principal, backe
 		return nil, err
 	}
 
-	backups, err := backupBackend.AllBackups(ctx)
+	backups, err := backupBackend.AllBackups(ctx, "", "")
 	if err != nil {
 		return nil, err
 	}
@@ -520,9 +551,38 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if err := s.checkIfBackupExists(ctx, store, req); err != nil {
 		return nil, err
 	}
+	if err := s.checkBaseBackup(ctx, req); err != nil {
+		return nil, err
+	}
 	return classes, nil
 }
 
+// checkBaseBackup makes sure the base of an incremental backup completed
+// successfully and lives on the same backend, bucket and path
+func (s *Scheduler) checkBaseBackup(ctx context.Context, req *BackupRequest) error {
+	if req.BaseBackupID == "" {
+		return nil
+	}
+	if err := validateID(req.BaseBackupID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	if req.BaseBackupID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own base", req.ID)
+	}
+	store, err := coordBackend(s.backends, req.Backend, req.BaseBackupID, req.Bucket, req.Path)
+	if err != nil {
+		return err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+	if err != nil {
+		return fmt.Errorf("find base backup %q at %q: %w", req.BaseBackupID, store.HomeDir(req.Bucket, req.Path), err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("base backup %q has status %q, expected %q", req.BaseBackupID, meta.Status, backup.Success)
+	}
+	return nil
+}
+
 func (s *Scheduler) checkIfBackupExists(ctx context.Context, store coordStore, req *BackupRequest) error {
 	destPath := store.HomeDir(req.Bucket, req.Path)
 	// there is no backup with given id on the backend, regardless of its state (valid or corrupted)
@@ -593,6 +653,17 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 		meta.NodeMapping = req.NodeMapping
 		meta.ApplyNodeMapping()
 	}
+	if len(req.ClassMapping) > 0 || len(req.TenantMapping) > 0 {
+		if meta.ServerVersion < "1.23" {
+			return nil, fmt.Errorf("restoring under a new class or tenant name requires a backup created with v1.23 or later, got %s", meta.ServerVersion)
+		}
+		mapping, err := validateRestoreMapping(meta.Classes(), s.restorer.selector.ListClasses(ctx),
+			req.ClassMapping, req.TenantMapping)
+		if err != nil {
+			return nil, err
+		}
+		req.ClassMapping = mapping
+	}
 	return meta, nil
 }
 
@@ -143,6 +143,66 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("BaseBackupIsSelf", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("ListClasses", ctx).Return([]string{cls})
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: id,
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, "cannot be its own base")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseBackupNotFound", func(t *testing.T) {
+		baseID := "base"
+		fs := newFakeScheduler(nil)
+		fs.selector.On("ListClasses", ctx).Return([]string{cls})
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, baseID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, baseID, BackupFile).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: baseID,
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, fmt.Sprintf("find base backup %q", baseID))
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseBackupFailed", func(t *testing.T) {
+		baseID := "base"
+		fs := newFakeScheduler(nil)
+		fs.selector.On("ListClasses", ctx).Return([]string{cls})
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: baseID, Status: backup.Failed})
+		fs.backend.On("GetObject", ctx, baseID, GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: baseID,
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, fmt.Sprintf("base backup %q has status", baseID))
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -699,6 +759,88 @@ func TestSchedulerRestoration(t *testing.T) {
 		assert.Equal(t, nodeMapping, fs.schema.lastNodeMapping)
 		fs.client.AssertExpectations(t)
 	})
+
+	t.Run("ClassMappingPassedCorrectly", func(t *testing.T) {
+		const target = "MyClassCopy"
+		meta := meta
+		meta.ServerVersion = "1.30"
+
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeA, nodeB}))
+		bytes := marshalCoordinatorMeta(meta)
+		fs.selector.On("ListClasses", ctx).Return([]string{cls})
+		fs.backend.On("Initialize", ctx, mock.Anything).Return(nil)
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("GetObject", ctx, backupID, GlobalRestoreFile).Return(bytes, nil)
+		fs.backend.On("GetObject", ctx, keyNodeA, BackupFile).Return(metaBytes1, nil)
+		fs.backend.On("GetObject", ctx, keyNodeB, BackupFile).Return(metaBytes2, nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		fs.backend.On("PutObject", mock.Anything, mock.Anything, GlobalRestoreFile, mock.AnythingOfType("[]uint8")).Return(nil).Times(3)
+
+		// participants receive the normalized class mapping
+		hasMapping := mock.MatchedBy(func(r *Request) bool {
+			return r.Method == OpRestore && len(r.ClassMapping) == 1 && r.ClassMapping[cls] == target
+		})
+		fs.client.On("CanCommit", any, nodeA, hasMapping).Return(cResp, nil)
+		fs.client.On("CanCommit", any, nodeB, hasMapping).Return(cResp, nil)
+		fs.client.On("Commit", any, nodeA, sReq).Return(nil)
+		fs.client.On("Commit", any, nodeB, sReq).Return(nil)
+		fs.client.On("Status", any, nodeA, sReq).Return(sresp, nil)
+		fs.client.On("Status", any, nodeB, sReq).Return(sresp, nil)
+
+		s := fs.scheduler()
+		req := BackupRequest{
+			ID:           backupID,
+			Include:      []string{cls},
+			Backend:      backendName,
+			ClassMapping: map[string]string{cls: "myClassCopy"},
+		}
+		_, err := s.Restore(ctx, nil, &req, false)
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Millisecond * 60)
+			if i > 0 && s.restorer.lastOp.get().Status == "" {
+				break
+			}
+		}
+
+		assert.Equal(t, backup.Success, fs.backend.glMeta.Status)
+		assert.Equal(t, []string{target}, fs.schema.restoredClasses)
+		fs.client.AssertExpectations(t)
+	})
+
+	t.Run("ClassMappingTargetExists", func(t *testing.T) {
+		meta := meta
+		meta.ServerVersion = "1.30"
+
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeA, nodeB}))
+		fs.selector.On("ListClasses", ctx).Return([]string{cls, "Existing"})
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(marshalCoordinatorMeta(meta), nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID:           backupID,
+			Include:      []string{cls},
+			Backend:      backendName,
+			ClassMapping: map[string]string{cls: "Existing"},
+		}, false)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "class Existing already exists")
+	})
+
+	t.Run("ClassMappingOfOldBackup", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeA, nodeB}))
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(marshalCoordinatorMeta(meta), nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID:           backupID,
+			Include:      []string{cls},
+			Backend:      backendName,
+			ClassMapping: map[string]string{cls: "MyClassCopy"},
+		}, false)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "requires a backup created with v1.23 or later")
+	})
 }
 
 func TestSchedulerRestoreRequestValidation(t *testing.T) {
@@ -886,7 +1028,7 @@ func TestSchedulerList(t *testing.T) {
 
 	t.Run("AllBackupsFails", func(t *testing.T) {
 		fs := newFakeScheduler(nil)
-		fs.backend.On("AllBackups", mock.Anything).Return(nil, ErrAny)
+		fs.backend.On("AllBackups", mock.Anything, "", "").Return(nil, ErrAny)
 		_, err := fs.scheduler().List(ctx, nil, backendName, defaultListOrdering)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrAny, err)
@@ -912,7 +1054,7 @@ func TestSchedulerList(t *testing.T) {
 				PreCompressionSizeBytes: 2147483648, // 2 GB
 			},
 		}
-		fs.backend.On("AllBackups", mock.Anything).Return(backups, nil)
+		fs.backend.On("AllBackups", mock.Anything, "", "").Return(backups, nil)
 
 		resp, err := fs.scheduler().List(ctx, nil, backendName, defaultListOrdering)
 		assert.Nil(t, err)
@@ -934,7 +1076,7 @@ func TestSchedulerList(t *testing.T) {
 
 	t.Run("EmptyList", func(t *testing.T) {
 		fs := newFakeScheduler(nil)
-		fs.backend.On("AllBackups", mock.Anything).Return([]*backup.DistributedBackupDescriptor{}, nil)
+		fs.backend.On("AllBackups", mock.Anything, "", "").Return([]*backup.DistributedBackupDescriptor{}, nil)
 
 		resp, err := fs.scheduler().List(ctx, nil, backendName, defaultListOrdering)
 		assert.Nil(t, err)
@@ -978,7 +1120,7 @@ func TestSchedulerList(t *testing.T) {
 				StartedAt:               timestamp.Add(-2 * time.Minute),
 			},
 		}
-		fs.backend.On("AllBackups", mock.Anything).Return(backups, nil)
+		fs.backend.On("AllBackups", mock.Anything, "", "").Return(backups, nil)
 
 		t.Run("return results sorted by default (desc)", func(t *testing.T) {
 			resp, err := fs.scheduler().List(ctx, nil, backendName, defaultListOrdering)
@@ -0,0 +1,284 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+var errSchedulesDisabled = errors.New("backup schedules are not available")
+
+// ScheduleStore persists the backup schedules and their run state, it is
+// implemented by the raft store
+type ScheduleStore interface {
+	IsLeader() bool
+	PutBackupSchedule(ctx context.Context, schedule backup.Schedule) error
+	DeleteBackupSchedule(ctx context.Context, id string) error
+	RecordBackupScheduleRun(ctx context.Context, id, backupID string, runAt time.Time, runErr string) error
+	RemoveBackupScheduleBackups(ctx context.Context, id string, backupIDs []string) error
+	BackupSchedules(ctx context.Context) ([]backup.Schedule, error)
+}
+
+// SetScheduleStore enables backup schedules
+func (s *Scheduler) SetScheduleStore(store ScheduleStore) {
+	s.schedules = store
+}
+
+// PutSchedule creates or replaces a backup schedule
+func (s *Scheduler) PutSchedule(ctx context.Context, pr *models.Principal, sched backup.Schedule) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "put_backup_schedule", sched.ID, sched.Backend, begin, err)
+	}(time.Now())
+
+	if err := s.authorizer.Authorize(ctx, pr, authorization.CREATE, authorization.Backups(sched.Include...)...); err != nil {
+		return err
+	}
+	if s.schedules == nil {
+		return backup.NewErrUnprocessable(errSchedulesDisabled)
+	}
+	if err := sched.Validate(); err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	if dup := findDuplicate(sched.Include); dup != "" {
+		return backup.NewErrUnprocessable(fmt.Errorf("class list 'include' contains duplicate: %s", dup))
+	}
+	store, err := s.backends.BackupBackend(sched.Backend)
+	if err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", sched.Backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	if !store.IsExternal() && s.backupper.nodeResolver.NodeCount() > 1 {
+		return backup.NewErrUnprocessable(errLocalBackendDBRO)
+	}
+	if sched.CreatedAt.IsZero() {
+		sched.CreatedAt = time.Now().UTC()
+	}
+	return s.schedules.PutBackupSchedule(ctx, sched)
+}
+
+// Schedules returns all backup schedules
+func (s *Scheduler) Schedules(ctx context.Context, pr *models.Principal) ([]backup.Schedule, error) {
+	if err := s.authorizer.Authorize(ctx, pr, authorization.READ, authorization.Backups()...); err != nil {
+		return nil, err
+	}
+	if s.schedules == nil {
+		return nil, nil
+	}
+	return s.schedules.BackupSchedules(ctx)
+}
+
+// DeleteSchedule deletes the schedule id. Backups it created are kept.
+func (s *Scheduler) DeleteSchedule(ctx context.Context, pr *models.Principal, id string) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "delete_backup_schedule", id, "", begin, err)
+	}(time.Now())
+
+	if s.schedules == nil {
+		return backup.NewErrNotFound(errSchedulesDisabled)
+	}
+	sched, err := s.findSchedule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizer.Authorize(ctx, pr, authorization.DELETE, authorization.Backups(sched.Include...)...); err != nil {
+		return err
+	}
+	return s.schedules.DeleteBackupSchedule(ctx, id)
+}
+
+func (s *Scheduler) findSchedule(ctx context.Context, id string) (backup.Schedule, error) {
+	schedules, err := s.schedules.BackupSchedules(ctx)
+	if err != nil {
+		return backup.Schedule{}, err
+	}
+	for _, sched := range schedules {
+		if sched.ID == id {
+			return sched, nil
+		}
+	}
+	return backup.Schedule{}, backup.NewErrNotFound(fmt.Errorf("backup schedule %q does not exist", id))
+}
+
+// RunSchedules executes the backup schedules every interval until ctx is done
+func (s *Scheduler) RunSchedules(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.RunSchedulesOnce(ctx, now)
+		}
+	}
+}
+
+// RunSchedulesOnce starts the backups of all schedules which are due at now
+// and deletes the backups which are no longer retained. It does nothing
+// unless this node is the leader, so that every run happens exactly once.
+func (s *Scheduler) RunSchedulesOnce(ctx context.Context, now time.Time) {
+	if s.schedules == nil || !s.schedules.IsLeader() {
+		return
+	}
+	logger := s.logger.WithField("action", "backup_schedule")
+	schedules, err := s.schedules.BackupSchedules(ctx)
+	if err != nil {
+		logger.WithError(err).Error("list backup schedules")
+		return
+	}
+	for _, sched := range schedules {
+		logger := logger.WithField("schedule", sched.ID)
+		if err := s.runSchedule(ctx, sched, now); err != nil {
+			logger.WithError(err).Error("run backup schedule")
+		}
+		if err := s.applyRetention(ctx, sched); err != nil {
+			logger.WithError(err).Error("apply backup schedule retention")
+		}
+	}
+}
+
+// runSchedule starts a backup if sched is due. Activations missed while no
+// leader was running the schedules are collapsed into a single backup.
+func (s *Scheduler) runSchedule(ctx context.Context, sched backup.Schedule, now time.Time) error {
+	next, err := sched.Next()
+	if err != nil {
+		return err
+	}
+	if next.After(now) {
+		return nil
+	}
+
+	cpu := sched.CPUPercentage
+	if cpu == 0 {
+		cpu = DefaultCPUPercentage
+	}
+	req := &BackupRequest{
+		ID:      sched.BackupID(now),
+		Backend: sched.Backend,
+		Include: sched.Include,
+		Exclude: sched.Exclude,
+		Bucket:  sched.Bucket,
+		Path:    sched.Path,
+		Compression: Compression{
+			Level:         ParseCompressionLevel(sched.CompressionLevel),
+			CPUPercentage: cpu,
+		},
+	}
+	backupID, runErr := req.ID, ""
+	// the schedule has been authorized when it was created
+	if _, err := s.backup(ctx, req, func([]string) error { return nil }); err != nil {
+		backupID, runErr = "", err.Error()
+	}
+	s.logger.WithFields(logrus.Fields{
+		"action":    "backup_schedule",
+		"schedule":  sched.ID,
+		"backup_id": req.ID,
+		"error":     runErr,
+	}).Info("backup schedule triggered")
+
+	return s.schedules.RecordBackupScheduleRun(ctx, sched.ID, backupID, now, runErr)
+}
+
+// applyRetention deletes the backups of sched which are no longer retained.
+// Backups which serve as the base of an incremental backup are kept.
+func (s *Scheduler) applyRetention(ctx context.Context, sched backup.Schedule) error {
+	if sched.Retention.IsZero() || len(sched.Backups) == 0 {
+		return nil
+	}
+	backend, err := s.backends.BackupBackend(sched.Backend)
+	if err != nil {
+		return fmt.Errorf("backup backend %q: %w", sched.Backend, err)
+	}
+
+	var (
+		backups []backup.ScheduledBackup
+		// gone are backups which have been deleted by other means
+		gone []string
+	)
+	for _, id := range sched.Backups {
+		t, ok := sched.BackupTime(id)
+		if !ok {
+			continue
+		}
+		store := coordStore{objectStore{backend: backend, backupId: id, bucket: sched.Bucket, path: sched.Path}}
+		meta, err := store.Meta(ctx, GlobalBackupFile, sched.Bucket, sched.Path)
+		if err != nil {
+			if errors.As(err, &backup.ErrNotFound{}) {
+				gone = append(gone, id)
+				continue
+			}
+			return fmt.Errorf("read backup %q: %w", id, err)
+		}
+		backups = append(backups, backup.ScheduledBackup{ID: id, Time: t, Status: meta.Status})
+	}
+
+	expired := sched.Retention.Expired(backups)
+	if len(expired) > 0 {
+		bases, err := baseBackupIDs(ctx, backend, sched.Bucket, sched.Path)
+		if err != nil {
+			return err
+		}
+		expired = slices.DeleteFunc(expired, func(id string) bool {
+			_, ok := bases[id]
+			return ok
+		})
+	}
+
+	var errs []error
+	for _, id := range expired {
+		if err := backend.DeleteBackup(ctx, id, sched.Bucket, sched.Path); err != nil {
+			errs = append(errs, fmt.Errorf("delete backup %q: %w", id, err))
+			continue
+		}
+		s.logger.WithFields(logrus.Fields{
+			"action":    "backup_schedule",
+			"schedule":  sched.ID,
+			"backup_id": id,
+		}).Info("expired backup deleted")
+		gone = append(gone, id)
+	}
+	if len(gone) > 0 {
+		if err := s.schedules.RemoveBackupScheduleBackups(ctx, sched.ID, gone); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// baseBackupIDs returns the IDs of the backups stored at bucket and path which
+// incremental backups build upon. An incremental backup is always stored next
+// to its base backup.
+func baseBackupIDs(ctx context.Context, backend modulecapabilities.BackupBackend, bucket, path string) (map[string]struct{}, error) {
+	all, err := backend.AllBackups(ctx, bucket, path)
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+	bases := make(map[string]struct{})
+	for _, b := range all {
+		if b.BaseBackupID != "" {
+			bases[b.BaseBackupID] = struct{}{}
+		}
+	}
+	return bases, nil
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+type fakeScheduleStore struct {
+	notLeader bool
+	schedules []backup.Schedule
+	runs      []backup.Schedule
+	removed   map[string][]string
+}
+
+func (f *fakeScheduleStore) IsLeader() bool { return !f.notLeader }
+
+func (f *fakeScheduleStore) PutBackupSchedule(_ context.Context, s backup.Schedule) error {
+	f.schedules = append(f.schedules, s)
+	return nil
+}
+
+func (f *fakeScheduleStore) DeleteBackupSchedule(_ context.Context, id string) error {
+	f.schedules = slices.DeleteFunc(f.schedules, func(s backup.Schedule) bool { return s.ID == id })
+	return nil
+}
+
+func (f *fakeScheduleStore) RecordBackupScheduleRun(_ context.Context, id, backupID string, runAt time.Time, runErr string) error {
+	f.runs = append(f.runs, backup.Schedule{ID: id, LastBackupID: backupID, LastRunAt: runAt, LastError: runErr})
+	return nil
+}
+
+func (f *fakeScheduleStore) RemoveBackupScheduleBackups(_ context.Context, id string, backupIDs []string) error {
+	if f.removed == nil {
+		f.removed = map[string][]string{}
+	}
+	f.removed[id] = append(f.removed[id], backupIDs...)
+	return nil
+}
+
+func (f *fakeScheduleStore) BackupSchedules(context.Context) ([]backup.Schedule, error) {
+	return f.schedules, nil
+}
+
+func TestSchedulerPutSchedule(t *testing.T) {
+	ctx := context.Background()
+	sched := backup.Schedule{ID: "daily", Cron: "0 3 * * *", Backend: "s3"}
+
+	t.Run("disabled", func(t *testing.T) {
+		err := newFakeScheduler(nil).scheduler().PutSchedule(ctx, nil, sched)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		s := newFakeScheduler(nil).scheduler()
+		s.SetScheduleStore(&fakeScheduleStore{})
+		invalid := sched
+		invalid.Cron = "every day"
+		err := s.PutSchedule(ctx, nil, invalid)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "invalid cron expression")
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		store := &fakeScheduleStore{}
+		s := newFakeScheduler(nil).scheduler()
+		s.SetScheduleStore(store)
+		require.NoError(t, s.PutSchedule(ctx, nil, sched))
+		require.Len(t, store.schedules, 1)
+		assert.False(t, store.schedules[0].CreatedAt.IsZero())
+	})
+}
+
+func TestSchedulerDeleteSchedule(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeScheduleStore{schedules: []backup.Schedule{{ID: "daily"}}}
+	s := newFakeScheduler(nil).scheduler()
+	s.SetScheduleStore(store)
+
+	err := s.DeleteSchedule(ctx, nil, "weekly")
+	assert.IsType(t, backup.ErrNotFound{}, err)
+	require.NoError(t, s.DeleteSchedule(ctx, nil, "daily"))
+	assert.Empty(t, store.schedules)
+}
+
+func TestSchedulerRunSchedulesOnce(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		created = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		now     = time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("NotLeader", func(t *testing.T) {
+		store := &fakeScheduleStore{notLeader: true, schedules: []backup.Schedule{
+			{ID: "daily", Cron: "@daily", Backend: "s3", CreatedAt: created},
+		}}
+		s := newFakeScheduler(nil).scheduler()
+		s.SetScheduleStore(store)
+		s.RunSchedulesOnce(ctx, now)
+		assert.Empty(t, store.runs)
+	})
+
+	t.Run("BackupNotStarted", func(t *testing.T) {
+		store := &fakeScheduleStore{schedules: []backup.Schedule{
+			{ID: "daily", Cron: "@daily", Backend: "s3", CreatedAt: created},
+		}}
+		fs := newFakeScheduler(nil)
+		fs.selector.On("ListClasses", ctx).Return([]string{})
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return("")
+		s := fs.scheduler()
+		s.SetScheduleStore(store)
+
+		s.RunSchedulesOnce(ctx, now)
+		require.Len(t, store.runs, 1)
+		assert.Equal(t, now, store.runs[0].LastRunAt)
+		assert.Empty(t, store.runs[0].LastBackupID)
+		assert.Contains(t, store.runs[0].LastError, "no available classes")
+	})
+
+	t.Run("NotDue", func(t *testing.T) {
+		store := &fakeScheduleStore{schedules: []backup.Schedule{
+			{ID: "daily", Cron: "@daily", Backend: "s3", CreatedAt: created, LastRunAt: now.Add(-time.Hour)},
+		}}
+		s := newFakeScheduler(nil).scheduler()
+		s.SetScheduleStore(store)
+		s.RunSchedulesOnce(ctx, now)
+		assert.Empty(t, store.runs)
+	})
+
+	for name, location := range map[string]struct{ bucket, path string }{
+		"Retention":                 {},
+		"RetentionWithOverridePath": {bucket: "scheduled-bucket", path: "scheduled/daily"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sched := backup.Schedule{
+				ID: "daily", Cron: "@daily", Backend: "s3", CreatedAt: created, LastRunAt: now,
+				Bucket: location.bucket, Path: location.path,
+				Retention: backup.Retention{KeepLast: 1},
+			}
+			var (
+				base    = sched.BackupID(created.Add(24 * time.Hour))
+				expired = sched.BackupID(created.Add(48 * time.Hour))
+				failed  = sched.BackupID(created.Add(60 * time.Hour))
+				last    = sched.BackupID(created.Add(72 * time.Hour))
+				missing = sched.BackupID(created.Add(96 * time.Hour))
+			)
+			sched.Backups = []string{base, expired, failed, last, missing}
+			store := &fakeScheduleStore{schedules: []backup.Schedule{sched}}
+
+			fs := newFakeScheduler(nil)
+			for id, status := range map[string]backup.Status{
+				base: backup.Success, expired: backup.Success, failed: backup.Failed, last: backup.Success,
+			} {
+				fs.backend.On("GetObject", ctx, id, GlobalBackupFile).
+					Return(marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: id, Status: status}), nil)
+			}
+			fs.backend.On("GetObject", ctx, missing, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+			fs.backend.On("GetObject", ctx, missing, BackupFile).Return(nil, backup.ErrNotFound{})
+			// the manual incremental backup is stored next to the scheduled ones
+			fs.backend.On("AllBackups", ctx, location.bucket, location.path).Return([]*backup.DistributedBackupDescriptor{
+				{ID: "manual", BaseBackupID: base},
+			}, nil)
+			fs.backend.On("DeleteBackup", ctx, expired).Return(nil)
+			fs.backend.On("DeleteBackup", ctx, failed).Return(nil)
+			s := fs.scheduler()
+			s.SetScheduleStore(store)
+
+			s.RunSchedulesOnce(ctx, now)
+			assert.Empty(t, store.runs)
+			fs.backend.AssertNotCalled(t, "DeleteBackup", ctx, base)
+			fs.backend.AssertNotCalled(t, "DeleteBackup", ctx, last)
+			assert.ElementsMatch(t, []string{missing, expired, failed}, store.removed["daily"])
+		})
+	}
+}
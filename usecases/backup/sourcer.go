@@ -34,4 +34,8 @@ type Sourcer interface { // implemented by the index
 	// BackupDescriptors acquires resources so that a call to ReleaseBackup() is mandatory to free acquired resources.
 	BackupDescriptors(_ context.Context, bakid string, classes []string,
 	) <-chan backup.ClassDescriptor
+
+	// RenameClass sets the class name stored in the objects of the shards
+	// restored to indexDir to class, when a class is restored under a new name.
+	RenameClass(_ context.Context, indexDir, class string) error
 }
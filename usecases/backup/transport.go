@@ -40,6 +40,15 @@ type Request struct {
 	// NodeMapping specify node names replacement to be made on restore
 	NodeMapping map[string]string
 
+	// ClassMapping specify class names replacement to be made on restore
+	ClassMapping map[string]string
+
+	// TenantMapping specify tenant names replacement to be made on restore
+	TenantMapping map[string]string
+
+	// MoveAliases specify whether aliases of renamed classes are restored
+	MoveAliases bool
+
 	// Classes is list of class which need to be backed up
 	Classes []string
 
@@ -55,6 +64,9 @@ type Request struct {
 	// Additional path prefix override
 	Path string
 
+	// BaseBackupID is the backup an incremental backup reuses files from
+	BaseBackupID string
+
 	// NodeName is the target node name for this backup operation
 	NodeName string
 	// NodeHost is the target node's hostname for this backup operation
@@ -277,6 +277,10 @@ type unzip struct {
 	r               *tar.Reader
 	pipeReader      *io.PipeReader
 	compressionType entBackup.CompressionType
+	// files restricts extraction to the given paths, all files are extracted if nil
+	files map[string]struct{}
+	// renameFn rewrites the path of an entry, entries it rejects are skipped
+	renameFn func(name string) (string, bool)
 }
 
 func NewUnzip(dst string, compressionType entBackup.CompressionType) (unzip, io.WriteCloser) {
@@ -288,6 +292,19 @@ func NewUnzip(dst string, compressionType entBackup.CompressionType) (unzip, io.
 	}, pw
 }
 
+// only restricts extraction to files, other entries of the chunk are skipped
+func (u *unzip) only(files []string) {
+	u.files = make(map[string]struct{}, len(files))
+	for _, f := range files {
+		u.files[f] = struct{}{}
+	}
+}
+
+// rename rewrites the paths of the entries of the chunk with f
+func (u *unzip) rename(f func(name string) (string, bool)) {
+	u.renameFn = f
+}
+
 func (u *unzip) init() error {
 	if u.gzr != nil {
 		return nil
@@ -346,11 +363,21 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		if header == nil {
 			continue
 		}
+		if _, ok := u.files[header.Name]; u.files != nil && !ok {
+			continue
+		}
+		name := header.Name
+		if u.renameFn != nil {
+			var ok bool
+			if name, ok = u.renameFn(name); !ok {
+				continue
+			}
+		}
 
 		// target file
-		target, err := diskio.SanitizeFilePathJoin(u.destPath, header.Name)
+		target, err := diskio.SanitizeFilePathJoin(u.destPath, name)
 		if err != nil {
-			return written, fmt.Errorf("sanitize file path %s: %w", header.Name, err)
+			return written, fmt.Errorf("sanitize file path %s: %w", name, err)
 		}
 		switch header.Typeflag {
 		case tar.TypeDir:
@@ -173,6 +173,87 @@ func TestUnzipPathEscape(t *testing.T) {
 	require.Len(t, entries, 0, "no files should be written outside of destPath")
 }
 
+func TestUnzipOnly(t *testing.T) {
+	srcPath, destPath := t.TempDir(), t.TempDir()
+	files := []string{"s1/lsm/objects/segment-1.db", "s1/lsm/objects/segment-2.db", "s1/indexcount"}
+	for _, file := range files {
+		path := filepath.Join(srcPath, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(file), 0o644))
+	}
+
+	z, rc, err := NewZip(srcPath, int(GzipBestSpeed), 0)
+	require.NoError(t, err)
+	go func() {
+		_, err := z.WriteRegulars(context.Background(), &backup.FileList{Files: files}, &atomic.Int64{})
+		require.NoError(t, err)
+		require.NoError(t, z.Close())
+	}()
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+
+	uz, wc := NewUnzip(destPath, backup.CompressionGZIP)
+	uz.only([]string{"s1/lsm/objects/segment-2.db"})
+	go func() {
+		_, err := io.Copy(wc, &buf)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+	}()
+	_, err = uz.ReadChunk()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(destPath, "s1/lsm/objects/segment-2.db"))
+	require.NoError(t, err)
+	require.Equal(t, "s1/lsm/objects/segment-2.db", string(content))
+	for _, skipped := range []string{"s1/lsm/objects/segment-1.db", "s1/indexcount"} {
+		_, err := os.Stat(filepath.Join(destPath, skipped))
+		require.True(t, os.IsNotExist(err), skipped)
+	}
+}
+
+func TestUnzipRename(t *testing.T) {
+	srcPath, destPath := t.TempDir(), t.TempDir()
+	files := []string{"article/tenant1/lsm/objects/segment-1.db", "article/tenant2/lsm/objects/segment-1.db"}
+	for _, file := range files {
+		path := filepath.Join(srcPath, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(file), 0o644))
+	}
+
+	z, rc, err := NewZip(srcPath, int(GzipBestSpeed), 0)
+	require.NoError(t, err)
+	go func() {
+		_, err := z.WriteRegulars(context.Background(), &backup.FileList{Files: files}, &atomic.Int64{})
+		require.NoError(t, err)
+		require.NoError(t, z.Close())
+	}()
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+
+	renaming := &classRenaming{from: "Article", to: "ArticleCopy", tenants: map[string]string{"tenant2": "restored"}}
+	uz, wc := NewUnzip(destPath, backup.CompressionGZIP)
+	uz.rename(renaming.path)
+	go func() {
+		_, err := io.Copy(wc, &buf)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+	}()
+	_, err = uz.ReadChunk()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(destPath, "articlecopy/restored/lsm/objects/segment-1.db"))
+	require.NoError(t, err)
+	require.Equal(t, "article/tenant2/lsm/objects/segment-1.db", string(content))
+	for _, skipped := range []string{"article", "articlecopy/tenant1"} {
+		_, err := os.Stat(filepath.Join(destPath, skipped))
+		require.True(t, os.IsNotExist(err), skipped)
+	}
+}
+
 func TestZipLevel(t *testing.T) {
 	tests := []struct {
 		in  int
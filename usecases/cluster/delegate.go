@@ -126,8 +126,12 @@ type delegate struct {
 }
 
 type NodeMetadata struct {
-	RestPort int `json:"rest_port"`
-	GrpcPort int `json:"grpc_port"`
+	RestPort int    `json:"rest_port"`
+	GrpcPort int    `json:"grpc_port"`
+	Zone     string `json:"zone,omitempty"`
+	Rack     string `json:"rack,omitempty"`
+	// QueryOnly is set by query nodes, which aren't storage candidates
+	QueryOnly bool `json:"query_only,omitempty"`
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -508,6 +508,51 @@ func (_c *MockNodeSelector_NonStorageNodes_Call) RunAndReturn(run func() []strin
 	return _c
 }
 
+// Placement provides a mock function with no fields
+func (_m *MockNodeSelector) Placement() Placement {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Placement")
+	}
+
+	var r0 Placement
+	if rf, ok := ret.Get(0).(func() Placement); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(Placement)
+	}
+
+	return r0
+}
+
+// MockNodeSelector_Placement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Placement'
+type MockNodeSelector_Placement_Call struct {
+	*mock.Call
+}
+
+// Placement is a helper method to define mock.On call
+func (_e *MockNodeSelector_Expecter) Placement() *MockNodeSelector_Placement_Call {
+	return &MockNodeSelector_Placement_Call{Call: _e.mock.On("Placement")}
+}
+
+func (_c *MockNodeSelector_Placement_Call) Run(run func()) *MockNodeSelector_Placement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNodeSelector_Placement_Call) Return(_a0 Placement) *MockNodeSelector_Placement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNodeSelector_Placement_Call) RunAndReturn(run func() Placement) *MockNodeSelector_Placement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Shutdown provides a mock function with no fields
 func (_m *MockNodeSelector) Shutdown() error {
 	ret := _m.Called()
@@ -11,7 +11,11 @@
 
 package mocks
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
 
 type memberlist struct {
 	// nodes include the node names only
@@ -86,6 +90,10 @@ func (m memberlist) NodeCount() int {
 	return len(m.nodes)
 }
 
+func (m memberlist) Placement() cluster.Placement {
+	return cluster.Placement{}
+}
+
 func NewMockNodeSelector(node ...string) memberlist {
 	return memberlist{nodes: node}
 }
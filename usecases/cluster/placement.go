@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// PlacementPolicyNone places replicas without considering failure domains
+	PlacementPolicyNone = "none"
+	// PlacementPolicyPreferred spreads replicas across failure domains where
+	// possible, but accepts explicit replica movements which reduce the spread
+	PlacementPolicyPreferred = "preferred"
+	// PlacementPolicyRequired spreads replicas across failure domains and
+	// rejects explicit replica movements which reduce the spread
+	PlacementPolicyRequired = "required"
+
+	PlacementDomainZone = "zone"
+	PlacementDomainRack = "rack"
+)
+
+// NodeLabels describe the failure domain a node is running in
+type NodeLabels struct {
+	Zone string `json:"zone,omitempty"`
+	Rack string `json:"rack,omitempty"`
+}
+
+// PlacementConfig configures how replicas are spread across failure domains
+type PlacementConfig struct {
+	Policy string `json:"policy" yaml:"policy"`
+	Domain string `json:"domain" yaml:"domain"`
+}
+
+func (c PlacementConfig) Validate() error {
+	switch c.Policy {
+	case "", PlacementPolicyNone, PlacementPolicyPreferred, PlacementPolicyRequired:
+	default:
+		return fmt.Errorf("invalid replica placement policy %q, must be one of %s, %s, %s",
+			c.Policy, PlacementPolicyNone, PlacementPolicyPreferred, PlacementPolicyRequired)
+	}
+	switch c.Domain {
+	case "", PlacementDomainZone, PlacementDomainRack:
+	default:
+		return fmt.Errorf("invalid replica placement domain %q, must be one of %s, %s",
+			c.Domain, PlacementDomainZone, PlacementDomainRack)
+	}
+	return nil
+}
+
+// Placement resolves the failure domains of nodes according to the
+// configured placement policy. The zero value disables placement.
+type Placement struct {
+	Config PlacementConfig
+	labels func(node string) NodeLabels
+}
+
+func NewPlacement(cfg PlacementConfig, labels func(node string) NodeLabels) Placement {
+	return Placement{Config: cfg, labels: labels}
+}
+
+// Enabled reports whether replicas are spread across failure domains
+func (p Placement) Enabled() bool {
+	return p.labels != nil && p.Config.Policy != "" && p.Config.Policy != PlacementPolicyNone
+}
+
+// Required reports whether the spread must not be reduced by replica movements
+func (p Placement) Required() bool {
+	return p.Enabled() && p.Config.Policy == PlacementPolicyRequired
+}
+
+// Domain returns the failure domain of node. Racks are scoped to their zone,
+// so that equally named racks of different zones are distinct domains.
+func (p Placement) Domain(node string) string {
+	if !p.Enabled() {
+		return ""
+	}
+	labels := p.labels(node)
+	if p.Config.Domain == PlacementDomainRack {
+		return labels.Zone + "/" + labels.Rack
+	}
+	return labels.Zone
+}
+
+// Domains returns the failure domain of each of nodes, or nil if placement is
+// disabled or none of nodes is labelled
+func (p Placement) Domains(nodes []string) map[string]string {
+	if !p.Enabled() {
+		return nil
+	}
+	out := make(map[string]string, len(nodes))
+	labelled := false
+	for _, node := range nodes {
+		domain := p.Domain(node)
+		labelled = labelled || strings.Trim(domain, "/") != ""
+		out[node] = domain
+	}
+	if !labelled {
+		return nil
+	}
+	return out
+}
+
+// CheckSpread returns an error if replicas span fewer failure domains than
+// they could, given the failure domains of the available nodes
+func CheckSpread(replicas, available []string, domains map[string]string) error {
+	if len(domains) == 0 {
+		return nil
+	}
+	possible := countDomains(available, domains)
+	want := min(len(replicas), possible)
+	if got := countDomains(replicas, domains); got < want {
+		return fmt.Errorf("replicas on %s span %d failure domains, %d are possible",
+			strings.Join(replicas, ", "), got, want)
+	}
+	return nil
+}
+
+func countDomains(nodes []string, domains map[string]string) int {
+	seen := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		seen[domains[node]] = struct{}{}
+	}
+	return len(seen)
+}
+
+// PickSpread picks up to n of candidates to join replicas. Nodes of failure
+// domains without a replica are picked first, otherwise the order of
+// candidates is kept. Candidates which already are replicas are skipped.
+func PickSpread(replicas, candidates []string, n int, domains map[string]string) []string {
+	taken := make(map[string]struct{}, len(replicas)+n)
+	used := make(map[string]struct{}, len(replicas)+n)
+	for _, node := range replicas {
+		taken[node] = struct{}{}
+		used[domains[node]] = struct{}{}
+	}
+
+	picked := make([]string, 0, n)
+	for len(picked) < n {
+		next := ""
+		for _, node := range candidates {
+			if _, ok := taken[node]; ok {
+				continue
+			}
+			if next == "" {
+				next = node
+			}
+			if _, ok := used[domains[node]]; !ok {
+				next = node
+				break
+			}
+		}
+		if next == "" {
+			break
+		}
+		picked = append(picked, next)
+		taken[next] = struct{}{}
+		used[domains[next]] = struct{}{}
+	}
+	return picked
+}
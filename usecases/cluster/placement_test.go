@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementDomains(t *testing.T) {
+	labels := map[string]NodeLabels{
+		"node1": {Zone: "a", Rack: "r1"},
+		"node2": {Zone: "b", Rack: "r1"},
+		"node3": {Zone: "a", Rack: "r2"},
+	}
+	lookup := func(node string) NodeLabels { return labels[node] }
+	nodes := []string{"node1", "node2", "node3"}
+
+	t.Run("zone", func(t *testing.T) {
+		p := NewPlacement(PlacementConfig{Policy: PlacementPolicyPreferred, Domain: PlacementDomainZone}, lookup)
+		assert.Equal(t, map[string]string{"node1": "a", "node2": "b", "node3": "a"}, p.Domains(nodes))
+		assert.False(t, p.Required())
+	})
+
+	t.Run("rack is scoped to zone", func(t *testing.T) {
+		p := NewPlacement(PlacementConfig{Policy: PlacementPolicyRequired, Domain: PlacementDomainRack}, lookup)
+		assert.Equal(t, map[string]string{"node1": "a/r1", "node2": "b/r1", "node3": "a/r2"}, p.Domains(nodes))
+		assert.True(t, p.Required())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		p := NewPlacement(PlacementConfig{Policy: PlacementPolicyNone, Domain: PlacementDomainZone}, lookup)
+		assert.Nil(t, p.Domains(nodes))
+		assert.Nil(t, Placement{}.Domains(nodes))
+	})
+
+	t.Run("unlabelled nodes", func(t *testing.T) {
+		p := NewPlacement(PlacementConfig{Policy: PlacementPolicyPreferred, Domain: PlacementDomainRack}, lookup)
+		assert.Nil(t, p.Domains([]string{"node4", "node5"}))
+	})
+}
+
+func TestPlacementConfigValidate(t *testing.T) {
+	require.NoError(t, PlacementConfig{}.Validate())
+	require.NoError(t, PlacementConfig{Policy: PlacementPolicyRequired, Domain: PlacementDomainRack}.Validate())
+	require.Error(t, PlacementConfig{Policy: "always"}.Validate())
+	require.Error(t, PlacementConfig{Policy: PlacementPolicyPreferred, Domain: "region"}.Validate())
+}
+
+func TestPickSpread(t *testing.T) {
+	domains := map[string]string{"n1": "a", "n2": "a", "n3": "b", "n4": "b", "n5": "c"}
+	candidates := []string{"n1", "n2", "n3", "n4", "n5"}
+
+	tests := []struct {
+		name     string
+		replicas []string
+		n        int
+		domains  map[string]string
+		want     []string
+	}{
+		{name: "unused domains first", replicas: []string{"n1"}, n: 2, domains: domains, want: []string{"n3", "n5"}},
+		{name: "fall back to candidate order", replicas: []string{"n1"}, n: 3, domains: domains, want: []string{"n3", "n5", "n2"}},
+		{name: "no domains keeps order", replicas: []string{"n1"}, n: 2, want: []string{"n2", "n3"}},
+		{name: "not enough candidates", replicas: []string{"n1", "n2", "n3"}, n: 5, domains: domains, want: []string{"n5", "n4"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, PickSpread(test.replicas, candidates, test.n, test.domains))
+		})
+	}
+}
+
+func TestCheckSpread(t *testing.T) {
+	domains := map[string]string{"n1": "a", "n2": "a", "n3": "b", "n4": "c"}
+	available := []string{"n1", "n2", "n3", "n4"}
+
+	assert.NoError(t, CheckSpread([]string{"n1", "n3"}, available, domains))
+	assert.NoError(t, CheckSpread([]string{"n1", "n3", "n4", "n2"}, available, domains))
+	assert.Error(t, CheckSpread([]string{"n1", "n2"}, available, domains))
+	// only a single domain is available
+	assert.NoError(t, CheckSpread([]string{"n1", "n2"}, []string{"n1", "n2"}, domains))
+	assert.NoError(t, CheckSpread([]string{"n1", "n2"}, available, nil))
+}
@@ -67,6 +67,8 @@ type NodeSelector interface {
 	Leave() error
 	// Shutdown is called when leaving the cluster gracefully and shutting down the memberlist instance.
 	Shutdown() error
+	// Placement returns how replicas are spread across the failure domains of the nodes.
+	Placement() Placement
 }
 
 type State struct {
@@ -108,6 +110,16 @@ type Config struct {
 	RaftBootstrapExpect int
 	// RequestQueueConfig is used to configure the request queue buffer for the replicated indices
 	RequestQueueConfig RequestQueueConfig `json:"requestQueueConfig" yaml:"requestQueueConfig"`
+	// Zone and Rack label the failure domain of this node, they are shared with
+	// the other members of the cluster
+	Zone string `json:"zone" yaml:"zone"`
+	Rack string `json:"rack" yaml:"rack"`
+	// ReplicaPlacement configures how replicas are spread across failure domains
+	ReplicaPlacement PlacementConfig `json:"replicaPlacement" yaml:"replicaPlacement"`
+	// QueryOnly makes this node a query node: it never gets shards assigned,
+	// doesn't vote in raft and its replicas, added explicitly by copying them
+	// from the storage nodes, only serve reads and don't count for write quorums
+	QueryOnly bool `json:"queryOnly" yaml:"queryOnly"`
 }
 
 type AuthConfig struct {
@@ -182,8 +194,11 @@ func Init(userConfig Config, raftTimeoutsMultiplier int, dataPath string, nonSto
 			dataPath: dataPath,
 			log:      logger,
 			metadata: NodeMetadata{
-				RestPort: userConfig.DataBindPort,
-				GrpcPort: userConfig.DataBindPort,
+				RestPort:  userConfig.DataBindPort,
+				GrpcPort:  userConfig.DataBindPort,
+				Zone:      userConfig.Zone,
+				Rack:      userConfig.Rack,
+				QueryOnly: userConfig.QueryOnly,
 			},
 		},
 	}
@@ -330,26 +345,43 @@ func (s *State) AllNames() []string {
 	return out
 }
 
-// StorageNodes returns all nodes except non storage nodes
+// StorageNodes returns all nodes except non storage nodes and query nodes
 func (s *State) storageNodes() []string {
-	if len(s.nonStorageNodes) == 0 {
-		return s.AllNames()
+	if s.list == nil {
+		return []string{}
 	}
-
 	members := s.list.Members()
 	out := make([]string, len(members))
 	n := 0
 	for _, m := range members {
 		name := m.Name
-		if _, ok := s.nonStorageNodes[name]; !ok {
-			out[n] = m.Name
-			n++
+		if _, ok := s.nonStorageNodes[name]; ok {
+			continue
 		}
+		if s.isQueryNode(m) {
+			continue
+		}
+		out[n] = name
+		n++
 	}
 
 	return out[:n]
 }
 
+// isQueryNode reports whether the member announces itself as query node. The
+// query nodes used for routing are registered in raft, the metadata only keeps
+// a joining query node from being picked as storage candidate before that.
+func (s *State) isQueryNode(m *memberlist.Node) bool {
+	if m.Name == s.config.Hostname {
+		return s.config.QueryOnly
+	}
+	meta, err := nodeMetadata(m)
+	if err != nil {
+		return false
+	}
+	return meta.QueryOnly
+}
+
 // StorageCandidates returns list of storage nodes (names)
 // sorted by the free amount of disk space in descending order
 func (s *State) StorageCandidates() []string {
@@ -479,6 +511,28 @@ func (s *State) NodeGRPCPort(nodeID string) (int, error) {
 	return 0, fmt.Errorf("node not found: %s", nodeID)
 }
 
+// NodeLabels returns the failure domain labels of a member, which are empty
+// if the member is unknown or did not set them
+func (s *State) NodeLabels(nodeName string) NodeLabels {
+	if nodeName == s.config.Hostname {
+		return NodeLabels{Zone: s.config.Zone, Rack: s.config.Rack}
+	}
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			meta, err := nodeMetadata(mem)
+			if err != nil {
+				return NodeLabels{}
+			}
+			return NodeLabels{Zone: meta.Zone, Rack: meta.Rack}
+		}
+	}
+	return NodeLabels{}
+}
+
+func (s *State) Placement() Placement {
+	return NewPlacement(s.config.ReplicaPlacement, s.NodeLabels)
+}
+
 func (s *State) SchemaSyncIgnored() bool {
 	return s.config.IgnoreStartupSchemaSync
 }
@@ -562,6 +616,10 @@ func validateClusterConfig(userConfig Config) error {
 		return fmt.Errorf("hostname cannot be empty")
 	}
 
+	if err := userConfig.ReplicaPlacement.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
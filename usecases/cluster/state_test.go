@@ -513,3 +513,15 @@ func TestGetConfigType(t *testing.T) {
 		})
 	}
 }
+
+func TestIsQueryNode(t *testing.T) {
+	s := &State{config: Config{Hostname: "node1", QueryOnly: true}}
+	d := delegate{metadata: NodeMetadata{RestPort: 8080, QueryOnly: true}}
+	queryMeta := d.NodeMeta(512)
+	require.NotEmpty(t, queryMeta)
+
+	assert.True(t, s.isQueryNode(&memberlist.Node{Name: "node1"}), "local node uses its own config")
+	assert.True(t, s.isQueryNode(&memberlist.Node{Name: "node2", Meta: queryMeta}))
+	assert.False(t, s.isQueryNode(&memberlist.Node{Name: "node3", Meta: []byte(`{"rest_port":8080}`)}))
+	assert.False(t, s.isQueryNode(&memberlist.Node{Name: "node4"}), "nodes without metadata are storage nodes")
+}
@@ -161,6 +161,7 @@ type Config struct {
 	TelemetryURL                        string                   `json:"telemetry_url" yaml:"telemetry_url"`
 	TelemetryPushInterval               time.Duration            `json:"telemetry_push_interval" yaml:"telemetry_push_interval"`
 	HNSWStartupWaitForVectorCache       bool                     `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
+	HNSWVectorCacheAdmission            bool                     `json:"hnsw_vector_cache_admission" yaml:"hnsw_vector_cache_admission"`
 	HNSWVisitedListPoolMaxSize          int                      `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
 	HNSWFlatSearchConcurrency           int                      `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
 	HNSWAcornFilterRatio                float64                  `json:"hnsw_acorn_filter_ratio" yaml:"hnsw_acorn_filter_ratio"`
@@ -185,6 +186,11 @@ type Config struct {
 
 	ReplicaMovementEnabled          bool                                 `json:"replica_movement_enabled" yaml:"replica_movement_enabled"`
 	ReplicaMovementMinimumAsyncWait *runtime.DynamicValue[time.Duration] `json:"REPLICA_MOVEMENT_MINIMUM_ASYNC_WAIT" yaml:"REPLICA_MOVEMENT_MINIMUM_ASYNC_WAIT"`
+	Rebalancer                      Rebalancer                           `json:"rebalancer" yaml:"rebalancer"`
+	CrossClusterReplication         CrossClusterReplication              `json:"cross_cluster_replication" yaml:"cross_cluster_replication"`
+	ChangeDataCapture               ChangeDataCapture                    `json:"change_data_capture" yaml:"change_data_capture"`
+	HintedHandoff                   HintedHandoff                        `json:"hinted_handoff" yaml:"hinted_handoff"`
+	RecallSampling                  RecallSampling                       `json:"recall_sampling" yaml:"recall_sampling"`
 
 	// TenantActivityReadLogLevel is 'debug' by default as every single READ
 	// interaction with a tenant leads to a log line. However, this may
@@ -303,6 +309,25 @@ func (c *Config) Validate() error {
 		return configErr(err)
 	}
 
+	if err := c.validateQueryOnly(); err != nil {
+		return configErr(err)
+	}
+
+	return nil
+}
+
+// validateQueryOnly makes sure a query node isn't one of the raft voters,
+// which are the first bootstrap_expect nodes of raft.join
+func (c *Config) validateQueryOnly() error {
+	if !c.Cluster.QueryOnly {
+		return nil
+	}
+	for _, voter := range c.Raft.Join[:c.Raft.BootstrapExpect] {
+		if strings.Split(voter, ":")[0] == c.Cluster.Hostname {
+			return fmt.Errorf("query only node %s cannot be a raft voter, remove it from the first %d nodes of raft.join",
+				c.Cluster.Hostname, c.Raft.BootstrapExpect)
+		}
+	}
 	return nil
 }
 
@@ -334,10 +359,12 @@ type moduleProvider interface {
 
 func (c *Config) validateDefaultVectorDistanceMetric() error {
 	switch c.DefaultVectorDistanceMetric {
-	case "", common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared, common.DistanceManhattan, common.DistanceHamming:
+	case "", common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared, common.DistanceManhattan, common.DistanceHamming,
+		common.DistanceJaccard:
+		// weighted-dot needs per-class weights and can't be the default
 		return nil
 	default:
-		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]")
+		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\"]")
 	}
 }
 
@@ -410,6 +437,71 @@ type DistributedTasksConfig struct {
 	SchedulerTickInterval time.Duration `json:"schedulerTickInterval" yaml:"schedulerTickInterval"`
 }
 
+// Rebalancer configures the automatic rebalancing of shard replicas across
+// the storage nodes. It requires replica movement to be enabled.
+type Rebalancer struct {
+	Enabled            bool                        `json:"enabled" yaml:"enabled"`
+	Interval           time.Duration               `json:"interval" yaml:"interval"`
+	ImbalanceThreshold float64                     `json:"imbalance_threshold" yaml:"imbalance_threshold"`
+	MaxConcurrentMoves int                         `json:"max_concurrent_moves" yaml:"max_concurrent_moves"`
+	Metric             string                      `json:"metric" yaml:"metric"`
+	Paused             *runtime.DynamicValue[bool] `json:"paused" yaml:"paused"`
+}
+
+// CrossClusterReplication configures the follower mode, in which the cluster
+// tails the schema and the objects of a primary cluster, e.g. running in
+// another region for disaster recovery.
+type CrossClusterReplication struct {
+	// PrimaryHost is the cluster API address (host:port) of a node of the
+	// primary cluster. The cluster runs as a follower when it is set. Both
+	// clusters need to share the cluster API credentials.
+	PrimaryHost string `json:"primary_host" yaml:"primary_host"`
+	// Interval between two synchronizations with the primary
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// SchemaBatchSize is the maximum number of schema log entries fetched at
+	// once from the primary
+	SchemaBatchSize int `json:"schema_batch_size" yaml:"schema_batch_size"`
+}
+
+// Enabled reports whether the cluster follows a primary cluster
+func (c CrossClusterReplication) Enabled() bool {
+	return c.PrimaryHost != ""
+}
+
+// ChangeDataCapture configures the per-shard change log, which records every
+// object mutation so that clients can follow the changes of a collection.
+type ChangeDataCapture struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Retention is the minimum duration a change is kept in the change log
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
+// HintedHandoff configures the hints a coordinator keeps for replicas which
+// missed a write, so that the write can be replayed once they are back.
+type HintedHandoff struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// TTL is the duration after which undelivered hints are dropped
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+	// MaxHints is the maximum number of hints stored on the node, hints
+	// exceeding it are dropped
+	MaxHints int `json:"max_hints" yaml:"max_hints"`
+	// ReplayInterval is the interval at which hints are replayed
+	ReplayInterval time.Duration `json:"replay_interval" yaml:"replay_interval"`
+}
+
+// RecallSampling configures the re-run of a fraction of the live vector
+// queries against an exact brute-force scan, to measure the recall of the
+// vector indexes.
+type RecallSampling struct {
+	// Rate is the fraction of queries which are sampled, 0 disables sampling
+	Rate float64 `json:"rate" yaml:"rate"`
+	// Timeout bounds a single ground truth scan
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// Concurrency is the number of ground truth scans which may run at the
+	// same time per vector index, samples are dropped while all are busy
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+}
+
 type Persistence struct {
 	DataPath                                     string `json:"dataPath" yaml:"dataPath"`
 	MemtablesFlushDirtyAfter                     int    `json:"flushDirtyMemtablesAfter" yaml:"flushDirtyMemtablesAfter"`
@@ -588,6 +680,11 @@ type Raft struct {
 
 	EnableOneNodeRecovery bool
 	ForceOneNodeRecovery  bool
+
+	// AuditLogMaxEntries and AuditLogMaxAge bound the schema audit log kept
+	// by the FSM, a zero max age keeps entries until the count limit is hit
+	AuditLogMaxEntries int
+	AuditLogMaxAge     time.Duration
 }
 
 func (r *Raft) Validate() error {
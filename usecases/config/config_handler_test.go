@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac/rbacconf"
+	"github.com/weaviate/weaviate/usecases/cluster"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,7 +36,7 @@ func TestConfigModules(t *testing.T) {
 		assert.EqualError(
 			t,
 			err,
-			"default vector distance metric: must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]",
+			"default vector distance metric: must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\"]",
 		)
 	})
 
@@ -292,3 +293,22 @@ func TestConfigValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidateQueryOnly(t *testing.T) {
+	raft := Raft{Join: []string{"node1:8300", "node2:8300", "node3:8300", "node4:8300"}, BootstrapExpect: 3}
+
+	t.Run("storage node", func(t *testing.T) {
+		c := &Config{Cluster: cluster.Config{Hostname: "node2"}, Raft: raft}
+		assert.NoError(t, c.validateQueryOnly())
+	})
+
+	t.Run("query node which is a voter", func(t *testing.T) {
+		c := &Config{Cluster: cluster.Config{Hostname: "node2", QueryOnly: true}, Raft: raft}
+		assert.ErrorContains(t, c.validateQueryOnly(), "query only node node2 cannot be a raft voter")
+	})
+
+	t.Run("query node which is not a voter", func(t *testing.T) {
+		c := &Config{Cluster: cluster.Config{Hostname: "node4", QueryOnly: true}, Raft: raft}
+		assert.NoError(t, c.validateQueryOnly())
+	})
+}
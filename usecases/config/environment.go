@@ -42,7 +42,9 @@ const (
 	DefaultRaftBootstrapTimeout = 600
 	DefaultRaftBootstrapExpect  = 1
 	DefaultRaftDir              = "raft"
-	DefaultHNSWAcornFilterRatio = 0.4
+	// DefaultRaftAuditLogMaxEntries is the number of schema audit log entries kept in the FSM
+	DefaultRaftAuditLogMaxEntries = 1000
+	DefaultHNSWAcornFilterRatio   = 0.4
 
 	DefaultRuntimeOverridesLoadInterval = 2 * time.Minute
 
@@ -56,6 +58,23 @@ const (
 
 	DefaultTransferInactivityTimeout = 5 * time.Minute
 
+	DefaultRebalancerInterval           = 5 * time.Minute
+	DefaultRebalancerImbalanceThreshold = 0.1
+	DefaultRebalancerMaxConcurrentMoves = 2
+	DefaultRebalancerMetric             = "objects"
+
+	DefaultCrossClusterReplicationInterval        = 30 * time.Second
+	DefaultCrossClusterReplicationSchemaBatchSize = 100
+
+	DefaultChangeDataCaptureRetention = 24 * time.Hour
+
+	DefaultHintedHandoffTTL            = 3 * time.Hour
+	DefaultHintedHandoffMaxHints       = 1_000_000
+	DefaultHintedHandoffReplayInterval = 10 * time.Second
+
+	DefaultRecallSamplingTimeout     = time.Minute
+	DefaultRecallSamplingConcurrency = 1
+
 	DefaultTrackVectorDimensionsInterval = 5 * time.Minute
 )
 
@@ -913,6 +932,10 @@ func FromEnv(config *Config) error {
 		config.HNSWStartupWaitForVectorCache = true
 	}
 
+	if entcfg.Enabled(os.Getenv("HNSW_VECTOR_CACHE_ADMISSION")) {
+		config.HNSWVectorCacheAdmission = true
+	}
+
 	if entcfg.Enabled(os.Getenv("ASYNC_INDEXING")) {
 		config.AsyncIndexingEnabled = true
 	}
@@ -1001,6 +1024,26 @@ func FromEnv(config *Config) error {
 	} else {
 		config.ReplicaMovementMinimumAsyncWait = configRuntime.NewDynamicValue(DefaultReplicaMovementMinimumAsyncWait)
 	}
+	if err := parseRebalancerConfig(&config.Rebalancer); err != nil {
+		return err
+	}
+
+	if err := parseCrossClusterReplicationConfig(&config.CrossClusterReplication); err != nil {
+		return err
+	}
+
+	if err := parseChangeDataCaptureConfig(&config.ChangeDataCapture); err != nil {
+		return err
+	}
+
+	if err := parseHintedHandoffConfig(&config.HintedHandoff); err != nil {
+		return err
+	}
+
+	if err := parseRecallSamplingConfig(&config.RecallSampling); err != nil {
+		return err
+	}
+
 	revoctorizeCheckDisabled := false
 	if v := os.Getenv("REVECTORIZE_CHECK_DISABLED"); v != "" {
 		revoctorizeCheckDisabled = !(strings.ToLower(v) == "false")
@@ -1192,6 +1235,22 @@ func parseRAFTConfig(hostname string) (Raft, error) {
 		return cfg, err
 	}
 
+	if err := parsePositiveInt(
+		"RAFT_AUDIT_LOG_MAX_ENTRIES",
+		func(val int) { cfg.AuditLogMaxEntries = val },
+		DefaultRaftAuditLogMaxEntries,
+	); err != nil {
+		return cfg, err
+	}
+
+	if err := parseDuration(
+		"RAFT_AUDIT_LOG_MAX_AGE",
+		func(val time.Duration) { cfg.AuditLogMaxAge = val },
+		0,
+	); err != nil {
+		return cfg, err
+	}
+
 	cfg.EnableOneNodeRecovery = entcfg.Enabled(os.Getenv("RAFT_ENABLE_ONE_NODE_RECOVERY"))
 	cfg.ForceOneNodeRecovery = entcfg.Enabled(os.Getenv("RAFT_FORCE_ONE_NODE_RECOVERY"))
 
@@ -1368,6 +1427,112 @@ func parseIntVerify(envName string, defaultValue int, cb func(val int), verify f
 	return nil
 }
 
+func parseRebalancerConfig(cfg *Rebalancer) error {
+	cfg.Enabled = entcfg.Enabled(os.Getenv("REBALANCER_ENABLED"))
+	cfg.Paused = configRuntime.NewDynamicValue(entcfg.Enabled(os.Getenv("REBALANCER_PAUSED")))
+
+	if err := parsePositiveDuration("REBALANCER_INTERVAL",
+		func(val time.Duration) { cfg.Interval = val },
+		DefaultRebalancerInterval,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveFloat("REBALANCER_IMBALANCE_THRESHOLD",
+		func(val float64) { cfg.ImbalanceThreshold = val },
+		DefaultRebalancerImbalanceThreshold,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt("REBALANCER_MAX_CONCURRENT_MOVES",
+		func(val int) { cfg.MaxConcurrentMoves = val },
+		DefaultRebalancerMaxConcurrentMoves,
+	); err != nil {
+		return err
+	}
+
+	cfg.Metric = DefaultRebalancerMetric
+	if v := os.Getenv("REBALANCER_METRIC"); v != "" {
+		cfg.Metric = strings.ToLower(v)
+	}
+	if cfg.Metric != "objects" && cfg.Metric != "disk" {
+		return fmt.Errorf("REBALANCER_METRIC must be one of objects, disk. Got: %s", cfg.Metric)
+	}
+	return nil
+}
+
+func parseCrossClusterReplicationConfig(cfg *CrossClusterReplication) error {
+	cfg.PrimaryHost = os.Getenv("CROSS_CLUSTER_REPLICATION_PRIMARY_HOST")
+
+	if err := parsePositiveDuration("CROSS_CLUSTER_REPLICATION_INTERVAL",
+		func(val time.Duration) { cfg.Interval = val },
+		DefaultCrossClusterReplicationInterval,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt("CROSS_CLUSTER_REPLICATION_SCHEMA_BATCH_SIZE",
+		func(val int) { cfg.SchemaBatchSize = val },
+		DefaultCrossClusterReplicationSchemaBatchSize,
+	)
+}
+
+func parseChangeDataCaptureConfig(cfg *ChangeDataCapture) error {
+	cfg.Enabled = entcfg.Enabled(os.Getenv("CHANGE_DATA_CAPTURE_ENABLED"))
+
+	return parsePositiveDuration("CHANGE_DATA_CAPTURE_RETENTION",
+		func(val time.Duration) { cfg.Retention = val },
+		DefaultChangeDataCaptureRetention,
+	)
+}
+
+func parseHintedHandoffConfig(cfg *HintedHandoff) error {
+	cfg.Enabled = entcfg.Enabled(os.Getenv("HINTED_HANDOFF_ENABLED"))
+
+	if err := parsePositiveDuration("HINTED_HANDOFF_TTL",
+		func(val time.Duration) { cfg.TTL = val },
+		DefaultHintedHandoffTTL,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt("HINTED_HANDOFF_MAX_HINTS",
+		func(val int) { cfg.MaxHints = val },
+		DefaultHintedHandoffMaxHints,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveDuration("HINTED_HANDOFF_REPLAY_INTERVAL",
+		func(val time.Duration) { cfg.ReplayInterval = val },
+		DefaultHintedHandoffReplayInterval,
+	)
+}
+
+func parseRecallSamplingConfig(cfg *RecallSampling) error {
+	if err := parseFloat64("RECALL_SAMPLING_RATE", 0, func(val float64) error {
+		if val < 0 || val > 1 {
+			return fmt.Errorf("RECALL_SAMPLING_RATE must be a float between 0 and 1. Got: %v", val)
+		}
+		return nil
+	}, func(val float64) { cfg.Rate = val }); err != nil {
+		return err
+	}
+
+	if err := parsePositiveDuration("RECALL_SAMPLING_TIMEOUT",
+		func(val time.Duration) { cfg.Timeout = val },
+		DefaultRecallSamplingTimeout,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt("RECALL_SAMPLING_CONCURRENCY",
+		func(val int) { cfg.Concurrency = val },
+		DefaultRecallSamplingConcurrency,
+	)
+}
+
 // parsePositiveDuration parses an environment variable as time.Duration using time.ParseDuration,
 // applies a default when unset, and validates it is > 0.
 func parsePositiveDuration(envName string, cb func(val time.Duration), defaultValue time.Duration) error {
@@ -1630,6 +1795,23 @@ func parseClusterConfig() (cluster.Config, error) {
 		}
 	}
 
+	cfg.Zone = os.Getenv("CLUSTER_NODE_ZONE")
+	cfg.Rack = os.Getenv("CLUSTER_NODE_RACK")
+	cfg.QueryOnly = entcfg.Enabled(os.Getenv("CLUSTER_NODE_QUERY_ONLY"))
+	cfg.ReplicaPlacement = cluster.PlacementConfig{
+		Policy: cluster.PlacementPolicyPreferred,
+		Domain: cluster.PlacementDomainZone,
+	}
+	if v := os.Getenv("REPLICA_PLACEMENT_POLICY"); v != "" {
+		cfg.ReplicaPlacement.Policy = strings.ToLower(v)
+	}
+	if v := os.Getenv("REPLICA_PLACEMENT_DOMAIN"); v != "" {
+		cfg.ReplicaPlacement.Domain = strings.ToLower(v)
+	}
+	if err := cfg.ReplicaPlacement.Validate(); err != nil {
+		return cfg, err
+	}
+
 	requestQueueIsEnabled := entcfg.Enabled(os.Getenv("REPLICATED_INDICES_REQUEST_QUEUE_ENABLED"))
 	cfg.RequestQueueConfig.IsEnabled = configRuntime.NewDynamicValue(requestQueueIsEnabled)
 	// choosing runtime.GOMAXPROCS(0)*2 for the number of workers as a reasonable default, but can be overridden
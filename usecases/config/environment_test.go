@@ -267,6 +267,10 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 		QueueFullHttpStatus:         429,
 		QueueShutdownTimeoutSeconds: 90,
 	}
+	defaultPlacement := cluster.PlacementConfig{
+		Policy: cluster.PlacementPolicyPreferred,
+		Domain: cluster.PlacementDomainZone,
+	}
 	tests := []struct {
 		name           string
 		envVars        map[string]string
@@ -289,6 +293,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				AdvertisePort:      9999,
 				MaintenanceNodes:   make([]string, 0),
 				RequestQueueConfig: defaultRequestQueueConfig,
+				ReplicaPlacement:   defaultPlacement,
 			},
 		},
 		{
@@ -300,6 +305,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				AdvertiseAddr:      "",
 				MaintenanceNodes:   make([]string, 0),
 				RequestQueueConfig: defaultRequestQueueConfig,
+				ReplicaPlacement:   defaultPlacement,
 			},
 		},
 		{
@@ -313,6 +319,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				DataBindPort:       7778,
 				MaintenanceNodes:   make([]string, 0),
 				RequestQueueConfig: defaultRequestQueueConfig,
+				ReplicaPlacement:   defaultPlacement,
 			},
 		},
 		{
@@ -327,6 +334,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				DataBindPort:       7111,
 				MaintenanceNodes:   make([]string, 0),
 				RequestQueueConfig: defaultRequestQueueConfig,
+				ReplicaPlacement:   defaultPlacement,
 			},
 		},
 		{
@@ -341,6 +349,7 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				IgnoreStartupSchemaSync: true,
 				MaintenanceNodes:        make([]string, 0),
 				RequestQueueConfig:      defaultRequestQueueConfig,
+				ReplicaPlacement:        defaultPlacement,
 			},
 		},
 		{
@@ -364,8 +373,53 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 					QueueFullHttpStatus:         504,
 					QueueShutdownTimeoutSeconds: 120,
 				},
+				ReplicaPlacement: defaultPlacement,
+			},
+		},
+		{
+			name: "node labels and replica placement",
+			envVars: map[string]string{
+				"CLUSTER_NODE_ZONE":        "eu-west-1a",
+				"CLUSTER_NODE_RACK":        "r12",
+				"REPLICA_PLACEMENT_POLICY": "Required",
+				"REPLICA_PLACEMENT_DOMAIN": "rack",
+			},
+			expectedResult: cluster.Config{
+				Hostname:           hostname,
+				GossipBindPort:     7946,
+				DataBindPort:       7947,
+				MaintenanceNodes:   make([]string, 0),
+				RequestQueueConfig: defaultRequestQueueConfig,
+				Zone:               "eu-west-1a",
+				Rack:               "r12",
+				ReplicaPlacement: cluster.PlacementConfig{
+					Policy: cluster.PlacementPolicyRequired,
+					Domain: cluster.PlacementDomainRack,
+				},
+			},
+		},
+		{
+			name: "query only node",
+			envVars: map[string]string{
+				"CLUSTER_NODE_QUERY_ONLY": "true",
+			},
+			expectedResult: cluster.Config{
+				Hostname:           hostname,
+				GossipBindPort:     7946,
+				DataBindPort:       7947,
+				MaintenanceNodes:   make([]string, 0),
+				RequestQueueConfig: defaultRequestQueueConfig,
+				ReplicaPlacement:   defaultPlacement,
+				QueryOnly:          true,
 			},
 		},
+		{
+			name: "invalid replica placement policy",
+			envVars: map[string]string{
+				"REPLICA_PLACEMENT_POLICY": "always",
+			},
+			expectedErr: fmt.Errorf("invalid replica placement policy \"always\", must be one of none, preferred, required"),
+		},
 	}
 
 	for _, test := range tests {
@@ -861,6 +915,199 @@ func TestEnvironmentHNSWWaitForPrefill(t *testing.T) {
 	}
 }
 
+func TestEnvironmentHNSWVectorCacheAdmission(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected bool
+	}{
+		{"Valid: true", []string{"true"}, true},
+		{"Valid: false", []string{"false"}, false},
+		{"Valid: 1", []string{"1"}, true},
+		{"Valid: 0", []string{"0"}, false},
+		{"not given", []string{}, false},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("HNSW_VECTOR_CACHE_ADMISSION", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.HNSWVectorCacheAdmission)
+		})
+	}
+}
+
+func TestEnvironmentRebalancer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.Rebalancer.Enabled)
+		require.False(t, conf.Rebalancer.Paused.Get())
+		require.Equal(t, DefaultRebalancerInterval, conf.Rebalancer.Interval)
+		require.Equal(t, DefaultRebalancerImbalanceThreshold, conf.Rebalancer.ImbalanceThreshold)
+		require.Equal(t, DefaultRebalancerMaxConcurrentMoves, conf.Rebalancer.MaxConcurrentMoves)
+		require.Equal(t, DefaultRebalancerMetric, conf.Rebalancer.Metric)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("REBALANCER_ENABLED", "true")
+		t.Setenv("REBALANCER_PAUSED", "true")
+		t.Setenv("REBALANCER_INTERVAL", "30s")
+		t.Setenv("REBALANCER_IMBALANCE_THRESHOLD", "0.25")
+		t.Setenv("REBALANCER_MAX_CONCURRENT_MOVES", "4")
+		t.Setenv("REBALANCER_METRIC", "Disk")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.Rebalancer.Enabled)
+		require.True(t, conf.Rebalancer.Paused.Get())
+		require.Equal(t, 30*time.Second, conf.Rebalancer.Interval)
+		require.Equal(t, 0.25, conf.Rebalancer.ImbalanceThreshold)
+		require.Equal(t, 4, conf.Rebalancer.MaxConcurrentMoves)
+		require.Equal(t, "disk", conf.Rebalancer.Metric)
+	})
+
+	t.Run("invalid metric", func(t *testing.T) {
+		t.Setenv("REBALANCER_METRIC", "cpu")
+		require.ErrorContains(t, FromEnv(&Config{}), "REBALANCER_METRIC")
+	})
+}
+
+func TestEnvironmentCrossClusterReplication(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.CrossClusterReplication.Enabled())
+		require.Equal(t, DefaultCrossClusterReplicationInterval, conf.CrossClusterReplication.Interval)
+		require.Equal(t, DefaultCrossClusterReplicationSchemaBatchSize, conf.CrossClusterReplication.SchemaBatchSize)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_PRIMARY_HOST", "primary.example.com:7101")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_INTERVAL", "5s")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_SCHEMA_BATCH_SIZE", "20")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.CrossClusterReplication.Enabled())
+		require.Equal(t, "primary.example.com:7101", conf.CrossClusterReplication.PrimaryHost)
+		require.Equal(t, 5*time.Second, conf.CrossClusterReplication.Interval)
+		require.Equal(t, 20, conf.CrossClusterReplication.SchemaBatchSize)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_INTERVAL", "0s")
+		require.ErrorContains(t, FromEnv(&Config{}), "CROSS_CLUSTER_REPLICATION_INTERVAL")
+	})
+}
+
+func TestEnvironmentChangeDataCapture(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.ChangeDataCapture.Enabled)
+		require.Equal(t, DefaultChangeDataCaptureRetention, conf.ChangeDataCapture.Retention)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("CHANGE_DATA_CAPTURE_ENABLED", "true")
+		t.Setenv("CHANGE_DATA_CAPTURE_RETENTION", "2h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.ChangeDataCapture.Enabled)
+		require.Equal(t, 2*time.Hour, conf.ChangeDataCapture.Retention)
+	})
+
+	t.Run("invalid retention", func(t *testing.T) {
+		t.Setenv("CHANGE_DATA_CAPTURE_RETENTION", "-1h")
+		require.ErrorContains(t, FromEnv(&Config{}), "CHANGE_DATA_CAPTURE_RETENTION")
+	})
+}
+
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.HintedHandoff.Enabled)
+		require.Equal(t, DefaultHintedHandoffTTL, conf.HintedHandoff.TTL)
+		require.Equal(t, DefaultHintedHandoffMaxHints, conf.HintedHandoff.MaxHints)
+		require.Equal(t, DefaultHintedHandoffReplayInterval, conf.HintedHandoff.ReplayInterval)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("HINTED_HANDOFF_ENABLED", "true")
+		t.Setenv("HINTED_HANDOFF_TTL", "30m")
+		t.Setenv("HINTED_HANDOFF_MAX_HINTS", "500")
+		t.Setenv("HINTED_HANDOFF_REPLAY_INTERVAL", "1s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.HintedHandoff.Enabled)
+		require.Equal(t, 30*time.Minute, conf.HintedHandoff.TTL)
+		require.Equal(t, 500, conf.HintedHandoff.MaxHints)
+		require.Equal(t, time.Second, conf.HintedHandoff.ReplayInterval)
+	})
+
+	t.Run("invalid max hints", func(t *testing.T) {
+		t.Setenv("HINTED_HANDOFF_MAX_HINTS", "0")
+		require.ErrorContains(t, FromEnv(&Config{}), "HINTED_HANDOFF_MAX_HINTS")
+	})
+}
+
+func TestEnvironmentRecallSampling(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Zero(t, conf.RecallSampling.Rate)
+		require.Equal(t, DefaultRecallSamplingTimeout, conf.RecallSampling.Timeout)
+		require.Equal(t, DefaultRecallSamplingConcurrency, conf.RecallSampling.Concurrency)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("RECALL_SAMPLING_RATE", "0.01")
+		t.Setenv("RECALL_SAMPLING_TIMEOUT", "10s")
+		t.Setenv("RECALL_SAMPLING_CONCURRENCY", "4")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, 0.01, conf.RecallSampling.Rate)
+		require.Equal(t, 10*time.Second, conf.RecallSampling.Timeout)
+		require.Equal(t, 4, conf.RecallSampling.Concurrency)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		t.Setenv("RECALL_SAMPLING_RATE", "1.5")
+		require.ErrorContains(t, FromEnv(&Config{}), "RECALL_SAMPLING_RATE")
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		t.Setenv("RECALL_SAMPLING_CONCURRENCY", "0")
+		require.ErrorContains(t, FromEnv(&Config{}), "RECALL_SAMPLING_CONCURRENCY")
+	})
+}
+
+func TestEnvironmentRaftAuditLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, DefaultRaftAuditLogMaxEntries, conf.Raft.AuditLogMaxEntries)
+		require.Zero(t, conf.Raft.AuditLogMaxAge)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("RAFT_AUDIT_LOG_MAX_ENTRIES", "50")
+		t.Setenv("RAFT_AUDIT_LOG_MAX_AGE", "720h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, 50, conf.Raft.AuditLogMaxEntries)
+		require.Equal(t, 720*time.Hour, conf.Raft.AuditLogMaxAge)
+	})
+
+	t.Run("invalid max entries", func(t *testing.T) {
+		t.Setenv("RAFT_AUDIT_LOG_MAX_ENTRIES", "0")
+		require.ErrorContains(t, FromEnv(&Config{}), "RAFT_AUDIT_LOG_MAX_ENTRIES")
+	})
+}
+
 func TestEnvironmentHNSWVisitedListPoolMaxSize(t *testing.T) {
 	factors := []struct {
 		name        string
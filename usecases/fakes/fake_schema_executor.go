@@ -53,6 +53,21 @@ func (m *MockSchemaExecutor) DeleteReplicaFromShard(class string, shard string,
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) StartShardSplit(class string, shard string) error {
+	args := m.Called(class, shard)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) CommitShardSplit(class string, shard string, target string) error {
+	args := m.Called(class, shard, target)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) AbortShardSplit(class string, shard string, target string) error {
+	args := m.Called(class, shard, target)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) LoadShard(class string, shard string) {
 	m.Called(class, shard)
 }
@@ -159,7 +159,7 @@ func (p *GenerateProvider) getProperties(result search.Result,
 				textProperties[property] = p.marshalInput(value)
 			case schema.DataTypeObject, schema.DataTypeObjectArray:
 				textProperties[property] = p.marshalInput(value)
-			case schema.DataTypePhoneNumber, schema.DataTypeGeoCoordinates:
+			case schema.DataTypePhoneNumber, schema.DataTypeGeoCoordinates, schema.DataTypeSparseVector:
 				textProperties[property] = p.marshalInput(value)
 			case schema.DataTypeCRef:
 				textProperties[property] = p.marshalInput(value)
@@ -509,7 +509,7 @@ func (m *dummyBackupModuleWithAltNames) HomeDir(backupID, overrideBucket, overri
 	return ""
 }
 
-func (m *dummyBackupModuleWithAltNames) AllBackups(context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+func (m *dummyBackupModuleWithAltNames) AllBackups(context.Context, string, string) ([]*backup.DistributedBackupDescriptor, error) {
 	return nil, nil
 }
 
@@ -529,6 +529,10 @@ func (m *dummyBackupModuleWithAltNames) Read(ctx context.Context, backupID, key,
 	return 0, nil
 }
 
+func (m *dummyBackupModuleWithAltNames) DeleteBackup(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return nil
+}
+
 func (m *dummyBackupModuleWithAltNames) SourceDataPath() string {
 	return ""
 }
@@ -101,6 +101,9 @@ type PrometheusMetrics struct {
 	VectorIndexTombstoneCycleStart     *prometheus.GaugeVec
 	VectorIndexTombstoneCycleEnd       *prometheus.GaugeVec
 	VectorIndexTombstoneCycleProgress  *prometheus.GaugeVec
+	VectorIndexSnapshotLastCreated     *prometheus.GaugeVec
+	VectorIndexSnapshotAge             *prometheus.GaugeVec
+	VectorIndexSnapshotsCreated        *prometheus.CounterVec
 	VectorIndexOperations              *prometheus.GaugeVec
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
@@ -338,6 +341,9 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.VectorIndexTombstoneCycleStart.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCycleEnd.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCycleProgress.DeletePartialMatch(labels)
+	pm.VectorIndexSnapshotLastCreated.DeletePartialMatch(labels)
+	pm.VectorIndexSnapshotAge.DeletePartialMatch(labels)
+	pm.VectorIndexSnapshotsCreated.DeletePartialMatch(labels)
 	pm.VectorIndexOperations.DeletePartialMatch(labels)
 	pm.VectorIndexMaintenanceDurations.DeletePartialMatch(labels)
 	pm.VectorIndexDurations.DeletePartialMatch(labels)
@@ -637,6 +643,18 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_tombstone_cycle_progress",
 			Help: "A ratio (percentage) of the progress of the current tombstone cleanup cycle. 0 indicates the very beginning, 1 is a complete cycle.",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexSnapshotLastCreated: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_snapshot_last_created",
+			Help: "Unix epoch timestamp of the commit log state contained in the last hnsw snapshot. 0 indicates that no snapshot exists",
+		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexSnapshotAge: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_snapshot_age_seconds",
+			Help: "Age in seconds of the commit log state contained in the last hnsw snapshot. A negative value indicates that no snapshot exists",
+		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexSnapshotsCreated: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_snapshots_created_total",
+			Help: "Total number of hnsw snapshots created, by trigger (startup, periodic, manual)",
+		}, []string{"class_name", "shard_name", "target_vector", "trigger"}),
 		VectorIndexOperations: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_index_operations",
 			Help: "Total number of mutating operations on the vector index",
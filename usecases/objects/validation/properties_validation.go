@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/sparsevector"
 )
 
 const (
@@ -292,6 +293,11 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		if err != nil {
 			return nil, fmt.Errorf("invalid phoneNumber property '%s' on class '%s': %w", propertyName, className, err)
 		}
+	case schema.DataTypeSparseVector:
+		data, err = sparsevector.Parse(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sparseVector property '%s' on class '%s': %w", propertyName, className, err)
+		}
 	case schema.DataTypeBlob:
 		data, err = blobVal(pv)
 		if err != nil {
@@ -60,6 +60,12 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+		// missed, if set, is called with the names of the nodes which did not
+		// apply a write that succeeded on at least one other replica
+		missed func(nodes []string)
+		// acked, if set, is called with the names of the nodes which
+		// acknowledged a write carrying a write concern
+		acked func(nodes []string)
 	}
 )
 
@@ -257,13 +263,22 @@ func (c *coordinator[T, R]) Push(ctx context.Context,
 		return nil, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
 
-	level := writeRoutingPlan.IntConsistencyLevel
+	// a write concern carried by ctx overrides the consistency level and the timeout
+	wc := WriteConcernFromContext(ctx)
+	level, err := wc.level(writeRoutingPlan.IntConsistencyLevel, len(writeRoutingPlan.Replicas()))
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+	}
+	timeout := wc.timeout()
+	deadline := time.Now().Add(timeout)
+	ask, com, missedHosts := c.trackMissed(ask, com)
+	com, ackedHosts := c.trackAcked(com, wc)
 
 	//nolint:govet // we expressely don't want to cancel that context as the timeout will take care of it
-	ctxWithTimeout, _ := context.WithTimeout(context.Background(), 20*time.Second)
+	ctxWithTimeout, _ := context.WithTimeout(context.Background(), timeout)
 	c.log.WithFields(logrus.Fields{
 		"action":   "coordinator_push",
-		"duration": 20 * time.Second,
+		"duration": timeout,
 		"level":    level,
 	}).Debug("context.WithTimeout")
 
@@ -287,11 +302,19 @@ func (c *coordinator[T, R]) Push(ctx context.Context,
 			}
 
 			c.metrics.ObserveWriteDuration(time.Since(start))
+
+			if successful > 0 && successful < numReplicas {
+				c.reportMissed(writeRoutingPlan.Replicas(), missedHosts())
+			}
 		}
 	}()
 
 	nodeCh := c.broadcast(ctxWithTimeout, writeRoutingPlan.HostAddresses(), ask, level)
 	commitCh := c.commitAll(context.Background(), nodeCh, com, callback)
+	if wc != nil && wc.Timeout > 0 {
+		// commits go on in the background, but the caller only waits until the deadline
+		commitCh = untilDeadline(commitCh, time.Until(deadline), c.log)
+	}
 
 	// if there are additional hosts, we do a "best effort" write to them
 	// where we don't wait for a response because they are not part of the
@@ -301,7 +324,101 @@ func (c *coordinator[T, R]) Push(ctx context.Context,
 		c.commitAll(context.Background(), additionalHostsBroadcast, com, nil)
 	}
 
-	return c.read(level, commitCh, onResult, onFlatten, batchSize), nil
+	res := c.read(level, commitCh, onResult, onFlatten, batchSize)
+	if wc != nil {
+		nodes := nodesOf(writeRoutingPlan.Replicas(), ackedHosts())
+		wc.acknowledged(c.Class, c.Shard, nodes)
+		if c.acked != nil {
+			c.acked(nodes)
+		}
+	}
+	return res, nil
+}
+
+// trackAcked wraps the commit phase of a write so that the hosts which
+// acknowledged it can be recorded in the request's write concern
+func (c *coordinator[T, R]) trackAcked(com commitOp[T], wc *WriteConcern,
+) (commitOp[T], func() map[string]struct{}) {
+	if wc == nil {
+		return com, func() map[string]struct{} { return nil }
+	}
+	var mu sync.Mutex
+	acked := make(map[string]struct{})
+	trackedCom := func(ctx context.Context, host, requestID string) (T, error) {
+		resp, err := com(ctx, host, requestID)
+		if err == nil {
+			mu.Lock()
+			acked[host] = struct{}{}
+			mu.Unlock()
+		}
+		return resp, err
+	}
+	hosts := func() map[string]struct{} {
+		mu.Lock()
+		defer mu.Unlock()
+		res := make(map[string]struct{}, len(acked))
+		for h := range acked {
+			res[h] = struct{}{}
+		}
+		return res
+	}
+	return trackedCom, hosts
+}
+
+// trackMissed wraps both phases of a write so that the hosts which failed
+// either of them can be reported to c.missed once all commits are done.
+func (c *coordinator[T, R]) trackMissed(ask readyOp, com commitOp[T],
+) (readyOp, commitOp[T], func() map[string]struct{}) {
+	if c.missed == nil {
+		return ask, com, func() map[string]struct{} { return nil }
+	}
+	var mu sync.Mutex
+	failed := make(map[string]struct{})
+	fail := func(host string) {
+		mu.Lock()
+		defer mu.Unlock()
+		failed[host] = struct{}{}
+	}
+	trackedAsk := func(ctx context.Context, host, requestID string) error {
+		err := ask(ctx, host, requestID)
+		if err != nil {
+			fail(host)
+		}
+		return err
+	}
+	trackedCom := func(ctx context.Context, host, requestID string) (T, error) {
+		resp, err := com(ctx, host, requestID)
+		if err != nil {
+			fail(host)
+		}
+		return resp, err
+	}
+	hosts := func() map[string]struct{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return failed
+	}
+	return trackedAsk, trackedCom, hosts
+}
+
+func (c *coordinator[T, R]) reportMissed(replicas []types.Replica, hosts map[string]struct{}) {
+	if c.missed == nil || len(hosts) == 0 {
+		return
+	}
+	if nodes := nodesOf(replicas, hosts); len(nodes) > 0 {
+		c.missed(nodes)
+	}
+}
+
+// nodesOf returns the names of the replicas whose host is in hosts
+func nodesOf(replicas []types.Replica, hosts map[string]struct{}) []string {
+	nodes := make([]string, 0, len(hosts))
+	for _, r := range replicas {
+		if _, ok := hosts[r.HostAddr]; ok {
+			nodes = append(nodes, r.NodeName)
+		}
+	}
+	return nodes
 }
 
 // Pull data from replica depending on consistency level, trying to reach level successful calls
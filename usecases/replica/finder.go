@@ -128,7 +128,8 @@ func (f *Finder) GetOne(ctx context.Context,
 			return findOneReply{host, x.Version, r, x.UpdateTime, true}, err
 		}
 	}
-	replyCh, level, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	directCandidate, l := f.sessionReplica(ctx, l, shard, id)
+	replyCh, level, err := c.Pull(ctx, l, op, directCandidate, 20*time.Second)
 	if err != nil {
 		f.log.WithField("op", "pull.one").Error(err)
 		return nil, fmt.Errorf("%s %q: %w", MsgCLevel, l, ErrReplicas)
@@ -255,7 +256,8 @@ func (f *Finder) Exists(ctx context.Context,
 		}
 		return existReply{host, x}, err
 	}
-	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	directCandidate, l := f.sessionReplica(ctx, l, shard, id)
+	replyCh, state, err := c.Pull(ctx, l, op, directCandidate, 20*time.Second)
 	if err != nil {
 		f.log.WithField("op", "pull.exist").Error(err)
 		return false, fmt.Errorf("%s %q: %w", MsgCLevel, l, ErrReplicas)
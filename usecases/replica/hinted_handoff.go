@@ -0,0 +1,350 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// hintsReplayBatchSize is the number of hints read from the store at once
+const hintsReplayBatchSize = 100
+
+// Hint records that a replica missed a write to an object which
+// succeeded on other replicas of the same shard.
+//
+// Hints do not hold the write itself: replaying a hint brings the object on
+// the replica up to date with the most recent version held by the others.
+type Hint struct {
+	Class   string
+	Shard   string
+	Node    string // node which missed the write
+	ID      strfmt.UUID
+	Created int64 // unix millis
+}
+
+// HintStore persists hints until they are replayed or expire
+type HintStore interface {
+	// Add stores hints and returns how many of them were stored.
+	// Hints which exceed the capacity of the store are dropped.
+	Add(hints []Hint) (int, error)
+	// Hints returns up to limit hints of class ordered by node, shard and id,
+	// starting right after the given hint or from the first one if after is nil.
+	Hints(class string, after *Hint, limit int) ([]Hint, error)
+	// Delete removes hints from the store
+	Delete(hints []Hint) error
+	// Expire removes the hints of all classes created before the given unix
+	// millis and returns how many were removed.
+	Expire(createdBefore int64) (int, error)
+	// DeleteClass removes all hints of class and returns how many were removed.
+	DeleteClass(class string) (int, error)
+	// Len returns the number of stored hints
+	Len() int
+}
+
+// HintedHandoff records writes missed by unavailable replicas so that
+// they can be replayed once the replicas are back
+type HintedHandoff struct {
+	store   HintStore
+	ttl     time.Duration
+	metrics *Metrics
+	log     logrus.FieldLogger
+	stopped atomic.Bool
+}
+
+func NewHintedHandoff(store HintStore, ttl time.Duration,
+	promMetrics *monitoring.PrometheusMetrics, l logrus.FieldLogger,
+) (*HintedHandoff, error) {
+	metrics, err := NewMetrics(promMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("create metrics: %w", err)
+	}
+	h := &HintedHandoff{store: store, ttl: ttl, metrics: metrics, log: l}
+	h.metrics.SetHintsPending(store.Len())
+	return h, nil
+}
+
+// Stop stops recording hints, so that the store can be closed while writes
+// are still being coordinated. Hints of writes missed afterwards are dropped.
+func (h *HintedHandoff) Stop() {
+	h.stopped.Store(true)
+}
+
+// Expire removes the hints older than the TTL of all classes. Unlike
+// ReplayHints, this includes the hints of classes which do not exist anymore.
+func (h *HintedHandoff) Expire() error {
+	n, err := h.store.Expire(time.Now().Add(-h.ttl).UnixMilli())
+	h.metrics.AddHintsExpired(n)
+	h.metrics.SetHintsPending(h.store.Len())
+	if err != nil {
+		return fmt.Errorf("expire hints: %w", err)
+	}
+	return nil
+}
+
+// DropClass removes all hints of a class which has been dropped
+func (h *HintedHandoff) DropClass(class string) error {
+	_, err := h.store.DeleteClass(class)
+	h.metrics.SetHintsPending(h.store.Len())
+	if err != nil {
+		return fmt.Errorf("delete hints of class %q: %w", class, err)
+	}
+	return nil
+}
+
+// record stores a hint for every node and object id of a missed write
+func (h *HintedHandoff) record(class, shard string, nodes []string, ids []strfmt.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+	if h.stopped.Load() {
+		h.metrics.AddHintsDropped(len(nodes) * len(ids))
+		return
+	}
+	now := time.Now().UnixMilli()
+	hints := make([]Hint, 0, len(nodes)*len(ids))
+	for _, node := range nodes {
+		for _, id := range ids {
+			hints = append(hints, Hint{Class: class, Shard: shard, Node: node, ID: id, Created: now})
+		}
+	}
+	n, err := h.store.Add(hints)
+	if err != nil {
+		h.log.WithField("action", "hinted_handoff_record").WithField("class", class).
+			WithField("shard", shard).WithField("nodes", nodes).Error(err)
+	}
+	h.metrics.AddHintsStored(n)
+	h.metrics.AddHintsDropped(len(hints) - n)
+	h.metrics.SetHintsPending(h.store.Len())
+}
+
+// remove deletes hints from the store, logging failures since the
+// hints will be replayed again on the next run
+func (h *HintedHandoff) remove(hints []Hint) bool {
+	if len(hints) == 0 {
+		return true
+	}
+	if err := h.store.Delete(hints); err != nil {
+		h.log.WithField("action", "hinted_handoff_delete").Error(err)
+		return false
+	}
+	return true
+}
+
+// missed returns the hook used by the write coordinator to report the
+// replicas which missed a write of the objects returned by ids, or nil
+// if hinted handoff is disabled
+func (r *Replicator) missed(shard string, ids func() []strfmt.UUID) func(nodes []string) {
+	if r.hints == nil {
+		return nil
+	}
+	return func(nodes []string) {
+		r.hints.record(r.class, shard, nodes, ids())
+	}
+}
+
+// ReplayHints replays the hints stored for the class of the replicator.
+// Expired hints are dropped, while hints for replicas which cannot be
+// reached are kept for the next run.
+func (r *Replicator) ReplayHints(ctx context.Context) error {
+	if r.hints == nil {
+		return nil
+	}
+	defer func() { r.hints.metrics.SetHintsPending(r.hints.store.Len()) }()
+
+	unreachable := make(map[string]struct{})
+	var after *Hint
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hints, err := r.hints.store.Hints(r.class, after, hintsReplayBatchSize)
+		if err != nil {
+			return fmt.Errorf("read hints: %w", err)
+		}
+		if len(hints) == 0 {
+			return nil
+		}
+		after = &hints[len(hints)-1]
+		last := len(hints) < hintsReplayBatchSize
+
+		// hints are ordered by node and shard, replay them in groups
+		for len(hints) > 0 {
+			n := 1
+			for n < len(hints) && hints[n].Node == hints[0].Node && hints[n].Shard == hints[0].Shard {
+				n++
+			}
+			node, shard := hints[0].Node, hints[0].Shard
+			expired, pending := r.hints.expired(hints[:n])
+			hints = hints[n:]
+
+			if r.hints.remove(expired) {
+				r.hints.metrics.AddHintsExpired(len(expired))
+			}
+			if _, ok := unreachable[node]; ok || len(pending) == 0 {
+				continue
+			}
+			done, err := r.replayHints(ctx, node, shard, pending)
+			if err != nil {
+				unreachable[node] = struct{}{}
+				r.log.WithField("action", "hinted_handoff_replay").WithField("class", r.class).
+					WithField("shard", shard).WithField("node", node).Debug(err)
+			}
+			if r.hints.remove(done) {
+				r.hints.metrics.AddHintsReplayed(len(done))
+			}
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// expired splits hints into the ones older than the TTL and the others
+func (h *HintedHandoff) expired(hints []Hint) (expired, pending []Hint) {
+	deadline := time.Now().Add(-h.ttl).UnixMilli()
+	for _, x := range hints {
+		if x.Created < deadline {
+			expired = append(expired, x)
+		} else {
+			pending = append(pending, x)
+		}
+	}
+	return expired, pending
+}
+
+// replayHints brings the objects of hints on node up to date with the most
+// recent versions held by the other replicas of shard. It returns the hints
+// which do not need to be replayed anymore.
+func (r *Replicator) replayHints(ctx context.Context, node, shard string, hints []Hint,
+) ([]Hint, error) {
+	options := r.router.BuildRoutingPlanOptions(shard, shard, types.ConsistencyLevelOne, "")
+	plan, err := r.router.BuildWriteRoutingPlan(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, r.class, shard)
+	}
+	var target string
+	sources := make([]string, 0, len(plan.Replicas()))
+	for _, replica := range plan.Replicas() {
+		if replica.NodeName == node {
+			target = replica.HostAddr
+		} else {
+			sources = append(sources, replica.HostAddr)
+		}
+	}
+	if target == "" {
+		// the node is down or not a replica anymore, in the latter
+		// case its hints are dropped once they expire
+		return nil, fmt.Errorf("node %q is not an available replica", node)
+	}
+
+	fc := NewFinderClient(r.client)
+	ids := make([]strfmt.UUID, len(hints))
+	for i, x := range hints {
+		ids[i] = x.ID
+	}
+	stale, err := fc.DigestReads(ctx, target, r.class, shard, ids, 0)
+	if err != nil {
+		return nil, fmt.Errorf("digest %q: %w", target, err)
+	}
+
+	// find the replica holding the most recent version of each object
+	latest := make([]types.RepairResponse, len(ids))
+	winners := make([]string, len(ids))
+	reached := false
+	for _, source := range sources {
+		digests, err := fc.DigestReads(ctx, source, r.class, shard, ids, 0)
+		if err != nil {
+			continue
+		}
+		reached = true
+		for i, x := range digests {
+			if x.UpdateTime > latest[i].UpdateTime || winners[i] == "" {
+				latest[i], winners[i] = x, source
+			}
+		}
+	}
+	if !reached {
+		return nil, fmt.Errorf("no replica of shard %q could be reached", shard)
+	}
+
+	done := make([]Hint, 0, len(hints))
+	updates := make([]*objects.VObject, 0, len(hints))
+	pos := make(map[strfmt.UUID]int, len(hints))
+	fetch := make(map[string][]int) // source => positions of objects to fetch
+	for i := range hints {
+		x, y := latest[i], stale[i]
+		switch {
+		case x.UpdateTime <= y.UpdateTime:
+			done = append(done, hints[i]) // replica is up to date
+		case x.Deleted:
+			pos[ids[i]] = i
+			updates = append(updates, &objects.VObject{
+				ID:                      ids[i],
+				Deleted:                 true,
+				LastUpdateTimeUnixMilli: x.UpdateTime,
+				StaleUpdateTime:         y.UpdateTime,
+			})
+		default:
+			fetch[winners[i]] = append(fetch[winners[i]], i)
+		}
+	}
+
+	for source, idxs := range fetch {
+		query := make([]strfmt.UUID, len(idxs))
+		for j, i := range idxs {
+			query[j] = ids[i]
+		}
+		objs, err := fc.FullReads(ctx, source, r.class, shard, query)
+		if err != nil {
+			continue // try again on the next run
+		}
+		for j, obj := range objs {
+			i := idxs[j]
+			if obj.Object == nil || obj.UpdateTime() != latest[i].UpdateTime {
+				continue // changed in the meantime, try again on the next run
+			}
+			pos[ids[i]] = i
+			updates = append(updates, &objects.VObject{
+				ID:                      ids[i],
+				LastUpdateTimeUnixMilli: obj.UpdateTime(),
+				LatestObject:            &obj.Object.Object,
+				Vector:                  obj.Object.Vector,
+				Vectors:                 obj.Object.Vectors,
+				MultiVectors:            obj.Object.MultiVectors,
+				StaleUpdateTime:         stale[i].UpdateTime,
+			})
+		}
+	}
+	if len(updates) == 0 {
+		return done, nil
+	}
+
+	if _, err := fc.Overwrite(ctx, target, r.class, shard, updates); err != nil {
+		return done, fmt.Errorf("overwrite %q: %w", target, err)
+	}
+	// objects are either overwritten or changed on the replica in the
+	// meantime, conflicts are left to read repair and async replication
+	for _, u := range updates {
+		done = append(done, hints[pos[u.ID]])
+	}
+	return done, nil
+}
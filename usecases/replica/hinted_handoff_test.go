@@ -0,0 +1,314 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// fakeHintStore is an in-memory replica.HintStore
+type fakeHintStore struct {
+	sync.Mutex
+	hints []replica.Hint
+}
+
+func (s *fakeHintStore) Add(hints []replica.Hint) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.hints = append(s.hints, hints...)
+	sort.Slice(s.hints, func(i, j int) bool {
+		a, b := s.hints[i], s.hints[j]
+		if a.Node != b.Node {
+			return a.Node < b.Node
+		}
+		if a.Shard != b.Shard {
+			return a.Shard < b.Shard
+		}
+		return a.ID < b.ID
+	})
+	return len(hints), nil
+}
+
+func (s *fakeHintStore) Hints(class string, after *replica.Hint, limit int) ([]replica.Hint, error) {
+	s.Lock()
+	defer s.Unlock()
+	var xs []replica.Hint
+	for _, h := range s.hints {
+		if h.Class == class && (after == nil || after.Node < h.Node ||
+			after.Node == h.Node && (after.Shard < h.Shard || after.Shard == h.Shard && after.ID < h.ID)) {
+			xs = append(xs, h)
+		}
+	}
+	if len(xs) > limit {
+		xs = xs[:limit]
+	}
+	return xs, nil
+}
+
+func (s *fakeHintStore) Delete(hints []replica.Hint) error {
+	s.Lock()
+	defer s.Unlock()
+	for _, h := range hints {
+		for i, x := range s.hints {
+			if x == h {
+				s.hints = append(s.hints[:i], s.hints[i+1:]...)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (s *fakeHintStore) Expire(createdBefore int64) (int, error) {
+	return s.deleteMatching(func(h replica.Hint) bool { return h.Created < createdBefore }), nil
+}
+
+func (s *fakeHintStore) DeleteClass(class string) (int, error) {
+	return s.deleteMatching(func(h replica.Hint) bool { return h.Class == class }), nil
+}
+
+func (s *fakeHintStore) deleteMatching(match func(replica.Hint) bool) int {
+	s.Lock()
+	defer s.Unlock()
+	kept := s.hints[:0]
+	for _, h := range s.hints {
+		if !match(h) {
+			kept = append(kept, h)
+		}
+	}
+	n := len(s.hints) - len(kept)
+	s.hints = kept
+	return n
+}
+
+func (s *fakeHintStore) Len() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.hints)
+}
+
+func (s *fakeHintStore) all() []replica.Hint {
+	s.Lock()
+	defer s.Unlock()
+	return append([]replica.Hint(nil), s.hints...)
+}
+
+func newFakeHintedHandoff(t *testing.T, f *fakeFactory, store replica.HintStore) *replica.HintedHandoff {
+	hints, err := replica.NewHintedHandoff(store, time.Hour, monitoring.GetMetrics(), f.log)
+	require.Nil(t, err)
+	return hints
+}
+
+func TestReplicatorRecordsHints(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		id    = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		obj   = object(id, 5)
+		ctx   = context.Background()
+	)
+
+	t.Run("PartialWrite", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		f.WClient.On("PutObject", mock.Anything, "A", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, nil)
+		f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, errAny)
+		f.WClient.On("PutObject", mock.Anything, "C", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, nil)
+		f.WClient.On("Commit", mock.Anything, "A", cls, shard, anyVal, anyVal).Return(nil)
+		f.WClient.On("Commit", mock.Anything, "C", cls, shard, anyVal, anyVal).Return(errAny)
+
+		require.Nil(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelOne, 123))
+		assert.Eventually(t, func() bool { return store.Len() == 2 }, time.Second, 10*time.Millisecond)
+		hints := store.all()
+		require.Len(t, hints, 2)
+		for i, node := range []string{"B", "C"} {
+			assert.Equal(t, cls, hints[i].Class)
+			assert.Equal(t, shard, hints[i].Shard)
+			assert.Equal(t, node, hints[i].Node)
+			assert.Equal(t, id, hints[i].ID)
+		}
+	})
+
+	t.Run("Stopped", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		f.Hints.Stop()
+		rep := f.newReplicator()
+
+		f.WClient.On("PutObject", mock.Anything, "A", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, nil)
+		f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, errAny)
+		f.WClient.On("PutObject", mock.Anything, "C", cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, nil)
+		f.WClient.On("Commit", mock.Anything, "A", cls, shard, anyVal, anyVal).Return(nil)
+		f.WClient.On("Commit", mock.Anything, "C", cls, shard, anyVal, anyVal).Return(errAny)
+
+		require.Nil(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelOne, 123))
+		assert.Never(t, func() bool { return store.Len() > 0 }, 100*time.Millisecond, 10*time.Millisecond,
+			"a stopped hinted handoff does not record hints")
+	})
+
+	t.Run("FailedWrite", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		for _, n := range nodes {
+			f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, errAny)
+			f.WClient.On("Abort", mock.Anything, n, cls, shard, anyVal).Return(replica.SimpleResponse{}, nil)
+		}
+
+		require.NotNil(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelOne, 123))
+		assert.Equal(t, 0, store.Len(), "writes which failed everywhere leave no hints")
+	})
+}
+
+func TestReplicatorReplayHints(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B"}
+		id1   = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		id2   = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+		ids   = []strfmt.UUID{id1, id2}
+		ctx   = context.Background()
+		now   = time.Now().UnixMilli()
+	)
+	newHints := func() []replica.Hint {
+		return []replica.Hint{
+			{Class: cls, Shard: shard, Node: "B", ID: id1, Created: now},
+			{Class: cls, Shard: shard, Node: "B", ID: id2, Created: now},
+		}
+	}
+
+	t.Run("Replay", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		store.Add(newHints())
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		// id1 was updated and id2 deleted while B was unavailable
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, ids, 0).Return([]types.RepairResponse{
+			{ID: id1.String(), UpdateTime: 3},
+			{ID: id2.String(), UpdateTime: 3},
+		}, nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, ids, 0).Return([]types.RepairResponse{
+			{ID: id1.String(), UpdateTime: 5},
+			{ID: id2.String(), UpdateTime: 6, Deleted: true},
+		}, nil)
+		latest := replica.Replica{ID: id1, Object: object(id1, 5)}
+		f.RClient.EXPECT().FetchObjects(anyVal, "A", cls, shard, []strfmt.UUID{id1}).
+			Return([]replica.Replica{latest}, nil)
+		f.RClient.EXPECT().OverwriteObjects(anyVal, "B", cls, shard, []*objects.VObject{
+			{ID: id2, Deleted: true, LastUpdateTimeUnixMilli: 6, StaleUpdateTime: 3},
+			{ID: id1, LastUpdateTimeUnixMilli: 5, LatestObject: &latest.Object.Object, StaleUpdateTime: 3},
+		}).Return(nil, nil)
+
+		require.Nil(t, rep.ReplayHints(ctx))
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("UpToDate", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		store.Add(newHints())
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		digests := []types.RepairResponse{{ID: id1.String(), UpdateTime: 5}, {ID: id2.String(), UpdateTime: 5}}
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, ids, 0).Return(digests, nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, ids, 0).Return(digests, nil)
+
+		require.Nil(t, rep.ReplayHints(ctx))
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("UnreachableReplica", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		store.Add(newHints())
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, ids, 0).Return(nil, errAny)
+
+		require.Nil(t, rep.ReplayHints(ctx))
+		assert.Equal(t, newHints(), store.all(), "hints are kept until the replica is back")
+	})
+
+	t.Run("ExpiredHints", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		store := &fakeHintStore{}
+		hints := newHints()
+		for i := range hints {
+			hints[i].Created = now - 2*time.Hour.Milliseconds()
+		}
+		store.Add(hints)
+		f.Hints = newFakeHintedHandoff(t, f, store)
+		rep := f.newReplicator()
+
+		require.Nil(t, rep.ReplayHints(ctx))
+		assert.Equal(t, 0, store.Len())
+	})
+}
+
+func TestHintedHandoffCleanup(t *testing.T) {
+	var (
+		shard = "SH1"
+		id    = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		now   = time.Now().UnixMilli()
+		old   = time.Now().Add(-2 * time.Hour).UnixMilli()
+	)
+	f := newFakeFactory(t, "C1", shard, []string{"A", "B"}, false)
+	store := &fakeHintStore{}
+	store.Add([]replica.Hint{
+		{Class: "C1", Shard: shard, Node: "B", ID: id, Created: now},
+		{Class: "C1", Shard: shard, Node: "A", ID: id, Created: old},
+		{Class: "Dropped", Shard: shard, Node: "B", ID: id, Created: now},
+		{Class: "Gone", Shard: shard, Node: "B", ID: id, Created: old},
+	})
+	hints := newFakeHintedHandoff(t, f, store)
+
+	t.Run("Expire", func(t *testing.T) {
+		require.NoError(t, hints.Expire())
+		assert.ElementsMatch(t, []replica.Hint{
+			{Class: "C1", Shard: shard, Node: "B", ID: id, Created: now},
+			{Class: "Dropped", Shard: shard, Node: "B", ID: id, Created: now},
+		}, store.all())
+	})
+
+	t.Run("DropClass", func(t *testing.T) {
+		require.NoError(t, hints.DropClass("Dropped"))
+		assert.Equal(t, []replica.Hint{
+			{Class: "C1", Shard: shard, Node: "B", ID: id, Created: now},
+		}, store.all())
+	})
+}
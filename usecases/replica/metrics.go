@@ -44,6 +44,13 @@ type Metrics struct {
 	readRepairCount   prometheus.Counter
 	readRepairFailure prometheus.Counter
 
+	// Hinted Handoff Metrics
+	hintsStored   prometheus.Counter
+	hintsReplayed prometheus.Counter
+	hintsExpired  prometheus.Counter
+	hintsDropped  prometheus.Counter
+	hintsPending  prometheus.Gauge
+
 	// Histograms
 	writeDuration      prometheus.Histogram
 	readDuration       prometheus.Histogram
@@ -110,6 +117,33 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) (*Metrics, error) {
 		return nil, err
 	}
 
+	// Hinted handoff metrics
+	m.hintsStored, err = newCounter(prom.Registerer,
+		"replication_hints_stored", "Count of hints stored for writes missed by a replica")
+	if err != nil {
+		return nil, err
+	}
+	m.hintsReplayed, err = newCounter(prom.Registerer,
+		"replication_hints_replayed", "Count of hints replayed to the replica which missed the write")
+	if err != nil {
+		return nil, err
+	}
+	m.hintsExpired, err = newCounter(prom.Registerer,
+		"replication_hints_expired", "Count of hints dropped because their TTL passed before they could be replayed")
+	if err != nil {
+		return nil, err
+	}
+	m.hintsDropped, err = newCounter(prom.Registerer,
+		"replication_hints_dropped", "Count of hints dropped because the hint store was full")
+	if err != nil {
+		return nil, err
+	}
+	m.hintsPending, err = newGauge(prom.Registerer,
+		"replication_hints_pending", "Number of hints stored on this node waiting to be replayed")
+	if err != nil {
+		return nil, err
+	}
+
 	// Histograms
 	m.writeDuration, err = newHistogram(prom.Registerer,
 		"replication_coordinator_writes_duration_seconds", "Duration in seconds of write operations to replicas", writeDurationBuckets)
@@ -149,6 +183,25 @@ func newCounter(reg prometheus.Registerer, name, help string) (prometheus.Counte
 	return c, nil
 }
 
+func newGauge(reg prometheus.Registerer, name, help string) (prometheus.Gauge, error) {
+	g := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "weaviate",
+		Name:      name,
+		Help:      help,
+	})
+	if err := reg.Register(g); err != nil {
+		var e prometheus.AlreadyRegisteredError
+		if errors.As(err, &e) {
+			if gauge, ok := e.ExistingCollector.(prometheus.Gauge); ok {
+				return gauge, nil
+			}
+			return nil, fmt.Errorf("metric %s already registered but not as a Gauge", name)
+		}
+		return nil, err
+	}
+	return g, nil
+}
+
 func newHistogram(reg prometheus.Registerer, name, help string, buckets []float64) (prometheus.Histogram, error) {
 	h := prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: "weaviate",
@@ -223,6 +276,38 @@ func (m *Metrics) IncReadRepairFailure() {
 	}
 }
 
+// Hinted handoff methods
+
+func (m *Metrics) AddHintsStored(n int) {
+	if m.monitoring {
+		m.hintsStored.Add(float64(n))
+	}
+}
+
+func (m *Metrics) AddHintsReplayed(n int) {
+	if m.monitoring {
+		m.hintsReplayed.Add(float64(n))
+	}
+}
+
+func (m *Metrics) AddHintsExpired(n int) {
+	if m.monitoring {
+		m.hintsExpired.Add(float64(n))
+	}
+}
+
+func (m *Metrics) AddHintsDropped(n int) {
+	if m.monitoring {
+		m.hintsDropped.Add(float64(n))
+	}
+}
+
+func (m *Metrics) SetHintsPending(n int) {
+	if m.monitoring {
+		m.hintsPending.Set(float64(n))
+	}
+}
+
 // Duration observation methods
 
 func (m *Metrics) ObserveWriteDuration(d time.Duration) {
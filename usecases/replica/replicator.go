@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -54,6 +55,7 @@ type Replicator struct {
 	nodeName       string
 	router         types.Router
 	client         Client
+	hints          *HintedHandoff // nil if hinted handoff is disabled
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	*Finder
@@ -65,6 +67,7 @@ func NewReplicator(className string,
 	nodeName string,
 	getDeletionStrategy func() string,
 	client Client,
+	hints *HintedHandoff,
 	promMetrics *monitoring.PrometheusMetrics,
 	l logrus.FieldLogger,
 ) (*Replicator, error) {
@@ -78,6 +81,7 @@ func NewReplicator(className string,
 		nodeName: nodeName,
 		router:   router,
 		client:   client,
+		hints:    hints,
 		log:      l,
 		Finder: NewFinder(
 			className,
@@ -99,6 +103,7 @@ func (r *Replicator) PutObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := NewWriteCoordinator[SimpleResponse, error](r.client, r.router, r.metrics, r.class, shard, r.requestID(opPutObject), r.log)
+	coord.missed = r.missed(shard, func() []strfmt.UUID { return []strfmt.UUID{obj.ID()} })
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj, schemaVersion)
 		if err == nil {
@@ -121,6 +126,7 @@ func (r *Replicator) PutObject(ctx context.Context,
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
 		return err
 	}
+	r.observe(ctx, shard, obj.LastUpdateTimeUnix())
 	return nil
 }
 
@@ -131,6 +137,7 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := NewWriteCoordinator[SimpleResponse, error](r.client, r.router, r.metrics, r.class, shard, r.requestID(opMergeObject), r.log)
+	coord.missed = r.missed(shard, func() []strfmt.UUID { return []strfmt.UUID{doc.ID} })
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
@@ -156,6 +163,7 @@ func (r *Replicator) MergeObject(ctx context.Context,
 		}
 		return err
 	}
+	r.observe(ctx, shard, doc.UpdateTime)
 	return nil
 }
 
@@ -166,7 +174,9 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	l types.ConsistencyLevel,
 	schemaVersion uint64,
 ) error {
+	start := time.Now()
 	coord := NewWriteCoordinator[SimpleResponse, error](r.client, r.router, r.metrics, r.class, shard, r.requestID(opDeleteObject), r.log)
+	coord.missed = r.missed(shard, func() []strfmt.UUID { return []strfmt.UUID{id} })
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, deletionTime, schemaVersion)
 		if err == nil {
@@ -188,6 +198,7 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 			WithField("shard", shard).WithField("uuid", id).Error(err)
 		return err
 	}
+	r.observe(ctx, shard, deletionUpdateTime(deletionTime, start))
 	return nil
 }
 
@@ -198,6 +209,16 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	schemaVersion uint64,
 ) []error {
 	coord := NewWriteCoordinator[SimpleResponse, error](r.client, r.router, r.metrics, r.class, shard, r.requestID(opPutObjects), r.log)
+	coord.missed = r.missed(shard, func() []strfmt.UUID {
+		ids := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID()
+		}
+		return ids
+	})
+	if wc := WriteConcernFromContext(ctx); wc != nil {
+		coord.acked = func(nodes []string) { wc.objectsAcknowledged(r.class, objs, nodes) }
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs, schemaVersion)
 		if err == nil {
@@ -223,6 +244,13 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
+	var updateTime int64
+	for i, err := range rs {
+		if err == nil && i < len(objs) {
+			updateTime = max(updateTime, objs[i].LastUpdateTimeUnix())
+		}
+	}
+	r.observe(ctx, shard, updateTime)
 	return rs
 }
 
@@ -234,7 +262,11 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 	l types.ConsistencyLevel,
 	schemaVersion uint64,
 ) []objects.BatchSimpleObject {
+	start := time.Now()
 	coord := NewWriteCoordinator[DeleteBatchResponse, objects.BatchSimpleObject](r.client, r.router, r.metrics, r.class, shard, r.requestID(opDeleteObjects), r.log)
+	if !dryRun {
+		coord.missed = r.missed(shard, func() []strfmt.UUID { return uuids })
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObjects(ctx, host, r.class, shard, requestID, uuids, deletionTime, dryRun, schemaVersion)
 		if err == nil {
@@ -271,6 +303,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		r.log.WithField("op", "put.deletes").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
+	if !dryRun && slices.ContainsFunc(rs, func(x objects.BatchSimpleObject) bool { return x.Err == nil }) {
+		r.observe(ctx, shard, deletionUpdateTime(deletionTime, start))
+	}
 	return rs
 }
 
@@ -280,7 +315,18 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	l types.ConsistencyLevel,
 	schemaVersion uint64,
 ) []error {
+	// replicas stamp reference updates with their own clock
+	start := time.Now()
 	coord := NewWriteCoordinator[SimpleResponse, error](r.client, r.router, r.metrics, r.class, shard, r.requestID(opAddReferences), r.log)
+	coord.missed = r.missed(shard, func() []strfmt.UUID {
+		ids := make([]strfmt.UUID, 0, len(refs))
+		for _, ref := range refs {
+			if ref.From != nil {
+				ids = append(ids, ref.From.TargetID)
+			}
+		}
+		return ids
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.AddReferences(ctx, host, r.class, shard, requestID, refs, schemaVersion)
 		if err == nil {
@@ -306,9 +352,29 @@ func (r *Replicator) AddReferences(ctx context.Context,
 		r.log.WithField("op", "put.refs").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
+	if slices.Contains(rs, nil) {
+		r.observe(ctx, shard, start.UnixMilli())
+	}
 	return rs
 }
 
+// observe records a write to shard in the session carried by ctx, if any
+func (r *Replicator) observe(ctx context.Context, shard string, updateTime int64) {
+	if updateTime > 0 {
+		SessionFromContext(ctx).Observe(r.class, shard, updateTime)
+	}
+}
+
+// deletionUpdateTime returns the update time replicas apply a deletion with.
+// Without an explicit deletion time they use their own clock, so the time
+// the deletion was started at is a lower bound.
+func deletionUpdateTime(deletionTime, start time.Time) int64 {
+	if deletionTime.IsZero() {
+		return start.UnixMilli()
+	}
+	return deletionTime.UnixMilli()
+}
+
 // simpleCommit generate commit function for the coordinator
 func (r *Replicator) simpleCommit(shard string) commitOp[SimpleResponse] {
 	return func(ctx context.Context, host, requestID string) (SimpleResponse, error) {
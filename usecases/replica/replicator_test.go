@@ -867,6 +867,7 @@ type fakeFactory struct {
 	log            *logrus.Logger
 	hook           *test.Hook
 	isMultiTenant  bool
+	Hints          *replica.HintedHandoff
 }
 
 func newFakeFactory(t *testing.T, class, shard string, nodes []string, isMultiTenant bool) *fakeFactory {
@@ -995,6 +996,7 @@ func (f *fakeFactory) newReplicatorWithSourceNode(thisNode string) *replica.Repl
 			replica.RClient
 			replica.WClient
 		}{f.RClient, f.WClient},
+		f.Hints,
 		metrics,
 		f.log,
 	)
@@ -1030,6 +1032,7 @@ func (f *fakeFactory) newReplicator() *replica.Replicator {
 			replica.RClient
 			replica.WClient
 		}{f.RClient, f.WClient},
+		f.Hints,
 		metrics,
 		f.log,
 	)
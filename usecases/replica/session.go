@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/cluster/router/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// SessionTokenHeader is the HTTP header and gRPC metadata key used to
+// exchange session tokens with clients.
+//
+// Writes return a token carrying the update times of the writes per shard,
+// streaming gRPC batches return it in the trailer of the stream. Clients
+// pass the latest token they received on subsequent requests, reads of
+// single objects by id are then served by a replica which caught up with
+// those writes. This gives read-your-writes consistency without reading from
+// a quorum of replicas.
+//
+// Searches, aggregations and listings of objects do not take the session
+// into account, they are served with the consistency level of the request.
+const SessionTokenHeader = "X-Weaviate-Session-Token"
+
+const (
+	// sessionCatchUpTimeout bounds how long a read waits for a replica to
+	// catch up with the writes of its session
+	sessionCatchUpTimeout = 2 * time.Second
+	// the replicas' versions of the object are polled with exponential backoff
+	// between these intervals while waiting
+	sessionPollInitialInterval = 20 * time.Millisecond
+	sessionPollMaxInterval     = 250 * time.Millisecond
+)
+
+type sessionCtxKey struct{}
+
+// Session tracks the latest update time in unix millis of the writes of a
+// client session, per class and shard. It is safe for concurrent use.
+type Session struct {
+	sync.Mutex
+	shards map[string]map[string]int64
+}
+
+// NewSession returns an empty session
+func NewSession() *Session {
+	return &Session{shards: map[string]map[string]int64{}}
+}
+
+// ParseSessionToken decodes a token previously returned by Session.Token.
+// An empty token results in an empty session.
+func ParseSessionToken(token string) (*Session, error) {
+	s := NewSession()
+	if token == "" {
+		return s, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode session token: %w", err)
+	}
+	if err := json.Unmarshal(b, &s.shards); err != nil {
+		return nil, fmt.Errorf("decode session token: %w", err)
+	}
+	if s.shards == nil {
+		s.shards = map[string]map[string]int64{}
+	}
+	return s, nil
+}
+
+// Token encodes the session. It returns an empty string if the session has
+// not observed any write.
+func (s *Session) Token() string {
+	s.Lock()
+	defer s.Unlock()
+
+	if len(s.shards) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(s.shards)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Observe records a write to shard of class which was applied with the
+// given update time
+func (s *Session) Observe(class, shard string, updateTime int64) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	shards, ok := s.shards[class]
+	if !ok {
+		shards = map[string]int64{}
+		s.shards[class] = shards
+	}
+	if updateTime > shards[shard] {
+		shards[shard] = updateTime
+	}
+}
+
+// UpdateTime returns the latest update time of the writes the session
+// observed for shard of class, or 0 if there was none
+func (s *Session) UpdateTime(class, shard string) int64 {
+	if s == nil {
+		return 0
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	return s.shards[class][shard]
+}
+
+// ContextWithSession returns a copy of ctx carrying the session
+func ContextWithSession(ctx context.Context, s *Session) context.Context {
+	return context.WithValue(ctx, sessionCtxKey{}, s)
+}
+
+// SessionFromContext returns the session carried by ctx or nil
+func SessionFromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(sessionCtxKey{}).(*Session)
+	return s
+}
+
+// sessionReplica picks a replica of shard which applied the writes to object
+// id observed by the session carried by ctx. It returns the node of that
+// replica, to be used as direct candidate of the read, and the consistency
+// level to read with. If not all replicas can be asked in time the level is
+// raised to ALL, so that the replicas which applied the writes take part in
+// the read.
+func (f *Finder) sessionReplica(ctx context.Context,
+	l types.ConsistencyLevel, shard string, id strfmt.UUID,
+) (string, types.ConsistencyLevel) {
+	updateTime := SessionFromContext(ctx).UpdateTime(f.class, shard)
+	if updateTime == 0 || l == types.ConsistencyLevelAll {
+		return "", l
+	}
+	options := f.router.BuildRoutingPlanOptions(shard, shard, l, "")
+	plan, err := f.router.BuildReadRoutingPlan(options)
+	if err != nil {
+		// the read itself reports the error
+		return "", l
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sessionCatchUpTimeout)
+	defer cancel()
+	interval := sessionPollInitialInterval
+	for {
+		if node := f.caughtUpReplica(ctx, plan.Replicas(), shard, id, updateTime); node != "" {
+			return node, l
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			f.log.WithField("op", "session.read").WithField("class", f.class).
+				WithField("shard", shard).
+				Debug("no replica caught up with session, reading with consistency level ALL")
+			return "", types.ConsistencyLevelAll
+		case <-timer.C:
+		}
+		interval = min(2*interval, sessionPollMaxInterval)
+	}
+}
+
+// caughtUpReplica returns the node of a replica holding the object in a
+// version at least as recent as the session's writes to it, or "" if there
+// is none yet.
+//
+// The session only knows the latest update time of its writes to the whole
+// shard. A replica whose version of the object is at least as recent has
+// caught up for sure, this includes a delete whose time is kept by a
+// tombstone. Otherwise the session's writes to the object, if any, are older
+// and were applied by at least one replica. Once every replica answered, the
+// one holding the most recent version has caught up.
+//
+// A replica without the object and without a deletion time may have applied
+// a delete of the session, or may lack the object's creation. As long as
+// another replica still holds the object the two cannot be ordered, so no
+// replica is picked and the read eventually falls back to ALL.
+func (f *Finder) caughtUpReplica(ctx context.Context,
+	replicas []types.Replica, shard string, id strfmt.UUID, updateTime int64,
+) string {
+	digests := make([]*types.RepairResponse, len(replicas))
+	eg := enterrors.NewErrorGroupWrapper(f.log)
+	for i, r := range replicas {
+		eg.Go(func() error {
+			xs, err := f.client.DigestReads(ctx, r.HostAddr, f.class, shard, []strfmt.UUID{id}, 0)
+			if err == nil && len(xs) == 1 && xs[0].Err == "" {
+				digests[i] = &xs[0]
+			}
+			return nil
+		}, r.NodeName)
+	}
+	eg.Wait()
+
+	latest, complete := -1, true
+	absent, present := false, false
+	for i, x := range digests {
+		if x == nil {
+			complete = false
+			continue
+		}
+		if x.UpdateTime >= updateTime {
+			return replicas[i].NodeName
+		}
+		if x.UpdateTime == 0 {
+			absent = true
+		} else if !x.Deleted {
+			present = true
+		}
+		if latest == -1 || x.UpdateTime > digests[latest].UpdateTime {
+			latest = i
+		}
+	}
+	if !complete || latest == -1 || (absent && present) {
+		return ""
+	}
+	return replicas[latest].NodeName
+}
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestSessionToken(t *testing.T) {
+	s := replica.NewSession()
+	assert.Equal(t, "", s.Token())
+
+	s.Observe("C1", "S1", 5)
+	s.Observe("C1", "S1", 3)
+	s.Observe("C1", "S2", 7)
+	s.Observe("C2", "S1", 1)
+
+	parsed, err := replica.ParseSessionToken(s.Token())
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), parsed.UpdateTime("C1", "S1"))
+	assert.Equal(t, int64(7), parsed.UpdateTime("C1", "S2"))
+	assert.Equal(t, int64(1), parsed.UpdateTime("C2", "S1"))
+	assert.Equal(t, int64(0), parsed.UpdateTime("C2", "S2"))
+
+	empty, err := replica.ParseSessionToken("")
+	require.Nil(t, err)
+	assert.Equal(t, "", empty.Token())
+
+	_, err = replica.ParseSessionToken("not a token!")
+	assert.NotNil(t, err)
+
+	var none *replica.Session
+	assert.Equal(t, int64(0), none.UpdateTime("C1", "S1"))
+	none.Observe("C1", "S1", 5)
+	assert.Nil(t, replica.SessionFromContext(context.Background()))
+}
+
+func TestReplicatorObservesSession(t *testing.T) {
+	var (
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A", "B"}
+		obj     = &storobj.Object{Object: models.Object{LastUpdateTimeUnix: 42}}
+		session = replica.NewSession()
+		ctx     = replica.ContextWithSession(context.Background(), session)
+	)
+	f := newFakeFactory(t, cls, shard, nodes, false)
+	rep := f.newReplicator()
+	for _, n := range nodes {
+		f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(replica.SimpleResponse{}, nil)
+		f.WClient.On("Commit", mock.Anything, n, cls, shard, anyVal, anyVal).Return(nil)
+	}
+
+	require.Nil(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelAll, 123))
+	assert.Equal(t, int64(42), session.UpdateTime(cls, shard))
+}
+
+func TestFinderGetOneWithSession(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		digest    = func(updateTime int64) []types.RepairResponse {
+			return []types.RepairResponse{{ID: id.String(), UpdateTime: updateTime}}
+		}
+	)
+
+	t.Run("RoutesToCaughtUpReplica", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, cls, shard, nodes, false)
+			finder  = f.newFinder("A")
+			item    = replica.Replica{ID: id, Object: object(id, 5)}
+			session = replica.NewSession()
+		)
+		session.Observe(cls, shard, 5)
+		ctx := replica.ContextWithSession(context.Background(), session)
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, digestIDs, 0).Return(digest(3), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, digestIDs, 0).Return(digest(5), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "C", cls, shard, digestIDs, 0).Return(nil, errAny)
+		f.RClient.EXPECT().FetchObject(anyVal, "B", cls, shard, id, proj, adds, 0).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+	})
+
+	t.Run("RoutesToLatestVersionOnceAllReplicasAnswered", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, cls, shard, nodes, false)
+			finder  = f.newFinder("A")
+			item    = replica.Replica{ID: id, Object: object(id, 4)}
+			session = replica.NewSession()
+		)
+		// the latest write of the session was to another object of the shard
+		session.Observe(cls, shard, 9)
+		ctx := replica.ContextWithSession(context.Background(), session)
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, digestIDs, 0).Return(digest(3), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, digestIDs, 0).Return(digest(2), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "C", cls, shard, digestIDs, 0).Return(digest(4), nil)
+		f.RClient.EXPECT().FetchObject(anyVal, "C", cls, shard, id, proj, adds, 0).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+	})
+
+	t.Run("WaitsForReplica", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, cls, shard, nodes, false)
+			finder  = f.newFinder("A")
+			item    = replica.Replica{ID: id, Object: object(id, 5)}
+			session = replica.NewSession()
+			polls   = 0
+		)
+		session.Observe(cls, shard, 5)
+		ctx := replica.ContextWithSession(context.Background(), session)
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, digestIDs, 0).RunAndReturn(
+			func(context.Context, string, string, string, []strfmt.UUID, int) ([]types.RepairResponse, error) {
+				polls++
+				if polls < 3 {
+					return nil, errAny
+				}
+				return digest(5), nil
+			})
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, digestIDs, 0).Return(digest(4), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "C", cls, shard, digestIDs, 0).Return(digest(4), nil)
+		f.RClient.EXPECT().FetchObject(anyVal, "A", cls, shard, id, proj, adds, 0).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.Equal(t, 3, polls)
+	})
+
+	t.Run("DeleteWithoutTombstoneIsNotRoutedToStaleReplica", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, cls, shard, nodes, false)
+			finder  = f.newFinder("A")
+			item    = replica.Replica{ID: id, Object: object(id, 5)}
+			session = replica.NewSession()
+			// A applied the session's delete and kept no deletion time
+			absent = []types.RepairResponse{{ID: id.String(), Deleted: true}}
+		)
+		session.Observe(cls, shard, 6)
+		ctx := replica.ContextWithSession(context.Background(), session)
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, digestIDs, 0).Return(absent, nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, digestIDs, 0).Return(digest(5), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "C", cls, shard, digestIDs, 0).Return(digest(5), nil)
+		f.RClient.EXPECT().FetchObject(anyVal, "A", cls, shard, id, proj, adds, 0).Return(
+			replica.Replica{ID: id, Deleted: true}, nil)
+		f.RClient.EXPECT().FetchObject(anyVal, "B", cls, shard, id, proj, adds, 0).Return(item, nil).Maybe()
+
+		// reading with ALL surfaces the conflict instead of the stale object
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, replica.ErrConflictExistOrDeleted.Error())
+	})
+
+	t.Run("FallsBackToConsistencyLevelAll", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(t, cls, shard, nodes, false)
+			finder  = f.newFinder("A")
+			item    = replica.Replica{ID: id, Object: object(id, 5)}
+			session = replica.NewSession()
+		)
+		session.Observe(cls, shard, 6)
+		ctx := replica.ContextWithSession(context.Background(), session)
+
+		f.RClient.EXPECT().DigestObjects(anyVal, "A", cls, shard, digestIDs, 0).Return(nil, errAny)
+		f.RClient.EXPECT().DigestObjects(anyVal, "B", cls, shard, digestIDs, 0).Return(digest(5), nil)
+		f.RClient.EXPECT().DigestObjects(anyVal, "C", cls, shard, digestIDs, 0).Return(digest(5), nil)
+		f.RClient.EXPECT().FetchObject(anyVal, "A", cls, shard, id, proj, adds, 0).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+	})
+}
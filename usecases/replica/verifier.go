@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+)
+
+// verifyDigestsBatchSize is the number of object digests read at once
+// when comparing the objects of differing hashtree leaves
+const verifyDigestsBatchSize = 1000
+
+// ErrVerificationUnavailable is returned when the replicas of a collection
+// or shard cannot be verified, e.g. because async replication is disabled
+var ErrVerificationUnavailable = errors.New("replicas cannot be verified")
+
+type (
+	// VerificationReport is the outcome of comparing the replicas of the
+	// shards of a collection with each other
+	VerificationReport struct {
+		Collection string `json:"collection"`
+		Consistent bool   `json:"consistent"`
+		// Pairs holds one report per shard and pair of its replicas
+		Pairs []ReplicaPairReport `json:"pairs"`
+	}
+
+	// ReplicaPairReport is the outcome of comparing two replicas of a shard
+	ReplicaPairReport struct {
+		Shard      string    `json:"shard"`
+		Nodes      [2]string `json:"nodes"`
+		Consistent bool      `json:"consistent"`
+		// DifferingLeaves is the number of hashtree leaves whose digests differ
+		DifferingLeaves int                `json:"differingLeaves,omitempty"`
+		Differences     []ObjectDifference `json:"differences,omitempty"`
+		// Truncated is set when there are more differences than reported
+		Truncated bool   `json:"truncated,omitempty"`
+		Error     string `json:"error,omitempty"`
+	}
+
+	// ObjectDifference is an object whose state differs between two replicas
+	ObjectDifference struct {
+		ID strfmt.UUID `json:"id"`
+		// UpdateTimes holds the update time of the object on each replica,
+		// in the order of the nodes of the report, 0 if it does not exist
+		UpdateTimes [2]int64 `json:"updateTimes"`
+	}
+)
+
+// VerifyShard compares the hashtree of every pair of replicas of shard and
+// reports the objects of the leaves which differ, up to limit objects per
+// pair. Nothing is repaired. height is the height of the hashtrees of the
+// shard, which only exist if async replication is enabled.
+func (f *Finder) VerifyShard(ctx context.Context, shard string, height, limit int,
+) ([]ReplicaPairReport, error) {
+	options := f.router.BuildRoutingPlanOptions(shard, shard, types.ConsistencyLevelOne, "")
+	plan, err := f.router.BuildReadRoutingPlan(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, f.class, shard)
+	}
+	replicas := plan.Replicas()
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].NodeName < replicas[j].NodeName })
+
+	var reports []ReplicaPairReport
+	for i := range replicas {
+		for j := i + 1; j < len(replicas); j++ {
+			report := f.verifyReplicaPair(ctx, shard, replicas[i], replicas[j], height, limit)
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (f *Finder) verifyReplicaPair(ctx context.Context, shard string,
+	a, b types.Replica, height, limit int,
+) ReplicaPairReport {
+	report := ReplicaPairReport{Shard: shard, Nodes: [2]string{a.NodeName, b.NodeName}}
+
+	diff, err := f.hashtreeDiff(ctx, shard, a.HostAddr, b.HostAddr, height)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	// leaves are the last positions of the discriminant
+	firstLeaf := hashtree.InnerNodesCount(height)
+	for pos := firstLeaf; pos < diff.Size(); pos++ {
+		if !diff.IsSet(pos) {
+			continue
+		}
+		report.DifferingLeaves++
+		if len(report.Differences) >= limit {
+			report.Truncated = true
+			continue
+		}
+		from, to := leafUUIDRange(uint64(pos-firstLeaf), height)
+		ds, err := f.leafDifferences(ctx, shard, a.HostAddr, b.HostAddr, from, to)
+		if err != nil {
+			report.Error = err.Error()
+			return report
+		}
+		if n := limit - len(report.Differences); len(ds) > n {
+			ds, report.Truncated = ds[:n], true
+		}
+		report.Differences = append(report.Differences, ds...)
+	}
+	report.Consistent = report.DifferingLeaves == 0
+	return report
+}
+
+// hashtreeDiff descends the hashtrees of both hosts level by level and
+// returns a discriminant whose set leaves are the ones which differ
+func (f *Finder) hashtreeDiff(ctx context.Context, shard, hostA, hostB string, height int,
+) (*hashtree.Bitset, error) {
+	diff := hashtree.NewBitset(hashtree.NodesCount(height))
+	diff.Set(0) // start with the root
+
+	for l := 0; l <= height; l++ {
+		digestsA, err := f.client.HashTreeLevel(ctx, hostA, f.class, shard, l, diff)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", hostA, err)
+		}
+		digestsB, err := f.client.HashTreeLevel(ctx, hostB, f.class, shard, l, diff)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", hostB, err)
+		}
+		if len(digestsA) != len(digestsB) {
+			return nil, fmt.Errorf("hashtree level %d: %d digests from %q and %d from %q",
+				l, len(digestsA), hostA, len(digestsB), hostB)
+		}
+		if hashtree.LevelDiff(l, diff, digestsA, digestsB) == 0 {
+			diff.Reset()
+			break
+		}
+	}
+	return diff, nil
+}
+
+// leafDifferences compares the digests of the objects within from and to.
+//
+// Object digests only carry the id and update time of an object, which is
+// also all the hashtrees are built from. Replicas whose objects differ in
+// content but share an update time are therefore not told apart, neither
+// here nor by async replication itself.
+func (f *Finder) leafDifferences(ctx context.Context, shard, hostA, hostB string,
+	from, to strfmt.UUID,
+) ([]ObjectDifference, error) {
+	digestsA, err := f.digestsInRange(ctx, shard, hostA, from, to)
+	if err != nil {
+		return nil, err
+	}
+	digestsB, err := f.digestsInRange(ctx, shard, hostB, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make(map[strfmt.UUID]*[2]int64, len(digestsA))
+	for _, d := range digestsA {
+		times[strfmt.UUID(d.ID)] = &[2]int64{d.UpdateTime, 0}
+	}
+	for _, d := range digestsB {
+		id := strfmt.UUID(d.ID)
+		if t, ok := times[id]; ok {
+			t[1] = d.UpdateTime
+		} else {
+			times[id] = &[2]int64{0, d.UpdateTime}
+		}
+	}
+
+	var ds []ObjectDifference
+	for id, t := range times {
+		if t[0] != t[1] {
+			ds = append(ds, ObjectDifference{ID: id, UpdateTimes: *t})
+		}
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i].ID < ds[j].ID })
+	return ds, nil
+}
+
+// digestsInRange reads all object digests of host within from and to
+func (f *Finder) digestsInRange(ctx context.Context, shard, host string, from, to strfmt.UUID,
+) ([]types.RepairResponse, error) {
+	var all []types.RepairResponse
+	for {
+		ds, err := f.client.DigestObjectsInRange(ctx, host, f.class, shard, from, to, verifyDigestsBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", host, err)
+		}
+		all = append(all, ds...)
+		if len(ds) < verifyDigestsBatchSize {
+			return all, nil
+		}
+		next, err := uuid.Parse(ds[len(ds)-1].ID)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", host, err)
+		}
+		if !incUUID(next[:]) {
+			return all, nil
+		}
+		from = strfmt.UUID(next.String())
+	}
+}
+
+// leafUUIDRange returns the first and last uuid hashed into a leaf of a
+// hashtree of the given height
+func leafUUIDRange(leaf uint64, height int) (strfmt.UUID, strfmt.UUID) {
+	var first, last uuid.UUID
+	binary.BigEndian.PutUint64(first[:8], leaf<<(64-height))
+	binary.BigEndian.PutUint64(last[:8], leaf<<(64-height)|(1<<(64-height)-1))
+	copy(last[8:], bytes.Repeat([]byte{0xff}, 8))
+	return strfmt.UUID(first.String()), strfmt.UUID(last.String())
+}
+
+// incUUID increments b to the next uuid in lexicographic order and returns
+// false if it overflows
+func incUUID(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < 0xff {
+			b[i]++
+			return true
+		}
+		b[i] = 0
+	}
+	return false
+}
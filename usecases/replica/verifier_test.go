@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+)
+
+func TestFinderVerifyShard(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B"}
+		ctx   = context.Background()
+		// uuids hashed into the second leaf of a hashtree of height 1
+		from = strfmt.UUID("80000000-0000-0000-0000-000000000000")
+		to   = strfmt.UUID("ffffffff-ffff-ffff-ffff-ffffffffffff")
+		id1  = "80000000-0000-0000-0000-000000000001"
+		id2  = "80000000-0000-0000-0000-000000000002"
+		id3  = "80000000-0000-0000-0000-000000000003"
+	)
+
+	t.Run("Consistent", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		finder := f.newFinder("A")
+
+		for _, n := range nodes {
+			f.RClient.EXPECT().HashTreeLevel(anyVal, n, cls, shard, 0, anyVal).Return([]hashtree.Digest{{1, 1}}, nil)
+		}
+
+		reports, err := finder.VerifyShard(ctx, shard, 1, 10)
+		require.Nil(t, err)
+		assert.Equal(t, []replica.ReplicaPairReport{
+			{Shard: shard, Nodes: [2]string{"A", "B"}, Consistent: true},
+		}, reports)
+	})
+
+	t.Run("Differences", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		finder := f.newFinder("A")
+
+		f.RClient.EXPECT().HashTreeLevel(anyVal, "A", cls, shard, 0, anyVal).Return([]hashtree.Digest{{1, 1}}, nil)
+		f.RClient.EXPECT().HashTreeLevel(anyVal, "B", cls, shard, 0, anyVal).Return([]hashtree.Digest{{2, 2}}, nil)
+		f.RClient.EXPECT().HashTreeLevel(anyVal, "A", cls, shard, 1, anyVal).Return([]hashtree.Digest{{3, 3}, {4, 4}}, nil)
+		f.RClient.EXPECT().HashTreeLevel(anyVal, "B", cls, shard, 1, anyVal).Return([]hashtree.Digest{{3, 3}, {5, 5}}, nil)
+		f.RClient.EXPECT().DigestObjectsInRange(anyVal, "A", cls, shard, from, to, 1000).Return([]types.RepairResponse{
+			{ID: id1, UpdateTime: 1},
+			{ID: id2, UpdateTime: 2},
+			{ID: id3, UpdateTime: 3},
+		}, nil)
+		f.RClient.EXPECT().DigestObjectsInRange(anyVal, "B", cls, shard, from, to, 1000).Return([]types.RepairResponse{
+			{ID: id1, UpdateTime: 1},
+			{ID: id3, UpdateTime: 4},
+		}, nil)
+
+		reports, err := finder.VerifyShard(ctx, shard, 1, 10)
+		require.Nil(t, err)
+		assert.Equal(t, []replica.ReplicaPairReport{{
+			Shard:           shard,
+			Nodes:           [2]string{"A", "B"},
+			DifferingLeaves: 1,
+			Differences: []replica.ObjectDifference{
+				{ID: strfmt.UUID(id2), UpdateTimes: [2]int64{2, 0}},
+				{ID: strfmt.UUID(id3), UpdateTimes: [2]int64{3, 4}},
+			},
+		}}, reports)
+
+		// the same differences, truncated to the limit
+		reports, err = finder.VerifyShard(ctx, shard, 1, 1)
+		require.Nil(t, err)
+		require.Len(t, reports, 1)
+		assert.True(t, reports[0].Truncated)
+		assert.Equal(t, []replica.ObjectDifference{
+			{ID: strfmt.UUID(id2), UpdateTimes: [2]int64{2, 0}},
+		}, reports[0].Differences)
+	})
+
+	t.Run("HashtreeNotInitialized", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		finder := f.newFinder("A")
+
+		f.RClient.EXPECT().HashTreeLevel(anyVal, "A", cls, shard, 0, anyVal).Return(nil, errAny)
+
+		reports, err := finder.VerifyShard(ctx, shard, 1, 10)
+		require.Nil(t, err)
+		require.Len(t, reports, 1)
+		assert.False(t, reports[0].Consistent)
+		assert.Contains(t, reports[0].Error, errAny.Error())
+	})
+}
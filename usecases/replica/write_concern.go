@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	// WriteAcksHeader is the HTTP header and gRPC metadata key clients use to
+	// set the number of replicas which must acknowledge each write of the
+	// request. It takes precedence over the request's consistency level.
+	WriteAcksHeader = "X-Weaviate-Write-Acks"
+	// WriteTimeoutHeader is the HTTP header and gRPC metadata key clients use to
+	// bound how long a write waits for acknowledgements, e.g. "500ms" or "2s"
+	WriteTimeoutHeader = "X-Weaviate-Write-Timeout"
+	// WriteAcknowledgementsHeader is returned on requests with a write concern.
+	// It lists the nodes which acknowledged the writes of each class and shard
+	// as JSON, e.g. {"Article":{"shard1":["node-0","node-2"]}}. Shards whose
+	// writes failed to reach the write concern list the nodes which did ack.
+	// Batch results list the acknowledging nodes of each object as well.
+	WriteAcknowledgementsHeader = "X-Weaviate-Write-Acknowledgements"
+)
+
+// defaultPushTimeout bounds the write phases when no write concern timeout is set
+const defaultPushTimeout = 20 * time.Second
+
+type writeConcernCtxKey struct{}
+
+// WriteConcern is a per-request write requirement: the number of replica
+// acknowledgements a write needs to succeed and how long to wait for them.
+// It also collects the acknowledging nodes of all writes of the request and
+// is safe for concurrent use.
+type WriteConcern struct {
+	// Acks overrides the consistency level if greater than zero
+	Acks int
+	// Timeout overrides the default write timeout if greater than zero
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	acks    map[string]map[string][]string // class -> shard -> nodes
+	objects map[objectKey][]string         // object -> nodes
+}
+
+// objectKey identifies an object written under a write concern
+type objectKey struct {
+	class, tenant string
+	id            strfmt.UUID
+}
+
+// ParseWriteConcern parses the values of WriteAcksHeader and
+// WriteTimeoutHeader. It returns nil if both are empty.
+func ParseWriteConcern(acks, timeout string) (*WriteConcern, error) {
+	if acks == "" && timeout == "" {
+		return nil, nil
+	}
+	wc := NewWriteConcern(0, 0)
+	if acks != "" {
+		n, err := strconv.Atoi(acks)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid %s %q: must be a positive integer", WriteAcksHeader, acks)
+		}
+		wc.Acks = n
+	}
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid %s %q: must be a positive duration", WriteTimeoutHeader, timeout)
+		}
+		wc.Timeout = d
+	}
+	return wc, nil
+}
+
+// NewWriteConcern returns a write concern requiring acks acknowledgements
+// within timeout. Zero values keep the consistency level and default timeout.
+func NewWriteConcern(acks int, timeout time.Duration) *WriteConcern {
+	return &WriteConcern{
+		Acks:    acks,
+		Timeout: timeout,
+		acks:    map[string]map[string][]string{},
+		objects: map[objectKey][]string{},
+	}
+}
+
+// level returns the number of acknowledgements a write to a shard with n
+// replicas needs, given the level of the request's consistency level
+func (wc *WriteConcern) level(level, n int) (int, error) {
+	if wc == nil || wc.Acks == 0 {
+		return level, nil
+	}
+	if wc.Acks > n {
+		return 0, fmt.Errorf("write concern of %d acknowledgements exceeds the %d replicas of the shard: %w", wc.Acks, n, ErrReplicas)
+	}
+	return wc.Acks, nil
+}
+
+func (wc *WriteConcern) timeout() time.Duration {
+	if wc == nil || wc.Timeout <= 0 {
+		return defaultPushTimeout
+	}
+	return wc.Timeout
+}
+
+// acknowledged records that nodes acknowledged a write to class and shard
+func (wc *WriteConcern) acknowledged(class, shard string, nodes []string) {
+	if wc == nil {
+		return
+	}
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	if wc.acks == nil {
+		wc.acks = map[string]map[string][]string{}
+	}
+	shards, ok := wc.acks[class]
+	if !ok {
+		shards = map[string][]string{}
+		wc.acks[class] = shards
+	}
+	for _, node := range nodes {
+		if !slices.Contains(shards[shard], node) {
+			shards[shard] = append(shards[shard], node)
+		}
+	}
+	if _, ok := shards[shard]; !ok {
+		shards[shard] = []string{}
+	}
+	sort.Strings(shards[shard])
+}
+
+// objectsAcknowledged records that nodes acknowledged the write of objs to class
+func (wc *WriteConcern) objectsAcknowledged(class string, objs []*storobj.Object, nodes []string) {
+	if wc == nil {
+		return
+	}
+	nodes = slices.Sorted(slices.Values(nodes))
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	if wc.objects == nil {
+		wc.objects = map[objectKey][]string{}
+	}
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		wc.objects[objectKey{class: class, tenant: obj.Object.Tenant, id: obj.ID()}] = nodes
+	}
+}
+
+// ObjectAcknowledgements returns the nodes which acknowledged the last write
+// of the object id of class and tenant. It is nil if no write was recorded.
+func (wc *WriteConcern) ObjectAcknowledgements(class, tenant string, id strfmt.UUID) []string {
+	if wc == nil {
+		return nil
+	}
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	nodes, ok := wc.objects[objectKey{class: class, tenant: tenant, id: id}]
+	if !ok {
+		return nil
+	}
+	return append([]string{}, nodes...)
+}
+
+// Acknowledgements returns the nodes which acknowledged the writes of each class and shard
+func (wc *WriteConcern) Acknowledgements() map[string]map[string][]string {
+	if wc == nil {
+		return nil
+	}
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	res := make(map[string]map[string][]string, len(wc.acks))
+	for class, shards := range wc.acks {
+		res[class] = make(map[string][]string, len(shards))
+		for shard, nodes := range shards {
+			res[class][shard] = append([]string{}, nodes...)
+		}
+	}
+	return res
+}
+
+// Header encodes the acknowledgements as the value of
+// WriteAcknowledgementsHeader. It is empty if no write has been recorded.
+func (wc *WriteConcern) Header() string {
+	acks := wc.Acknowledgements()
+	if len(acks) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(acks)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// ContextWithWriteConcern returns a copy of ctx carrying the write concern
+func ContextWithWriteConcern(ctx context.Context, wc *WriteConcern) context.Context {
+	return context.WithValue(ctx, writeConcernCtxKey{}, wc)
+}
+
+// WriteConcernFromContext returns the write concern carried by ctx or nil
+func WriteConcernFromContext(ctx context.Context) *WriteConcern {
+	wc, _ := ctx.Value(writeConcernCtxKey{}).(*WriteConcern)
+	return wc
+}
+
+// untilDeadline forwards the results of ch until it is closed or until d
+// elapsed. Results arriving after the deadline are drained and dropped.
+func untilDeadline[T any](ch <-chan Result[T], d time.Duration, log logrus.FieldLogger) <-chan Result[T] {
+	out := make(chan Result[T], cap(ch))
+	f := func() {
+		defer close(out)
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		for {
+			select {
+			case r, ok := <-ch:
+				if !ok {
+					return
+				}
+				out <- r
+			case <-timer.C:
+				enterrors.GoWrapper(func() {
+					for range ch {
+					}
+				}, log)
+				return
+			}
+		}
+	}
+	enterrors.GoWrapper(f, log)
+	return out
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestParseWriteConcern(t *testing.T) {
+	wc, err := replica.ParseWriteConcern("", "")
+	require.NoError(t, err)
+	assert.Nil(t, wc)
+
+	wc, err = replica.ParseWriteConcern("2", "500ms")
+	require.NoError(t, err)
+	assert.Equal(t, 2, wc.Acks)
+	assert.Equal(t, 500*time.Millisecond, wc.Timeout)
+	assert.Equal(t, "", wc.Header())
+
+	for _, tc := range []struct{ acks, timeout string }{{"0", ""}, {"two", ""}, {"", "-1s"}, {"", "soon"}} {
+		_, err = replica.ParseWriteConcern(tc.acks, tc.timeout)
+		assert.Error(t, err, "acks %q timeout %q", tc.acks, tc.timeout)
+	}
+}
+
+func TestReplicatorPutObjectsWriteConcern(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ids   = []strfmt.UUID{"73f2eb5f-5abf-447a-81ca-74b1dd168241", "73f2eb5f-5abf-447a-81ca-74b1dd168242"}
+		objs  = []*storobj.Object{
+			{Object: models.Object{ID: ids[0], Tenant: "T1"}},
+			{Object: models.Object{ID: ids[1], Tenant: "T1"}},
+		}
+		resp = replica.SimpleResponse{Errors: make([]replica.Error, 2)}
+	)
+	commitOK := func(wg *sync.WaitGroup, delay time.Duration) func(mock.Arguments) {
+		return func(a mock.Arguments) {
+			defer wg.Done()
+			time.Sleep(delay)
+			*a[5].(*replica.SimpleResponse) = replica.SimpleResponse{Errors: make([]replica.Error, 2)}
+		}
+	}
+
+	t.Run("AcksOverrideConsistencyLevel", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		rep := f.newReplicator()
+		wc, _ := replica.ParseWriteConcern("2", "")
+		ctx := replica.ContextWithWriteConcern(context.Background(), wc)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		for _, n := range nodes[:2] {
+			f.WClient.On("PutObjects", mock.Anything, n, cls, shard, anyVal, objs, uint64(0)).Return(resp, nil)
+			f.WClient.On("Commit", mock.Anything, n, cls, shard, anyVal, anyVal).Return(nil).RunFn = commitOK(&wg, 0)
+		}
+		f.WClient.On("PutObjects", mock.Anything, "C", cls, shard, anyVal, objs, uint64(0)).Return(resp, errAny)
+
+		errs := rep.PutObjects(ctx, shard, objs, types.ConsistencyLevelOne, 0)
+		wg.Wait()
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.Equal(t, map[string]map[string][]string{cls: {shard: {"A", "B"}}}, wc.Acknowledgements())
+		for _, id := range ids {
+			assert.Equal(t, []string{"A", "B"}, wc.ObjectAcknowledgements(cls, "T1", id))
+		}
+		assert.Nil(t, wc.ObjectAcknowledgements(cls, "T2", ids[0]))
+	})
+
+	t.Run("AcksNotReached", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		rep := f.newReplicator()
+		wc, _ := replica.ParseWriteConcern("3", "")
+		ctx := replica.ContextWithWriteConcern(context.Background(), wc)
+
+		for _, n := range nodes[:2] {
+			f.WClient.On("PutObjects", mock.Anything, n, cls, shard, anyVal, objs, uint64(0)).Return(resp, nil)
+		}
+		f.WClient.On("PutObjects", mock.Anything, "C", cls, shard, anyVal, objs, uint64(0)).Return(resp, errAny)
+		for _, n := range nodes {
+			f.WClient.On("Abort", mock.Anything, n, cls, shard, anyVal).Return(resp, nil)
+		}
+
+		errs := rep.PutObjects(ctx, shard, objs, types.ConsistencyLevelOne, 0)
+		require.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], replica.ErrReplicas)
+		assert.Equal(t, `{"C1":{"SH1":[]}}`, wc.Header())
+		assert.Equal(t, []string{}, wc.ObjectAcknowledgements(cls, "T1", ids[0]))
+	})
+
+	t.Run("AcksExceedReplicas", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		rep := f.newReplicator()
+		wc, _ := replica.ParseWriteConcern("4", "")
+		ctx := replica.ContextWithWriteConcern(context.Background(), wc)
+
+		errs := rep.PutObjects(ctx, shard, objs, types.ConsistencyLevelOne, 0)
+		require.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], replica.ErrReplicas)
+		f.WClient.AssertNotCalled(t, "PutObjects", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes, false)
+		rep := f.newReplicator()
+		wc, _ := replica.ParseWriteConcern("3", "100ms")
+		ctx := replica.ContextWithWriteConcern(context.Background(), wc)
+
+		var wg sync.WaitGroup
+		wg.Add(3)
+		for _, n := range nodes {
+			f.WClient.On("PutObjects", mock.Anything, n, cls, shard, anyVal, objs, uint64(0)).Return(resp, nil)
+		}
+		f.WClient.On("Commit", mock.Anything, "A", cls, shard, anyVal, anyVal).Return(nil).RunFn = commitOK(&wg, 0)
+		f.WClient.On("Commit", mock.Anything, "B", cls, shard, anyVal, anyVal).Return(nil).RunFn = commitOK(&wg, 0)
+		f.WClient.On("Commit", mock.Anything, "C", cls, shard, anyVal, anyVal).Return(nil).RunFn = commitOK(&wg, time.Second)
+
+		start := time.Now()
+		errs := rep.PutObjects(ctx, shard, objs, types.ConsistencyLevelAll, 0)
+		assert.Less(t, time.Since(start), time.Second)
+		require.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], replica.ErrReplicas)
+		assert.Equal(t, map[string]map[string][]string{cls: {shard: {"A", "B"}}}, wc.Acknowledgements())
+		// the slow replica still commits in the background
+		wg.Wait()
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/weaviate/weaviate/cluster/audit"
 	cschema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -92,7 +93,7 @@ func (h *Handler) AddAlias(ctx context.Context, principal *models.Principal,
 	alias.Alias = al
 
 	class := h.schemaReader.ReadOnlyClass(alias.Class)
-	version, err := h.schemaManager.CreateAlias(ctx, alias.Alias, class)
+	version, err := h.schemaManager.CreateAlias(audit.ContextWithPrincipal(ctx, principal), alias.Alias, class)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -120,7 +121,7 @@ func (h *Handler) UpdateAlias(ctx context.Context, principal *models.Principal,
 	alias := aliases[0]
 	targetClass := h.schemaReader.ReadOnlyClass(targetClassName)
 
-	_, err = h.schemaManager.ReplaceAlias(ctx, alias, targetClass)
+	_, err = h.schemaManager.ReplaceAlias(audit.ContextWithPrincipal(ctx, principal), alias, targetClass)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +145,7 @@ func (h *Handler) DeleteAlias(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	if _, err = h.schemaManager.DeleteAlias(ctx, aliasName); err != nil {
+	if _, err = h.schemaManager.DeleteAlias(audit.ContextWithPrincipal(ctx, principal), aliasName); err != nil {
 		return err
 	}
 	return nil
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/cluster/audit"
 	"github.com/weaviate/weaviate/entities/modelsext"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
@@ -151,10 +152,10 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 			limit)
 	}
 
-	shardState, err := sharding.InitState(cls.Class,
+	shardState, err := sharding.InitStateWithDomains(cls.Class,
 		cls.ShardingConfig.(shardingcfg.Config),
 		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), cls.ReplicationConfig.Factor,
-		schema.MultiTenancyEnabled(cls))
+		schema.MultiTenancyEnabled(cls), h.schemaManager.StorageCandidateDomains())
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "init sharding state")
 	}
@@ -162,7 +163,7 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	defaultQuantization := h.config.DefaultQuantization
 	h.enableQuantization(cls, defaultQuantization)
 
-	version, err := h.schemaManager.AddClass(ctx, cls, shardState)
+	version, err := h.schemaManager.AddClass(audit.ContextWithPrincipal(ctx, principal), cls, shardState)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -301,7 +302,7 @@ func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal,
 
 	class = schema.UppercaseClassName(class)
 
-	if _, err = h.schemaManager.DeleteClass(ctx, class); err != nil {
+	if _, err = h.schemaManager.DeleteClass(audit.ContextWithPrincipal(ctx, principal), class); err != nil {
 		return err
 	}
 
@@ -316,7 +317,7 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	return UpdateClassInternal(h, ctx, className, updated)
+	return UpdateClassInternal(h, audit.ContextWithPrincipal(ctx, principal), className, updated)
 }
 
 // bypass the auth check for internal class update requests
@@ -516,7 +517,12 @@ func setPropertyDefaultIndexing(props ...*models.Property) {
 		vTrue := true
 		vFalse := false
 		if prop.IndexFilterable == nil {
-			prop.IndexFilterable = &vTrue
+			// sparse vectors can only be searched, not filtered
+			if schema.IsSparseVectorDataType(prop.DataType) {
+				prop.IndexFilterable = &vFalse
+			} else {
+				prop.IndexFilterable = &vTrue
+			}
 		}
 		if prop.IndexSearchable == nil {
 			switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
@@ -524,7 +530,7 @@ func setPropertyDefaultIndexing(props ...*models.Property) {
 				// string/string[] are migrated to text/text[] later,
 				// at this point they are still valid data types, therefore should be handled here
 				prop.IndexSearchable = &vTrue
-			case schema.DataTypeText, schema.DataTypeTextArray:
+			case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeSparseVector:
 				prop.IndexSearchable = &vTrue
 			default:
 				prop.IndexSearchable = &vFalse
@@ -650,6 +656,9 @@ func migratePropertyIndexInverted(props ...*models.Property) {
 			// string/string[] are already migrated into text/text[], can be skipped here
 			case schema.DataTypeText, schema.DataTypeTextArray:
 				prop.IndexSearchable = prop.IndexInverted
+			case schema.DataTypeSparseVector:
+				prop.IndexFilterable = &vFalse
+				prop.IndexSearchable = prop.IndexInverted
 			default:
 				prop.IndexSearchable = &vFalse
 			}
@@ -871,13 +880,19 @@ func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 			// true or false allowed
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			// true or false allowed
+		case schema.DataTypeSparseVector:
+			// true or false allowed, the searchable index holds the posting lists
 		default:
 			if *prop.IndexSearchable {
-				return fmt.Errorf("`indexSearchable` is allowed only for text/text[] data types. " +
+				return fmt.Errorf("`indexSearchable` is allowed only for text/text[] and sparseVector data types. " +
 					"For other data types set false or leave empty")
 			}
 		}
 	}
+	if prop.IndexFilterable != nil && *prop.IndexFilterable && dataType == schema.DataTypeSparseVector {
+		return fmt.Errorf("`indexFilterable` is not allowed for sparseVector data type. " +
+			"Set false or leave empty")
+	}
 	if prop.IndexRangeFilters != nil {
 		switch dataType {
 		case schema.DataTypeNumber, schema.DataTypeInt, schema.DataTypeDate:
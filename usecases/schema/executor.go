@@ -124,6 +124,18 @@ func (e *executor) DeleteReplicaFromShard(class string, shard string, targetNode
 	return e.migrator.DropShard(ctx, class, shard)
 }
 
+func (e *executor) StartShardSplit(class string, shard string) error {
+	return e.migrator.StartShardSplit(context.Background(), class, shard)
+}
+
+func (e *executor) CommitShardSplit(class string, shard string, target string) error {
+	return e.migrator.CommitShardSplit(context.Background(), class, shard, target)
+}
+
+func (e *executor) AbortShardSplit(class string, shard string, target string) error {
+	return e.migrator.AbortShardSplit(context.Background(), class, shard, target)
+}
+
 func (e *executor) LoadShard(class string, shard string) {
 	ctx := context.Background()
 	if err := e.migrator.LoadShard(ctx, class, shard); err != nil {
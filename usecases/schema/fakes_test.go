@@ -129,6 +129,10 @@ func (f *fakeSchemaManager) StorageCandidates() []string {
 	return []string{"node-1"}
 }
 
+func (f *fakeSchemaManager) StorageCandidateDomains() map[string]string {
+	return nil
+}
+
 func (f *fakeSchemaManager) ClassInfoWithVersion(ctx context.Context, class string, version uint64) (clusterSchema.ClassInfo, error) {
 	args := f.Called(ctx, class, version)
 	return args.Get(0).(clusterSchema.ClassInfo), args.Error(1)
@@ -60,6 +60,7 @@ type SchemaManager interface {
 	Remove(_ context.Context, nodeID string) error
 	Stats() map[string]any
 	StorageCandidates() []string
+	StorageCandidateDomains() map[string]string
 
 	// Strongly consistent schema read. These endpoints will emit a query to the leader to ensure that the data is read
 	// from an up to date schema.
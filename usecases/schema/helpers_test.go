@@ -105,6 +105,18 @@ func (f *fakeDB) DeleteReplicaFromShard(class string, shard string, targetNode s
 	return nil
 }
 
+func (f *fakeDB) StartShardSplit(class string, shard string) error {
+	return nil
+}
+
+func (f *fakeDB) CommitShardSplit(class string, shard string, target string) error {
+	return nil
+}
+
+func (f *fakeDB) AbortShardSplit(class string, shard string, target string) error {
+	return nil
+}
+
 func (f *fakeDB) LoadShard(class string, shard string) {
 }
 
@@ -313,6 +325,21 @@ func (f *fakeMigrator) LoadShard(ctx context.Context, class string, shard string
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) StartShardSplit(ctx context.Context, class string, shard string) error {
+	args := f.Called(ctx, class, shard)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) CommitShardSplit(ctx context.Context, class string, shard string, target string) error {
+	args := f.Called(ctx, class, shard, target)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) AbortShardSplit(ctx context.Context, class string, shard string, target string) error {
+	args := f.Called(ctx, class, shard, target)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) DropShard(ctx context.Context, class string, shard string) error {
 	args := f.Called(ctx, class, shard)
 	return args.Error(0)
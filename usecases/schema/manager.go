@@ -339,6 +339,7 @@ func (m *Manager) activateTenantIfInactive(ctx context.Context, class string,
 	req := &api.UpdateTenantsRequest{
 		Tenants:               make([]*api.Tenant, 0, len(status)),
 		ClusterNodes:          m.schemaManager.StorageCandidates(),
+		NodeDomains:           m.schemaManager.StorageCandidateDomains(),
 		ImplicitUpdateRequest: true,
 	}
 	for tenant, s := range status {
@@ -407,6 +408,7 @@ func (m *Manager) changeTenantsActivityStatus(ctx context.Context, class string,
 	req := &api.UpdateTenantsRequest{
 		Tenants:               make([]*api.Tenant, len(tenants)),
 		ClusterNodes:          m.schemaManager.StorageCandidates(),
+		NodeDomains:           m.schemaManager.StorageCandidateDomains(),
 		ImplicitUpdateRequest: true,
 	}
 	for i := range tenants {
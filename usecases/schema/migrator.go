@@ -41,6 +41,9 @@ type Migrator interface {
 	LoadShard(ctx context.Context, class, shard string) error
 	DropShard(ctx context.Context, class, shard string) error
 	ShutdownShard(ctx context.Context, class, shard string) error
+	StartShardSplit(ctx context.Context, class, shard string) error
+	CommitShardSplit(ctx context.Context, class, shard, target string) error
+	AbortShardSplit(ctx context.Context, class, shard, target string) error
 
 	AddProperty(ctx context.Context, className string,
 		props ...*models.Property) error
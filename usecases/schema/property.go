@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/weaviate/weaviate/cluster/audit"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -85,7 +86,7 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 	migratePropertySettings(props...)
 
 	class.Properties = clusterSchema.MergeProps(class.Properties, props)
-	version, err := h.schemaManager.AddProperty(ctx, class.Class, props...)
+	version, err := h.schemaManager.AddProperty(audit.ContextWithPrincipal(ctx, principal), class.Class, props...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -150,7 +151,7 @@ func (h *Handler) DeleteClassPropertyIndex(ctx context.Context, principal *model
 	if err := h.validatePropertyIndexing(prop); err != nil {
 		return err
 	}
-	_, err := h.schemaManager.UpdateProperty(ctx, class.Class, prop)
+	_, err := h.schemaManager.UpdateProperty(audit.ContextWithPrincipal(ctx, principal), class.Class, prop)
 	if err != nil {
 		return err
 	}
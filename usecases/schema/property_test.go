@@ -672,10 +672,10 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 							})
 							continue
 						}
-						// searchable=true can be set only for text/text[]
+						// searchable=true can be set only for text/text[] and sparseVector
 						if searchable != nil && *searchable {
 							switch dataType {
-							case schema.DataTypeText, schema.DataTypeTextArray:
+							case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeSparseVector:
 								// ignore
 							default:
 								testCases = append(testCases, testCase{
@@ -686,13 +686,27 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 									indexSearchable: searchable,
 									expectedErrContains: []string{
 										// TODO should be changed as on master
-										"`indexSearchable` is allowed only for text/text[] data types. For other data types set false or leave empty",
+										"`indexSearchable` is allowed only for text/text[] and sparseVector data types. For other data types set false or leave empty",
 										// "`indexSearchable` is not allowed for other than text/text[] data types" ,
 									},
 								})
 								continue
 							}
 						}
+						// filterable=true can not be set for sparseVector
+						if filterable != nil && *filterable && dataType == schema.DataTypeSparseVector {
+							testCases = append(testCases, testCase{
+								propName:        propName,
+								dataType:        dataType,
+								indexInverted:   inverted,
+								indexFilterable: filterable,
+								indexSearchable: searchable,
+								expectedErrContains: []string{
+									"`indexFilterable` is not allowed for sparseVector data type. Set false or leave empty",
+								},
+							})
+							continue
+						}
 
 						testCases = append(testCases, testCase{
 							propName:        propName,
@@ -18,6 +18,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/weaviate/weaviate/cluster/audit"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
@@ -59,6 +60,7 @@ func (h *Handler) AddTenants(ctx context.Context,
 
 	request := api.AddTenantsRequest{
 		ClusterNodes: h.schemaManager.StorageCandidates(),
+		NodeDomains:  h.schemaManager.StorageCandidateDomains(),
 		Tenants:      make([]*api.Tenant, 0, len(validated)),
 	}
 	for i, tenant := range validated {
@@ -68,7 +70,7 @@ func (h *Handler) AddTenants(ctx context.Context,
 		})
 	}
 
-	return h.schemaManager.AddTenants(ctx, class, &request)
+	return h.schemaManager.AddTenants(audit.ContextWithPrincipal(ctx, principal), class, &request)
 }
 
 func validateTenants(tenants []*models.Tenant, allowOverHundred bool) (validated []*models.Tenant, err error) {
@@ -170,6 +172,7 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 	req := api.UpdateTenantsRequest{
 		Tenants:      make([]*api.Tenant, len(tenants)),
 		ClusterNodes: h.schemaManager.StorageCandidates(),
+		NodeDomains:  h.schemaManager.StorageCandidateDomains(),
 	}
 	tNames := make([]string, len(tenants))
 	for i, tenant := range tenants {
@@ -177,7 +180,7 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 		req.Tenants[i] = &api.Tenant{Name: tenant.Name, Status: tenant.ActivityStatus}
 	}
 
-	if _, err = h.schemaManager.UpdateTenants(ctx, class, &req); err != nil {
+	if _, err = h.schemaManager.UpdateTenants(audit.ContextWithPrincipal(ctx, principal), class, &req); err != nil {
 		return nil, err
 	}
 
@@ -208,7 +211,7 @@ func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal
 		Tenants: tenants,
 	}
 
-	if _, err := h.schemaManager.DeleteTenants(ctx, class, &req); err != nil {
+	if _, err := h.schemaManager.DeleteTenants(audit.ContextWithPrincipal(ctx, principal), class, &req); err != nil {
 		return err
 	}
 
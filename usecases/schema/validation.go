@@ -73,7 +73,7 @@ func validateNestedPropertyDataType(property *models.NestedProperty,
 		switch primitiveDataType {
 		case schema.DataTypeString, schema.DataTypeStringArray:
 			return fmt.Errorf("property '%s': data type '%s' is deprecated and not allowed as nested property", propName, primitiveDataType)
-		case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+		case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector:
 			return fmt.Errorf("property '%s': data type '%s' not allowed as nested property", propName, primitiveDataType)
 		default:
 			// do nothing
@@ -88,7 +88,7 @@ func Test_Validation_NestedProperties(t *testing.T) {
 		for _, pdt := range schema.PrimitiveDataTypes {
 			tokenization := ""
 			switch pdt {
-			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector:
 				// skip - not supported as nested
 				continue
 			case schema.DataTypeText, schema.DataTypeTextArray:
@@ -207,7 +207,7 @@ func Test_Validation_NestedProperties(t *testing.T) {
 	})
 
 	t.Run("does not validate unsupported primitive types", func(t *testing.T) {
-		for _, pdt := range []schema.DataType{schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber} {
+		for _, pdt := range []schema.DataType{schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector} {
 			t.Run(pdt.String(), func(t *testing.T) {
 				nestedProperties := []*models.NestedProperty{
 					{
@@ -364,7 +364,7 @@ func Test_Validation_NestedProperties(t *testing.T) {
 			switch pdt {
 			case schema.DataTypeText, schema.DataTypeTextArray:
 				continue
-			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector:
 				// skip - not supported as nested
 				continue
 			default:
@@ -480,7 +480,7 @@ func Test_Validation_NestedProperties(t *testing.T) {
 			case schema.DataTypeBlob:
 				// skip - not indexable
 				continue
-			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector:
 				// skip - not supported as nested
 				continue
 			case schema.DataTypeText, schema.DataTypeTextArray:
@@ -698,7 +698,7 @@ func Test_Validation_NestedProperties(t *testing.T) {
 			switch pdt {
 			case schema.DataTypeText, schema.DataTypeTextArray:
 				continue
-			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber:
+			case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeSparseVector:
 				// skip - not supported as nested
 				continue
 			default:
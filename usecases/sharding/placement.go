@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// PlacementViolation is a shard whose replicas span fewer failure domains
+// than the available nodes allow
+type PlacementViolation struct {
+	Shard    string   `json:"shard"`
+	Replicas []string `json:"replicas"`
+	// Domains are the failure domains of the replicas, in replica order
+	Domains []string `json:"domains"`
+	Reason  string   `json:"reason"`
+}
+
+// PlacementViolations returns the shards, sorted by name, whose replicas are
+// not spread across as many failure domains as possible given the available
+// nodes and their failure domains. Shards without replicas are skipped.
+func (s *State) PlacementViolations(available []string, domains map[string]string) []PlacementViolation {
+	if len(domains) == 0 {
+		return nil
+	}
+
+	var out []PlacementViolation
+	for name, physical := range s.Physical {
+		if len(physical.BelongsToNodes) == 0 {
+			continue
+		}
+		err := cluster.CheckSpread(physical.BelongsToNodes, available, domains)
+		if err == nil {
+			continue
+		}
+		replicaDomains := make([]string, len(physical.BelongsToNodes))
+		for i, node := range physical.BelongsToNodes {
+			replicaDomains[i] = domains[node]
+		}
+		out = append(out, PlacementViolation{
+			Shard:    name,
+			Replicas: slices.Clone(physical.BelongsToNodes),
+			Domains:  replicaDomains,
+			Reason:   err.Error(),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Shard < out[j].Shard })
+	return out
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+type zonedNodeSelector struct {
+	cluster.NodeSelector
+	zones map[string]string
+}
+
+func (z zonedNodeSelector) Placement() cluster.Placement {
+	return cluster.NewPlacement(
+		cluster.PlacementConfig{Policy: cluster.PlacementPolicyPreferred, Domain: cluster.PlacementDomainZone},
+		func(node string) cluster.NodeLabels { return cluster.NodeLabels{Zone: z.zones[node]} })
+}
+
+// two zones, where the ring order places neighbours in the same zone
+var testZones = map[string]string{"N1": "a", "N2": "a", "N3": "b", "N4": "b"}
+
+func TestInitStateWithDomains(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(8)}, 4)
+	require.NoError(t, err)
+	nodes := []string{"N1", "N2", "N3", "N4"}
+
+	state, err := InitStateWithDomains("my-index", cfg, "N1", nodes, 2, false, testZones)
+	require.NoError(t, err)
+
+	for name, physical := range state.Physical {
+		require.Len(t, physical.BelongsToNodes, 2)
+		assert.NotEqual(t, testZones[physical.BelongsToNodes[0]], testZones[physical.BelongsToNodes[1]],
+			"replicas of shard %s share a zone", name)
+	}
+	assert.Empty(t, state.PlacementViolations(nodes, testZones))
+}
+
+func TestGetPartitionsWithDomains(t *testing.T) {
+	nodes := []string{"N1", "N2", "N3", "N4"}
+	shards := []string{"t1", "t2", "t3", "t4", "t5"}
+
+	partitions, err := State{}.GetPartitionsWithDomains(nodes, shards, 2, testZones)
+	require.NoError(t, err)
+	require.Len(t, partitions, len(shards))
+	for shard, replicas := range partitions {
+		require.Len(t, replicas, 2)
+		assert.NoError(t, cluster.CheckSpread(replicas, nodes, testZones), shard)
+	}
+}
+
+func TestAdjustReplicasWithPlacement(t *testing.T) {
+	nodes := zonedNodeSelector{NodeSelector: mocks.NewMockNodeSelector("N1", "N2", "N3", "N4"), zones: testZones}
+
+	p := Physical{Name: "shard", BelongsToNodes: []string{"N1"}}
+	require.NoError(t, p.AdjustReplicas(3, nodes))
+	assert.Equal(t, []string{"N1", "N3", "N2"}, p.BelongsToNodes)
+}
+
+func TestPlacementViolations(t *testing.T) {
+	nodes := []string{"N1", "N2", "N3", "N4"}
+	state := State{Physical: map[string]Physical{
+		"spread":  {Name: "spread", BelongsToNodes: []string{"N1", "N3"}},
+		"same":    {Name: "same", BelongsToNodes: []string{"N4", "N3"}},
+		"single":  {Name: "single", BelongsToNodes: []string{"N2"}},
+		"another": {Name: "another", BelongsToNodes: []string{"N1", "N2", "N4"}},
+		"empty":   {Name: "empty"},
+	}}
+
+	violations := state.PlacementViolations(nodes, testZones)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "same", violations[0].Shard)
+	assert.Equal(t, []string{"N4", "N3"}, violations[0].Replicas)
+	assert.Equal(t, []string{"b", "b"}, violations[0].Domains)
+	assert.NotEmpty(t, violations[0].Reason)
+
+	assert.Empty(t, state.PlacementViolations(nodes, nil))
+	// a single zone is available
+	assert.Empty(t, state.PlacementViolations([]string{"N3", "N4"}, map[string]string{"N3": "b", "N4": "b"}))
+}
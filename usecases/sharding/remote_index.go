@@ -21,6 +21,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -100,6 +101,8 @@ type RemoteIndexClient interface {
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
+	ReadChanges(ctx context.Context, hostName, indexName, shardName string,
+		after uint64, limit int, withObject bool) ([]changelog.Change, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -415,6 +418,19 @@ func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (st
 	return ri.client.GetShardStatus(ctx, host, ri.class, shardName)
 }
 
+// ReadChanges reads the change log of the replica of the shard on the given
+// node
+func (ri *RemoteIndex) ReadChanges(ctx context.Context, shardName, nodeName string,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.ReadChanges(ctx, host, ri.class, shardName, after, limit, withObject)
+}
+
 func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
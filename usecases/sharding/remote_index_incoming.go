@@ -17,6 +17,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 
@@ -89,6 +90,8 @@ type RemoteIndexIncomingRepo interface {
 		initialUUID, finalUUID strfmt.UUID, limit int) (result []types.RepairResponse, err error)
 	IncomingHashTreeLevel(ctx context.Context, shardName string,
 		level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	IncomingReadChanges(ctx context.Context, shardName string,
+		after uint64, limit int, withObject bool) ([]changelog.Change, error)
 
 	// Scale-Out Replication POC
 	IncomingFilePutter(ctx context.Context, shardName,
@@ -447,6 +450,17 @@ func (rii *RemoteIndexIncoming) HashTreeLevel(ctx context.Context,
 	return index.IncomingHashTreeLevel(ctx, shardName, level, discriminant)
 }
 
+func (rii *RemoteIndexIncoming) ReadChanges(ctx context.Context,
+	indexName, shardName string, after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingReadChanges(ctx, shardName, after, limit, withObject)
+}
+
 func (rii *RemoteIndexIncoming) AddAsyncReplicationTargetNode(
 	ctx context.Context,
 	indexName, shardName string,
@@ -144,6 +144,9 @@ type Physical struct {
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
 
 	Status string `json:"status,omitempty"`
+
+	// Split is set while the shard is being split into a new physical shard
+	Split *ShardSplit `json:"split,omitempty"`
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -255,16 +258,10 @@ func (p *Physical) AdjustReplicas(count int, nodes cluster.NodeSelector) error {
 		return fmt.Errorf("not enough storage replicas: found %d want %d", len(names), count)
 	}
 
-	// make sure included nodes are unique
-	for _, n := range names {
-		if !available[n] {
-			p.BelongsToNodes = append(p.BelongsToNodes, n)
-			available[n] = true
-		}
-		if len(available) == count {
-			break
-		}
-	}
+	// prefer nodes of failure domains which do not hold a replica yet
+	domains := nodes.Placement().Domains(names)
+	p.BelongsToNodes = append(p.BelongsToNodes,
+		cluster.PickSpread(p.BelongsToNodes, names, count-len(p.BelongsToNodes), domains)...)
 
 	return nil
 }
@@ -284,6 +281,14 @@ func (p *Physical) IsLocalShard(nodeName string) bool {
 }
 
 func InitState(id string, config config.Config, nodeLocalName string, names []string, replFactor int64, partitioningEnabled bool) (*State, error) {
+	return InitStateWithDomains(id, config, nodeLocalName, names, replFactor, partitioningEnabled, nil)
+}
+
+// InitStateWithDomains initializes the state like InitState, but spreads the
+// replicas of each shard across the failure domains of the nodes
+func InitStateWithDomains(id string, config config.Config, nodeLocalName string, names []string, replFactor int64,
+	partitioningEnabled bool, domains map[string]string,
+) (*State, error) {
 	if replFactor < 1 {
 		return nil, fmt.Errorf("replication factor must be at least 1, got %d", replFactor)
 	}
@@ -305,7 +310,7 @@ func InitState(id string, config config.Config, nodeLocalName string, names []st
 		return out, nil
 	}
 
-	if err := out.initPhysical(names, replFactor); err != nil {
+	if err := out.initPhysical(names, replFactor, domains); err != nil {
 		return nil, err
 	}
 	out.initVirtual()
@@ -418,7 +423,7 @@ func (s *State) IsLocalShard(name string) bool {
 // Shard 1: Node7, Node8, Node9, Node10, Node 11
 // Shard 2: Node8, Node9, Node10, Node 11, Node 12
 // Shard 3: Node9, Node10, Node11, Node 12, Node 1
-func (s *State) initPhysical(nodes []string, replFactor int64) error {
+func (s *State) initPhysical(nodes []string, replFactor int64, domains map[string]string) error {
 	if len(nodes) == 0 {
 		return fmt.Errorf("there is no nodes provided, can't initiate state for empty node list")
 	}
@@ -449,9 +454,7 @@ func (s *State) initPhysical(nodes []string, replFactor int64) error {
 			}
 		}
 
-		for i := replFactor; i > 1; i-- {
-			shard.BelongsToNodes = append(shard.BelongsToNodes, it.Next())
-		}
+		shard.BelongsToNodes = append(shard.BelongsToNodes, nextReplicas(it, nodes, shard.BelongsToNodes[0], replFactor, domains)...)
 
 		s.Physical[name] = shard
 	}
@@ -459,11 +462,42 @@ func (s *State) initPhysical(nodes []string, replFactor int64) error {
 	return nil
 }
 
+// nextReplicas returns the replicas which follow primary in the iteration
+// over nodes. Without failure domains these are the next nodes of the
+// iteration. Otherwise nodes of failure domains without a replica are
+// preferred, looking at the nodes in iteration order.
+func nextReplicas(it *cluster.NodeIterator, nodes []string, primary string, replFactor int64,
+	domains map[string]string,
+) []string {
+	next := make([]string, 0, replFactor-1)
+	for i := replFactor; i > 1; i-- {
+		next = append(next, it.Next())
+	}
+	if len(domains) == 0 {
+		return next
+	}
+
+	start := slices.Index(nodes, primary)
+	ring := make([]string, 0, len(nodes))
+	for i := 1; i < len(nodes); i++ {
+		ring = append(ring, nodes[(start+i)%len(nodes)])
+	}
+	return cluster.PickSpread([]string{primary}, ring, int(replFactor-1), domains)
+}
+
 // GetPartitions based on the specified shards, available nodes, and replFactor
 // It doesn't change the internal state
 // TODO-RAFT: Ensure this function is higherorder, if the repartition result is changed, this will result in
 // inconsistency when applying old log entry for add tenants
 func (s State) GetPartitions(nodes []string, shards []string, replFactor int64) (map[string][]string, error) {
+	return s.GetPartitionsWithDomains(nodes, shards, replFactor, nil)
+}
+
+// GetPartitionsWithDomains works like GetPartitions, but spreads the replicas
+// of each partition across the failure domains of the nodes
+func (s State) GetPartitionsWithDomains(nodes []string, shards []string, replFactor int64,
+	domains map[string]string,
+) (map[string][]string, error) {
 	if len(nodes) == 0 {
 		return nil, fmt.Errorf("list of storage nodes is empty")
 	}
@@ -498,9 +532,7 @@ func (s State) GetPartitions(nodes []string, shards []string, replFactor int64)
 			}
 		}
 
-		for i := replFactor; i > 1; i-- {
-			owners = append(owners, it.Next())
-		}
+		owners = append(owners, nextReplicas(it, nodes, owners[0], replFactor, domains)...)
 
 		partitions[name] = owners
 	}
@@ -705,12 +737,19 @@ func (p Physical) DeepCopy() Physical {
 	belongsCopy := make([]string, len(p.BelongsToNodes))
 	copy(belongsCopy, p.BelongsToNodes)
 
+	var splitCopy *ShardSplit
+	if p.Split != nil {
+		split := p.Split.DeepCopy()
+		splitCopy = &split
+	}
+
 	return Physical{
 		Name:           p.Name,
 		OwnsVirtual:    ownsVirtualCopy,
 		OwnsPercentage: p.OwnsPercentage,
 		BelongsToNodes: belongsCopy,
 		Status:         p.Status,
+		Split:          splitCopy,
 	}
 }
 
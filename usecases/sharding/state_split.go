@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/spaolacci/murmur3"
+)
+
+var ErrSplitInProgress = errors.New("shard split already in progress")
+
+// ShardSplit describes an online split of a physical shard which is still
+// being prepared. Until every replica of the source shard has reported
+// itself ready the Virtual shards keep being routed to the source shard.
+type ShardSplit struct {
+	// Target is the name of the physical shard that is being created
+	Target string `json:"target"`
+	// Virtual are the virtual shards that are moved to Target on cutover
+	Virtual []string `json:"virtual"`
+	// ReadyNodes are the replicas that finished copying into Target
+	ReadyNodes []string `json:"readyNodes,omitempty"`
+}
+
+func (s ShardSplit) DeepCopy() ShardSplit {
+	return ShardSplit{
+		Target:     s.Target,
+		Virtual:    slices.Clone(s.Virtual),
+		ReadyNodes: slices.Clone(s.ReadyNodes),
+	}
+}
+
+// StartSplit registers the split of source into a new physical shard named
+// target. The upper half of the token range owned by source, counted in
+// virtual shards, is selected to be moved. Routing is left unchanged until
+// the split is committed by MarkSplitReady.
+func (s *State) StartSplit(source, target string) error {
+	if s.PartitioningEnabled {
+		return fmt.Errorf("shard splitting is not supported for multi-tenant collections")
+	}
+	phys, ok := s.Physical[source]
+	if !ok {
+		return fmt.Errorf("could not find shard %s", source)
+	}
+	if phys.Split != nil {
+		return fmt.Errorf("%w: shard %s is being split into %s", ErrSplitInProgress, source, phys.Split.Target)
+	}
+	if _, ok := s.Physical[target]; ok {
+		return fmt.Errorf("shard %s already exists", target)
+	}
+	for _, other := range s.Physical {
+		if other.Split != nil && other.Split.Target == target {
+			return fmt.Errorf("shard %s is already the target of a split of %s", target, other.Name)
+		}
+	}
+
+	virtual, err := s.splitVirtual(phys)
+	if err != nil {
+		return fmt.Errorf("split shard %s: %w", source, err)
+	}
+
+	phys.Split = &ShardSplit{Target: target, Virtual: virtual}
+	s.Physical[source] = phys
+	return nil
+}
+
+// splitVirtual returns the virtual shards of phys which cover the upper half
+// of its token range
+func (s *State) splitVirtual(phys Physical) ([]string, error) {
+	if len(phys.OwnsVirtual) < 2 {
+		return nil, fmt.Errorf("shard owns %d virtual shards, at least 2 are needed", len(phys.OwnsVirtual))
+	}
+
+	owned := make([]*Virtual, 0, len(phys.OwnsVirtual))
+	for _, name := range phys.OwnsVirtual {
+		v := s.VirtualByName(name)
+		if v == nil {
+			return nil, fmt.Errorf("virtual shard %s not found", name)
+		}
+		owned = append(owned, v)
+	}
+	sort.Slice(owned, func(a, b int) bool {
+		return owned[a].Upper < owned[b].Upper
+	})
+
+	moved := owned[len(owned)/2:]
+	names := make([]string, len(moved))
+	for i, v := range moved {
+		names[i] = v.Name
+	}
+	return names, nil
+}
+
+// MarkSplitReady records that node finished preparing the split target of
+// source. Once all replicas of source are ready, the split is committed: the
+// target shard is added with the same replicas and the moved virtual shards
+// are reassigned to it. The returned split is non-nil if this call committed
+// the split.
+func (s *State) MarkSplitReady(source, node string) (*ShardSplit, error) {
+	phys, ok := s.Physical[source]
+	if !ok {
+		return nil, fmt.Errorf("could not find shard %s", source)
+	}
+	if phys.Split == nil {
+		return nil, fmt.Errorf("shard %s is not being split", source)
+	}
+	if !slices.Contains(phys.BelongsToNodes, node) {
+		return nil, fmt.Errorf("node %s is not a replica of shard %s", node, source)
+	}
+
+	if !slices.Contains(phys.Split.ReadyNodes, node) {
+		phys.Split.ReadyNodes = append(phys.Split.ReadyNodes, node)
+	}
+	for _, replica := range phys.BelongsToNodes {
+		if !slices.Contains(phys.Split.ReadyNodes, replica) {
+			s.Physical[source] = phys
+			return nil, nil
+		}
+	}
+
+	split := *phys.Split
+	s.commitSplit(phys, split)
+	return &split, nil
+}
+
+// AbortSplit drops a split that has not been committed yet
+func (s *State) AbortSplit(source string) (*ShardSplit, error) {
+	phys, ok := s.Physical[source]
+	if !ok {
+		return nil, fmt.Errorf("could not find shard %s", source)
+	}
+	if phys.Split == nil {
+		return nil, fmt.Errorf("shard %s is not being split", source)
+	}
+	split := *phys.Split
+	phys.Split = nil
+	s.Physical[source] = phys
+	return &split, nil
+}
+
+func (s *State) commitSplit(source Physical, split ShardSplit) {
+	target := Physical{
+		Name:           split.Target,
+		BelongsToNodes: slices.Clone(source.BelongsToNodes),
+		Status:         source.Status,
+	}
+
+	moved := make(map[string]struct{}, len(split.Virtual))
+	for _, name := range split.Virtual {
+		moved[name] = struct{}{}
+	}
+
+	source.OwnsVirtual = slices.DeleteFunc(source.OwnsVirtual, func(name string) bool {
+		_, ok := moved[name]
+		return ok
+	})
+	source.OwnsPercentage = 0
+	for i := range s.Virtual {
+		v := &s.Virtual[i]
+		if _, ok := moved[v.Name]; ok {
+			v.AssignedToPhysical = target.Name
+			target.OwnsVirtual = append(target.OwnsVirtual, v.Name)
+			target.OwnsPercentage += v.OwnsPercentage
+		} else if v.AssignedToPhysical == source.Name {
+			source.OwnsPercentage += v.OwnsPercentage
+		}
+	}
+	source.Split = nil
+
+	s.Physical[source.Name] = source
+	s.Physical[target.Name] = target
+	s.Config.DesiredCount = len(s.Physical)
+	s.Config.ActualCount = len(s.Physical)
+}
+
+// SplitInProgress returns the pending split of shard, if any
+func (s *State) SplitInProgress(shard string) *ShardSplit {
+	phys, ok := s.Physical[shard]
+	if !ok || phys.Split == nil {
+		return nil
+	}
+	split := phys.Split.DeepCopy()
+	return &split
+}
+
+// VirtualShard returns the name of the virtual shard the given object id
+// hashes into
+func (s *State) VirtualShard(in []byte) string {
+	if len(s.Virtual) == 0 {
+		return ""
+	}
+
+	h := murmur3.New64()
+	h.Write(in)
+	return s.virtualByToken(h.Sum64()).Name
+}
+
+// NewShardName returns a random name for a new physical shard, e.g. for the
+// target of a split
+func NewShardName() string {
+	return generateShardName()
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestStateSplit(t *testing.T) {
+	newState := func(t *testing.T) *State {
+		cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 2)
+		require.NoError(t, err)
+
+		nodes := mocks.NewMockNodeSelector("node1", "node2")
+		state, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 2, false)
+		require.NoError(t, err)
+		return state
+	}
+
+	t.Run("split moves half of the virtual shards once all replicas are ready", func(t *testing.T) {
+		state := newState(t)
+		source := state.AllPhysicalShards()[0]
+		owned := len(state.Physical[source].OwnsVirtual)
+
+		ids := make([][]byte, 1000)
+		for i := range ids {
+			ids[i] = make([]byte, 16)
+			rand.Read(ids[i])
+			require.Equal(t, source, state.PhysicalShard(ids[i]))
+		}
+
+		require.NoError(t, state.StartSplit(source, "target"))
+		split := state.SplitInProgress(source)
+		require.NotNil(t, split)
+		assert.Len(t, split.Virtual, owned-owned/2)
+
+		// routing is unchanged until the split is committed
+		for _, id := range ids {
+			require.Equal(t, source, state.PhysicalShard(id))
+		}
+		require.ErrorIs(t, state.StartSplit(source, "other"), ErrSplitInProgress)
+
+		committed, err := state.MarkSplitReady(source, "node1")
+		require.NoError(t, err)
+		assert.Nil(t, committed)
+		_, err = state.MarkSplitReady(source, "node3")
+		require.Error(t, err)
+
+		committed, err = state.MarkSplitReady(source, "node2")
+		require.NoError(t, err)
+		require.NotNil(t, committed)
+		assert.Equal(t, "target", committed.Target)
+		assert.Nil(t, state.SplitInProgress(source))
+
+		require.Len(t, state.Physical, 2)
+		assert.Equal(t, 2, state.Config.DesiredCount)
+		assert.ElementsMatch(t, state.Physical[source].BelongsToNodes, state.Physical["target"].BelongsToNodes)
+		assert.Len(t, state.Physical[source].OwnsVirtual, owned/2)
+		assert.ElementsMatch(t, split.Virtual, state.Physical["target"].OwnsVirtual)
+		assert.InDelta(t, 1.0, state.Physical[source].OwnsPercentage+state.Physical["target"].OwnsPercentage, 1e-9)
+
+		moved := map[string]struct{}{}
+		for _, name := range split.Virtual {
+			moved[name] = struct{}{}
+		}
+		for _, id := range ids {
+			expected := source
+			if _, ok := moved[state.VirtualShard(id)]; ok {
+				expected = "target"
+			}
+			assert.Equal(t, expected, state.PhysicalShard(id))
+		}
+	})
+
+	t.Run("abort keeps the routing", func(t *testing.T) {
+		state := newState(t)
+		source := state.AllPhysicalShards()[0]
+
+		require.NoError(t, state.StartSplit(source, "target"))
+		split, err := state.AbortSplit(source)
+		require.NoError(t, err)
+		assert.Equal(t, "target", split.Target)
+		assert.Nil(t, state.SplitInProgress(source))
+		assert.Len(t, state.Physical, 1)
+
+		_, err = state.MarkSplitReady(source, "node1")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid splits", func(t *testing.T) {
+		state := newState(t)
+		source := state.AllPhysicalShards()[0]
+
+		require.Error(t, state.StartSplit("unknown", "target"))
+		require.Error(t, state.StartSplit(source, source))
+
+		partitioned, err := InitState("my-index", config.Config{}, "node1", []string{"node1"}, 1, true)
+		require.NoError(t, err)
+		_, err = partitioned.AddPartition("tenant", []string{"node1"}, models.TenantActivityStatusHOT)
+		require.NoError(t, err)
+		require.Error(t, partitioned.StartSplit("tenant", "target"))
+	})
+
+	t.Run("split survives deep copy and serialization", func(t *testing.T) {
+		state := newState(t)
+		source := state.AllPhysicalShards()[0]
+		require.NoError(t, state.StartSplit(source, "target"))
+
+		copied := state.DeepCopy()
+		copied.Physical[source].Split.ReadyNodes = append(copied.Physical[source].Split.ReadyNodes, "node1")
+		assert.Empty(t, state.Physical[source].Split.ReadyNodes)
+
+		bytes, err := state.JSON()
+		require.NoError(t, err)
+		reloaded, err := StateFromJSON(bytes, mocks.NewMockNodeSelector("node1"))
+		require.NoError(t, err)
+		assert.Equal(t, state.SplitInProgress(source), reloaded.SplitInProgress(source))
+	})
+}
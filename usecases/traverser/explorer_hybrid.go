@@ -32,12 +32,25 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a sparse vector search if a learned sparse vector was
+// provided.  The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
-	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+	name := "keyword,bm25"
+	if params.HybridSearch.NearSparseVectorParams != nil {
+		if err := params.HybridSearch.NearSparseVectorParams.Validate(); err != nil {
+			return nil, "", err
+		}
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparseVector",
+			SparseVector: params.HybridSearch.NearSparseVectorParams,
+		}
+		name = "keyword,sparseVector"
+	} else {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Query:      params.HybridSearch.Query,
+			Type:       "bm25",
+			Properties: params.HybridSearch.Properties,
+		}
 	}
 
 	params.Group = nil
@@ -78,7 +91,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, name, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+)
+
+type changeReader interface {
+	ChangeSources(ctx context.Context, className, tenant string) ([]changelog.Source, error)
+	ReadChanges(ctx context.Context, className string, source changelog.Source,
+		after uint64, limit int, withObject bool) ([]changelog.Change, error)
+}
+
+// ChangeSources returns the shard replicas whose change logs make up the
+// change stream of the class, or of the tenant in case of multi-tenancy
+func (t *Traverser) ChangeSources(ctx context.Context, className, tenant string) ([]changelog.Source, error) {
+	reader, ok := t.vectorSearcher.(changeReader)
+	if !ok {
+		return nil, fmt.Errorf("change streams are not supported by %T", t.vectorSearcher)
+	}
+	return reader.ChangeSources(ctx, className, tenant)
+}
+
+// ReadChanges reads up to limit changes after the given offset from the
+// change log of the source. Unlike queries, reads of change streams are not
+// rate limited, as streams are long-lived and mostly idle.
+func (t *Traverser) ReadChanges(ctx context.Context, className string, source changelog.Source,
+	after uint64, limit int, withObject bool,
+) ([]changelog.Change, error) {
+	reader, ok := t.vectorSearcher.(changeReader)
+	if !ok {
+		return nil, fmt.Errorf("change streams are not supported by %T", t.vectorSearcher)
+	}
+	return reader.ReadChanges(ctx, className, source, after, limit, withObject)
+}
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type batchVectorSearcher interface {
+	VectorSearchBatch(ctx context.Context, params dto.BatchVectorSearchParams) ([]search.Results, error)
+}
+
+// SearchBatch runs several nearVector queries which share the class, target
+// vector, filters and limit. It counts as a single query for rate limiting.
+func (t *Traverser) SearchBatch(ctx context.Context, principal *models.Principal,
+	params dto.BatchVectorSearchParams,
+) ([]search.Results, error) {
+	before := time.Now()
+
+	searcher, ok := t.vectorSearcher.(batchVectorSearcher)
+	if !ok {
+		return nil, fmt.Errorf("batch vector search is not supported by %T", t.vectorSearcher)
+	}
+
+	if !t.ratelimiter.TryInc() {
+		return nil, enterrors.NewErrRateLimit()
+	}
+	defer t.ratelimiter.Dec()
+
+	t.metrics.QueriesGetInc(params.ClassName)
+	defer t.metrics.QueriesGetDec(params.ClassName)
+	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
+
+	if len(params.Vectors) == 0 {
+		return nil, errors.New("at least one query vector is required")
+	}
+
+	// validate here, because filters can contain references that need to be authorized
+	if err := t.validateFilters(ctx, principal, params.Filters); err != nil {
+		return nil, errors.Wrap(err, "invalid 'where' filter")
+	}
+
+	return searcher.VectorSearchBatch(ctx, params)
+}